@@ -1,10 +1,14 @@
 package main
 
 import (
+	"context"
+	"crypto/rand"
 	"fmt"
 	"log"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
@@ -12,9 +16,32 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/app"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/auth"
+	auth_handler "github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/auth"
+	brand_handler "github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/brand"
+	campaign_handler "github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/campaign"
 	copy_handler "github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/copy"
+	definition_handler "github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/definition"
+	job_handler "github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/job"
+	meta_handler "github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/meta"
+	product_handler "github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/product"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/middleware"
+	brand_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/brand"
+	campaign_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/campaign"
 	copy_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/copy"
+	definition_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/definition"
+	job_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/job"
+	product_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/product"
+	user_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/user"
 	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/routes"
+	auth_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/auth"
+	brand_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/brand"
+	campaign_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/campaign"
+	definition_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/definition"
+	job_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/job"
+	product_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/product"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/worker"
 )
 
 func main() {
@@ -41,6 +68,8 @@ func main() {
 
 	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Info),
+		// 一意制約の違反などをgorm.ErrDuplicatedKeyに変換し、リポジトリでドメインのエラーにする
+		TranslateError: true,
 	})
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
@@ -48,13 +77,70 @@ func main() {
 
 	// リポジトリの初期化
 	copyRepository := copy_repository.NewRepository(db)
+	channelRepository := definition_repository.NewChannelRepository(db)
+	toneRepository := definition_repository.NewToneRepository(db)
+	brandRepository := brand_repository.NewRepository(db)
+	productRepository := product_repository.NewRepository(db)
+	campaignRepository := campaign_repository.NewRepository(db)
+	userRepository := user_repository.NewRepository(db)
+	apiKeyRepository := user_repository.NewAPIKeyRepository(db)
+
+	// コピーのユースケース（ハンドラー・キャンペーン・生成ジョブ・スケジューラーで共有）
+	copyConfig, err := app.LoadCopyConfig()
+	if err != nil {
+		log.Fatalf("Failed to load copy config: %v", err)
+	}
+	copyUsecase, err := app.NewCopyUseCase(db, copyConfig)
+	if err != nil {
+		log.Fatalf("Failed to initialize copy usecase: %v", err)
+	}
+
+	// 認証（ログインで発行するトークンはAUTH_JWT_SECRETで署名し、AUTH_TOKEN_TTLの間有効）
+	authUsecase := auth_usecase.NewUseCase(userRepository, apiKeyRepository, auth.NewSigner(jwtSecret()),
+		auth_usecase.WithTokenTTL(tokenTTL()),
+	)
 
 	// ハンドラーの初期化
-	copyHandler := copy_handler.NewHandler(copyRepository)
+	definitionUsecase := definition_usecase.NewUseCase(channelRepository, toneRepository)
+	brandUsecase := brand_usecase.NewUseCase(brandRepository)
+	productUsecase := product_usecase.NewUseCase(productRepository, copyRepository)
+	campaignUsecase := campaign_usecase.NewUseCase(campaignRepository, copyRepository, copyUsecase)
+	copyHandler := copy_handler.NewHandlerWithUseCase(copyUsecase)
+
+	// 生成ジョブ（JOB_WORKERS個のワーカーをAPIプロセス内で起動、0の場合はcmd/workerで実行）
+	jobUsecase, err := job_usecase.NewUseCase(job_repository.NewRepository(db), copyUsecase, jobOptions()...)
+	if err != nil {
+		log.Fatalf("Failed to initialize generation jobs: %v", err)
+	}
+	jobHandler := job_handler.NewHandler(jobUsecase)
+	workers := 2
+	if n, err := strconv.Atoi(os.Getenv("JOB_WORKERS")); err == nil {
+		workers = n
+	}
+	if workers > 0 {
+		go worker.NewPool(jobUsecase, workers, pollInterval()).Run(context.Background())
+	} else if err := copyConfig.RequireSharedSearchIndex(); err != nil {
+		// 生成をcmd/workerで実行する場合、プロセスごとの索引にはワーカーが作成したコピーが反映されない
+		log.Fatalf("Failed to initialize generation jobs: %v", err)
+	}
+
+	// 公開日時・公開終了日時によるコピーの公開・アーカイブ（SCHEDULE_INTERVALごと、0の場合は実行しない）
+	if interval := scheduleInterval(); interval > 0 {
+		go worker.NewScheduler("copy-schedule", interval, func(ctx context.Context) error {
+			result, err := copyUsecase.RunSchedule(ctx)
+			if result != nil && len(result.Published)+len(result.Archived) > 0 {
+				log.Printf("Scheduled copies: published=%v archived=%v", result.Published, result.Archived)
+			}
+			return err
+		}).Run(context.Background())
+	}
 
 	// Ginルーターの初期化
 	r := gin.Default()
 
+	// c.Errorで登録したエラーをRFC 7807のレスポンスに変換
+	r.Use(middleware.ErrorHandler())
+
 	// CORS設定
 	r.Use(func(c *gin.Context) {
 		// 環境変数から許可するオリジンのリストを取得（カンマ区切り）
@@ -73,8 +159,8 @@ func main() {
 			}
 		}
 
-		c.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		c.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-API-Key, X-Client-ID")
 		if c.Request.Method == "OPTIONS" {
 			c.AbortWithStatus(204)
 			return
@@ -82,6 +168,29 @@ func main() {
 		c.Next()
 	})
 
+	// トークン・APIキーから呼び出し元を特定し、コピーの生成者などとして記録する
+	r.Use(middleware.Authenticate(authUsecase))
+
+	// 参照以外のリクエストは認証を必須とする（AUTH_REQUIRED=falseの場合は匿名の呼び出しも受け付ける）
+	// 参照のうち、レビュー中のコピー・版・生成結果・生成ジョブ・キャンペーンと商品のコピーの一覧は未公開のコピーを含むため認証を必須とする
+	// 未公開を含む検索・未公開のコピーの取得はハンドラーで拒否する
+	// ログイン・利用者の登録（最初の利用者）は認証せずに受け付ける
+	// いいねは匿名の投票者をクライアントIDで区別するだけで、IDを変えれば何度でも数えられるため認証を必須とする
+	if os.Getenv("AUTH_REQUIRED") != "false" {
+		r.Use(middleware.RequireAuth(
+			middleware.SafeMethodExcept(
+				"/api/v1/copies/in-review",
+				"/api/v1/copies/:id/revisions",
+				"/api/v1/copies/:id/revisions/diff",
+				"/api/v1/generations/:id",
+				"/api/v1/generation-jobs/:id",
+				"/api/v1/campaigns/:id/copies",
+				"/api/v1/products/:id/copies",
+			),
+			middleware.Paths("/api/v1/auth/login", "/api/v1/users"),
+		))
+	}
+
 	// ヘルスチェックエンドポイント
 	r.GET("/health", func(c *gin.Context) {
 		c.JSON(200, gin.H{
@@ -91,6 +200,13 @@ func main() {
 
 	// ルートの設定
 	routes.SetupCopyRoutes(r, copyHandler)
+	routes.SetupJobRoutes(r, jobHandler)
+	routes.SetupDefinitionRoutes(r, definition_handler.NewHandler(definitionUsecase))
+	routes.SetupMetaRoutes(r, meta_handler.NewHandler(definitionUsecase))
+	routes.SetupBrandRoutes(r, brand_handler.NewHandler(brandUsecase))
+	routes.SetupProductRoutes(r, product_handler.NewHandler(productUsecase))
+	routes.SetupCampaignRoutes(r, campaign_handler.NewHandler(campaignUsecase))
+	routes.SetupAuthRoutes(r, auth_handler.NewHandler(authUsecase))
 
 	// サーバー起動
 	port := os.Getenv("PORT")
@@ -102,3 +218,65 @@ func main() {
 		log.Fatalf("Failed to start server: %v", err)
 	}
 }
+
+// jobOptions: 環境変数から生成ジョブの設定を読み込む
+func jobOptions() []job_usecase.Option {
+	var opts []job_usecase.Option
+	if n, err := strconv.Atoi(os.Getenv("JOB_MAX_ATTEMPTS")); err == nil {
+		opts = append(opts, job_usecase.WithMaxAttempts(n))
+	}
+	if d, err := time.ParseDuration(os.Getenv("JOB_TIMEOUT")); err == nil {
+		opts = append(opts, job_usecase.WithTimeout(d))
+	}
+	return opts
+}
+
+// scheduleInterval: コピーの公開・アーカイブを確認する間隔（SCHEDULE_INTERVAL、例: 30s、未設定の場合は既定値）
+func scheduleInterval() time.Duration {
+	value := os.Getenv("SCHEDULE_INTERVAL")
+	if value == "" {
+		return worker.DefaultScheduleInterval
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		log.Fatalf("Failed to parse SCHEDULE_INTERVAL: %v", err)
+	}
+	return d
+}
+
+// jwtSecret: トークンの署名に使う秘密鍵（AUTH_JWT_SECRET）
+//
+// 本番環境では必須とし、それ以外で未設定の場合は起動ごとにランダムな鍵を生成します（再起動でトークンは無効になる）。
+func jwtSecret() []byte {
+	if secret := os.Getenv("AUTH_JWT_SECRET"); secret != "" {
+		return []byte(secret)
+	}
+	if os.Getenv("ENVIRONMENT") == "production" {
+		log.Fatal("AUTH_JWT_SECRET is required in production")
+	}
+	log.Println("Warning: AUTH_JWT_SECRET is not set, using a random secret")
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		log.Fatalf("Failed to generate JWT secret: %v", err)
+	}
+	return secret
+}
+
+// tokenTTL: ログインで発行するトークンの有効期間（AUTH_TOKEN_TTL、例: 12h、未設定の場合は既定値）
+func tokenTTL() time.Duration {
+	value := os.Getenv("AUTH_TOKEN_TTL")
+	if value == "" {
+		return auth_usecase.DefaultTokenTTL
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		log.Fatalf("Failed to parse AUTH_TOKEN_TTL: %v", err)
+	}
+	return d
+}
+
+// pollInterval: 実行するジョブがない場合の待機時間（JOB_POLL_INTERVAL、例: 500ms）
+func pollInterval() time.Duration {
+	d, _ := time.ParseDuration(os.Getenv("JOB_POLL_INTERVAL"))
+	return d
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/joho/godotenv"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/app"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/feed"
+	copy_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/copy"
+)
+
+// CSV / JSONLの商品フィードから一括でコピーを生成し、行ごとの結果をCSVで出力するコマンド
+//
+//	go run ./cmd/bulk -in products.csv -out report.csv -concurrency 8
+func main() {
+	in := flag.String("in", "", "商品フィードのファイル（CSVまたはJSONL）")
+	format := flag.String("format", "", "フィードの形式（csv / jsonl、省略時は拡張子で判定）")
+	out := flag.String("out", "", "結果のCSVの出力先（省略時は標準出力）")
+	concurrency := flag.Int("concurrency", copy_usecase.DefaultBulkConcurrency, "同時に生成するコピー数")
+	flag.Parse()
+	if *in == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// 環境変数の読み込み（開発環境のみ）
+	if os.Getenv("ENVIRONMENT") != "production" {
+		if err := godotenv.Load(); err != nil {
+			log.Println("Warning: .env file not found")
+		}
+	}
+
+	// データベース接続
+	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
+		os.Getenv("MYSQL_USER"),
+		os.Getenv("MYSQL_PASSWORD"),
+		os.Getenv("MYSQL_DB_HOST"),
+		os.Getenv("MYSQL_DB_PORT"),
+		os.Getenv("MYSQL_DATABASE"),
+	)
+
+	// 環境変数が設定されていない場合はデフォルト値を使用
+	if dsn == "@tcp(:)/?charset=utf8mb4&parseTime=True&loc=Local" {
+		dsn = "user:password@tcp(db:3306)/ai_sales_copy?charset=utf8mb4&parseTime=True&loc=Local"
+	}
+
+	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Warn),
+		// 一意制約の違反などをgorm.ErrDuplicatedKeyに変換し、リポジトリでドメインのエラーにする
+		TranslateError: true,
+	})
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+
+	// コピーのユースケース（同時に生成するコピー数は引数で指定）
+	copyConfig, err := app.LoadCopyConfig()
+	if err != nil {
+		log.Fatalf("Failed to load copy config: %v", err)
+	}
+	// このプロセスで作成したコピーをAPIの検索に反映するため、プロセスごとの索引は使用しない
+	if err := copyConfig.RequireSharedSearchIndex(); err != nil {
+		log.Fatalf("Failed to load copy config: %v", err)
+	}
+	usecase, err := app.NewCopyUseCase(db, copyConfig, copy_usecase.WithBulkConcurrency(*concurrency))
+	if err != nil {
+		log.Fatalf("Failed to initialize copy usecase: %v", err)
+	}
+
+	// フィードの読み込み
+	file, err := os.Open(*in)
+	if err != nil {
+		log.Fatalf("Failed to open feed: %v", err)
+	}
+	defer file.Close()
+
+	feedFormat, err := feed.DetectFormat(*format, *in, "")
+	if err != nil {
+		log.Fatalf("Failed to detect feed format: %v", err)
+	}
+	rows, err := feed.Parse(file, feedFormat)
+	if err != nil {
+		log.Fatalf("Failed to parse feed: %v", err)
+	}
+
+	// SIGINT / SIGTERMで未着手の行の生成を取りやめる
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	log.Printf("Generating copies for %d rows", len(rows))
+	report := feed.NewReport(rows, usecase.CreateBulk(ctx, feed.Inputs(rows)))
+
+	// 結果の書き出し
+	w := os.Stdout
+	if *out != "" {
+		w, err = os.Create(*out)
+		if err != nil {
+			log.Fatalf("Failed to create report: %v", err)
+		}
+		defer w.Close()
+	}
+	if err := report.WriteCSV(w); err != nil {
+		log.Fatalf("Failed to write report: %v", err)
+	}
+	log.Printf("Succeeded: %d, Failed: %d", report.Succeeded, report.Failed)
+}
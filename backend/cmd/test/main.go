@@ -1,10 +1,13 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
@@ -12,9 +15,32 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/app"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/auth"
+	auth_handler "github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/auth"
+	brand_handler "github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/brand"
+	campaign_handler "github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/campaign"
 	copy_handler "github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/copy"
+	definition_handler "github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/definition"
+	job_handler "github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/job"
+	meta_handler "github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/meta"
+	product_handler "github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/product"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/middleware"
+	brand_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/brand"
+	campaign_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/campaign"
 	copy_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/copy"
+	definition_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/definition"
+	job_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/job"
+	product_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/product"
+	user_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/user"
 	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/routes"
+	auth_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/auth"
+	brand_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/brand"
+	campaign_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/campaign"
+	definition_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/definition"
+	job_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/job"
+	product_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/product"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/worker"
 )
 
 func main() {
@@ -39,6 +65,8 @@ func main() {
 
 	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Info),
+		// 一意制約の違反などをgorm.ErrDuplicatedKeyに変換し、リポジトリでドメインのエラーにする
+		TranslateError: true,
 	})
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
@@ -46,13 +74,60 @@ func main() {
 
 	// リポジトリの初期化
 	copyRepository := copy_repository.NewRepository(db)
+	channelRepository := definition_repository.NewChannelRepository(db)
+	toneRepository := definition_repository.NewToneRepository(db)
+	brandRepository := brand_repository.NewRepository(db)
+	productRepository := product_repository.NewRepository(db)
+	campaignRepository := campaign_repository.NewRepository(db)
+
+	// コピーのユースケース（ハンドラー・キャンペーン・生成ジョブで共有）
+	copyConfig, err := app.LoadCopyConfig()
+	if err != nil {
+		log.Fatalf("Failed to load copy config: %v", err)
+	}
+	copyUsecase, err := app.NewCopyUseCase(db, copyConfig)
+	if err != nil {
+		log.Fatalf("Failed to initialize copy usecase: %v", err)
+	}
+
+	// 認証（E2Eテスト用の固定の鍵で署名し、AUTH_JWT_SECRETで上書きできる）
+	jwtSecret := os.Getenv("AUTH_JWT_SECRET")
+	if jwtSecret == "" {
+		jwtSecret = "test-jwt-secret"
+	}
+	authUsecase := auth_usecase.NewUseCase(
+		user_repository.NewRepository(db),
+		user_repository.NewAPIKeyRepository(db),
+		auth.NewSigner([]byte(jwtSecret)),
+	)
 
 	// ハンドラーの初期化
-	copyHandler := copy_handler.NewHandler(copyRepository)
+	definitionUsecase := definition_usecase.NewUseCase(channelRepository, toneRepository)
+	brandUsecase := brand_usecase.NewUseCase(brandRepository)
+	productUsecase := product_usecase.NewUseCase(productRepository, copyRepository)
+	campaignUsecase := campaign_usecase.NewUseCase(campaignRepository, copyRepository, copyUsecase)
+	copyHandler := copy_handler.NewHandlerWithUseCase(copyUsecase)
+
+	// 生成ジョブ（JOB_WORKERS個のワーカーをAPIプロセス内で起動、0の場合はcmd/workerで実行）
+	jobUsecase, err := job_usecase.NewUseCase(job_repository.NewRepository(db), copyUsecase, jobOptions()...)
+	if err != nil {
+		log.Fatalf("Failed to initialize generation jobs: %v", err)
+	}
+	jobHandler := job_handler.NewHandler(jobUsecase)
+	workers := 2
+	if n, err := strconv.Atoi(os.Getenv("JOB_WORKERS")); err == nil {
+		workers = n
+	}
+	if workers > 0 {
+		go worker.NewPool(jobUsecase, workers, pollInterval()).Run(context.Background())
+	}
 
 	// Ginルーターの初期化
 	r := gin.Default()
 
+	// c.Errorで登録したエラーをRFC 7807のレスポンスに変換
+	r.Use(middleware.ErrorHandler())
+
 	// CORS設定
 	r.Use(func(c *gin.Context) {
 		// 環境変数から許可するオリジンのリストを取得（カンマ区切り）
@@ -71,8 +146,8 @@ func main() {
 			}
 		}
 
-		c.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		c.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-API-Key, X-Client-ID")
 		c.Writer.Header().Set("Access-Control-Max-Age", "86400") // 24時間
 
 		if c.Request.Method == "OPTIONS" {
@@ -82,6 +157,9 @@ func main() {
 		c.Next()
 	})
 
+	// トークン・APIキーから呼び出し元を特定する（E2Eテストでは匿名の呼び出しも受け付ける）
+	r.Use(middleware.Authenticate(authUsecase))
+
 	// ヘルスチェックエンドポイントの追加
 	r.GET("/api/v1/health", func(c *gin.Context) {
 		c.JSON(200, gin.H{
@@ -91,6 +169,13 @@ func main() {
 
 	// ルートの設定
 	routes.SetupCopyRoutes(r, copyHandler)
+	routes.SetupJobRoutes(r, jobHandler)
+	routes.SetupDefinitionRoutes(r, definition_handler.NewHandler(definitionUsecase))
+	routes.SetupMetaRoutes(r, meta_handler.NewHandler(definitionUsecase))
+	routes.SetupBrandRoutes(r, brand_handler.NewHandler(brandUsecase))
+	routes.SetupProductRoutes(r, product_handler.NewHandler(productUsecase))
+	routes.SetupCampaignRoutes(r, campaign_handler.NewHandler(campaignUsecase))
+	routes.SetupAuthRoutes(r, auth_handler.NewHandler(authUsecase))
 
 	// サーバー起動
 	port := os.Getenv("PORT")
@@ -102,3 +187,21 @@ func main() {
 		log.Fatalf("Failed to start server: %v", err)
 	}
 }
+
+// jobOptions: 環境変数から生成ジョブの設定を読み込む
+func jobOptions() []job_usecase.Option {
+	var opts []job_usecase.Option
+	if n, err := strconv.Atoi(os.Getenv("JOB_MAX_ATTEMPTS")); err == nil {
+		opts = append(opts, job_usecase.WithMaxAttempts(n))
+	}
+	if d, err := time.ParseDuration(os.Getenv("JOB_TIMEOUT")); err == nil {
+		opts = append(opts, job_usecase.WithTimeout(d))
+	}
+	return opts
+}
+
+// pollInterval: 実行するジョブがない場合の待機時間（JOB_POLL_INTERVAL、例: 500ms）
+func pollInterval() time.Duration {
+	d, _ := time.ParseDuration(os.Getenv("JOB_POLL_INTERVAL"))
+	return d
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/joho/godotenv"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/app"
+	job_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/job"
+	job_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/job"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/worker"
+)
+
+// 生成ジョブをAPIプロセスとは別に実行するワーカー
+// APIのJOB_WORKERSを0にし、このプロセスをスケールさせて使用します。
+func main() {
+	// 環境変数の読み込み（開発環境のみ）
+	if os.Getenv("ENVIRONMENT") != "production" {
+		if err := godotenv.Load(); err != nil {
+			log.Println("Warning: .env file not found")
+		}
+	}
+
+	// データベース接続
+	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
+		os.Getenv("MYSQL_USER"),
+		os.Getenv("MYSQL_PASSWORD"),
+		os.Getenv("MYSQL_DB_HOST"),
+		os.Getenv("MYSQL_DB_PORT"),
+		os.Getenv("MYSQL_DATABASE"),
+	)
+
+	// 環境変数が設定されていない場合はデフォルト値を使用
+	if dsn == "@tcp(:)/?charset=utf8mb4&parseTime=True&loc=Local" {
+		dsn = "user:password@tcp(db:3306)/ai_sales_copy?charset=utf8mb4&parseTime=True&loc=Local"
+	}
+
+	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+		// 一意制約の違反などをgorm.ErrDuplicatedKeyに変換し、リポジトリでドメインのエラーにする
+		TranslateError: true,
+	})
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+
+	// コピーのユースケース
+	copyConfig, err := app.LoadCopyConfig()
+	if err != nil {
+		log.Fatalf("Failed to load copy config: %v", err)
+	}
+	// このプロセスで作成したコピーをAPIの検索に反映するため、プロセスごとの索引は使用しない
+	if err := copyConfig.RequireSharedSearchIndex(); err != nil {
+		log.Fatalf("Failed to load copy config: %v", err)
+	}
+	copyUsecase, err := app.NewCopyUseCase(db, copyConfig)
+	if err != nil {
+		log.Fatalf("Failed to initialize copy usecase: %v", err)
+	}
+
+	// 生成ジョブのワーカー（JOB_WORKERS個を並行して実行）
+	jobUsecase, err := job_usecase.NewUseCase(job_repository.NewRepository(db), copyUsecase, jobOptions()...)
+	if err != nil {
+		log.Fatalf("Failed to initialize generation jobs: %v", err)
+	}
+
+	workers := 4
+	if n, err := strconv.Atoi(os.Getenv("JOB_WORKERS")); err == nil && n > 0 {
+		workers = n
+	}
+
+	// SIGINT / SIGTERMで新しいジョブの取得を止め、実行中のジョブが終わるのを待って終了
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	log.Printf("Worker started with %d workers", workers)
+	worker.NewPool(jobUsecase, workers, pollInterval()).Run(ctx)
+	log.Println("Worker stopped")
+}
+
+// jobOptions: 環境変数から生成ジョブの設定を読み込む
+func jobOptions() []job_usecase.Option {
+	var opts []job_usecase.Option
+	if n, err := strconv.Atoi(os.Getenv("JOB_MAX_ATTEMPTS")); err == nil {
+		opts = append(opts, job_usecase.WithMaxAttempts(n))
+	}
+	if d, err := time.ParseDuration(os.Getenv("JOB_TIMEOUT")); err == nil {
+		opts = append(opts, job_usecase.WithTimeout(d))
+	}
+	return opts
+}
+
+// pollInterval: 実行するジョブがない場合の待機時間（JOB_POLL_INTERVAL、例: 500ms）
+func pollInterval() time.Duration {
+	d, _ := time.ParseDuration(os.Getenv("JOB_POLL_INTERVAL"))
+	return d
+}
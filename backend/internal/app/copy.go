@@ -0,0 +1,159 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"gorm.io/gorm"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/compliance"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/llm"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/prompt"
+	brand_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/brand"
+	campaign_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/campaign"
+	copy_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/copy"
+	definition_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/definition"
+	product_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/product"
+	revision_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/revision"
+	search_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/search"
+	copy_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/copy"
+)
+
+// 全文検索の索引の種類（SEARCH_INDEX）
+const (
+	// SearchIndexMySQL: MySQLのFULLTEXTインデックス（どのプロセスの書き込みも反映）
+	SearchIndexMySQL = "mysql"
+	// SearchIndexMemory: プロセスごとのメモリ上の索引（他のプロセスの書き込みは反映しない）
+	SearchIndexMemory = "memory"
+)
+
+// ErrProcessLocalSearchIndex: 他のプロセスもコピーを書き込む構成でメモリ上の索引を指定した場合のエラー
+var ErrProcessLocalSearchIndex = errors.New("SEARCH_INDEX=memory is process-local; copies written by other processes would be missing from search")
+
+// CopyConfig: コピーのユースケースの設定（API・ワーカー・一括生成・E2Eテスト用のサーバーで共通）
+//
+// 数値の項目は0の場合に既定値を使用します。
+type CopyConfig struct {
+	// LLM: LLMプロバイダーの設定
+	LLM llm.Config
+	// PromptTemplateDir: 埋め込みのプロンプトテンプレートを上書きするディレクトリ（PROMPT_TEMPLATE_DIR）
+	PromptTemplateDir string
+	// MaxAttempts: 出力が不正な場合の試行回数（LLM_MAX_ATTEMPTS）
+	MaxAttempts int
+	// LengthPolicy: 出力がチャネルの長さの制限を満たさない場合の扱い（COPY_LENGTH_POLICY）
+	LengthPolicy copy_usecase.LengthPolicy
+	// ComplianceRulesPath: 埋め込みの表現規制の辞書を上書きする辞書（COMPLIANCE_RULES_PATH）
+	ComplianceRulesPath string
+	// BlockSeverity: この重大度以上の表現を含むコピーは公開しない（COMPLIANCE_BLOCK_SEVERITY、空の場合は記録のみ）
+	BlockSeverity entity.Severity
+	// BulkConcurrency: 一括生成で同時に生成するコピー数（BULK_CONCURRENCY）
+	BulkConcurrency int
+	// SearchIndex: 全文検索の索引（SEARCH_INDEX=mysql|memory、未設定の場合はmysql）
+	SearchIndex string
+}
+
+// LoadCopyConfig: 環境変数からコピーのユースケースの設定を読み込む
+func LoadCopyConfig() (CopyConfig, error) {
+	cfg := CopyConfig{
+		LLM:                 llm.LoadConfig(),
+		PromptTemplateDir:   os.Getenv("PROMPT_TEMPLATE_DIR"),
+		ComplianceRulesPath: os.Getenv("COMPLIANCE_RULES_PATH"),
+		BlockSeverity:       entity.Severity(os.Getenv("COMPLIANCE_BLOCK_SEVERITY")),
+		SearchIndex:         os.Getenv("SEARCH_INDEX"),
+	}
+	if n, err := strconv.Atoi(os.Getenv("LLM_MAX_ATTEMPTS")); err == nil {
+		cfg.MaxAttempts = n
+	}
+	if n, err := strconv.Atoi(os.Getenv("BULK_CONCURRENCY")); err == nil {
+		cfg.BulkConcurrency = n
+	}
+
+	policy, err := copy_usecase.ParseLengthPolicy(os.Getenv("COPY_LENGTH_POLICY"))
+	if err != nil {
+		return CopyConfig{}, fmt.Errorf("COPY_LENGTH_POLICY: %w", err)
+	}
+	cfg.LengthPolicy = policy
+
+	if cfg.BlockSeverity != "" && !cfg.BlockSeverity.Valid() {
+		return CopyConfig{}, fmt.Errorf("invalid COMPLIANCE_BLOCK_SEVERITY: %q", cfg.BlockSeverity)
+	}
+
+	switch cfg.SearchIndex {
+	case "":
+		cfg.SearchIndex = SearchIndexMySQL
+	case SearchIndexMySQL, SearchIndexMemory:
+	default:
+		return CopyConfig{}, fmt.Errorf("unknown SEARCH_INDEX: %q", cfg.SearchIndex)
+	}
+	return cfg, nil
+}
+
+// RequireSharedSearchIndex: 他のプロセスもコピーを書き込む場合に、プロセスごとの索引の指定を拒否する
+//
+// メモリ上の索引は作成したプロセスの書き込みしか反映しないため、
+// cmd/workerやcmd/bulkが書き込む構成ではFULLTEXTインデックスを使用します。
+func (c CopyConfig) RequireSharedSearchIndex() error {
+	if c.SearchIndex == SearchIndexMemory {
+		return ErrProcessLocalSearchIndex
+	}
+	return nil
+}
+
+// NewCopyUseCase: dbのリポジトリとcfgの設定でコピーのユースケースを作成
+//
+// 1プロセスで1つ作成し、ハンドラー・生成ジョブ・キャンペーン・スケジューラーで共有します。
+// optsはcfgの設定の後に適用します（コマンドの引数で上書きする場合など）。
+func NewCopyUseCase(db *gorm.DB, cfg CopyConfig, opts ...copy_usecase.Option) (copy_usecase.UseCase, error) {
+	generator, err := llm.New(cfg.LLM)
+	if err != nil {
+		return nil, fmt.Errorf("initialize LLM provider: %w", err)
+	}
+	prompts, err := prompt.Load(cfg.PromptTemplateDir)
+	if err != nil {
+		return nil, fmt.Errorf("load prompt templates: %w", err)
+	}
+	checker, err := compliance.Load(cfg.ComplianceRulesPath)
+	if err != nil {
+		return nil, fmt.Errorf("load compliance rules: %w", err)
+	}
+	searchIndex, err := newSearchIndex(db, cfg.SearchIndex)
+	if err != nil {
+		return nil, fmt.Errorf("initialize search index: %w", err)
+	}
+
+	options := []copy_usecase.Option{
+		copy_usecase.WithPromptStore(prompts),
+		// 生成・編集ごとにコピーの版を記録
+		copy_usecase.WithRevisionRepository(revision_repository.NewRepository(db)),
+		// 配信チャネル・トーンの定義（プロンプトの指示・出力形式・長さの制限）
+		copy_usecase.WithDefinitions(definition_repository.NewChannelRepository(db), definition_repository.NewToneRepository(db)),
+		copy_usecase.WithBrandProfiles(brand_repository.NewRepository(db)),
+		copy_usecase.WithProducts(product_repository.NewRepository(db)),
+		copy_usecase.WithCampaigns(campaign_repository.NewRepository(db)),
+		copy_usecase.WithMaxAttempts(cfg.MaxAttempts),
+		copy_usecase.WithLengthPolicy(cfg.LengthPolicy),
+		copy_usecase.WithComplianceChecker(checker),
+		copy_usecase.WithPublishBlocking(cfg.BlockSeverity),
+		copy_usecase.WithBulkConcurrency(cfg.BulkConcurrency),
+		// どのプロセスで作成・更新したコピーも検索の索引に反映
+		copy_usecase.WithSearchIndex(searchIndex),
+	}
+	return copy_usecase.NewUseCase(copy_repository.NewRepository(db), generator, append(options, opts...)...), nil
+}
+
+// newSearchIndex: 全文検索の索引を作成
+func newSearchIndex(db *gorm.DB, kind string) (repository.CopySearchIndex, error) {
+	switch kind {
+	case "", SearchIndexMySQL:
+		return search_repository.NewMySQLIndex(db), nil
+	case SearchIndexMemory:
+		return search_repository.NewBigramIndex(context.Background(), db)
+	default:
+		return nil, fmt.Errorf("unknown search index: %s", kind)
+	}
+}
@@ -0,0 +1,105 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+)
+
+func TestLoadCopyConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		want    func(t *testing.T, cfg CopyConfig)
+		wantErr bool
+	}{
+		{
+			name: "正常系_環境変数から読み込む",
+			env: map[string]string{
+				"LLM_MAX_ATTEMPTS":          "5",
+				"BULK_CONCURRENCY":          "8",
+				"COMPLIANCE_BLOCK_SEVERITY": "high",
+			},
+			want: func(t *testing.T, cfg CopyConfig) {
+				assert.Equal(t, 5, cfg.MaxAttempts)
+				assert.Equal(t, 8, cfg.BulkConcurrency)
+				assert.Equal(t, entity.SeverityHigh, cfg.BlockSeverity)
+				assert.Equal(t, SearchIndexMySQL, cfg.SearchIndex)
+			},
+		},
+		{
+			name: "正常系_数値が不正な場合は既定値",
+			env: map[string]string{
+				"LLM_MAX_ATTEMPTS": "many",
+			},
+			want: func(t *testing.T, cfg CopyConfig) {
+				assert.Zero(t, cfg.MaxAttempts)
+			},
+		},
+		{
+			name:    "異常系_重大度が不正",
+			env:     map[string]string{"COMPLIANCE_BLOCK_SEVERITY": "fatal"},
+			wantErr: true,
+		},
+		{
+			name:    "異常系_検索の索引が不正",
+			env:     map[string]string{"SEARCH_INDEX": "elasticsearch"},
+			wantErr: true,
+		},
+		{
+			name:    "異常系_長さのポリシーが不正",
+			env:     map[string]string{"COPY_LENGTH_POLICY": "ignore"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// 環境変数の準備
+			for _, key := range []string{"LLM_MAX_ATTEMPTS", "BULK_CONCURRENCY", "COMPLIANCE_BLOCK_SEVERITY", "COPY_LENGTH_POLICY", "SEARCH_INDEX"} {
+				t.Setenv(key, tt.env[key])
+			}
+
+			// テスト実行
+			cfg, err := LoadCopyConfig()
+
+			// アサーション
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			tt.want(t, cfg)
+		})
+	}
+}
+
+func TestCopyConfig_RequireSharedSearchIndex(t *testing.T) {
+	tests := []struct {
+		name        string
+		searchIndex string
+		wantErr     error
+	}{
+		{
+			name:        "正常系_FULLTEXTインデックス",
+			searchIndex: SearchIndexMySQL,
+		},
+		{
+			name:        "異常系_プロセスごとの索引",
+			searchIndex: SearchIndexMemory,
+			wantErr:     ErrProcessLocalSearchIndex,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト実行
+			err := CopyConfig{SearchIndex: tt.searchIndex}.RequireSharedSearchIndex()
+
+			// アサーション
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/domainerr"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+)
+
+// ErrAuthenticationRequired: 認証が必要な操作を認証せずに行った
+var ErrAuthenticationRequired = domainerr.Unauthenticated("authentication_required", "authentication is required")
+
+type identityKey struct{}
+
+type authRequiredKey struct{}
+
+// WithIdentity: 呼び出し元を含むコンテキストを作成
+func WithIdentity(ctx context.Context, identity *entity.Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// IdentityFrom: コンテキストの呼び出し元（認証されていない場合はnil）
+func IdentityFrom(ctx context.Context) *entity.Identity {
+	identity, _ := ctx.Value(identityKey{}).(*entity.Identity)
+	return identity
+}
+
+// UserID: コンテキストの呼び出し元の利用者のID（認証されていない場合はnil、コピーの生成者として記録する）
+func UserID(ctx context.Context) *int {
+	identity := IdentityFrom(ctx)
+	if identity == nil {
+		return nil
+	}
+	id := identity.UserID
+	return &id
+}
+
+// WithAuthRequired: 認証が必須であることを含むコンテキストを作成（RequireAuthが認証せずに受け付けたリクエストに設定する）
+func WithAuthRequired(ctx context.Context) context.Context {
+	return context.WithValue(ctx, authRequiredKey{}, true)
+}
+
+// RequireIdentity: 認証が必須で、呼び出し元が特定されていない場合にErrAuthenticationRequiredを返す
+//
+// 未公開のコピーの参照など、リクエストの内容によって認証が必要かどうかが決まる場合にハンドラーで使用します。
+// 認証を必須にしていない場合（RequireAuthを使用しない場合）は匿名の呼び出しも受け付けます。
+func RequireIdentity(ctx context.Context) error {
+	if IdentityFrom(ctx) != nil {
+		return nil
+	}
+	if required, _ := ctx.Value(authRequiredKey{}).(bool); required {
+		return ErrAuthenticationRequired
+	}
+	return nil
+}
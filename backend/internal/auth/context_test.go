@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+)
+
+func TestIdentity(t *testing.T) {
+	ctx := context.Background()
+	assert.Nil(t, IdentityFrom(ctx))
+	assert.Nil(t, UserID(ctx))
+
+	ctx = WithIdentity(ctx, &entity.Identity{UserID: 1, Email: "tanaka@example.com", Method: entity.AuthMethodSession})
+	assert.Equal(t, "tanaka@example.com", IdentityFrom(ctx).Email)
+	assert.Equal(t, 1, *UserID(ctx))
+}
+
+func TestRequireIdentity(t *testing.T) {
+	identity := &entity.Identity{UserID: 1, Email: "tanaka@example.com", Method: entity.AuthMethodSession}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr error
+	}{
+		{
+			name: "正常系_認証を必須にしていない",
+			ctx:  context.Background(),
+		},
+		{
+			name: "正常系_認証済み",
+			ctx:  WithIdentity(WithAuthRequired(context.Background()), identity),
+		},
+		{
+			name:    "異常系_認証が必須で呼び出し元なし",
+			ctx:     WithAuthRequired(context.Background()),
+			wantErr: ErrAuthenticationRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, RequireIdentity(tt.ctx), tt.wantErr)
+		})
+	}
+}
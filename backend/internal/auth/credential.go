@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// APIKeyPrefix: APIキーの先頭の文字列（ログなどに誤って記録されたキーを見分けやすくする）
+const APIKeyPrefix = "ascg_"
+
+// apiKeyDisplayLength: 一覧に表示するキーの先頭の文字数
+const apiKeyDisplayLength = len(APIKeyPrefix) + 8
+
+// HashPassword: パスワードをbcryptでハッシュにする（72バイトを超えるパスワードはbcrypt.ErrPasswordTooLong）
+func HashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// CheckPassword: パスワードがハッシュと一致するかどうか
+func CheckPassword(hash, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+// GenerateAPIKey: 新しいAPIキーと、表示用の先頭の文字列、照合用のハッシュを作成
+func GenerateAPIKey() (key, prefix, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", "", err
+	}
+	key = APIKeyPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return key, key[:apiKeyDisplayLength], HashAPIKey(key), nil
+}
+
+// HashAPIKey: APIキーの照合用のハッシュ
+//
+// キーは十分な長さの乱数のため、ソルトを付けずにSHA-256で照合し、インデックスで検索できるようにします。
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsAPIKey: APIキーの形式かどうか（トークンと区別するため）
+func IsAPIKey(credential string) bool {
+	return strings.HasPrefix(credential, APIKeyPrefix)
+}
@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigner(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	signer := NewSigner([]byte("test-secret"))
+	token, err := signer.Sign(Claims{Subject: "1", Email: "tanaka@example.com", IssuedAt: now.Unix(), ExpiresAt: now.Add(time.Hour).Unix()})
+	require.NoError(t, err)
+
+	parts := strings.Split(token, ".")
+	tampered := parts[0] + "." + strings.TrimSuffix(parts[1], parts[1][len(parts[1])-1:]) + "A." + parts[2]
+
+	tests := []struct {
+		name    string
+		signer  *Signer
+		token   string
+		now     time.Time
+		wantErr error
+	}{
+		{
+			name:   "正常系",
+			signer: signer,
+			token:  token,
+			now:    now.Add(59 * time.Minute),
+		},
+		{
+			name:    "異常系_有効期限切れ",
+			signer:  signer,
+			token:   token,
+			now:     now.Add(time.Hour),
+			wantErr: ErrTokenExpired,
+		},
+		{
+			name:    "異常系_異なる秘密鍵",
+			signer:  NewSigner([]byte("other-secret")),
+			token:   token,
+			now:     now,
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "異常系_ペイロードの改ざん",
+			signer:  signer,
+			token:   tampered,
+			now:     now,
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "異常系_アルゴリズムの変更",
+			signer:  signer,
+			token:   "eyJhbGciOiJub25lIiwidHlwIjoiSldUIn0." + parts[1] + ".",
+			now:     now,
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "異常系_形式が不正",
+			signer:  signer,
+			token:   "not-a-token",
+			now:     now,
+			wantErr: ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト実行
+			got, err := tt.signer.Verify(tt.token, tt.now)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "1", got.Subject)
+			assert.Equal(t, "tanaka@example.com", got.Email)
+		})
+	}
+}
@@ -0,0 +1,259 @@
+package compliance
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+)
+
+//go:embed rules.json
+var defaultRules []byte
+
+// Rule: 問題となり得る表現の定義
+type Rule struct {
+	ID       string          `json:"id"`
+	Category string          `json:"category"`
+	Severity entity.Severity `json:"severity"`
+	// Pattern: 表現を検出する正規表現（RE2構文）
+	Pattern    string `json:"pattern"`
+	Message    string `json:"message"`
+	Suggestion string `json:"suggestion,omitempty"`
+	// Disabled: 既定の辞書のルールを無効にする場合にtrueを指定
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// Dictionary: ルールの辞書
+type Dictionary struct {
+	Rules []Rule `json:"rules"`
+}
+
+// Text: チェック対象の項目
+type Text struct {
+	Field string
+	Value string
+}
+
+type compiledRule struct {
+	Rule
+	re *regexp.Regexp
+}
+
+// Checker: 辞書のルールに従って表現をチェックする
+type Checker struct {
+	rules []compiledRule
+}
+
+// NewChecker: ルールを検証し、正規表現をコンパイルしたCheckerを生成
+func NewChecker(rules []Rule) (*Checker, error) {
+	c := &Checker{}
+	for _, rule := range rules {
+		if rule.Disabled {
+			continue
+		}
+		if rule.ID == "" {
+			return nil, fmt.Errorf("compliance: rule id is required (pattern %q)", rule.Pattern)
+		}
+		if !rule.Severity.Valid() {
+			return nil, fmt.Errorf("compliance: rule %s has invalid severity %q", rule.ID, rule.Severity)
+		}
+		re, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("compliance: rule %s has invalid pattern: %w", rule.ID, err)
+		}
+		c.rules = append(c.rules, compiledRule{Rule: rule, re: re})
+	}
+	return c, nil
+}
+
+// Default: バイナリに埋め込んだ既定の辞書を使用するChecker
+func Default() *Checker {
+	dict, err := parseDictionary(defaultRules)
+	if err != nil {
+		panic(err)
+	}
+	c, err := NewChecker(dict.Rules)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Load: pathの辞書で既定の辞書を上書きしたCheckerを生成
+//
+// 同じIDのルールは置き換え、新しいIDのルールは追加します。
+// disabledを指定したルールは無効になります。pathが空の場合は既定の辞書のみを使用します。
+func Load(path string) (*Checker, error) {
+	dict, err := parseDictionary(defaultRules)
+	if err != nil {
+		return nil, err
+	}
+	if path == "" {
+		return NewChecker(dict.Rules)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	custom, err := parseDictionary(b)
+	if err != nil {
+		return nil, fmt.Errorf("compliance: failed to parse %s: %w", path, err)
+	}
+
+	return NewChecker(mergeRules(dict.Rules, custom.Rules))
+}
+
+func parseDictionary(b []byte) (*Dictionary, error) {
+	var dict Dictionary
+	if err := json.Unmarshal(b, &dict); err != nil {
+		return nil, err
+	}
+	return &dict, nil
+}
+
+// mergeRules: 既定のルールをIDで上書き・追加する（順序は既定のルールを優先）
+func mergeRules(base, overrides []Rule) []Rule {
+	index := map[string]int{}
+	merged := make([]Rule, 0, len(base)+len(overrides))
+	for _, rule := range base {
+		index[rule.ID] = len(merged)
+		merged = append(merged, rule)
+	}
+	for _, rule := range overrides {
+		if i, ok := index[rule.ID]; ok {
+			merged[i] = rule
+			continue
+		}
+		index[rule.ID] = len(merged)
+		merged = append(merged, rule)
+	}
+	return merged
+}
+
+// Rules: 有効なルールの一覧
+func (c *Checker) Rules() []Rule {
+	rules := make([]Rule, len(c.rules))
+	for i, rule := range c.rules {
+		rules[i] = rule.Rule
+	}
+	return rules
+}
+
+// Check: 各項目から問題となり得る表現を検出する
+//
+// 検出結果は項目の指定順、項目内の出現位置順に並びます。
+func (c *Checker) Check(texts ...Text) []entity.ComplianceFinding {
+	findings := []entity.ComplianceFinding{}
+	for _, text := range texts {
+		var fieldFindings []entity.ComplianceFinding
+		for _, rule := range c.rules {
+			for _, loc := range rule.re.FindAllStringIndex(text.Value, -1) {
+				if loc[0] == loc[1] {
+					continue
+				}
+				start := utf8.RuneCountInString(text.Value[:loc[0]])
+				fieldFindings = append(fieldFindings, entity.ComplianceFinding{
+					RuleID:     rule.ID,
+					Category:   rule.Category,
+					Severity:   rule.Severity,
+					Field:      text.Field,
+					Match:      text.Value[loc[0]:loc[1]],
+					Start:      start,
+					End:        start + utf8.RuneCountInString(text.Value[loc[0]:loc[1]]),
+					Message:    rule.Message,
+					Suggestion: rule.Suggestion,
+				})
+			}
+		}
+		sort.SliceStable(fieldFindings, func(a, b int) bool {
+			return fieldFindings[a].Start < fieldFindings[b].Start
+		})
+		findings = append(findings, fieldFindings...)
+	}
+	return findings
+}
+
+// CategoryBrand: ブランドプロファイルの使用しない言葉の検出結果のカテゴリー
+const CategoryBrand = "brand"
+
+// RuleBrandBannedWord: ブランドプロファイルの使用しない言葉のルールID
+const RuleBrandBannedWord = "brand-banned-word"
+
+// BrandChecker: ブランドプロファイルの使用しない言葉を検出するChecker（profileがnilの場合は何も検出しない）
+//
+// 言葉はそのままの表記で検出し、英字の大文字・小文字は区別しません。
+// 表現規制の検出結果と同じく、公開を見送る重大度の判定の対象になります（重大度はmedium）。
+func BrandChecker(profile *entity.BrandProfile) *Checker {
+	c := &Checker{}
+	if profile == nil {
+		return c
+	}
+	for _, word := range profile.BannedWords {
+		if word == "" {
+			continue
+		}
+		c.rules = append(c.rules, compiledRule{
+			Rule: Rule{
+				ID:       RuleBrandBannedWord,
+				Category: CategoryBrand,
+				Severity: entity.SeverityMedium,
+				Pattern:  regexp.QuoteMeta(word),
+				Message:  fmt.Sprintf("ブランド「%s」で使用しない言葉です", profile.Name),
+			},
+			re: regexp.MustCompile("(?i)" + regexp.QuoteMeta(word)),
+		})
+	}
+	return c
+}
+
+// HasSeverity: threshold以上の重大度の検出結果があるかどうか
+func HasSeverity(findings []entity.ComplianceFinding, threshold entity.Severity) bool {
+	for _, f := range findings {
+		if f.Severity.AtLeast(threshold) {
+			return true
+		}
+	}
+	return false
+}
+
+// CopyTexts: コピーのチェック対象の項目（見出し・本文とチャネル固有の項目）
+func CopyTexts(copy *entity.Copy) []Text {
+	texts := []Text{
+		{Field: "title", Value: copy.Title},
+		{Field: "description", Value: copy.Description},
+	}
+
+	content := copy.Content
+	if content == nil {
+		return texts
+	}
+	switch {
+	case content.Line != nil:
+		texts = append(texts, Text{Field: "altText", Value: content.Line.AltText})
+	case content.Pop != nil:
+		texts = append(texts, Text{Field: "priceCallout", Value: content.Pop.PriceCallout})
+	case content.SNS != nil:
+		for _, tag := range content.SNS.Hashtags {
+			texts = append(texts, Text{Field: "hashtags", Value: tag})
+		}
+	case content.Email != nil:
+		texts = append(texts, Text{Field: "preheader", Value: content.Email.Preheader})
+	}
+
+	// 組み込み以外のチャネルの固有の項目（項目名の順）
+	names := make([]string, 0, len(content.Fields))
+	for name := range content.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		texts = append(texts, Text{Field: name, Value: content.Fields[name]})
+	}
+	return texts
+}
@@ -0,0 +1,205 @@
+package compliance
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+)
+
+func ruleIDs(findings []entity.ComplianceFinding) []string {
+	ids := []string{}
+	for _, f := range findings {
+		ids = append(ids, f.RuleID)
+	}
+	return ids
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name    string
+		texts   []Text
+		wantIDs []string
+	}{
+		{
+			name:    "正常系_問題のない表現",
+			texts:   []Text{{Field: "title", Value: "毎日の暮らしを快適に"}},
+			wantIDs: []string{},
+		},
+		{
+			name:    "正常系_最上級表現",
+			texts:   []Text{{Field: "title", Value: "日本一おいしいパン"}},
+			wantIDs: []string{"superlative-best-in-japan"},
+		},
+		{
+			name:    "正常系_No.1表示",
+			texts:   []Text{{Field: "title", Value: "満足度no.1の美容液"}},
+			wantIDs: []string{"unsupported-number-one"},
+		},
+		{
+			name:    "正常系_薬機法",
+			texts:   []Text{{Field: "description", Value: "飲むだけで痩せる。医師も推奨するサプリ"}},
+			wantIDs: []string{"physical-change", "doctor-endorsement"},
+		},
+		{
+			name: "正常系_項目順",
+			texts: []Text{
+				{Field: "title", Value: "今だけ半額"},
+				{Field: "description", Value: "必ず効果を実感"},
+			},
+			wantIDs: []string{"limited-time-urgency", "absolute-claim"},
+		},
+	}
+
+	c := Default()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantIDs, ruleIDs(c.Check(tt.texts...)))
+		})
+	}
+}
+
+func TestCheck_Position(t *testing.T) {
+	findings := Default().Check(Text{Field: "description", Value: "話題の絶対おすすめ商品"})
+
+	assert.Len(t, findings, 1)
+	assert.Equal(t, entity.ComplianceFinding{
+		RuleID:     "absolute-claim",
+		Category:   "景品表示法",
+		Severity:   entity.SeverityHigh,
+		Field:      "description",
+		Match:      "絶対",
+		Start:      3,
+		End:        5,
+		Message:    findings[0].Message,
+		Suggestion: findings[0].Suggestion,
+	}, findings[0])
+}
+
+func TestNewChecker(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   []Rule
+		wantErr bool
+	}{
+		{
+			name:  "正常系",
+			rules: []Rule{{ID: "sample", Severity: entity.SeverityLow, Pattern: "サンプル"}},
+		},
+		{
+			name:  "正常系_無効なルールは検証しない",
+			rules: []Rule{{ID: "sample", Severity: "unknown", Pattern: "(", Disabled: true}},
+		},
+		{
+			name:    "異常系_IDなし",
+			rules:   []Rule{{Severity: entity.SeverityLow, Pattern: "サンプル"}},
+			wantErr: true,
+		},
+		{
+			name:    "異常系_不正な重大度",
+			rules:   []Rule{{ID: "sample", Severity: "critical", Pattern: "サンプル"}},
+			wantErr: true,
+		},
+		{
+			name:    "異常系_不正な正規表現",
+			rules:   []Rule{{ID: "sample", Severity: entity.SeverityLow, Pattern: "("}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewChecker(tt.rules)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "rules.json")
+	err := os.WriteFile(path, []byte(`{"rules": [
+		{"id": "limited-time-urgency", "disabled": true},
+		{"id": "absolute-claim", "category": "景品表示法", "severity": "medium", "pattern": "絶対"},
+		{"id": "brand-ng-word", "category": "社内基準", "severity": "low", "pattern": "激安"}
+	]}`), 0o644)
+	assert.NoError(t, err)
+
+	c, err := Load(path)
+	assert.NoError(t, err)
+
+	findings := c.Check(Text{Field: "title", Value: "今だけ絶対激安"})
+	assert.Equal(t, []string{"absolute-claim", "brand-ng-word"}, ruleIDs(findings))
+	// 同じIDのルールは置き換えられる
+	assert.Equal(t, entity.SeverityMedium, findings[0].Severity)
+	assert.Len(t, c.Rules(), len(Default().Rules()))
+
+	_, err = Load(filepath.Join(dir, "missing.json"))
+	assert.Error(t, err)
+}
+
+func TestHasSeverity(t *testing.T) {
+	findings := []entity.ComplianceFinding{{Severity: entity.SeverityLow}, {Severity: entity.SeverityMedium}}
+
+	assert.True(t, HasSeverity(findings, entity.SeverityLow))
+	assert.True(t, HasSeverity(findings, entity.SeverityMedium))
+	assert.False(t, HasSeverity(findings, entity.SeverityHigh))
+	assert.False(t, HasSeverity(nil, entity.SeverityLow))
+}
+
+func TestCopyTexts(t *testing.T) {
+	copy := &entity.Copy{
+		Title:       "タイトル",
+		Description: "本文",
+		Content: &entity.CopyContent{
+			SNS: &entity.SNSContent{Text: "本文", Hashtags: []string{"タグ1", "タグ2"}},
+		},
+	}
+
+	assert.Equal(t, []Text{
+		{Field: "title", Value: "タイトル"},
+		{Field: "description", Value: "本文"},
+		{Field: "hashtags", Value: "タグ1"},
+		{Field: "hashtags", Value: "タグ2"},
+	}, CopyTexts(copy))
+}
+
+func TestCopyTexts_Fields(t *testing.T) {
+	copy := &entity.Copy{
+		Title:       "タイトル",
+		Description: "本文",
+		Content:     &entity.CopyContent{Fields: map[string]string{"hook": "冒頭", "cta": "今すぐ"}},
+	}
+
+	assert.Equal(t, []Text{
+		{Field: "title", Value: "タイトル"},
+		{Field: "description", Value: "本文"},
+		{Field: "cta", Value: "今すぐ"},
+		{Field: "hook", Value: "冒頭"},
+	}, CopyTexts(copy))
+}
+
+func TestBrandChecker(t *testing.T) {
+	profile := &entity.BrandProfile{Name: "ACME", BannedWords: []string{"激安", "No.1", ""}}
+
+	findings := BrandChecker(profile).Check(
+		Text{Field: "title", Value: "激安セール"},
+		Text{Field: "description", Value: "満足度no.1の激安価格"},
+	)
+
+	assert.Equal(t, []entity.ComplianceFinding{
+		{RuleID: RuleBrandBannedWord, Category: CategoryBrand, Severity: entity.SeverityMedium, Field: "title", Match: "激安", Start: 0, End: 2, Message: "ブランド「ACME」で使用しない言葉です"},
+		{RuleID: RuleBrandBannedWord, Category: CategoryBrand, Severity: entity.SeverityMedium, Field: "description", Match: "no.1", Start: 3, End: 7, Message: "ブランド「ACME」で使用しない言葉です"},
+		{RuleID: RuleBrandBannedWord, Category: CategoryBrand, Severity: entity.SeverityMedium, Field: "description", Match: "激安", Start: 8, End: 10, Message: "ブランド「ACME」で使用しない言葉です"},
+	}, findings)
+
+	// プロファイルを指定しない場合は何も検出しない
+	assert.Empty(t, BrandChecker(nil).Check(Text{Field: "title", Value: "激安セール"}))
+}
@@ -0,0 +1,159 @@
+// Package domainerr: リポジトリ・ユースケースが返すエラーの種類と、フロントエンドが判定に使用する安定したエラーコード
+//
+// ハンドラーはエラーをgin.Context.Errorに渡すだけとし、HTTPのステータスコードへの対応付けは
+// エラーミドルウェアで一元的に行います。
+package domainerr
+
+import (
+	"errors"
+	"time"
+)
+
+// Kind: エラーの種類（HTTPのステータスコードに対応する）
+type Kind string
+
+const (
+	// KindInternal: 種類を特定できない内部のエラー（domainerrのエラーでない場合を含む）
+	KindInternal Kind = "internal"
+	// KindNotFound: 対象が存在しない
+	KindNotFound Kind = "not_found"
+	// KindValidation: 入力が不正
+	KindValidation Kind = "validation"
+	// KindConflict: 現在の状態と競合するため実行できない
+	KindConflict Kind = "conflict"
+	// KindUpstreamUnavailable: LLMプロバイダーなど依存先が利用できない、または有効な結果を返さない
+	KindUpstreamUnavailable Kind = "upstream_unavailable"
+	// KindRateLimited: 呼び出し回数の上限に達した
+	KindRateLimited Kind = "rate_limited"
+	// KindUnauthenticated: 認証されていない、または認証情報が不正
+	KindUnauthenticated Kind = "unauthenticated"
+)
+
+// Error: 種類・エラーコード・利用者向けのメッセージを持つエラー
+//
+// 原因のエラーはUnwrapで取得できますが、レスポンスにはMessageとDetailsのみを含めます。
+type Error struct {
+	Kind Kind
+	// Code: フロントエンドが判定に使用する安定したエラーコード（例: copy_not_found）
+	Code    string
+	Message string
+	// Details: レスポンスに含める追加の情報（違反内容など）
+	Details map[string]any
+	// RetryAfter: 再試行までに待つ時間（不明な場合は0）
+	RetryAfter time.Duration
+
+	cause error
+}
+
+// New: エラーを作成
+//
+// codeが空のエラーは同じ種類のエラーすべてに一致するため、errors.Isで種類のみを判定する場合に使用します。
+func New(kind Kind, code, message string) *Error {
+	return &Error{Kind: kind, Code: code, Message: message}
+}
+
+// NotFound: 対象が存在しないエラー
+func NotFound(code, message string) *Error {
+	return New(KindNotFound, code, message)
+}
+
+// Validation: 入力が不正なエラー
+func Validation(code, message string) *Error {
+	return New(KindValidation, code, message)
+}
+
+// Conflict: 現在の状態と競合するエラー
+func Conflict(code, message string) *Error {
+	return New(KindConflict, code, message)
+}
+
+// UpstreamUnavailable: 依存先が利用できないエラー
+func UpstreamUnavailable(code, message string) *Error {
+	return New(KindUpstreamUnavailable, code, message)
+}
+
+// RateLimited: 呼び出し回数の上限に達したエラー
+func RateLimited(code, message string) *Error {
+	return New(KindRateLimited, code, message)
+}
+
+// Unauthenticated: 認証されていないエラー
+func Unauthenticated(code, message string) *Error {
+	return New(KindUnauthenticated, code, message)
+}
+
+func (e *Error) Error() string {
+	if e.cause != nil {
+		return e.Message + ": " + e.cause.Error()
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// Is: 種類とエラーコードが同じエラーに一致（targetのコードが空の場合は種類のみを比較）
+//
+// Wrap・WithDetailなどで作成した複製も元のエラーに一致します。
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+	return t.Kind == e.Kind && (t.Code == "" || t.Code == e.Code)
+}
+
+// Wrap: 原因のエラーを持つ複製を作成
+func (e *Error) Wrap(cause error) *Error {
+	c := e.clone()
+	c.cause = cause
+	return c
+}
+
+// WithMessage: メッセージを置き換えた複製を作成
+func (e *Error) WithMessage(message string) *Error {
+	c := e.clone()
+	c.Message = message
+	return c
+}
+
+// WithDetail: 追加の情報を加えた複製を作成
+func (e *Error) WithDetail(key string, value any) *Error {
+	c := e.clone()
+	c.Details = make(map[string]any, len(e.Details)+1)
+	for k, v := range e.Details {
+		c.Details[k] = v
+	}
+	c.Details[key] = value
+	return c
+}
+
+// WithRetryAfter: 再試行までに待つ時間を設定した複製を作成
+func (e *Error) WithRetryAfter(d time.Duration) *Error {
+	c := e.clone()
+	c.RetryAfter = d
+	return c
+}
+
+func (e *Error) clone() *Error {
+	c := *e
+	return &c
+}
+
+// As: エラーの連鎖からdomainerrのエラーを取得
+func As(err error) (*Error, bool) {
+	var e *Error
+	if errors.As(err, &e) {
+		return e, true
+	}
+	return nil, false
+}
+
+// KindOf: エラーの種類（domainerrのエラーでない場合はKindInternal）
+func KindOf(err error) Kind {
+	if e, ok := As(err); ok {
+		return e.Kind
+	}
+	return KindInternal
+}
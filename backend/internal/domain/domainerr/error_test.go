@@ -0,0 +1,99 @@
+package domainerr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorIs(t *testing.T) {
+	errCopyNotFound := NotFound("copy_not_found", "copy not found")
+	cause := errors.New("record not found")
+
+	tests := []struct {
+		name   string
+		err    error
+		target error
+		want   bool
+	}{
+		{
+			name:   "正常系_同じエラー",
+			err:    errCopyNotFound,
+			target: errCopyNotFound,
+			want:   true,
+		},
+		{
+			name:   "正常系_複製は元のエラーに一致",
+			err:    errCopyNotFound.Wrap(cause).WithDetail("id", 1),
+			target: errCopyNotFound,
+			want:   true,
+		},
+		{
+			name:   "正常系_原因のエラーに一致",
+			err:    fmt.Errorf("get copy: %w", errCopyNotFound.Wrap(cause)),
+			target: cause,
+			want:   true,
+		},
+		{
+			name:   "正常系_コードが空の場合は種類のみを比較",
+			err:    errCopyNotFound,
+			target: NotFound("", "not found"),
+			want:   true,
+		},
+		{
+			name:   "異常系_コードが異なる",
+			err:    errCopyNotFound,
+			target: NotFound("revision_not_found", "revision not found"),
+			want:   false,
+		},
+		{
+			name:   "異常系_種類が異なる",
+			err:    Validation("copy_not_found", "copy not found"),
+			target: NotFound("", "not found"),
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, errors.Is(tt.err, tt.target))
+		})
+	}
+}
+
+func TestWithDetail(t *testing.T) {
+	base := Validation("invalid_edit", "invalid edit")
+
+	got := base.WithDetail("violations", []string{"title is too long"}).WithDetail("field", "title")
+
+	assert.Equal(t, map[string]any{"violations": []string{"title is too long"}, "field": "title"}, got.Details)
+	// 元のエラーは変更しない
+	assert.Nil(t, base.Details)
+}
+
+func TestKindOf(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want Kind
+	}{
+		{
+			name: "正常系_包まれたエラー",
+			err:  fmt.Errorf("like: %w", Conflict("publish_blocked", "blocked")),
+			want: KindConflict,
+		},
+		{
+			name: "正常系_domainerrのエラーでない",
+			err:  errors.New("database error"),
+			want: KindInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, KindOf(tt.err))
+		})
+	}
+}
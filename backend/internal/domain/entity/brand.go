@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Ending: 文末の表現（文体）
+type Ending string
+
+const (
+	// EndingAny: 文末の表現を指定しない
+	EndingAny Ending = ""
+	// EndingPolite: です・ます調
+	EndingPolite Ending = "desu_masu"
+	// EndingPlain: だ・である調
+	EndingPlain Ending = "da_dearu"
+)
+
+// Valid: 定義済みの文末の表現かどうか（指定しない場合を含む）
+func (e Ending) Valid() bool {
+	switch e {
+	case EndingAny, EndingPolite, EndingPlain:
+		return true
+	}
+	return false
+}
+
+// Label: プロンプトに記載する文末の表現の名前
+func (e Ending) Label() string {
+	switch e {
+	case EndingPolite:
+		return "です・ます調"
+	case EndingPlain:
+		return "だ・である調"
+	}
+	return ""
+}
+
+// BrandProfile: ブランドのボイスの定義
+//
+// 生成時に指定するとプロンプトにボイスを記載し、生成後に使用しない言葉を検出結果として記録します。
+type BrandProfile struct {
+	ID   int    `json:"id" gorm:"primaryKey;autoIncrement"`
+	Name string `json:"name"`
+	// Voice: ブランドの語り口の説明
+	Voice string `json:"voice"`
+	// PreferredWords / BannedWords: 積極的に使う言葉と使用しない言葉
+	PreferredWords []string `json:"preferredWords" gorm:"serializer:json"`
+	BannedWords    []string `json:"bannedWords" gorm:"serializer:json"`
+	// Samples: 承認済みのコピーの例（プロンプトに参考として記載する）
+	Samples   []string  `json:"samples" gorm:"serializer:json"`
+	Ending    Ending    `json:"ending"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	// DeletedAt: 削除した日時（削除したプロファイルは新しい生成に使用できないが、既存のコピーは残る）
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
+}
@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Campaign: 複数の配信チャネルにまたがる販促キャンペーン
+//
+// キャンペーンを指定して生成すると、キャンペーンの内容をプロンプトに記載し、コピーをキャンペーンごとにまとめます。
+type Campaign struct {
+	ID   int    `json:"id" gorm:"primaryKey;autoIncrement"`
+	Name string `json:"name"`
+	// Goal: キャンペーンの目的（例: 新規会員の獲得）
+	Goal string `json:"goal"`
+	// Target: キャンペーンの対象者（生成時にターゲットを省略した場合に使用する）
+	Target string `json:"target"`
+	// StartsAt / EndsAt: キャンペーンの期間
+	StartsAt  time.Time `json:"startsAt"`
+	EndsAt    time.Time `json:"endsAt"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	// DeletedAt: 削除した日時（削除したキャンペーンは新しい生成に使用できないが、既存のコピーは残る）
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
+}
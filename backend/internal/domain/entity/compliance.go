@@ -0,0 +1,48 @@
+package entity
+
+// Severity: 表現規制上の問題の重大度
+type Severity string
+
+const (
+	SeverityLow    Severity = "low"
+	SeverityMedium Severity = "medium"
+	SeverityHigh   Severity = "high"
+)
+
+// rank: 重大度の順位（未知の値は0）
+func (s Severity) rank() int {
+	switch s {
+	case SeverityLow:
+		return 1
+	case SeverityMedium:
+		return 2
+	case SeverityHigh:
+		return 3
+	}
+	return 0
+}
+
+// Valid: 定義済みの重大度かどうか
+func (s Severity) Valid() bool {
+	return s.rank() > 0
+}
+
+// AtLeast: 重大度がother以上かどうか
+func (s Severity) AtLeast(other Severity) bool {
+	return s.Valid() && s.rank() >= other.rank()
+}
+
+// ComplianceFinding: 景品表示法・薬機法などの観点で問題となり得る表現
+type ComplianceFinding struct {
+	RuleID   string   `json:"ruleId"`
+	Category string   `json:"category"`
+	Severity Severity `json:"severity"`
+	// Field: 表現が含まれる項目（title、description、hashtagsなど）
+	Field string `json:"field"`
+	Match string `json:"match"`
+	// Start / End: 項目内での位置（文字単位、Endは含まない）
+	Start      int    `json:"start"`
+	End        int    `json:"end"`
+	Message    string `json:"message"`
+	Suggestion string `json:"suggestion,omitempty"`
+}
@@ -0,0 +1,137 @@
+package entity
+
+// CopyContent: チャネルごとの構造化された出力
+//
+// コピーのチャネルに対応するフィールドのみが設定されます。
+type CopyContent struct {
+	App   *AppContent   `json:"app,omitempty"`
+	Line  *LineContent  `json:"line,omitempty"`
+	Pop   *PopContent   `json:"pop,omitempty"`
+	SNS   *SNSContent   `json:"sns,omitempty"`
+	Email *EmailContent `json:"email,omitempty"`
+	// Fields: 組み込み以外のチャネルの出力項目（titleとdescriptionはCopyの見出しと本文）
+	Fields map[string]string `json:"fields,omitempty"`
+}
+
+// SetSummary: 見出しと本文に対応するチャネル固有の項目を更新
+//
+// 手動で編集した見出し・本文を構造化された出力にも反映するために使用します。
+func (c *CopyContent) SetSummary(title, description string) {
+	switch {
+	case c.App != nil:
+		c.App.Title, c.App.Body = title, description
+	case c.Line != nil:
+		if header := c.Line.Bubble.Header; header != nil && len(header.Contents) > 0 {
+			header.Contents[0].Text = title
+		}
+		if body := c.Line.Bubble.Body; body != nil && len(body.Contents) > 0 {
+			body.Contents[0].Text = description
+		}
+	case c.Pop != nil:
+		c.Pop.Headline, c.Pop.SubCopy = title, description
+	case c.SNS != nil:
+		c.SNS.Text = description
+	case c.Email != nil:
+		c.Email.Subject, c.Email.Body = title, description
+	}
+}
+
+// AppContent: アプリのプッシュ通知
+type AppContent struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+// LineContent: LINEのFlex Message
+type LineContent struct {
+	// AltText: 通知やトーク一覧に表示される代替テキスト
+	AltText string     `json:"altText"`
+	Bubble  FlexBubble `json:"bubble"`
+}
+
+// FlexBubble: Flex Messageのバブルコンテナ
+type FlexBubble struct {
+	Type   string   `json:"type"`
+	Header *FlexBox `json:"header,omitempty"`
+	Body   *FlexBox `json:"body,omitempty"`
+	Footer *FlexBox `json:"footer,omitempty"`
+}
+
+// FlexBox: Flex Messageのボックスコンポーネント
+type FlexBox struct {
+	Type     string          `json:"type"`
+	Layout   string          `json:"layout"`
+	Contents []FlexComponent `json:"contents"`
+}
+
+// FlexComponent: Flex Messageのテキスト・ボタンコンポーネント
+type FlexComponent struct {
+	Type   string      `json:"type"`
+	Text   string      `json:"text,omitempty"`
+	Weight string      `json:"weight,omitempty"`
+	Size   string      `json:"size,omitempty"`
+	Wrap   bool        `json:"wrap,omitempty"`
+	Style  string      `json:"style,omitempty"`
+	Action *FlexAction `json:"action,omitempty"`
+}
+
+// FlexAction: ボタンをタップした際のアクション
+type FlexAction struct {
+	Type  string `json:"type"`
+	Label string `json:"label"`
+	Text  string `json:"text,omitempty"`
+}
+
+// NewLineContent: 見出し・本文・ボタンのラベルからバブル1つのFlex Messageを組み立てる
+func NewLineContent(altText, title, body, buttonLabel string) *LineContent {
+	return &LineContent{
+		AltText: altText,
+		Bubble: FlexBubble{
+			Type: "bubble",
+			Header: &FlexBox{
+				Type:   "box",
+				Layout: "vertical",
+				Contents: []FlexComponent{
+					{Type: "text", Text: title, Weight: "bold", Size: "lg", Wrap: true},
+				},
+			},
+			Body: &FlexBox{
+				Type:   "box",
+				Layout: "vertical",
+				Contents: []FlexComponent{
+					{Type: "text", Text: body, Size: "sm", Wrap: true},
+				},
+			},
+			Footer: &FlexBox{
+				Type:   "box",
+				Layout: "vertical",
+				Contents: []FlexComponent{
+					{Type: "button", Style: "primary", Action: &FlexAction{Type: "message", Label: buttonLabel, Text: buttonLabel}},
+				},
+			},
+		},
+	}
+}
+
+// PopContent: 店頭POP
+type PopContent struct {
+	Headline string `json:"headline"`
+	SubCopy  string `json:"subCopy"`
+	// PriceCallout: 価格やお得感を訴求する一言
+	PriceCallout string `json:"priceCallout"`
+}
+
+// SNSContent: SNSの投稿
+type SNSContent struct {
+	Text string `json:"text"`
+	// Hashtags: 先頭の#を除いたハッシュタグ
+	Hashtags []string `json:"hashtags"`
+}
+
+// EmailContent: メールマガジン
+type EmailContent struct {
+	Subject string `json:"subject"`
+	// Preheader: 受信一覧で件名の後に表示されるテキスト
+	Preheader string `json:"preheader"`
+	Body      string `json:"body"`
+}
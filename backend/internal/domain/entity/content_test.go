@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLineContent(t *testing.T) {
+	content := NewLineContent("夏の新作のお知らせ", "夏の新作", "涼しく快適な着心地です。", "詳しく見る")
+
+	b, err := json.Marshal(content)
+	require.NoError(t, err)
+
+	// LINEのFlex Messageのバブルとして送信できる形式であること
+	assert.JSONEq(t, `{
+		"altText": "夏の新作のお知らせ",
+		"bubble": {
+			"type": "bubble",
+			"header": {"type": "box", "layout": "vertical", "contents": [
+				{"type": "text", "text": "夏の新作", "weight": "bold", "size": "lg", "wrap": true}
+			]},
+			"body": {"type": "box", "layout": "vertical", "contents": [
+				{"type": "text", "text": "涼しく快適な着心地です。", "size": "sm", "wrap": true}
+			]},
+			"footer": {"type": "box", "layout": "vertical", "contents": [
+				{"type": "button", "style": "primary", "action": {"type": "message", "label": "詳しく見る", "text": "詳しく見る"}}
+			]}
+		}
+	}`, string(b))
+}
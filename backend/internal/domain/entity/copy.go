@@ -2,6 +2,10 @@ package entity
 
 import (
 	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/textmetrics"
 )
 
 // Channel: 配信チャネルの種類
@@ -28,16 +32,87 @@ const (
 
 // Copy: 販促コピーエンティティ
 type Copy struct {
-	ID              int       `json:"id" gorm:"primaryKey;autoIncrement"`
-	Title           string    `json:"title"`
-	Description     string    `json:"description"`
-	Channel         Channel   `json:"channel"`
-	Tone            Tone      `json:"tone"`
-	Target          string    `json:"target"`
-	Likes           int       `json:"likes"`
-	CreatedAt       time.Time `json:"createdAt"`
-	UpdatedAt       time.Time `json:"updatedAt"`
-	IsPublished     bool      `json:"isPublished"`
-	ProductName     string    `json:"productName"`
-	ProductFeatures string    `json:"productFeatures"`
+	ID          int       `json:"id" gorm:"primaryKey;autoIncrement"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Channel     Channel   `json:"channel"`
+	Tone        Tone      `json:"tone"`
+	Target      string    `json:"target"`
+	Likes       int       `json:"likes"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+	// TrendingScore: 話題順の並び替えに使用するスコア（TrendingScoreを参照）
+	TrendingScore int64 `json:"-"`
+	// DeletedAt: 論理削除した日時（削除したコピーは取得・一覧の対象外）
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
+	// Status: 編集ワークフローの状態（公開済みの一覧・検索の対象はpublishedのみ）
+	Status CopyStatus `json:"status" gorm:"type:varchar(20);not null;default:draft"`
+	// Reviewer: レビューを依頼された利用者（承認・差し戻しはこの利用者のみ行える）
+	Reviewer string `json:"reviewer"`
+	// SubmittedBy: レビューを依頼した利用者（Reviewerには指定できない）
+	SubmittedBy string `json:"submittedBy"`
+	// ReviewComment: 直近の承認・差し戻しのコメント
+	ReviewComment string `json:"reviewComment"`
+	// PublishAt: 公開する日時（承認済みのコピーをこの日時に公開し、公開済みの一覧にもこの日時から表示する）
+	PublishAt *time.Time `json:"publishAt,omitempty"`
+	// ExpireAt: 公開を終了する日時（公開済みのコピーをこの日時にアーカイブし、公開済みの一覧からも外す）
+	ExpireAt        *time.Time `json:"expireAt,omitempty"`
+	ProductName     string     `json:"productName"`
+	ProductFeatures string     `json:"productFeatures"`
+	// ProductID: 生成に使用した商品（商品名・特徴を直接入力した場合はnil）
+	ProductID *int `json:"productId,omitempty" gorm:"index"`
+	// CampaignID: コピーが属するキャンペーン（キャンペーンを指定しない場合はnil）
+	CampaignID *int `json:"campaignId,omitempty" gorm:"index"`
+	// CreatedBy: コピーを生成した利用者（認証を導入する前に生成したコピーはnil）
+	CreatedBy *int `json:"createdBy,omitempty" gorm:"index"`
+	// GenerationID: 同じリクエストで生成された候補をまとめるID
+	GenerationID string  `json:"generationId" gorm:"index"`
+	Score        float64 `json:"score"`
+	// IsSelected: 候補の中から採用されたかどうか
+	IsSelected bool `json:"isSelected"`
+	// PromptTemplateID / PromptTemplateVersion: 生成に使用したプロンプトテンプレート
+	PromptTemplateID      string `json:"promptTemplateId"`
+	PromptTemplateVersion string `json:"promptTemplateVersion"`
+	// Content: チャネルごとの構造化された出力
+	Content *CopyContent `json:"content,omitempty" gorm:"serializer:json"`
+	// BrandProfileID: 生成に使用したブランドプロファイル（指定しない場合はnil）
+	BrandProfileID *int `json:"brandProfileId,omitempty" gorm:"index"`
+	// Findings: 表現規制のチェックで検出された表現
+	Findings []ComplianceFinding `json:"findings" gorm:"serializer:json"`
+	// Liked: いいね・取り消しをした投票者がいいね済みかどうか（いいねの操作の結果のみ、保存はしない）
+	Liked *bool `json:"liked,omitempty" gorm:"-"`
+	// Metrics: 生成時に計測した各項目の長さ（保存はしない）
+	Metrics *CopyMetrics `json:"metrics,omitempty" gorm:"-"`
+}
+
+// Visible: 公開済みで、nowが公開日時以降かつ公開終了日時より前かどうか（公開済みの一覧・検索と同じ条件）
+func (c *Copy) Visible(now time.Time) bool {
+	if c.Status != CopyStatusPublished {
+		return false
+	}
+	if c.PublishAt != nil && c.PublishAt.After(now) {
+		return false
+	}
+	return c.ExpireAt == nil || c.ExpireAt.After(now)
+}
+
+// TrendingLikeWeight: 話題順で1いいねを何時間分の新しさとみなすか
+const TrendingLikeWeight = 2 * time.Hour
+
+// TrendingLikeScore: 1いいねで加算する話題順のスコア（TrendingLikeWeightの秒数）
+const TrendingLikeScore = int64(TrendingLikeWeight / time.Second)
+
+// TrendingScore: 話題順のスコア（作成日時のUNIX秒にいいね数×TrendingLikeWeightの秒数を加えた値）
+//
+// 閲覧時刻に依存しないためカーソルによるページングで順序が変わらず、
+// いいねのたびに一定値を加算するだけで更新できます。
+func TrendingScore(likes int, createdAt time.Time) int64 {
+	return createdAt.Unix() + int64(likes)*TrendingLikeScore
+}
+
+// CopyMetrics: 生成したコピーの各項目の長さ
+type CopyMetrics struct {
+	Fields map[string]textmetrics.Metrics `json:"fields"`
+	// Truncated: 上限を超えたため切り詰めた項目
+	Truncated []string `json:"truncated,omitempty"`
 }
@@ -119,4 +119,26 @@ func TestCopy(t *testing.T) {
 		// いいね数が1に増えたことを確認
 		assert.Equal(t, 1, copy.Likes)
 	})
+
+	t.Run("Visible", func(t *testing.T) {
+		past := now.Add(-time.Hour)
+		future := now.Add(time.Hour)
+		tests := []struct {
+			name string
+			copy Copy
+			want bool
+		}{
+			{name: "公開済み", copy: Copy{Status: CopyStatusPublished}, want: true},
+			{name: "公開日時・公開終了日時の期間内", copy: Copy{Status: CopyStatusPublished, PublishAt: &past, ExpireAt: &future}, want: true},
+			{name: "公開日時前", copy: Copy{Status: CopyStatusPublished, PublishAt: &future}, want: false},
+			{name: "公開終了日時ちょうど", copy: Copy{Status: CopyStatusPublished, ExpireAt: &now}, want: false},
+			{name: "承認済み", copy: Copy{Status: CopyStatusApproved, PublishAt: &past}, want: false},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, tt.copy.Visible(now))
+			})
+		}
+	})
 }
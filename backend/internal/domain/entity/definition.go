@@ -0,0 +1,222 @@
+package entity
+
+import (
+	"regexp"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/textmetrics"
+)
+
+// 出力項目のうち、すべてのチャネルで一覧表示用の見出しと本文として使用する項目
+const (
+	FieldTitle       = "title"
+	FieldDescription = "description"
+)
+
+// definitionIDPattern: 配信チャネル・トーンのIDの形式（英小文字で始まる英小文字・数字・_・-の50文字以内）
+var definitionIDPattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,49}$`)
+
+// WellFormed: IDの形式を満たしているかどうか（登録済みかどうかは確認しない）
+func (c Channel) WellFormed() bool {
+	return definitionIDPattern.MatchString(string(c))
+}
+
+// WellFormed: IDの形式を満たしているかどうか（登録済みかどうかは確認しない）
+func (t Tone) WellFormed() bool {
+	return definitionIDPattern.MatchString(string(t))
+}
+
+// Option: 画面の選択肢に表示する値と表示名・説明
+type Option struct {
+	Value       string `json:"value"`
+	Label       string `json:"label"`
+	Description string `json:"description"`
+}
+
+// OutputField: チャネルの出力形式（JSON）の文字列項目
+type OutputField struct {
+	// Name: JSONのキー（titleとdescriptionは必須）
+	Name string `json:"name"`
+	// Description: プロンプトの出力形式に記載する項目の説明
+	Description string            `json:"description"`
+	Limit       textmetrics.Limit `json:"limit"`
+}
+
+// ChannelDefinition: 配信チャネルの定義
+//
+// 管理者が追加・編集でき、コピーはIDをChannelとして保持します。
+// 組み込みのチャネルは削除できず、構造化された出力（CopyContent）の組み立てもIDに応じて行います。
+type ChannelDefinition struct {
+	ID          Channel `json:"id" gorm:"primaryKey;size:50"`
+	Label       string  `json:"label"`
+	Description string  `json:"description"`
+	// Guidance: プロンプトに追加するチャネル固有の指示
+	Guidance string `json:"guidance"`
+	// Fields: 出力形式の項目と長さの制限（表示順）
+	Fields    []OutputField `json:"fields" gorm:"serializer:json"`
+	BuiltIn   bool          `json:"builtIn"`
+	SortOrder int           `json:"sortOrder"`
+	CreatedAt time.Time     `json:"createdAt"`
+	UpdatedAt time.Time     `json:"updatedAt"`
+	// DeletedAt: 削除した日時（削除したチャネルは新しい生成に使用できないが、既存のコピーは残る）
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
+}
+
+func (ChannelDefinition) TableName() string {
+	return "channels"
+}
+
+// Field: 名前に対応する出力項目
+func (d *ChannelDefinition) Field(name string) (OutputField, bool) {
+	for _, f := range d.Fields {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return OutputField{}, false
+}
+
+// Option: 選択肢として表示する値
+func (d *ChannelDefinition) Option() Option {
+	return Option{Value: string(d.ID), Label: d.Label, Description: d.Description}
+}
+
+// ToneDefinition: トーンの定義
+type ToneDefinition struct {
+	ID          Tone   `json:"id" gorm:"primaryKey;size:50"`
+	Label       string `json:"label"`
+	Description string `json:"description"`
+	// Guidance: プロンプトに追加するトーン固有の指示
+	Guidance string `json:"guidance"`
+	// Markers: 候補の採点でトーンらしさを判定する表現
+	Markers   []string  `json:"markers" gorm:"serializer:json"`
+	BuiltIn   bool      `json:"builtIn"`
+	SortOrder int       `json:"sortOrder"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	// DeletedAt: 削除した日時（削除したトーンは新しい生成に使用できないが、既存のコピーは残る）
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
+}
+
+func (ToneDefinition) TableName() string {
+	return "tones"
+}
+
+// Option: 選択肢として表示する値
+func (d *ToneDefinition) Option() Option {
+	return Option{Value: string(d.ID), Label: d.Label, Description: d.Description}
+}
+
+// graphemes / displayWidth: 組み込みの定義を簡潔にするためのヘルパー
+func graphemes(min, max int) textmetrics.Limit {
+	return textmetrics.Limit{Unit: textmetrics.UnitGraphemes, Min: min, Max: max}
+}
+
+func displayWidth(min, max int) textmetrics.Limit {
+	return textmetrics.Limit{Unit: textmetrics.UnitWidth, Min: min, Max: max}
+}
+
+// BuiltinChannels: 組み込みの配信チャネル（マイグレーションで同じ内容を登録する）
+//
+// 日本語の「文字」は書記素クラスタ数で数え、表示領域が限られるプッシュ通知は表示幅で数えます。
+var BuiltinChannels = []*ChannelDefinition{
+	{
+		ID: ChannelApp, Label: "アプリ通知", Description: "スマートフォンのプッシュ通知。短いタイトルと本文で来店や購入を促します",
+		Fields: []OutputField{
+			// 通知に表示される幅（全角20文字・全角60文字相当）
+			{Name: FieldTitle, Description: "プッシュ通知のタイトル", Limit: displayWidth(0, 40)},
+			{Name: FieldDescription, Description: "プッシュ通知の本文", Limit: displayWidth(40, 120)},
+		},
+		BuiltIn: true, SortOrder: 1,
+	},
+	{
+		ID: ChannelLine, Label: "LINE広告", Description: "LINEのFlex Message。見出し・本文・ボタンで構成します",
+		Fields: []OutputField{
+			{Name: FieldTitle, Description: "メッセージの見出し", Limit: graphemes(0, 20)},
+			{Name: FieldDescription, Description: "メッセージの本文", Limit: graphemes(50, 100)},
+			// LINEの代替テキストとアクションラベルの上限
+			{Name: "altText", Description: "トーク一覧や通知に表示される代替テキスト", Limit: graphemes(0, 400)},
+			{Name: "buttonLabel", Description: "ボタンの文言", Limit: graphemes(0, 20)},
+		},
+		BuiltIn: true, SortOrder: 2,
+	},
+	{
+		ID: ChannelPop, Label: "店舗POP", Description: "店頭に掲示するPOP。キャッチコピーとサブコピー、価格の訴求で構成します",
+		Fields: []OutputField{
+			{Name: FieldTitle, Description: "POPのキャッチコピー", Limit: graphemes(0, 20)},
+			{Name: FieldDescription, Description: "サブコピー", Limit: graphemes(20, 50)},
+			{Name: "priceCallout", Description: "価格やお得感を伝える一言", Limit: graphemes(0, 15)},
+		},
+		BuiltIn: true, SortOrder: 3,
+	},
+	{
+		ID: ChannelSNS, Label: "SNS投稿", Description: "SNSへの投稿文。ハッシュタグを含めて全角140文字以内に収めます",
+		Fields: []OutputField{
+			{Name: FieldTitle, Description: "投稿の見出し", Limit: graphemes(0, 20)},
+			{Name: FieldDescription, Description: "投稿本文（ハッシュタグは含めない）", Limit: graphemes(50, 100)},
+		},
+		BuiltIn: true, SortOrder: 4,
+	},
+	{
+		ID: ChannelEmail, Label: "メールマガジン", Description: "メールマガジンの件名・プリヘッダー・本文。開封したくなる件名を重視します",
+		Fields: []OutputField{
+			{Name: FieldTitle, Description: "件名", Limit: graphemes(0, 30)},
+			{Name: FieldDescription, Description: "本文", Limit: graphemes(100, 400)},
+			{Name: "preheader", Description: "受信一覧で件名の後に表示されるプレヘッダー", Limit: graphemes(0, 50)},
+		},
+		BuiltIn: true, SortOrder: 5,
+	},
+}
+
+// BuiltinTones: 組み込みのトーン（マイグレーションで同じ内容を登録する）
+var BuiltinTones = []*ToneDefinition{
+	{
+		ID: TonePop, Label: "ポップ", Description: "明るく親しみやすい、弾むような言葉づかい",
+		Markers: []string{"！", "!", "♪", "ワクワク", "今すぐ", "見逃し"},
+		BuiltIn: true, SortOrder: 1,
+	},
+	{
+		ID: ToneTrust, Label: "信頼感", Description: "落ち着いた丁寧な言葉づかいで、品質や実績を伝えます",
+		Markers: []string{"安心", "品質", "信頼", "確か", "実績", "自信"},
+		BuiltIn: true, SortOrder: 2,
+	},
+	{
+		ID: ToneValue, Label: "お得感", Description: "価格や特典を前面に出し、今買う理由を伝えます",
+		Markers: []string{"お得", "円", "%", "％", "割", "コスパ", "セール", "お買い得"},
+		BuiltIn: true, SortOrder: 3,
+	},
+	{
+		ID: ToneLuxury, Label: "高級感", Description: "上品で洗練された言葉づかいで、特別感を演出します",
+		Markers: []string{"上質", "特別", "贅沢", "極上", "ワンランク", "洗練"},
+		BuiltIn: true, SortOrder: 4,
+	},
+	{
+		ID: ToneCasual, Label: "カジュアル", Description: "友人に話しかけるような、気軽な言葉づかい",
+		Markers: []string{"ね", "よ", "気軽", "〜", "ちょっと", "はじめ"},
+		BuiltIn: true, SortOrder: 5,
+	},
+}
+
+// BuiltinChannel: IDに対応する組み込みの配信チャネル（複製を返す）
+func BuiltinChannel(id Channel) (*ChannelDefinition, bool) {
+	for _, d := range BuiltinChannels {
+		if d.ID == id {
+			c := *d
+			return &c, true
+		}
+	}
+	return nil, false
+}
+
+// BuiltinTone: IDに対応する組み込みのトーン（複製を返す）
+func BuiltinTone(id Tone) (*ToneDefinition, bool) {
+	for _, d := range BuiltinTones {
+		if d.ID == id {
+			c := *d
+			return &c, true
+		}
+	}
+	return nil, false
+}
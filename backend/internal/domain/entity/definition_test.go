@@ -0,0 +1,70 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelWellFormed(t *testing.T) {
+	tests := []struct {
+		name    string
+		channel Channel
+		want    bool
+	}{
+		{name: "正常系_組み込み", channel: ChannelLine, want: true},
+		{name: "正常系_記号を含む", channel: Channel("instagram_reels-2"), want: true},
+		{name: "異常系_空", channel: Channel(""), want: false},
+		{name: "異常系_大文字", channel: Channel("SNS"), want: false},
+		{name: "異常系_数字で始まる", channel: Channel("1line"), want: false},
+		{name: "異常系_空白を含む", channel: Channel("sns post"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.channel.WellFormed())
+		})
+	}
+}
+
+func TestToneWellFormed(t *testing.T) {
+	assert.True(t, Tone("urgent").WellFormed())
+	assert.False(t, Tone("限定感").WellFormed())
+}
+
+func TestBuiltinChannels(t *testing.T) {
+	for _, d := range BuiltinChannels {
+		t.Run(string(d.ID), func(t *testing.T) {
+			// すべてのチャネルで見出しと本文を出力する
+			_, ok := d.Field(FieldTitle)
+			assert.True(t, ok)
+			_, ok = d.Field(FieldDescription)
+			assert.True(t, ok)
+			assert.True(t, d.BuiltIn)
+		})
+	}
+}
+
+func TestBuiltinChannel(t *testing.T) {
+	d, ok := BuiltinChannel(ChannelPop)
+	require.True(t, ok)
+	assert.Equal(t, Option{Value: "pop", Label: "店舗POP", Description: "店頭に掲示するPOP。キャッチコピーとサブコピー、価格の訴求で構成します"}, d.Option())
+
+	// 複製を返すため、変更しても組み込みの定義は変わらない
+	d.Label = "変更"
+	again, _ := BuiltinChannel(ChannelPop)
+	assert.Equal(t, "店舗POP", again.Label)
+
+	_, ok = BuiltinChannel(Channel("reels"))
+	assert.False(t, ok)
+}
+
+func TestBuiltinTone(t *testing.T) {
+	d, ok := BuiltinTone(ToneValue)
+	require.True(t, ok)
+	assert.Contains(t, d.Markers, "お得")
+
+	_, ok = BuiltinTone(Tone("urgent"))
+	assert.False(t, ok)
+}
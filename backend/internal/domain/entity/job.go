@@ -0,0 +1,51 @@
+package entity
+
+import "time"
+
+// JobStatus: 生成ジョブの状態
+type JobStatus string
+
+const (
+	JobStatusQueued    JobStatus = "queued"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusSucceeded JobStatus = "succeeded"
+	JobStatusFailed    JobStatus = "failed"
+)
+
+// GenerationJobInput: 生成ジョブの入力（コピー生成のリクエスト内容）
+type GenerationJobInput struct {
+	ProductName     string  `json:"productName"`
+	ProductFeatures string  `json:"productFeatures"`
+	Target          string  `json:"target"`
+	Channel         Channel `json:"channel"`
+	Tone            Tone    `json:"tone"`
+	Variants        int     `json:"variants,omitempty"`
+	Locale          string  `json:"locale,omitempty"`
+	BrandProfileID  *int    `json:"brandProfileId,omitempty"`
+	ProductID       *int    `json:"productId,omitempty"`
+	CampaignID      *int    `json:"campaignId,omitempty"`
+	// CreatedBy: ジョブを登録した利用者（生成したコピーの生成者として記録する）
+	CreatedBy *int `json:"createdBy,omitempty"`
+}
+
+// GenerationJob: 非同期で実行するコピー生成ジョブ
+type GenerationJob struct {
+	ID     int                `json:"id" gorm:"primaryKey;autoIncrement"`
+	Status JobStatus          `json:"status"`
+	Input  GenerationJobInput `json:"input" gorm:"serializer:json"`
+	// Attempts / MaxAttempts: 実行した回数と実行する回数の上限
+	Attempts    int `json:"attempts"`
+	MaxAttempts int `json:"maxAttempts"`
+	// RunAt: 次に実行できる日時（再試行時はバックオフ後の日時）
+	RunAt time.Time `json:"runAt"`
+	// LockedBy / LockedAt: 実行中のワーカーと取得した日時
+	LockedBy  string     `json:"-"`
+	LockedAt  *time.Time `json:"-"`
+	LastError string     `json:"lastError,omitempty"`
+	// CopyIDs / GenerationID: 生成したコピーのID（複数候補の場合は生成IDも記録）
+	CopyIDs      []int      `json:"copyIds" gorm:"serializer:json"`
+	GenerationID string     `json:"generationId,omitempty"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	UpdatedAt    time.Time  `json:"updatedAt"`
+	CompletedAt  *time.Time `json:"completedAt,omitempty"`
+}
@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// Like: 投票者ごとのいいね（同じ投票者は1つのコピーに1回のみいいねできる）
+type Like struct {
+	CopyID int `json:"copyId" gorm:"primaryKey;autoIncrement:false"`
+	// Voter: 投票者（UserVoter・AnonymousVoterで作成）
+	Voter     string    `json:"voter" gorm:"primaryKey;size:191"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// UserVoter: ログイン中の利用者の投票者
+func UserVoter(userID string) string {
+	return "user:" + userID
+}
+
+// AnonymousVoter: 匿名の投票者（クライアントの識別子などはそのまま保存せずハッシュにする）
+func AnonymousVoter(fingerprint string) string {
+	sum := sha256.Sum256([]byte(fingerprint))
+	return "anon:" + hex.EncodeToString(sum[:16])
+}
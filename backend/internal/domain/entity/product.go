@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Product: 商品カタログの商品
+//
+// 生成時に商品IDを指定すると、商品名・特徴を入力し直さずに生成でき、商品ごとにコピーをまとめて取得できます。
+type Product struct {
+	ID int `json:"id" gorm:"primaryKey;autoIncrement"`
+	// SKU: 商品を識別するコード（一意）
+	SKU      string `json:"sku" gorm:"uniqueIndex"`
+	Name     string `json:"name"`
+	Features string `json:"features"`
+	// Price: 価格（円、未設定の場合はnil）
+	Price    *int   `json:"price"`
+	Category string `json:"category"`
+	// Images: 商品画像の情報（画像そのものは保存しない）
+	Images    []ProductImage `json:"images" gorm:"serializer:json"`
+	CreatedAt time.Time      `json:"createdAt"`
+	UpdatedAt time.Time      `json:"updatedAt"`
+	// DeletedAt: 削除した日時（削除した商品は新しい生成に使用できないが、既存のコピーは残る）
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
+}
+
+// ProductImage: 商品画像の情報
+type ProductImage struct {
+	URL    string `json:"url"`
+	Alt    string `json:"alt"`
+	Width  int    `json:"width,omitempty"`
+	Height int    `json:"height,omitempty"`
+}
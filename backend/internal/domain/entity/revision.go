@@ -0,0 +1,57 @@
+package entity
+
+import "time"
+
+// RevisionSource: 版を作成した操作
+type RevisionSource string
+
+const (
+	// RevisionSourceGenerated: LLMによる生成（最初の版）
+	RevisionSourceGenerated RevisionSource = "generated"
+	RevisionSourceEdited    RevisionSource = "edited"
+	RevisionSourceRollback  RevisionSource = "rollback"
+	// RevisionSourceWorkflow: 編集ワークフローの状態の変更（レビューの依頼・承認・差し戻し・公開など）
+	RevisionSourceWorkflow RevisionSource = "workflow"
+	// RevisionSourceScheduler: 公開日時・公開終了日時による自動の公開・アーカイブ
+	RevisionSourceScheduler RevisionSource = "scheduler"
+	// RevisionSourceSchedule: 公開日時・公開終了日時の設定
+	RevisionSourceSchedule RevisionSource = "schedule"
+)
+
+// CopyRevision: コピーの版（見出し・本文・状態の変更ごとに作成し、変更はしない）
+type CopyRevision struct {
+	ID     int `json:"id" gorm:"primaryKey;autoIncrement"`
+	CopyID int `json:"copyId" gorm:"uniqueIndex:idx_copy_revisions_copy_id_revision"`
+	// Revision: コピーごとの版番号（1から連番）
+	Revision int            `json:"revision" gorm:"uniqueIndex:idx_copy_revisions_copy_id_revision"`
+	Source   RevisionSource `json:"source"`
+	// Author: 変更した利用者（生成時や不明な場合は空）
+	Author      string       `json:"author"`
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	Status      CopyStatus   `json:"status"`
+	Content     *CopyContent `json:"content,omitempty" gorm:"serializer:json"`
+	// PublishAt / ExpireAt: この版の時点の公開日時・公開終了日時
+	PublishAt *time.Time `json:"publishAt,omitempty"`
+	ExpireAt  *time.Time `json:"expireAt,omitempty"`
+	// Comment: 承認・差し戻しのコメント（状態の変更の版のみ）
+	Comment string `json:"comment,omitempty"`
+	// RollbackOf: ロールバックで戻した版の番号
+	RollbackOf int       `json:"rollbackOf,omitempty"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// NewCopyRevision: コピーの現在の内容から版を作成する（版番号は保存時に採番）
+func NewCopyRevision(copy *Copy, source RevisionSource, author string) *CopyRevision {
+	return &CopyRevision{
+		CopyID:      copy.ID,
+		Source:      source,
+		Author:      author,
+		Title:       copy.Title,
+		Description: copy.Description,
+		Status:      copy.Status,
+		Content:     copy.Content,
+		PublishAt:   copy.PublishAt,
+		ExpireAt:    copy.ExpireAt,
+	}
+}
@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// User: APIを利用するアカウント
+type User struct {
+	ID int `json:"id" gorm:"primaryKey;autoIncrement"`
+	// Email: ログインに使用するメールアドレス（小文字で保存し、一意）
+	Email string `json:"email" gorm:"uniqueIndex;size:191"`
+	Name  string `json:"name"`
+	// PasswordHash: bcryptでハッシュにしたパスワード
+	PasswordHash string `json:"-"`
+	// FirstUser: 認証せずに登録した最初の利用者のみtrue（以降の利用者はNULL、一意制約で最初の利用者を1人に限る）
+	FirstUser *bool     `json:"-" gorm:"uniqueIndex"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// APIKey: スクリプトなどから利用する長期間有効なAPIキー
+//
+// キーは発行時のみ返し、照合用のハッシュと表示用の先頭の文字列のみを保存します。
+type APIKey struct {
+	ID     int `json:"id" gorm:"primaryKey;autoIncrement"`
+	UserID int `json:"userId" gorm:"index"`
+	// Name: キーの用途（例: 夜間の一括生成）
+	Name string `json:"name"`
+	// Prefix: キーの先頭の文字列（一覧でキーを見分けるために表示する）
+	Prefix string `json:"prefix"`
+	// KeyHash: キーのSHA-256のハッシュ（16進数）
+	KeyHash string `json:"-" gorm:"uniqueIndex;size:64"`
+	// LastUsedAt: 直近に認証に使用した日時（使用していない場合はnil）
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	// DeletedAt: 無効にした日時（無効にしたキーでは認証できない）
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
+}
+
+// AuthMethod: 呼び出し元を認証した方法
+type AuthMethod string
+
+const (
+	// AuthMethodSession: ログインで発行したトークン
+	AuthMethodSession AuthMethod = "session"
+	// AuthMethodAPIKey: APIキー
+	AuthMethodAPIKey AuthMethod = "api_key"
+)
+
+// Identity: 認証済みのリクエストの呼び出し元
+type Identity struct {
+	UserID int
+	Email  string
+	Method AuthMethod
+	// APIKeyID: 認証に使用したAPIキー（APIキーで認証した場合のみ）
+	APIKeyID int
+}
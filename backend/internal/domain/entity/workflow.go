@@ -0,0 +1,46 @@
+package entity
+
+// CopyStatus: コピーの編集ワークフローの状態
+//
+// 生成したコピーは下書きから始まり、レビューで承認されたコピーのみ公開できます。
+type CopyStatus string
+
+const (
+	CopyStatusDraft     CopyStatus = "draft"
+	CopyStatusInReview  CopyStatus = "in_review"
+	CopyStatusApproved  CopyStatus = "approved"
+	CopyStatusPublished CopyStatus = "published"
+	CopyStatusArchived  CopyStatus = "archived"
+)
+
+// copyTransitions: 状態ごとに遷移できる状態
+var copyTransitions = map[CopyStatus][]CopyStatus{
+	CopyStatusDraft: {CopyStatusInReview, CopyStatusArchived},
+	// 差し戻したコピーは下書きに戻る
+	CopyStatusInReview: {CopyStatusApproved, CopyStatusDraft, CopyStatusArchived},
+	// 承認後に内容を直す場合は下書きに戻し、もう一度レビューを受ける
+	CopyStatusApproved:  {CopyStatusPublished, CopyStatusDraft, CopyStatusArchived},
+	CopyStatusPublished: {CopyStatusArchived},
+	CopyStatusArchived:  {CopyStatusDraft},
+}
+
+// Valid: 定義済みの状態かどうか
+func (s CopyStatus) Valid() bool {
+	_, ok := copyTransitions[s]
+	return ok
+}
+
+// CanTransitionTo: 状態nextに遷移できるかどうか
+func (s CopyStatus) CanTransitionTo(next CopyStatus) bool {
+	for _, to := range copyTransitions[s] {
+		if to == next {
+			return true
+		}
+	}
+	return false
+}
+
+// Editable: 見出し・本文を編集できるかどうか（レビューに出した内容は承認・差し戻しまで変更できない）
+func (s CopyStatus) Editable() bool {
+	return s == CopyStatusDraft
+}
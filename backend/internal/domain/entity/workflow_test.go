@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCopyStatus_CanTransitionTo(t *testing.T) {
+	tests := []struct {
+		name string
+		from CopyStatus
+		to   CopyStatus
+		want bool
+	}{
+		{name: "下書きからレビュー中", from: CopyStatusDraft, to: CopyStatusInReview, want: true},
+		{name: "レビュー中から承認済み", from: CopyStatusInReview, to: CopyStatusApproved, want: true},
+		{name: "レビュー中から下書き（差し戻し）", from: CopyStatusInReview, to: CopyStatusDraft, want: true},
+		{name: "承認済みから公開済み", from: CopyStatusApproved, to: CopyStatusPublished, want: true},
+		{name: "公開済みからアーカイブ", from: CopyStatusPublished, to: CopyStatusArchived, want: true},
+		{name: "アーカイブから下書き", from: CopyStatusArchived, to: CopyStatusDraft, want: true},
+		{name: "下書きから公開済み", from: CopyStatusDraft, to: CopyStatusPublished, want: false},
+		{name: "レビュー中から公開済み", from: CopyStatusInReview, to: CopyStatusPublished, want: false},
+		{name: "公開済みから下書き", from: CopyStatusPublished, to: CopyStatusDraft, want: false},
+		{name: "同じ状態", from: CopyStatusApproved, to: CopyStatusApproved, want: false},
+		{name: "未定義の状態", from: CopyStatus("deleted"), to: CopyStatusDraft, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.from.CanTransitionTo(tt.to))
+		})
+	}
+}
+
+func TestCopyStatus_Valid(t *testing.T) {
+	assert.True(t, CopyStatusInReview.Valid())
+	assert.False(t, CopyStatus("deleted").Valid())
+	assert.False(t, CopyStatus("").Valid())
+}
+
+func TestCopyStatus_Editable(t *testing.T) {
+	assert.True(t, CopyStatusDraft.Editable())
+	assert.False(t, CopyStatusInReview.Editable())
+	assert.False(t, CopyStatusApproved.Editable())
+	assert.False(t, CopyStatusPublished.Editable())
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+)
+
+type BrandProfileRepository interface {
+	// List: 削除していないブランドプロファイルを名前順に取得
+	List(ctx context.Context) ([]*entity.BrandProfile, error)
+	// Get: ブランドプロファイルを取得（存在しない・削除済みの場合はErrBrandProfileNotFoundを返す）
+	Get(ctx context.Context, id int) (*entity.BrandProfile, error)
+	Create(ctx context.Context, profile *entity.BrandProfile) error
+	// Update: ブランドプロファイルを更新（存在しない場合はErrBrandProfileNotFoundを返す）
+	Update(ctx context.Context, profile *entity.BrandProfile) error
+	// Delete: ブランドプロファイルを論理削除
+	Delete(ctx context.Context, id int) error
+}
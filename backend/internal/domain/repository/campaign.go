@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+)
+
+type CampaignRepository interface {
+	// List: 削除していないキャンペーンを開始日時の新しい順に取得
+	List(ctx context.Context) ([]*entity.Campaign, error)
+	// Get: キャンペーンを取得（存在しない・削除済みの場合はErrCampaignNotFoundを返す）
+	Get(ctx context.Context, id int) (*entity.Campaign, error)
+	Create(ctx context.Context, campaign *entity.Campaign) error
+	// Update: キャンペーンを更新（存在しない場合はErrCampaignNotFoundを返す）
+	Update(ctx context.Context, campaign *entity.Campaign) error
+	// Delete: キャンペーンを論理削除
+	Delete(ctx context.Context, id int) error
+}
@@ -2,13 +2,106 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
 )
 
+// CopySort: 一覧の並び順
+type CopySort string
+
+const (
+	// CopySortNewest: 作成日時の新しい順
+	CopySortNewest CopySort = "newest"
+	// CopySortLikes: いいね数の多い順
+	CopySortLikes CopySort = "likes"
+	// CopySortTrending: 話題順（新しさといいね数を合わせたスコアの高い順）
+	CopySortTrending CopySort = "trending"
+)
+
+// Valid: 定義済みの並び順かどうか
+func (s CopySort) Valid() bool {
+	switch s {
+	case CopySortNewest, CopySortLikes, CopySortTrending:
+		return true
+	}
+	return false
+}
+
+// CopyFilter: 一覧の絞り込み条件（空の項目は条件にしない）
+type CopyFilter struct {
+	Channel entity.Channel
+	Tone    entity.Tone
+	Target  string
+	// ProductName: 商品名の部分一致
+	ProductName string
+	// CreatedFrom / CreatedTo: 作成日時の範囲（CreatedFromを含み、CreatedToを含まない）
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+}
+
+// CopyCursor: 前のページの最後のコピー（並び順のキーとIDの組でこのコピーより後を取得）
+type CopyCursor struct {
+	ID            int
+	CreatedAt     time.Time
+	Likes         int
+	TrendingScore int64
+}
+
+// CopyListQuery: 公開済みのコピーの一覧の取得条件
+type CopyListQuery struct {
+	Filter CopyFilter
+	Sort   CopySort
+	After  *CopyCursor
+	Limit  int
+	// Now: 公開日時・公開終了日時の判定に使う現在時刻（公開日時前・公開終了後のコピーは含めない）
+	Now time.Time
+}
+
+// CopyStatusGuard: UpdateStatusで更新する条件（取得・一覧の時点から変わっていないこと）
+type CopyStatusGuard struct {
+	// Status: 取得・一覧の時点の状態
+	Status entity.CopyStatus
+	// PublishDue / ExpireDue: 指定した場合、公開日時・公開終了日時がこの時刻以前であること（スケジューラーによる変更）
+	PublishDue *time.Time
+	ExpireDue  *time.Time
+}
+
 type CopyRepository interface {
 	Create(ctx context.Context, copy *entity.Copy) error
+	CreateBatch(ctx context.Context, copies []*entity.Copy) error
+	// CreateWithRevision: コピーを保存し、同じトランザクションで最初の版revisionを記録（revisionがnilの場合は保存のみ）
+	CreateWithRevision(ctx context.Context, copy *entity.Copy, revision *entity.CopyRevision) error
+	// CreateBatchWithRevisions: 複数のコピーを1トランザクションで保存し、同じトランザクションでcopies[i]の最初の版revisions[i]を記録
+	CreateBatchWithRevisions(ctx context.Context, copies []*entity.Copy, revisions []*entity.CopyRevision) error
 	Get(ctx context.Context, id int) (*entity.Copy, error)
-	GetPublished(ctx context.Context) ([]*entity.Copy, error)
-	UpdateLikes(ctx context.Context, id int, likes int) error
+	// GetByIDs: 指定したIDのコピーをidsの順に取得（存在しないIDは除く）
+	GetByIDs(ctx context.Context, ids []int) ([]*entity.Copy, error)
+	// ListPublished: 公開済みのコピーを条件で絞り込み、並び順のキーとIDによるカーソルで取得
+	ListPublished(ctx context.Context, query CopyListQuery) ([]*entity.Copy, error)
+	// Like: 投票者のいいねを記録し、初めてのいいねの場合のみいいね数を1増やす
+	Like(ctx context.Context, id int, voter string) error
+	// Unlike: 投票者のいいねを取り消し、いいね済みだった場合のみいいね数を1減らす
+	Unlike(ctx context.Context, id int, voter string) error
+	GetByGenerationID(ctx context.Context, generationID string) ([]*entity.Copy, error)
+	// ListByProduct: 商品を指定して生成したコピーを公開状態にかかわらず新しい順に取得
+	ListByProduct(ctx context.Context, productID int) ([]*entity.Copy, error)
+	// ListByCampaign: キャンペーンに属するコピーを公開状態にかかわらず新しい順に取得
+	ListByCampaign(ctx context.Context, campaignID int) ([]*entity.Copy, error)
+	// ListInReview: レビュー中のコピーを更新の古い順に取得（reviewerが空でない場合はその利用者の担当のみ）
+	ListInReview(ctx context.Context, reviewer string) ([]*entity.Copy, error)
+	// ListDueToPublish: 公開日時がnow以前の承認済みのコピーを公開日時の古い順に取得
+	ListDueToPublish(ctx context.Context, now time.Time) ([]*entity.Copy, error)
+	// ListDueToExpire: 公開終了日時がnow以前の公開済みのコピーを公開終了日時の古い順に取得
+	ListDueToExpire(ctx context.Context, now time.Time) ([]*entity.Copy, error)
+	SelectVariant(ctx context.Context, generationID string, id int) error
+	UpdateFindings(ctx context.Context, id int, findings []entity.ComplianceFinding) error
+	// Update: fieldsに指定したフィールドのみを更新
+	Update(ctx context.Context, copy *entity.Copy, fields []string) error
+	// UpdateWithRevision: fieldsに指定したフィールドを更新し、同じトランザクションで版を記録（revisionがnilの場合は更新のみ）
+	UpdateWithRevision(ctx context.Context, copy *entity.Copy, fields []string, revision *entity.CopyRevision) error
+	// UpdateStatus: guardの条件を満たす場合のみ更新し、同じトランザクションで版を記録（満たさない場合はErrCopyStatusChangedを返す）
+	UpdateStatus(ctx context.Context, copy *entity.Copy, guard CopyStatusGuard, fields []string, revision *entity.CopyRevision) error
+	Delete(ctx context.Context, id int) error
+	Restore(ctx context.Context, id int) error
 }
@@ -20,6 +20,11 @@ func (m *MockCopyRepository) Create(ctx context.Context, copy *entity.Copy) erro
 	return args.Error(0)
 }
 
+func (m *MockCopyRepository) CreateBatch(ctx context.Context, copies []*entity.Copy) error {
+	args := m.Called(ctx, copies)
+	return args.Error(0)
+}
+
 func (m *MockCopyRepository) Get(ctx context.Context, id int) (*entity.Copy, error) {
 	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
@@ -28,19 +33,45 @@ func (m *MockCopyRepository) Get(ctx context.Context, id int) (*entity.Copy, err
 	return args.Get(0).(*entity.Copy), args.Error(1)
 }
 
-func (m *MockCopyRepository) GetPublished(ctx context.Context) ([]*entity.Copy, error) {
-	args := m.Called(ctx)
+func (m *MockCopyRepository) ListPublished(ctx context.Context, query CopyListQuery) ([]*entity.Copy, error) {
+	args := m.Called(ctx, query)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]*entity.Copy), args.Error(1)
 }
 
-func (m *MockCopyRepository) UpdateLikes(ctx context.Context, id int, likes int) error {
-	args := m.Called(ctx, id, likes)
+func (m *MockCopyRepository) Like(ctx context.Context, id int, voter string) error {
+	args := m.Called(ctx, id, voter)
 	return args.Error(0)
 }
 
+func (m *MockCopyRepository) Unlike(ctx context.Context, id int, voter string) error {
+	args := m.Called(ctx, id, voter)
+	return args.Error(0)
+}
+
+func (m *MockCopyRepository) GetByGenerationID(ctx context.Context, generationID string) ([]*entity.Copy, error) {
+	args := m.Called(ctx, generationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Copy), args.Error(1)
+}
+
+func (m *MockCopyRepository) SelectVariant(ctx context.Context, generationID string, id int) error {
+	args := m.Called(ctx, generationID, id)
+	return args.Error(0)
+}
+
+func TestCopySort_Valid(t *testing.T) {
+	assert.True(t, CopySortNewest.Valid())
+	assert.True(t, CopySortLikes.Valid())
+	assert.True(t, CopySortTrending.Valid())
+	assert.False(t, CopySort("oldest").Valid())
+	assert.False(t, CopySort("").Valid())
+}
+
 func TestCopyRepository(t *testing.T) {
 	// テスト用のコンテキスト
 	ctx := context.Background()
@@ -55,7 +86,6 @@ func TestCopyRepository(t *testing.T) {
 			Target:          "20-30代女性",
 			Channel:         entity.ChannelSNS,
 			Tone:            entity.ToneCasual,
-			IsPublished:     true,
 		}
 
 		t.Run("正常系", func(t *testing.T) {
@@ -90,7 +120,7 @@ func TestCopyRepository(t *testing.T) {
 			Target:          "20-30代女性",
 			Channel:         entity.ChannelSNS,
 			Tone:            entity.ToneCasual,
-			IsPublished:     true,
+			Status:          entity.CopyStatusPublished,
 		}
 
 		t.Run("正常系", func(t *testing.T) {
@@ -116,7 +146,7 @@ func TestCopyRepository(t *testing.T) {
 		})
 	})
 
-	t.Run("GetPublished", func(t *testing.T) {
+	t.Run("ListPublished", func(t *testing.T) {
 		// テスト用のコピーエンティティリスト
 		testCopies := []*entity.Copy{
 			{
@@ -128,7 +158,7 @@ func TestCopyRepository(t *testing.T) {
 				Target:          "20-30代女性",
 				Channel:         entity.ChannelSNS,
 				Tone:            entity.ToneCasual,
-				IsPublished:     true,
+				Status:          entity.CopyStatusPublished,
 			},
 			{
 				ID:              2,
@@ -139,15 +169,16 @@ func TestCopyRepository(t *testing.T) {
 				Target:          "20-30代女性",
 				Channel:         entity.ChannelSNS,
 				Tone:            entity.ToneCasual,
-				IsPublished:     true,
+				Status:          entity.CopyStatusPublished,
 			},
 		}
 
 		t.Run("正常系", func(t *testing.T) {
 			mockRepo := new(MockCopyRepository)
-			mockRepo.On("GetPublished", ctx).Return(testCopies, nil)
+			query := CopyListQuery{Sort: CopySortNewest, Limit: 20}
+			mockRepo.On("ListPublished", ctx, query).Return(testCopies, nil)
 
-			got, err := mockRepo.GetPublished(ctx)
+			got, err := mockRepo.ListPublished(ctx, query)
 
 			assert.NoError(t, err)
 			assert.Equal(t, testCopies, got)
@@ -156,9 +187,10 @@ func TestCopyRepository(t *testing.T) {
 
 		t.Run("異常系_エラー発生", func(t *testing.T) {
 			mockRepo := new(MockCopyRepository)
-			mockRepo.On("GetPublished", ctx).Return(nil, assert.AnError)
+			query := CopyListQuery{Sort: CopySortNewest, Limit: 20}
+			mockRepo.On("ListPublished", ctx, query).Return(nil, assert.AnError)
 
-			got, err := mockRepo.GetPublished(ctx)
+			got, err := mockRepo.ListPublished(ctx, query)
 
 			assert.Error(t, err)
 			assert.Nil(t, got)
@@ -166,12 +198,12 @@ func TestCopyRepository(t *testing.T) {
 		})
 	})
 
-	t.Run("UpdateLikes", func(t *testing.T) {
+	t.Run("Like", func(t *testing.T) {
 		t.Run("正常系", func(t *testing.T) {
 			mockRepo := new(MockCopyRepository)
-			mockRepo.On("UpdateLikes", ctx, 1, 1).Return(nil)
+			mockRepo.On("Like", ctx, 1, "user:1").Return(nil)
 
-			err := mockRepo.UpdateLikes(ctx, 1, 1)
+			err := mockRepo.Like(ctx, 1, "user:1")
 
 			assert.NoError(t, err)
 			mockRepo.AssertExpectations(t)
@@ -179,11 +211,23 @@ func TestCopyRepository(t *testing.T) {
 
 		t.Run("異常系_存在しないID", func(t *testing.T) {
 			mockRepo := new(MockCopyRepository)
-			mockRepo.On("UpdateLikes", ctx, 999, 1).Return(assert.AnError)
+			mockRepo.On("Like", ctx, 999, "user:1").Return(ErrNotFound)
 
-			err := mockRepo.UpdateLikes(ctx, 999, 1)
+			err := mockRepo.Like(ctx, 999, "user:1")
 
-			assert.Error(t, err)
+			assert.ErrorIs(t, err, ErrNotFound)
+			mockRepo.AssertExpectations(t)
+		})
+	})
+
+	t.Run("Unlike", func(t *testing.T) {
+		t.Run("正常系", func(t *testing.T) {
+			mockRepo := new(MockCopyRepository)
+			mockRepo.On("Unlike", ctx, 1, "user:1").Return(nil)
+
+			err := mockRepo.Unlike(ctx, 1, "user:1")
+
+			assert.NoError(t, err)
 			mockRepo.AssertExpectations(t)
 		})
 	})
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+)
+
+type ChannelRepository interface {
+	// List: 削除していない配信チャネルを表示順に取得
+	List(ctx context.Context) ([]*entity.ChannelDefinition, error)
+	// Get: 配信チャネルを取得（存在しない・削除済みの場合はErrChannelNotFoundを返す）
+	Get(ctx context.Context, id entity.Channel) (*entity.ChannelDefinition, error)
+	// Create: 配信チャネルを登録（IDが重複する場合はErrDuplicatedを返す）
+	Create(ctx context.Context, definition *entity.ChannelDefinition) error
+	// Update: 配信チャネルの定義を更新
+	Update(ctx context.Context, definition *entity.ChannelDefinition) error
+	// Delete: 配信チャネルを論理削除
+	Delete(ctx context.Context, id entity.Channel) error
+}
+
+type ToneRepository interface {
+	// List: 削除していないトーンを表示順に取得
+	List(ctx context.Context) ([]*entity.ToneDefinition, error)
+	// Get: トーンを取得（存在しない・削除済みの場合はErrToneNotFoundを返す）
+	Get(ctx context.Context, id entity.Tone) (*entity.ToneDefinition, error)
+	// Create: トーンを登録（IDが重複する場合はErrDuplicatedを返す）
+	Create(ctx context.Context, definition *entity.ToneDefinition) error
+	// Update: トーンの定義を更新
+	Update(ctx context.Context, definition *entity.ToneDefinition) error
+	// Delete: トーンを論理削除
+	Delete(ctx context.Context, id entity.Tone) error
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/domainerr"
+)
+
+var (
+	// ErrNotFound: 対象のレコードが存在しない（論理削除済みを含む）
+	//
+	// コードを持たないため、ErrCopyNotFoundなど対象ごとのエラーのいずれにも一致します。
+	ErrNotFound = domainerr.NotFound("", "record not found")
+	// ErrCopyNotFound / ErrRevisionNotFound: コピー・コピーの版が存在しない
+	ErrCopyNotFound     = domainerr.NotFound("copy_not_found", "copy not found")
+	ErrRevisionNotFound = domainerr.NotFound("revision_not_found", "revision not found")
+	// ErrCopyStatusChanged: 状態を変更する間に他の操作でコピーの状態が変わった
+	ErrCopyStatusChanged = domainerr.Conflict("copy_status_changed", "copy status was changed by another operation")
+	// ErrChannelNotFound / ErrToneNotFound: 配信チャネル・トーンの定義が存在しない
+	ErrChannelNotFound = domainerr.NotFound("channel_not_found", "channel not found")
+	ErrToneNotFound    = domainerr.NotFound("tone_not_found", "tone not found")
+	// ErrBrandProfileNotFound: ブランドプロファイルが存在しない
+	ErrBrandProfileNotFound = domainerr.NotFound("brand_profile_not_found", "brand profile not found")
+	// ErrProductNotFound: 商品が存在しない
+	ErrProductNotFound = domainerr.NotFound("product_not_found", "product not found")
+	// ErrCampaignNotFound: キャンペーンが存在しない
+	ErrCampaignNotFound = domainerr.NotFound("campaign_not_found", "campaign not found")
+	// ErrJobNotFound: 生成ジョブが存在しない
+	ErrJobNotFound = domainerr.NotFound("job_not_found", "generation job not found")
+	// ErrJobLeaseLost: 実行中のジョブを他のワーカーが再取得した（ロックの期限切れ）
+	ErrJobLeaseLost = domainerr.Conflict("job_lease_lost", "generation job was reclaimed by another worker")
+	// ErrUserNotFound / ErrAPIKeyNotFound: 利用者・APIキーが存在しない
+	ErrUserNotFound   = domainerr.NotFound("user_not_found", "user not found")
+	ErrAPIKeyNotFound = domainerr.NotFound("api_key_not_found", "api key not found")
+	// ErrUsersExist: 最初の利用者として登録しようとしたが、既に利用者が登録されている
+	ErrUsersExist = domainerr.Conflict("users_exist", "users already exist")
+	// ErrDuplicated: 一意制約に違反する
+	ErrDuplicated = domainerr.Conflict("duplicated", "record already exists")
+)
+
+// TranslateError: 読み込みのエラーをドメインのエラーに変換（レコードが存在しない場合はnotFoundを返す）
+//
+// 一意制約の違反を判定するには、gorm.ConfigのTranslateErrorを有効にする必要があります。
+func TranslateError(err error, notFound *domainerr.Error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return notFound.Wrap(err)
+	}
+	return TranslateWriteError(err)
+}
+
+// TranslateWriteError: 作成・更新のエラーをドメインのエラーに変換（一意制約の違反はErrDuplicatedを返す）
+//
+// 作成・更新ではgorm.ErrRecordNotFoundは返らないため、更新の対象が存在しないことは
+// 更新した件数が0件かどうかで判定し、対象ごとのエラー（ErrProductNotFoundなど）を返します。
+func TranslateWriteError(err error) error {
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return ErrDuplicated.Wrap(err)
+	}
+	return err
+}
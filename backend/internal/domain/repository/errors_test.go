@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestTranslateError(t *testing.T) {
+	other := errors.New("connection refused")
+
+	tests := []struct {
+		name      string
+		err       error
+		translate func(error) error
+		want      error
+	}{
+		{
+			name:      "正常系_読み込みで存在しない",
+			err:       gorm.ErrRecordNotFound,
+			translate: func(err error) error { return TranslateError(err, ErrProductNotFound) },
+			want:      ErrProductNotFound,
+		},
+		{
+			name:      "正常系_読み込みで一意制約の違反",
+			err:       gorm.ErrDuplicatedKey,
+			translate: func(err error) error { return TranslateError(err, ErrProductNotFound) },
+			want:      ErrDuplicated,
+		},
+		{
+			name:      "正常系_作成・更新で一意制約の違反",
+			err:       gorm.ErrDuplicatedKey,
+			translate: TranslateWriteError,
+			want:      ErrDuplicated,
+		},
+		{
+			name:      "正常系_その他のエラーはそのまま返す",
+			err:       other,
+			translate: TranslateWriteError,
+			want:      other,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト実行
+			got := tt.translate(tt.err)
+
+			// アサーション（原因のエラーも取得できる）
+			assert.ErrorIs(t, got, tt.want)
+			assert.ErrorIs(t, got, tt.err)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+)
+
+type GenerationJobRepository interface {
+	Create(ctx context.Context, job *entity.GenerationJob) error
+	// Get: ジョブを取得（存在しない場合はErrJobNotFoundを返す）
+	Get(ctx context.Context, id int) (*entity.GenerationJob, error)
+	// Claim: 実行可能なジョブを1件ロックして実行中にする（ない場合はnilを返す）
+	//
+	// staleBefore以前にロックされたまま実行中のジョブは、ワーカーが停止したものとして再取得します。
+	Claim(ctx context.Context, workerID string, now, staleBefore time.Time) (*entity.GenerationJob, error)
+	// Complete / Retry / Fail: workerIDが実行中のジョブの結果を記録する
+	//
+	// ロックの期限が切れて他のワーカーが再取得した場合は更新せず、ErrJobLeaseLostを返します。
+	Complete(ctx context.Context, id int, workerID string, copyIDs []int, generationID string, completedAt time.Time) error
+	Retry(ctx context.Context, id int, workerID string, runAt time.Time, lastError string) error
+	Fail(ctx context.Context, id int, workerID string, lastError string, completedAt time.Time) error
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+)
+
+type ProductRepository interface {
+	// List: 削除していない商品をSKU順に取得
+	List(ctx context.Context) ([]*entity.Product, error)
+	// Get: 商品を取得（存在しない・削除済みの場合はErrProductNotFoundを返す）
+	Get(ctx context.Context, id int) (*entity.Product, error)
+	// Create: 商品を登録（SKUが登録済みの場合はErrDuplicatedを返す）
+	Create(ctx context.Context, product *entity.Product) error
+	// Update: 商品を更新（存在しない場合はErrProductNotFoundを返す）
+	Update(ctx context.Context, product *entity.Product) error
+	// Delete: 商品を論理削除
+	Delete(ctx context.Context, id int) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+)
+
+type CopyRevisionRepository interface {
+	// Create: コピーの次の版番号を採番して保存
+	Create(ctx context.Context, revision *entity.CopyRevision) error
+	// List: コピーの版を版番号の順に取得
+	List(ctx context.Context, copyID int) ([]*entity.CopyRevision, error)
+	// Get: 版を取得（存在しない場合はErrNotFoundを返す）
+	Get(ctx context.Context, copyID, revision int) (*entity.CopyRevision, error)
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+)
+
+// CopySearchQuery: コピーの全文検索の条件
+type CopySearchQuery struct {
+	// Terms: 正規化済みの検索語（すべてを含むコピーを対象にする）
+	Terms []string
+	// PublishedOnly: 公開済みのコピーのみを対象にするかどうか
+	PublishedOnly bool
+	// Now: PublishedOnlyの場合の公開日時・公開終了日時の判定に使う現在時刻（公開日時前・公開終了後のコピーは含めない）
+	Now    time.Time
+	Limit  int
+	Offset int
+}
+
+// CopySearchHit: 検索に一致したコピーとスコア
+type CopySearchHit struct {
+	ID    int
+	Score float64
+}
+
+// CopySearchIndex: タイトル・説明文・商品名・商品の特徴を対象にしたコピーの全文検索の索引
+type CopySearchIndex interface {
+	// Index: コピーを索引に追加（登録済みの場合は置き換える）
+	Index(ctx context.Context, copy *entity.Copy) error
+	// Remove: コピーを索引から除く
+	Remove(ctx context.Context, id int) error
+	// Search: 検索語に一致したコピーをスコアの高い順に取得
+	Search(ctx context.Context, query CopySearchQuery) ([]CopySearchHit, error)
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+)
+
+type UserRepository interface {
+	// Get: 利用者を取得（存在しない場合はErrUserNotFoundを返す）
+	Get(ctx context.Context, id int) (*entity.User, error)
+	// GetByEmail: メールアドレスで利用者を取得（存在しない場合はErrUserNotFoundを返す）
+	GetByEmail(ctx context.Context, email string) (*entity.User, error)
+	// Create: 利用者を登録（メールアドレスが登録済みの場合はErrDuplicatedを返す）
+	Create(ctx context.Context, user *entity.User) error
+	// CreateFirst: 利用者が登録されていない場合のみ最初の利用者として登録（登録済みの場合はErrUsersExistを返す）
+	CreateFirst(ctx context.Context, user *entity.User) error
+	// Count: 登録済みの利用者の数
+	Count(ctx context.Context) (int64, error)
+}
+
+type APIKeyRepository interface {
+	// ListByUser: 利用者の有効なAPIキーを発行の新しい順に取得
+	ListByUser(ctx context.Context, userID int) ([]*entity.APIKey, error)
+	// GetByHash: ハッシュが一致する有効なAPIキーを取得（存在しない・無効にした場合はErrAPIKeyNotFoundを返す）
+	GetByHash(ctx context.Context, hash string) (*entity.APIKey, error)
+	// Create: APIキーを登録
+	Create(ctx context.Context, key *entity.APIKey) error
+	// Delete: 利用者のAPIキーを無効にする（他の利用者のキーの場合はErrAPIKeyNotFoundを返す）
+	Delete(ctx context.Context, userID, id int) error
+	// Touch: APIキーを使用した日時を記録
+	Touch(ctx context.Context, id int, usedAt time.Time) error
+}
@@ -0,0 +1,263 @@
+package feed
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/domainerr"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	copy_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/copy"
+)
+
+// Format: 商品フィードの形式
+type Format string
+
+const (
+	FormatCSV   Format = "csv"
+	FormatJSONL Format = "jsonl"
+)
+
+var (
+	ErrUnknownFormat = domainerr.Validation("unknown_feed_format", "feed format must be csv or jsonl")
+	ErrMissingHeader = domainerr.Validation("missing_feed_header", "csv header must include target, channel, tone and either productId or productName and productFeatures")
+)
+
+// requiredColumns: CSVのヘッダーに必要な列（正規化後の名前）
+var requiredColumns = []string{"target", "channel", "tone"}
+
+// productColumns: 商品IDの列がない場合に必要な列（正規化後の名前）
+var productColumns = []string{"productname", "productfeatures"}
+
+// Row: フィードの1行（入力が不正な場合はErrを設定）
+type Row struct {
+	// Line: ファイル内の行番号（CSVはヘッダーを1行目とする）
+	Line  int
+	Input copy_usecase.CreateCopyInput
+	Err   error
+}
+
+// record: JSONLの1行
+type record struct {
+	ProductName     string         `json:"productName"`
+	ProductFeatures string         `json:"productFeatures"`
+	Target          string         `json:"target"`
+	Channel         entity.Channel `json:"channel"`
+	Tone            entity.Tone    `json:"tone"`
+	Locale          string         `json:"locale"`
+	BrandProfileID  *int           `json:"brandProfileId"`
+	ProductID       *int           `json:"productId"`
+	CampaignID      *int           `json:"campaignId"`
+}
+
+func (r record) input() copy_usecase.CreateCopyInput {
+	return copy_usecase.CreateCopyInput{
+		ProductName:     r.ProductName,
+		ProductFeatures: r.ProductFeatures,
+		Target:          r.Target,
+		Channel:         r.Channel,
+		Tone:            r.Tone,
+		Locale:          r.Locale,
+		BrandProfileID:  r.BrandProfileID,
+		ProductID:       r.ProductID,
+		CampaignID:      r.CampaignID,
+	}
+}
+
+// DetectFormat: 指定された形式、ファイル名の拡張子、Content-Typeの順に形式を判定
+func DetectFormat(format, filename, contentType string) (Format, error) {
+	switch strings.ToLower(format) {
+	case "csv":
+		return FormatCSV, nil
+	case "jsonl", "ndjson":
+		return FormatJSONL, nil
+	case "":
+	default:
+		return "", ErrUnknownFormat
+	}
+
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv":
+		return FormatCSV, nil
+	case ".jsonl", ".ndjson":
+		return FormatJSONL, nil
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch mediaType {
+	case "text/csv":
+		return FormatCSV, nil
+	case "application/jsonl", "application/x-ndjson", "application/x-jsonlines":
+		return FormatJSONL, nil
+	}
+	return "", ErrUnknownFormat
+}
+
+// Parse: フィードを読み込み、空行を除いた各行を返す
+//
+// 行ごとの不正はRow.Errに設定し、ファイル全体を読み込めない場合のみエラーを返します。
+func Parse(r io.Reader, format Format) ([]Row, error) {
+	switch format {
+	case FormatCSV:
+		return parseCSV(r)
+	case FormatJSONL:
+		return parseJSONL(r)
+	}
+	return nil, ErrUnknownFormat
+}
+
+func parseCSV(r io.Reader) ([]Row, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, ErrMissingHeader
+		}
+		return nil, err
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[normalizeColumn(name)] = i
+	}
+	for _, name := range requiredColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, ErrMissingHeader
+		}
+	}
+	if _, ok := columns["productid"]; !ok {
+		for _, name := range productColumns {
+			if _, ok := columns[name]; !ok {
+				return nil, ErrMissingHeader
+			}
+		}
+	}
+
+	var rows []Row
+	for {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		line, _ := reader.FieldPos(0)
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			rows = append(rows, Row{Line: parseErr.StartLine, Err: parseErr.Err})
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		value := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(fields) {
+				return ""
+			}
+			return strings.TrimSpace(fields[i])
+		}
+		rec := record{
+			ProductName:     value("productname"),
+			ProductFeatures: value("productfeatures"),
+			Target:          value("target"),
+			Channel:         entity.Channel(value("channel")),
+			Tone:            entity.Tone(value("tone")),
+			Locale:          value("locale"),
+		}
+		row := Row{Line: line}
+		rec.BrandProfileID, row.Err = parseID("brandProfileId", value("brandprofileid"))
+		if row.Err == nil {
+			rec.ProductID, row.Err = parseID("productId", value("productid"))
+		}
+		if row.Err == nil {
+			rec.CampaignID, row.Err = parseID("campaignId", value("campaignid"))
+		}
+		row.Input = rec.input()
+		if row.Err == nil {
+			row.Err = validate(row.Input)
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func parseJSONL(r io.Reader) ([]Row, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var rows []Row
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		var rec record
+		if err := json.Unmarshal([]byte(text), &rec); err != nil {
+			rows = append(rows, Row{Line: line, Err: err})
+			continue
+		}
+		row := Row{Line: line, Input: rec.input()}
+		row.Err = validate(row.Input)
+		rows = append(rows, row)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// normalizeColumn: product_name、Product Nameなどの表記揺れを吸収した列名
+func normalizeColumn(name string) string {
+	name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+	return strings.NewReplacer("_", "", "-", "", " ", "").Replace(name)
+}
+
+// parseID: CSVのID列の値を解釈（空の場合はnil）
+func parseID(name, value string) (*int, error) {
+	if value == "" {
+		return nil, nil
+	}
+	id, err := strconv.Atoi(value)
+	if err != nil || id < 1 {
+		return nil, fmt.Errorf("%s must be a positive integer: %q", name, value)
+	}
+	return &id, nil
+}
+
+// validate: 必須項目が揃っているかを検証（配信チャネル・トーン・商品が登録済みかは生成時に検証する）
+//
+// 商品IDを指定した行は商品名・特徴を、キャンペーンIDを指定した行はターゲットを省略できます。
+func validate(input copy_usecase.CreateCopyInput) error {
+	var missing []string
+	if input.ProductName == "" && input.ProductID == nil {
+		missing = append(missing, "productName")
+	}
+	if input.ProductFeatures == "" && input.ProductID == nil {
+		missing = append(missing, "productFeatures")
+	}
+	if input.Target == "" && input.CampaignID == nil {
+		missing = append(missing, "target")
+	}
+	if input.Channel == "" {
+		missing = append(missing, "channel")
+	}
+	if input.Tone == "" {
+		missing = append(missing, "tone")
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("required: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package feed
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	copy_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/copy"
+)
+
+func TestDetectFormat(t *testing.T) {
+	tests := []struct {
+		name        string
+		format      string
+		filename    string
+		contentType string
+		want        Format
+		wantErr     bool
+	}{
+		{name: "正常系_形式の指定を優先", format: "jsonl", filename: "products.csv", want: FormatJSONL},
+		{name: "正常系_拡張子", filename: "products.CSV", want: FormatCSV},
+		{name: "正常系_Content-Type", contentType: "application/x-ndjson; charset=utf-8", want: FormatJSONL},
+		{name: "異常系_不明な形式", format: "xml", wantErr: true},
+		{name: "異常系_判定できない", filename: "products.txt", contentType: "text/plain", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DetectFormat(tt.format, tt.filename, tt.contentType)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrUnknownFormat)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParse_CSV(t *testing.T) {
+	// 公開状態はワークフローで変更するため、is_published列は無視する
+	input := "\ufeffproduct_name,Product Features,target,channel,tone,is_published\n" +
+		"テスト商品,\"高品質, 使いやすい\",20代女性,sns,casual,true\n" +
+		"\n" +
+		"商品2,軽量,30代男性,app,pop,\n" +
+		"商品3,,30代男性,app,pop,yes\n"
+
+	rows, err := Parse(strings.NewReader(input), FormatCSV)
+
+	assert.NoError(t, err)
+	assert.Len(t, rows, 3)
+
+	assert.Equal(t, 2, rows[0].Line)
+	assert.NoError(t, rows[0].Err)
+	assert.Equal(t, copy_usecase.CreateCopyInput{
+		ProductName:     "テスト商品",
+		ProductFeatures: "高品質, 使いやすい",
+		Target:          "20代女性",
+		Channel:         entity.ChannelSNS,
+		Tone:            entity.ToneCasual,
+	}, rows[0].Input)
+
+	// 空行は読み飛ばし、行番号はファイル内の位置を指す
+	assert.Equal(t, 4, rows[1].Line)
+	assert.NoError(t, rows[1].Err)
+
+	assert.EqualError(t, rows[2].Err, "required: productFeatures")
+}
+
+func TestParse_CSVBrandProfile(t *testing.T) {
+	input := "productName,productFeatures,target,channel,tone,brand_profile_id\n" +
+		"テスト商品,高品質,20代女性,sns,casual,3\n" +
+		"商品2,軽量,30代男性,app,pop,\n" +
+		"商品3,防水,全世代,line,value,abc\n"
+
+	rows, err := Parse(strings.NewReader(input), FormatCSV)
+
+	assert.NoError(t, err)
+	assert.Len(t, rows, 3)
+	assert.NoError(t, rows[0].Err)
+	if assert.NotNil(t, rows[0].Input.BrandProfileID) {
+		assert.Equal(t, 3, *rows[0].Input.BrandProfileID)
+	}
+	assert.NoError(t, rows[1].Err)
+	assert.Nil(t, rows[1].Input.BrandProfileID)
+	assert.EqualError(t, rows[2].Err, `brandProfileId must be a positive integer: "abc"`)
+}
+
+func TestParse_CSVProduct(t *testing.T) {
+	input := "product_id,target,channel,tone\n" +
+		"7,20代女性,sns,casual\n" +
+		",30代男性,app,pop\n"
+
+	rows, err := Parse(strings.NewReader(input), FormatCSV)
+
+	assert.NoError(t, err)
+	assert.Len(t, rows, 2)
+	assert.NoError(t, rows[0].Err)
+	if assert.NotNil(t, rows[0].Input.ProductID) {
+		assert.Equal(t, 7, *rows[0].Input.ProductID)
+	}
+	// 商品IDを指定しない行は商品名・特徴が必要
+	assert.EqualError(t, rows[1].Err, "required: productName, productFeatures")
+}
+
+func TestParse_CSVMissingHeader(t *testing.T) {
+	_, err := Parse(strings.NewReader("productName,target\nテスト商品,20代\n"), FormatCSV)
+	assert.ErrorIs(t, err, ErrMissingHeader)
+
+	_, err = Parse(strings.NewReader(""), FormatCSV)
+	assert.ErrorIs(t, err, ErrMissingHeader)
+}
+
+func TestParse_JSONL(t *testing.T) {
+	input := `{"productName": "テスト商品", "productFeatures": "高品質", "target": "20代", "channel": "email", "tone": "trust", "locale": "en"}
+
+{"productName": "商品2", "target": "30代"}
+{broken`
+
+	rows, err := Parse(strings.NewReader(input), FormatJSONL)
+
+	assert.NoError(t, err)
+	assert.Len(t, rows, 3)
+
+	assert.Equal(t, 1, rows[0].Line)
+	assert.NoError(t, rows[0].Err)
+	assert.Equal(t, entity.ChannelEmail, rows[0].Input.Channel)
+	assert.Equal(t, "en", rows[0].Input.Locale)
+
+	assert.Equal(t, 3, rows[1].Line)
+	assert.EqualError(t, rows[1].Err, "required: productFeatures, channel, tone")
+
+	assert.Equal(t, 4, rows[2].Line)
+	assert.Error(t, rows[2].Err)
+}
+
+func TestReport(t *testing.T) {
+	rows := []Row{
+		{Line: 2, Input: copy_usecase.CreateCopyInput{ProductName: "商品1", Channel: entity.ChannelSNS, Tone: entity.ToneCasual}},
+		{Line: 3, Err: errors.New("required: productName")},
+		{Line: 4, Input: copy_usecase.CreateCopyInput{ProductName: "商品3", Channel: entity.ChannelApp, Tone: entity.TonePop}},
+	}
+	assert.Len(t, Inputs(rows), 2)
+
+	report := NewReport(rows, []copy_usecase.BulkResult{
+		{Copy: &entity.Copy{ID: 10}},
+		{Err: errors.New("llm error")},
+	})
+
+	assert.Equal(t, 1, report.Succeeded)
+	assert.Equal(t, 2, report.Failed)
+	assert.Equal(t, []ReportRow{
+		{Line: 2, ProductName: "商品1", Channel: entity.ChannelSNS, Tone: entity.ToneCasual, Status: StatusSucceeded, CopyID: 10},
+		{Line: 3, Status: StatusFailed, Error: "required: productName"},
+		{Line: 4, ProductName: "商品3", Channel: entity.ChannelApp, Tone: entity.TonePop, Status: StatusFailed, Error: "llm error"},
+	}, report.Rows)
+
+	var buf bytes.Buffer
+	assert.NoError(t, report.WriteCSV(&buf))
+	assert.Equal(t, "line,productName,channel,tone,status,copyId,error\n"+
+		"2,商品1,sns,casual,succeeded,10,\n"+
+		"3,,,,failed,,required: productName\n"+
+		"4,商品3,app,pop,failed,,llm error\n", buf.String())
+}
@@ -0,0 +1,112 @@
+package feed
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	copy_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/copy"
+)
+
+const (
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+)
+
+// ReportRow: 一括生成の1行分の結果
+type ReportRow struct {
+	Line        int            `json:"line"`
+	ProductName string         `json:"productName"`
+	Channel     entity.Channel `json:"channel"`
+	Tone        entity.Tone    `json:"tone"`
+	Status      string         `json:"status"`
+	CopyID      int            `json:"copyId,omitempty"`
+	Error       string         `json:"error,omitempty"`
+}
+
+// Report: 一括生成の結果
+type Report struct {
+	Succeeded int         `json:"succeeded"`
+	Failed    int         `json:"failed"`
+	Rows      []ReportRow `json:"rows"`
+}
+
+// Inputs: 不正な行を除いた生成の入力
+func Inputs(rows []Row) []copy_usecase.CreateCopyInput {
+	var inputs []copy_usecase.CreateCopyInput
+	for _, row := range rows {
+		if row.Err == nil {
+			inputs = append(inputs, row.Input)
+		}
+	}
+	return inputs
+}
+
+// NewReport: フィードの各行と生成結果を行番号順の結果にまとめる
+//
+// resultsはInputsで取り出した入力と同じ順で並んでいる必要があります。
+func NewReport(rows []Row, results []copy_usecase.BulkResult) *Report {
+	report := &Report{Rows: make([]ReportRow, 0, len(rows))}
+	next := 0
+	for _, row := range rows {
+		r := ReportRow{
+			Line:        row.Line,
+			ProductName: row.Input.ProductName,
+			Channel:     row.Input.Channel,
+			Tone:        row.Input.Tone,
+		}
+
+		err := row.Err
+		if err == nil {
+			result := results[next]
+			next++
+			if result.Err == nil {
+				r.CopyID = result.Copy.ID
+				// 商品IDのみを指定した行は、商品カタログから補った商品名を記載する
+				if r.ProductName == "" {
+					r.ProductName = result.Copy.ProductName
+				}
+			}
+			err = result.Err
+		}
+
+		if err != nil {
+			r.Status = StatusFailed
+			r.Error = err.Error()
+			report.Failed++
+		} else {
+			r.Status = StatusSucceeded
+			report.Succeeded++
+		}
+		report.Rows = append(report.Rows, r)
+	}
+	return report
+}
+
+// WriteCSV: 結果をCSVで書き出す
+func (r *Report) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"line", "productName", "channel", "tone", "status", "copyId", "error"}); err != nil {
+		return err
+	}
+	for _, row := range r.Rows {
+		copyID := ""
+		if row.CopyID != 0 {
+			copyID = strconv.Itoa(row.CopyID)
+		}
+		if err := writer.Write([]string{
+			strconv.Itoa(row.Line),
+			row.ProductName,
+			string(row.Channel),
+			string(row.Tone),
+			row.Status,
+			copyID,
+			row.Error,
+		}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
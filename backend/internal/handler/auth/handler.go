@@ -0,0 +1,132 @@
+package auth_handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/handlererr"
+	auth_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/auth"
+)
+
+type Handler interface {
+	Register(c *gin.Context)
+	Login(c *gin.Context)
+	Me(c *gin.Context)
+	CreateAPIKey(c *gin.Context)
+	ListAPIKeys(c *gin.Context)
+	RevokeAPIKey(c *gin.Context)
+}
+
+type handler struct {
+	usecase auth_usecase.UseCase
+}
+
+// RegisterRequest: 利用者の登録のリクエスト
+type RegisterRequest struct {
+	Email    string `json:"email" binding:"required"`
+	Name     string `json:"name" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+// LoginRequest: ログインのリクエスト
+type LoginRequest struct {
+	Email    string `json:"email" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+// APIKeyRequest: APIキーの発行のリクエスト
+type APIKeyRequest struct {
+	// Name: キーの用途（例: 夜間バッチ）
+	Name string `json:"name" binding:"required"`
+}
+
+func NewHandler(usecase auth_usecase.UseCase) Handler {
+	return &handler{usecase: usecase}
+}
+
+// Register: 利用者を登録し、201 Createdで利用者を返す（メールアドレスが登録済みの場合は409）
+func (h *handler) Register(c *gin.Context) {
+	var req RegisterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(handlererr.InvalidRequest(err))
+		return
+	}
+
+	user, err := h.usecase.Register(c.Request.Context(), auth_usecase.RegisterInput{
+		Email:    req.Email,
+		Name:     req.Name,
+		Password: req.Password,
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusCreated, user)
+}
+
+// Login: メールアドレスとパスワードでログインし、トークンを返す（一致しない場合は401）
+func (h *handler) Login(c *gin.Context) {
+	var req LoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(handlererr.InvalidRequest(err))
+		return
+	}
+
+	session, err := h.usecase.Login(c.Request.Context(), req.Email, req.Password)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, session)
+}
+
+func (h *handler) Me(c *gin.Context) {
+	user, err := h.usecase.Me(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, user)
+}
+
+// CreateAPIKey: APIキーを発行し、201 Createdでキーを返す（キーはこのレスポンスでのみ返す）
+func (h *handler) CreateAPIKey(c *gin.Context) {
+	var req APIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(handlererr.InvalidRequest(err))
+		return
+	}
+
+	created, err := h.usecase.CreateAPIKey(c.Request.Context(), req.Name)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusCreated, created)
+}
+
+func (h *handler) ListAPIKeys(c *gin.Context) {
+	keys, err := h.usecase.ListAPIKeys(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, keys)
+}
+
+// RevokeAPIKey: APIキーを無効にする（無効にしたキーでは認証できない）
+func (h *handler) RevokeAPIKey(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(handlererr.InvalidParameter("id"))
+		return
+	}
+
+	if err := h.usecase.RevokeAPIKey(c.Request.Context(), id); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,335 @@
+package auth_handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/auth"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/middleware"
+	auth_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/auth"
+)
+
+// ユースケースのモック（登録・ログイン・APIキーの発行と無効化のみ）
+type mockUseCase struct {
+	auth_usecase.UseCase
+	mock.Mock
+}
+
+func (m *mockUseCase) Register(ctx context.Context, input auth_usecase.RegisterInput) (*entity.User, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUseCase) Login(ctx context.Context, email, password string) (*auth_usecase.Session, error) {
+	args := m.Called(ctx, email, password)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*auth_usecase.Session), args.Error(1)
+}
+
+func (m *mockUseCase) CreateAPIKey(ctx context.Context, name string) (*auth_usecase.CreatedAPIKey, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*auth_usecase.CreatedAPIKey), args.Error(1)
+}
+
+func (m *mockUseCase) RevokeAPIKey(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func setupTestRouter(h Handler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	r.Use(middleware.ErrorHandler())
+
+	r.POST("/api/users", h.Register)
+	r.POST("/api/auth/login", h.Login)
+	r.POST("/api/users/me/api-keys", h.CreateAPIKey)
+	r.DELETE("/api/users/me/api-keys/:id", h.RevokeAPIKey)
+
+	return r
+}
+
+func TestRegister(t *testing.T) {
+	validBody := `{"email": "tanaka@example.com", "name": "田中", "password": "password123"}`
+
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantCode   string
+		setupMock  func(*mockUseCase)
+	}{
+		{
+			name:       "正常系",
+			body:       validBody,
+			wantStatus: http.StatusCreated,
+			setupMock: func(m *mockUseCase) {
+				m.On("Register", mock.Anything, auth_usecase.RegisterInput{Email: "tanaka@example.com", Name: "田中", Password: "password123"}).
+					Return(&entity.User{ID: 1, Email: "tanaka@example.com", Name: "田中", PasswordHash: "hash"}, nil)
+			},
+		},
+		{
+			name:       "異常系_パスワードなし",
+			body:       `{"email": "tanaka@example.com", "name": "田中"}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_request",
+			setupMock:  func(*mockUseCase) {},
+		},
+		{
+			name:       "異常系_認証されていない",
+			body:       validBody,
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   "authentication_required",
+			setupMock: func(m *mockUseCase) {
+				m.On("Register", mock.Anything, mock.Anything).Return(nil, auth.ErrAuthenticationRequired)
+			},
+		},
+		{
+			name:       "異常系_登録済みのメールアドレス",
+			body:       validBody,
+			wantStatus: http.StatusConflict,
+			wantCode:   "duplicated",
+			setupMock: func(m *mockUseCase) {
+				m.On("Register", mock.Anything, mock.Anything).Return(nil, repository.ErrDuplicated)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			m := new(mockUseCase)
+			tt.setupMock(m)
+			router := setupTestRouter(NewHandler(m))
+
+			// リクエストの実行
+			req := httptest.NewRequest(http.MethodPost, "/api/users", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			var body map[string]any
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			if tt.wantCode != "" {
+				assert.Equal(t, tt.wantCode, body["code"])
+			} else {
+				// パスワードのハッシュは返さない
+				assert.NotContains(t, body, "passwordHash")
+				assert.Equal(t, "tanaka@example.com", body["email"])
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func TestLogin(t *testing.T) {
+	expiresAt := time.Date(2025, 6, 16, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantCode   string
+		setupMock  func(*mockUseCase)
+	}{
+		{
+			name:       "正常系",
+			body:       `{"email": "tanaka@example.com", "password": "password123"}`,
+			wantStatus: http.StatusOK,
+			setupMock: func(m *mockUseCase) {
+				m.On("Login", mock.Anything, "tanaka@example.com", "password123").Return(&auth_usecase.Session{
+					Token:     "token",
+					ExpiresAt: expiresAt,
+					User:      &entity.User{ID: 1, Email: "tanaka@example.com"},
+				}, nil)
+			},
+		},
+		{
+			name:       "異常系_パスワードが一致しない",
+			body:       `{"email": "tanaka@example.com", "password": "wrong"}`,
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   "invalid_credentials",
+			setupMock: func(m *mockUseCase) {
+				m.On("Login", mock.Anything, mock.Anything, mock.Anything).Return(nil, auth_usecase.ErrInvalidCredentials)
+			},
+		},
+		{
+			name:       "異常系_メールアドレスなし",
+			body:       `{"password": "password123"}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_request",
+			setupMock:  func(*mockUseCase) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			m := new(mockUseCase)
+			tt.setupMock(m)
+			router := setupTestRouter(NewHandler(m))
+
+			// リクエストの実行
+			req := httptest.NewRequest(http.MethodPost, "/api/auth/login", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			var body map[string]any
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			if tt.wantCode != "" {
+				assert.Equal(t, tt.wantCode, body["code"])
+			} else {
+				assert.Equal(t, "token", body["token"])
+				assert.Equal(t, "2025-06-16T12:00:00Z", body["expiresAt"])
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateAPIKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantCode   string
+		setupMock  func(*mockUseCase)
+	}{
+		{
+			name:       "正常系",
+			body:       `{"name": "夜間バッチ"}`,
+			wantStatus: http.StatusCreated,
+			setupMock: func(m *mockUseCase) {
+				m.On("CreateAPIKey", mock.Anything, "夜間バッチ").Return(&auth_usecase.CreatedAPIKey{
+					Key:    "ascg_secret",
+					APIKey: &entity.APIKey{ID: 3, UserID: 1, Name: "夜間バッチ", Prefix: "ascg_sec", KeyHash: "hash"},
+				}, nil)
+			},
+		},
+		{
+			name:       "異常系_認証されていない",
+			body:       `{"name": "夜間バッチ"}`,
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   "authentication_required",
+			setupMock: func(m *mockUseCase) {
+				m.On("CreateAPIKey", mock.Anything, mock.Anything).Return(nil, auth.ErrAuthenticationRequired)
+			},
+		},
+		{
+			name:       "異常系_用途なし",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_request",
+			setupMock:  func(*mockUseCase) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			m := new(mockUseCase)
+			tt.setupMock(m)
+			router := setupTestRouter(NewHandler(m))
+
+			// リクエストの実行
+			req := httptest.NewRequest(http.MethodPost, "/api/users/me/api-keys", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			var body map[string]any
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			if tt.wantCode != "" {
+				assert.Equal(t, tt.wantCode, body["code"])
+			} else {
+				assert.Equal(t, "ascg_secret", body["key"])
+				// キーのハッシュは返さない
+				assert.NotContains(t, body["apiKey"], "keyHash")
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRevokeAPIKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		wantStatus int
+		wantCode   string
+		setupMock  func(*mockUseCase)
+	}{
+		{
+			name:       "正常系",
+			id:         "3",
+			wantStatus: http.StatusNoContent,
+			setupMock: func(m *mockUseCase) {
+				m.On("RevokeAPIKey", mock.Anything, 3).Return(nil)
+			},
+		},
+		{
+			name:       "異常系_存在しないキー",
+			id:         "999",
+			wantStatus: http.StatusNotFound,
+			wantCode:   "api_key_not_found",
+			setupMock: func(m *mockUseCase) {
+				m.On("RevokeAPIKey", mock.Anything, 999).Return(repository.ErrAPIKeyNotFound)
+			},
+		},
+		{
+			name:       "異常系_IDが数値でない",
+			id:         "abc",
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_parameter",
+			setupMock:  func(*mockUseCase) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			m := new(mockUseCase)
+			tt.setupMock(m)
+			router := setupTestRouter(NewHandler(m))
+
+			// リクエストの実行
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/api/users/me/api-keys/"+tt.id, nil))
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantCode != "" {
+				var problem map[string]any
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+				assert.Equal(t, tt.wantCode, problem["code"])
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
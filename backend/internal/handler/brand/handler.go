@@ -0,0 +1,128 @@
+package brand_handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/handlererr"
+	brand_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/brand"
+)
+
+type Handler interface {
+	List(c *gin.Context)
+	Get(c *gin.Context)
+	Create(c *gin.Context)
+	Update(c *gin.Context)
+	Delete(c *gin.Context)
+}
+
+type handler struct {
+	usecase brand_usecase.UseCase
+}
+
+// ProfileRequest: ブランドプロファイルの登録・更新のリクエスト
+type ProfileRequest struct {
+	Name           string        `json:"name" binding:"required"`
+	Voice          string        `json:"voice"`
+	PreferredWords []string      `json:"preferredWords"`
+	BannedWords    []string      `json:"bannedWords"`
+	Samples        []string      `json:"samples"`
+	Ending         entity.Ending `json:"ending"`
+}
+
+func NewHandler(usecase brand_usecase.UseCase) Handler {
+	return &handler{usecase: usecase}
+}
+
+// List: 登録済みのブランドプロファイルを名前順に返す
+func (h *handler) List(c *gin.Context) {
+	profiles, err := h.usecase.List(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, profiles)
+}
+
+func (h *handler) Get(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(handlererr.InvalidParameter("id"))
+		return
+	}
+
+	profile, err := h.usecase.Get(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, profile)
+}
+
+// Create: ブランドプロファイルを登録し、201 Createdでプロファイルを返す
+func (h *handler) Create(c *gin.Context) {
+	var req ProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(handlererr.InvalidRequest(err))
+		return
+	}
+
+	profile, err := h.usecase.Create(c.Request.Context(), req.input())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Header("Location", c.FullPath()+"/"+strconv.Itoa(profile.ID))
+	c.JSON(http.StatusCreated, profile)
+}
+
+func (h *handler) Update(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(handlererr.InvalidParameter("id"))
+		return
+	}
+
+	var req ProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(handlererr.InvalidRequest(err))
+		return
+	}
+
+	profile, err := h.usecase.Update(c.Request.Context(), id, req.input())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, profile)
+}
+
+// Delete: ブランドプロファイルを削除（プロファイルを指定して生成した既存のコピーは残る）
+func (h *handler) Delete(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(handlererr.InvalidParameter("id"))
+		return
+	}
+
+	if err := h.usecase.Delete(c.Request.Context(), id); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (r ProfileRequest) input() brand_usecase.Input {
+	return brand_usecase.Input{
+		Name:           r.Name,
+		Voice:          r.Voice,
+		PreferredWords: r.PreferredWords,
+		BannedWords:    r.BannedWords,
+		Samples:        r.Samples,
+		Ending:         r.Ending,
+	}
+}
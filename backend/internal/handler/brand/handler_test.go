@@ -0,0 +1,195 @@
+package brand_handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/middleware"
+	brand_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/brand"
+)
+
+// ユースケースのモック（登録・取得・削除のみ）
+type mockUseCase struct {
+	brand_usecase.UseCase
+	mock.Mock
+}
+
+func (m *mockUseCase) Get(ctx context.Context, id int) (*entity.BrandProfile, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.BrandProfile), args.Error(1)
+}
+
+func (m *mockUseCase) Create(ctx context.Context, input brand_usecase.Input) (*entity.BrandProfile, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.BrandProfile), args.Error(1)
+}
+
+func (m *mockUseCase) Delete(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func setupTestRouter(h Handler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	r.Use(middleware.ErrorHandler())
+
+	r.POST("/api/brand-profiles", h.Create)
+	r.GET("/api/brand-profiles/:id", h.Get)
+	r.DELETE("/api/brand-profiles/:id", h.Delete)
+
+	return r
+}
+
+func TestCreate(t *testing.T) {
+	validBody := `{"name": "テストブランド", "voice": "誠実な語り口", "bannedWords": ["激安"], "ending": "desu_masu"}`
+
+	tests := []struct {
+		name         string
+		body         string
+		wantStatus   int
+		wantCode     string
+		wantLocation string
+		setupMock    func(*mockUseCase)
+	}{
+		{
+			name:         "正常系",
+			body:         validBody,
+			wantStatus:   http.StatusCreated,
+			wantLocation: "/api/brand-profiles/1",
+			setupMock: func(m *mockUseCase) {
+				m.On("Create", mock.Anything, mock.MatchedBy(func(input brand_usecase.Input) bool {
+					return input.Name == "テストブランド" && input.Ending == entity.EndingPolite && len(input.BannedWords) == 1
+				})).Return(&entity.BrandProfile{ID: 1, Name: "テストブランド"}, nil)
+			},
+		},
+		{
+			name:       "異常系_名前なし",
+			body:       `{"voice": "誠実な語り口"}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_request",
+			setupMock:  func(*mockUseCase) {},
+		},
+		{
+			name:       "異常系_内容が不正",
+			body:       validBody,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_profile",
+			setupMock: func(m *mockUseCase) {
+				m.On("Create", mock.Anything, mock.Anything).Return(nil, &brand_usecase.InvalidProfileError{
+					Violations: []string{"samplesは5件以内にしてください"},
+				})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			m := new(mockUseCase)
+			tt.setupMock(m)
+			router := setupTestRouter(NewHandler(m))
+
+			// リクエストの実行
+			req := httptest.NewRequest(http.MethodPost, "/api/brand-profiles", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, tt.wantLocation, rec.Header().Get("Location"))
+			if tt.wantCode != "" {
+				var problem map[string]any
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+				assert.Equal(t, tt.wantCode, problem["code"])
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		wantStatus int
+		wantCode   string
+		setupMock  func(*mockUseCase)
+	}{
+		{
+			name:       "正常系",
+			id:         "1",
+			wantStatus: http.StatusOK,
+			setupMock: func(m *mockUseCase) {
+				m.On("Get", mock.Anything, 1).Return(&entity.BrandProfile{ID: 1, Name: "テストブランド"}, nil)
+			},
+		},
+		{
+			name:       "異常系_存在しないプロファイル",
+			id:         "999",
+			wantStatus: http.StatusNotFound,
+			wantCode:   "brand_profile_not_found",
+			setupMock: func(m *mockUseCase) {
+				m.On("Get", mock.Anything, 999).Return(nil, repository.ErrBrandProfileNotFound)
+			},
+		},
+		{
+			name:       "異常系_IDが数値でない",
+			id:         "abc",
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_parameter",
+			setupMock:  func(*mockUseCase) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			m := new(mockUseCase)
+			tt.setupMock(m)
+			router := setupTestRouter(NewHandler(m))
+
+			// リクエストの実行
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/brand-profiles/"+tt.id, nil))
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantCode != "" {
+				var problem map[string]any
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+				assert.Equal(t, tt.wantCode, problem["code"])
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	m := new(mockUseCase)
+	m.On("Delete", mock.Anything, 1).Return(nil)
+	router := setupTestRouter(NewHandler(m))
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/api/brand-profiles/1", nil))
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	m.AssertExpectations(t)
+}
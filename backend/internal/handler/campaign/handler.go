@@ -0,0 +1,202 @@
+package campaign_handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/handlererr"
+	campaign_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/campaign"
+	copy_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/copy"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/validation"
+)
+
+type Handler interface {
+	List(c *gin.Context)
+	Get(c *gin.Context)
+	Create(c *gin.Context)
+	Update(c *gin.Context)
+	Delete(c *gin.Context)
+	GenerateSet(c *gin.Context)
+	Copies(c *gin.Context)
+}
+
+type handler struct {
+	usecase campaign_usecase.UseCase
+}
+
+// CampaignRequest: キャンペーンの登録・更新のリクエスト
+type CampaignRequest struct {
+	Name   string `json:"name" binding:"required"`
+	Goal   string `json:"goal"`
+	Target string `json:"target"`
+	// StartsAt / EndsAt: キャンペーンの期間（RFC3339）
+	StartsAt time.Time `json:"startsAt" binding:"required"`
+	EndsAt   time.Time `json:"endsAt" binding:"required"`
+}
+
+// GenerateSetRequest: キャンペーンのコピーのセットを生成するリクエスト
+//
+// channelsの配信チャネルごとに、共通の内容から1件ずつコピーを生成します。
+type GenerateSetRequest struct {
+	// ProductName / ProductFeatures: 商品名・特徴（productIdを指定した場合は省略でき、商品の内容で補う）
+	ProductName     string `json:"productName" binding:"required_without=ProductID"`
+	ProductFeatures string `json:"productFeatures" binding:"required_without=ProductID"`
+	// Target: ターゲット（省略時はキャンペーンの対象者）
+	Target   string           `json:"target"`
+	Channels []entity.Channel `json:"channels" binding:"required,min=1,dive,required,channel"`
+	Tone     entity.Tone      `json:"tone" binding:"required,tone"`
+	// Locale: プロンプトテンプレートのロケール（省略時はja）
+	Locale         string `json:"locale"`
+	BrandProfileID *int   `json:"brandProfileId" binding:"omitempty,min=1"`
+	ProductID      *int   `json:"productId" binding:"omitempty,min=1"`
+}
+
+func NewHandler(usecase campaign_usecase.UseCase) Handler {
+	// リクエストのchannel・toneを検証するタグを登録
+	validation.Register()
+	return &handler{usecase: usecase}
+}
+
+// List: 登録済みのキャンペーンを開始日時の新しい順に返す
+func (h *handler) List(c *gin.Context) {
+	campaigns, err := h.usecase.List(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, campaigns)
+}
+
+func (h *handler) Get(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(handlererr.InvalidParameter("id"))
+		return
+	}
+
+	campaign, err := h.usecase.Get(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, campaign)
+}
+
+// Create: キャンペーンを登録し、201 Createdでキャンペーンを返す
+func (h *handler) Create(c *gin.Context) {
+	var req CampaignRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(handlererr.InvalidRequest(err))
+		return
+	}
+
+	campaign, err := h.usecase.Create(c.Request.Context(), req.input())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Header("Location", c.FullPath()+"/"+strconv.Itoa(campaign.ID))
+	c.JSON(http.StatusCreated, campaign)
+}
+
+func (h *handler) Update(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(handlererr.InvalidParameter("id"))
+		return
+	}
+
+	var req CampaignRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(handlererr.InvalidRequest(err))
+		return
+	}
+
+	campaign, err := h.usecase.Update(c.Request.Context(), id, req.input())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, campaign)
+}
+
+// Delete: キャンペーンを削除（キャンペーンのコピーは残る）
+func (h *handler) Delete(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(handlererr.InvalidParameter("id"))
+		return
+	}
+
+	if err := h.usecase.Delete(c.Request.Context(), id); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// GenerateSet: 配信チャネルごとにキャンペーンのコピーを生成し、201 Createdでセットを返す
+func (h *handler) GenerateSet(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(handlererr.InvalidParameter("id"))
+		return
+	}
+
+	var req GenerateSetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(handlererr.InvalidRequest(err))
+		return
+	}
+
+	set, err := h.usecase.GenerateSet(c.Request.Context(), id, req.brief(), req.Channels)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusCreated, set)
+}
+
+// Copies: キャンペーンのすべてのコピーといいね数の集計を返す
+func (h *handler) Copies(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(handlererr.InvalidParameter("id"))
+		return
+	}
+
+	copies, err := h.usecase.Copies(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, copies)
+}
+
+func (r CampaignRequest) input() campaign_usecase.Input {
+	return campaign_usecase.Input{
+		Name:     r.Name,
+		Goal:     r.Goal,
+		Target:   r.Target,
+		StartsAt: r.StartsAt,
+		EndsAt:   r.EndsAt,
+	}
+}
+
+// brief: 配信チャネル以外の共通の生成内容
+func (r GenerateSetRequest) brief() copy_usecase.CreateCopyInput {
+	return copy_usecase.CreateCopyInput{
+		ProductName:     r.ProductName,
+		ProductFeatures: r.ProductFeatures,
+		Target:          r.Target,
+		Tone:            r.Tone,
+		Locale:          r.Locale,
+		BrandProfileID:  r.BrandProfileID,
+		ProductID:       r.ProductID,
+	}
+}
@@ -0,0 +1,237 @@
+package campaign_handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/middleware"
+	campaign_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/campaign"
+	copy_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/copy"
+)
+
+// ユースケースのモック（登録・セットの生成・コピーの一覧のみ）
+type mockUseCase struct {
+	campaign_usecase.UseCase
+	mock.Mock
+}
+
+func (m *mockUseCase) Create(ctx context.Context, input campaign_usecase.Input) (*entity.Campaign, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Campaign), args.Error(1)
+}
+
+func (m *mockUseCase) GenerateSet(ctx context.Context, id int, brief copy_usecase.CreateCopyInput, channels []entity.Channel) (*copy_usecase.CampaignSet, error) {
+	args := m.Called(ctx, id, brief, channels)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*copy_usecase.CampaignSet), args.Error(1)
+}
+
+func (m *mockUseCase) Copies(ctx context.Context, id int) (*campaign_usecase.CampaignCopies, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*campaign_usecase.CampaignCopies), args.Error(1)
+}
+
+func setupTestRouter(h Handler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	r.Use(middleware.ErrorHandler())
+
+	r.POST("/api/campaigns", h.Create)
+	r.POST("/api/campaigns/:id/copies", h.GenerateSet)
+	r.GET("/api/campaigns/:id/copies", h.Copies)
+
+	return r
+}
+
+func TestCreate(t *testing.T) {
+	validBody := `{"name": "夏のセール", "goal": "新規会員の獲得", "target": "20代の会社員", "startsAt": "2026-07-01T00:00:00+09:00", "endsAt": "2026-07-31T23:59:59+09:00"}`
+
+	tests := []struct {
+		name         string
+		body         string
+		wantStatus   int
+		wantCode     string
+		wantLocation string
+		setupMock    func(*mockUseCase)
+	}{
+		{
+			name:         "正常系",
+			body:         validBody,
+			wantStatus:   http.StatusCreated,
+			wantLocation: "/api/campaigns/1",
+			setupMock: func(m *mockUseCase) {
+				m.On("Create", mock.Anything, mock.MatchedBy(func(input campaign_usecase.Input) bool {
+					return input.Name == "夏のセール" && input.StartsAt.Day() == 1 && input.EndsAt.Day() == 31
+				})).Return(&entity.Campaign{ID: 1, Name: "夏のセール"}, nil)
+			},
+		},
+		{
+			name:       "異常系_期間なし",
+			body:       `{"name": "夏のセール"}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_request",
+			setupMock:  func(*mockUseCase) {},
+		},
+		{
+			name:       "異常系_内容が不正",
+			body:       validBody,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_campaign_input",
+			setupMock: func(m *mockUseCase) {
+				m.On("Create", mock.Anything, mock.Anything).Return(nil, &campaign_usecase.InvalidCampaignError{
+					Violations: []string{"endsAtはstartsAt以降にしてください"},
+				})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			m := new(mockUseCase)
+			tt.setupMock(m)
+			router := setupTestRouter(NewHandler(m))
+
+			// リクエストの実行
+			req := httptest.NewRequest(http.MethodPost, "/api/campaigns", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, tt.wantLocation, rec.Header().Get("Location"))
+			if tt.wantCode != "" {
+				var problem map[string]any
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+				assert.Equal(t, tt.wantCode, problem["code"])
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGenerateSet(t *testing.T) {
+	validBody := `{"productName": "テスト商品", "productFeatures": "軽量、防水", "channels": ["sns", "email"], "tone": "trust"}`
+
+	tests := []struct {
+		name       string
+		id         string
+		body       string
+		wantStatus int
+		wantCode   string
+		setupMock  func(*mockUseCase)
+	}{
+		{
+			name:       "正常系",
+			id:         "3",
+			body:       validBody,
+			wantStatus: http.StatusCreated,
+			setupMock: func(m *mockUseCase) {
+				m.On("GenerateSet", mock.Anything, 3, mock.MatchedBy(func(brief copy_usecase.CreateCopyInput) bool {
+					return brief.ProductName == "テスト商品" && brief.Tone == entity.ToneTrust && brief.Target == ""
+				}), []entity.Channel{entity.ChannelSNS, entity.ChannelEmail}).Return(&copy_usecase.CampaignSet{
+					CampaignID: 3,
+					Copies:     []*entity.Copy{{ID: 1}, {ID: 2}},
+				}, nil)
+			},
+		},
+		{
+			name:       "異常系_チャネルなし",
+			id:         "3",
+			body:       `{"productName": "テスト商品", "productFeatures": "軽量、防水", "channels": [], "tone": "trust"}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_request",
+			setupMock:  func(*mockUseCase) {},
+		},
+		{
+			name:       "異常系_存在しないキャンペーン",
+			id:         "999",
+			body:       validBody,
+			wantStatus: http.StatusNotFound,
+			wantCode:   "campaign_not_found",
+			setupMock: func(m *mockUseCase) {
+				m.On("GenerateSet", mock.Anything, 999, mock.Anything, mock.Anything).Return(nil, repository.ErrCampaignNotFound)
+			},
+		},
+		{
+			name:       "異常系_チャネルの重複",
+			id:         "3",
+			body:       validBody,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_campaign_channels",
+			setupMock: func(m *mockUseCase) {
+				m.On("GenerateSet", mock.Anything, 3, mock.Anything, mock.Anything).Return(nil, copy_usecase.ErrInvalidCampaignChannels)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			m := new(mockUseCase)
+			tt.setupMock(m)
+			router := setupTestRouter(NewHandler(m))
+
+			// リクエストの実行
+			req := httptest.NewRequest(http.MethodPost, "/api/campaigns/"+tt.id+"/copies", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantCode != "" {
+				var problem map[string]any
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+				assert.Equal(t, tt.wantCode, problem["code"])
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCopies(t *testing.T) {
+	m := new(mockUseCase)
+	m.On("Copies", mock.Anything, 3).Return(&campaign_usecase.CampaignCopies{
+		Campaign: &entity.Campaign{ID: 3, Name: "夏のセール"},
+		Copies:   []*entity.Copy{{ID: 2, Channel: entity.ChannelSNS, Likes: 5}, {ID: 1, Channel: entity.ChannelEmail, Likes: 1}},
+		Likes:    campaign_usecase.LikeSummary{Total: 6, ByChannel: map[entity.Channel]int{entity.ChannelSNS: 5, entity.ChannelEmail: 1}},
+	}, nil)
+	router := setupTestRouter(NewHandler(m))
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/campaigns/3/copies", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var got struct {
+		Copies []entity.Copy `json:"copies"`
+		Likes  struct {
+			Total     int            `json:"total"`
+			ByChannel map[string]int `json:"byChannel"`
+		} `json:"likes"`
+	}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	assert.Len(t, got.Copies, 2)
+	assert.Equal(t, 6, got.Likes.Total)
+	assert.Equal(t, 5, got.Likes.ByChannel["sns"])
+	m.AssertExpectations(t)
+}
@@ -0,0 +1,13 @@
+package copy_handler
+
+import (
+	"fmt"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/domainerr"
+)
+
+var (
+	// errEmptyFeed / errTooManyRows: 一括生成のフィードの行数が不正
+	errEmptyFeed   = domainerr.Validation("empty_feed", "feed has no rows")
+	errTooManyRows = domainerr.Validation("too_many_rows", fmt.Sprintf("feed must have at most %d rows", maxBulkRows))
+)
@@ -1,109 +1,491 @@
 package copy_handler
 
 import (
+	"encoding/json"
+	"io"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/auth"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/compliance"
 	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
 	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/feed"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/handlererr"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/llm"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/middleware"
 	copy_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/copy"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/validation"
 )
 
 type Handler interface {
 	CreateCopy(c *gin.Context)
+	CreateCopyStream(c *gin.Context)
+	CreateCopiesBulk(c *gin.Context)
 	GetCopy(c *gin.Context)
 	GetPublishedCopies(c *gin.Context)
-	UpdateLikes(c *gin.Context)
+	SearchCopies(c *gin.Context)
+	LikeCopy(c *gin.Context)
+	UnlikeCopy(c *gin.Context)
+	UpdateCopy(c *gin.Context)
+	DeleteCopy(c *gin.Context)
+	RestoreCopy(c *gin.Context)
+	ListRevisions(c *gin.Context)
+	DiffRevisions(c *gin.Context)
+	RollbackCopy(c *gin.Context)
+	SubmitForReview(c *gin.Context)
+	AssignReviewer(c *gin.Context)
+	ApproveCopy(c *gin.Context)
+	RejectCopy(c *gin.Context)
+	PublishCopy(c *gin.Context)
+	ArchiveCopy(c *gin.Context)
+	ReopenCopy(c *gin.Context)
+	ListInReview(c *gin.Context)
+	ScheduleCopy(c *gin.Context)
+	GetGeneration(c *gin.Context)
+	SelectVariant(c *gin.Context)
+	CheckCopyCompliance(c *gin.Context)
+	CheckCompliance(c *gin.Context)
 }
 
+const (
+	// maxBulkRows / maxBulkBytes: 一括生成で受け付ける行数とファイルサイズの上限
+	maxBulkRows  = 1000
+	maxBulkBytes = 10 << 20
+)
+
 type handler struct {
 	usecase copy_usecase.UseCase
 }
 
 type CreateCopyRequest struct {
-	ProductName     string         `json:"productName" binding:"required"`
-	ProductFeatures string         `json:"productFeatures" binding:"required"`
-	Target          string         `json:"target" binding:"required"`
-	Channel         entity.Channel `json:"channel" binding:"required"`
-	Tone            entity.Tone    `json:"tone" binding:"required"`
-	IsPublished     bool           `json:"isPublished"`
+	// ProductName / ProductFeatures: 商品名・特徴（productIdを指定した場合は省略でき、商品の内容で補う）
+	ProductName     string `json:"productName" binding:"required_without=ProductID"`
+	ProductFeatures string `json:"productFeatures" binding:"required_without=ProductID"`
+	// Target: ターゲット（campaignIdを指定した場合は省略でき、キャンペーンの対象者で補う）
+	Target  string         `json:"target" binding:"required_without=CampaignID"`
+	Channel entity.Channel `json:"channel" binding:"required,channel"`
+	Tone    entity.Tone    `json:"tone" binding:"required,tone"`
+	// Variants: 生成する候補数（省略時は1件のみ生成し、従来通りコピーを返す）
+	Variants int `json:"variants" binding:"omitempty,min=1,max=10"`
+	// Locale: プロンプトテンプレートのロケール（省略時はja）
+	Locale string `json:"locale"`
+	// BrandProfileID: 適用するブランドプロファイルのID（省略時はブランドの指定なし）
+	BrandProfileID *int `json:"brandProfileId" binding:"omitempty,min=1"`
+	// ProductID: 商品カタログの商品のID（省略時は商品名・特徴の入力が必要）
+	ProductID *int `json:"productId" binding:"omitempty,min=1"`
+	// CampaignID: コピーが属するキャンペーンのID（省略時はキャンペーンに属さない）
+	CampaignID *int `json:"campaignId" binding:"omitempty,min=1"`
+}
+
+// ListCopiesRequest: 公開済みのコピーの一覧のクエリパラメータ
+type ListCopiesRequest struct {
+	Channel     entity.Channel `form:"channel" binding:"channel"`
+	Tone        entity.Tone    `form:"tone" binding:"tone"`
+	Target      string         `form:"target"`
+	ProductName string         `form:"productName"`
+	// CreatedFrom / CreatedTo: 作成日時の範囲（RFC3339またはYYYY-MM-DD、日付のみの場合は両端を含む）
+	CreatedFrom string              `form:"createdFrom"`
+	CreatedTo   string              `form:"createdTo"`
+	Sort        repository.CopySort `form:"sort"`
+	Cursor      string              `form:"cursor"`
+	Limit       int                 `form:"limit"`
+}
+
+// UpdateCopyRequest: 編集できる項目のみを受け付ける（省略した項目は変更しない）
+//
+// 状態（公開など）はワークフローのエンドポイントでのみ変更できます。
+type UpdateCopyRequest struct {
+	Title       *string `json:"title"`
+	Description *string `json:"description"`
+}
+
+type SelectVariantRequest struct {
+	CopyID int `json:"copyId" binding:"required"`
+}
+
+// CheckComplianceRequest: 任意のテキストの表現をチェックするリクエスト
+type CheckComplianceRequest struct {
+	Texts []ComplianceText `json:"texts" binding:"required,min=1,dive"`
 }
 
-func NewHandler(repo repository.CopyRepository) Handler {
+type ComplianceText struct {
+	Field string `json:"field" binding:"required"`
+	Value string `json:"value"`
+}
+
+func (r CreateCopyRequest) toInput() copy_usecase.CreateCopyInput {
+	return copy_usecase.CreateCopyInput{
+		ProductName:     r.ProductName,
+		ProductFeatures: r.ProductFeatures,
+		Target:          r.Target,
+		Channel:         r.Channel,
+		Tone:            r.Tone,
+		Variants:        r.Variants,
+		Locale:          r.Locale,
+		BrandProfileID:  r.BrandProfileID,
+		ProductID:       r.ProductID,
+		CampaignID:      r.CampaignID,
+	}
+}
+
+func NewHandler(repo repository.CopyRepository, generator llm.Generator, opts ...copy_usecase.Option) Handler {
+	return NewHandlerWithUseCase(copy_usecase.NewUseCase(repo, generator, opts...))
+}
+
+// NewHandlerWithUseCase: 作成済みのユースケースを使うハンドラーを作成（生成ジョブなどとユースケースを共有する場合）
+func NewHandlerWithUseCase(usecase copy_usecase.UseCase) Handler {
+	// リクエストのchannel・toneを検証するタグを登録
+	validation.Register()
 	return &handler{
-		usecase: copy_usecase.NewUseCase(repo),
+		usecase: usecase,
 	}
 }
 
 func (h *handler) CreateCopy(c *gin.Context) {
 	var req CreateCopyRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		_ = c.Error(handlererr.InvalidRequest(err))
 		return
 	}
 
-	input := copy_usecase.CreateCopyInput{
-		ProductName:     req.ProductName,
-		ProductFeatures: req.ProductFeatures,
-		Target:          req.Target,
-		Channel:         req.Channel,
-		Tone:            req.Tone,
-		IsPublished:     req.IsPublished,
+	input := req.toInput()
+
+	// 複数候補の場合はスコア順の候補一覧を返す
+	if req.Variants > 1 {
+		generation, err := h.usecase.CreateVariants(c.Request.Context(), input)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusCreated, generation)
+		return
 	}
 
 	copy, err := h.usecase.CreateCopy(c.Request.Context(), input)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		_ = c.Error(err)
 		return
 	}
 
 	c.JSON(http.StatusCreated, copy)
 }
 
+// CreateCopyStream: 生成途中のタイトル・本文をServer-Sent Eventsで配信
+//
+// イベント:
+//   - title / description: {"delta": "..."} 生成途中の差分
+//   - retry: {"delta": "..."} 出力が不正なため再生成する（deltaは問題点）
+//   - copy: 保存したコピー
+//   - error: 生成中に発生したエラー（RFC 7807のエラーレスポンスと同じ形式）
+func (h *handler) CreateCopyStream(c *gin.Context) {
+	var req CreateCopyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(handlererr.InvalidRequest(err))
+		return
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	// クライアントが切断するとリクエストのコンテキストがキャンセルされ、LLMの呼び出しも中断される
+	ctx := c.Request.Context()
+	input := req.toInput()
+	input.Variants = 0
+
+	copy, err := h.usecase.CreateCopyStream(ctx, input, func(event copy_usecase.StreamEvent) error {
+		c.SSEvent(event.Field, gin.H{"delta": event.Delta})
+		c.Writer.Flush()
+		return ctx.Err()
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		c.SSEvent("error", middleware.NewProblem(err, c.Request.URL.Path))
+		c.Writer.Flush()
+		return
+	}
+
+	c.SSEvent("copy", copy)
+	c.Writer.Flush()
+}
+
+// CreateCopiesBulk: CSV / JSONLの商品フィードから一括でコピーを生成し、行ごとの結果を返す
+//
+// フィードはmultipartのfileまたはリクエストボディで受け取ります。
+// 形式はformatパラメータ、ファイル名の拡張子、Content-Typeの順に判定します。
+// Accept: text/csvまたはreport=csvの場合は、結果をCSVファイルとして返します。
+func (h *handler) CreateCopiesBulk(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxBulkBytes)
+
+	var (
+		body        io.Reader = c.Request.Body
+		filename    string
+		contentType = c.ContentType()
+	)
+	if contentType == gin.MIMEMultipartPOSTForm {
+		fileHeader, err := c.FormFile("file")
+		if err != nil {
+			_ = c.Error(handlererr.InvalidRequest(err))
+			return
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			_ = c.Error(handlererr.InvalidRequest(err))
+			return
+		}
+		defer file.Close()
+		body, filename, contentType = file, fileHeader.Filename, fileHeader.Header.Get("Content-Type")
+	}
+
+	format, err := feed.DetectFormat(c.Query("format"), filename, contentType)
+	if err != nil {
+		_ = c.Error(handlererr.InvalidRequest(err))
+		return
+	}
+	rows, err := feed.Parse(body, format)
+	if err != nil {
+		_ = c.Error(handlererr.InvalidRequest(err))
+		return
+	}
+	if len(rows) == 0 {
+		_ = c.Error(errEmptyFeed)
+		return
+	}
+	if len(rows) > maxBulkRows {
+		_ = c.Error(errTooManyRows)
+		return
+	}
+
+	results := h.usecase.CreateBulk(c.Request.Context(), feed.Inputs(rows))
+	report := feed.NewReport(rows, results)
+
+	if c.Query("report") == "csv" || c.NegotiateFormat(gin.MIMEJSON, "text/csv") == "text/csv" {
+		c.Header("Content-Type", "text/csv; charset=utf-8")
+		c.Header("Content-Disposition", `attachment; filename="copy-report.csv"`)
+		c.Status(http.StatusOK)
+		if err := report.WriteCSV(c.Writer); err != nil {
+			_ = c.Error(err)
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// GetCopy: コピーを取得する（公開済みの一覧・検索に表示されないコピーは認証が必須の場合に呼び出し元が必要）
 func (h *handler) GetCopy(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id parameter"})
+		_ = c.Error(handlererr.InvalidParameter("id"))
 		return
 	}
 
 	copy, err := h.usecase.GetCopy(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		_ = c.Error(err)
 		return
 	}
+	// 公開日時前・公開終了日時後のコピーは公開済みの一覧・検索と同様に認証した利用者のみ閲覧できる
+	if !h.usecase.Visible(copy) {
+		if err := auth.RequireIdentity(c.Request.Context()); err != nil {
+			_ = c.Error(err)
+			return
+		}
+	}
 
 	c.JSON(http.StatusOK, copy)
 }
 
+// GetPublishedCopies: 公開済みのコピーの一覧をページ単位で取得
+//
+// 次のページはレスポンスのnextCursorをcursorに指定して取得します。
 func (h *handler) GetPublishedCopies(c *gin.Context) {
-	copies, err := h.usecase.GetPublishedCopies(c.Request.Context())
+	var req ListCopiesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		_ = c.Error(handlererr.InvalidRequest(err))
+		return
+	}
+	createdFrom, err := parseDate(req.CreatedFrom, false)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+		_ = c.Error(handlererr.InvalidParameter("createdFrom"))
+		return
+	}
+	createdTo, err := parseDate(req.CreatedTo, true)
+	if err != nil {
+		_ = c.Error(handlererr.InvalidParameter("createdTo"))
 		return
 	}
 
-	c.JSON(http.StatusOK, copies)
+	page, err := h.usecase.GetPublishedCopies(c.Request.Context(), copy_usecase.ListCopiesInput{
+		Filter: repository.CopyFilter{
+			Channel:     req.Channel,
+			Tone:        req.Tone,
+			Target:      req.Target,
+			ProductName: req.ProductName,
+			CreatedFrom: createdFrom,
+			CreatedTo:   createdTo,
+		},
+		Sort:   req.Sort,
+		Cursor: req.Cursor,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
 }
 
-func (h *handler) UpdateLikes(c *gin.Context) {
+// parseDate: RFC3339の日時またはYYYY-MM-DDの日付を解析（空の場合はnil）
+//
+// 日付のみの場合、終了日（endOfDay）はその日を含むよう翌日の0時とします。
+func parseDate(s string, endOfDay bool) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return &t, nil
+	}
+	t, err := time.ParseInLocation(time.DateOnly, s, time.Local)
+	if err != nil {
+		return nil, err
+	}
+	if endOfDay {
+		t = t.AddDate(0, 0, 1)
+	}
+	return &t, nil
+}
+
+// UpdateCopy: 下書きのコピーの見出し・本文を編集
+//
+// 編集できない項目（likes・状態など）を含むリクエストは400を返します。
+// 編集した利用者（認証済みの利用者のID）を版に記録します。
+func (h *handler) UpdateCopy(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id parameter"})
+		_ = c.Error(handlererr.InvalidParameter("id"))
+		return
+	}
+
+	var req UpdateCopyRequest
+	decoder := json.NewDecoder(c.Request.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&req); err != nil {
+		_ = c.Error(handlererr.InvalidRequest(err))
 		return
 	}
 
-	copy, err := h.usecase.UpdateLikes(c.Request.Context(), id)
+	copy, err := h.usecase.UpdateCopy(c.Request.Context(), id, copy_usecase.UpdateCopyInput{
+		Title:       req.Title,
+		Description: req.Description,
+		Author:      authorFrom(c),
+	})
 	if err != nil {
-		if err.Error() == "not found" {
-			c.JSON(http.StatusNotFound, gin.H{"error": "copy not found"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, copy)
+}
+
+// DeleteCopy: コピーを論理削除
+func (h *handler) DeleteCopy(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(handlererr.InvalidParameter("id"))
+		return
+	}
+
+	if err := h.usecase.DeleteCopy(c.Request.Context(), id); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RestoreCopy: 論理削除したコピーを元に戻す
+func (h *handler) RestoreCopy(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(handlererr.InvalidParameter("id"))
+		return
+	}
+
+	copy, err := h.usecase.RestoreCopy(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, copy)
+}
+
+func (h *handler) GetGeneration(c *gin.Context) {
+	generation, err := h.usecase.GetGeneration(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generation)
+}
+
+func (h *handler) SelectVariant(c *gin.Context) {
+	var req SelectVariantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(handlererr.InvalidRequest(err))
+		return
+	}
+
+	copy, err := h.usecase.SelectVariant(c.Request.Context(), c.Param("id"), req.CopyID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, copy)
+}
+
+// CheckCopyCompliance: 保存済みのコピーを再チェックし、検出結果を含むコピーを返す
+func (h *handler) CheckCopyCompliance(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(handlererr.InvalidParameter("id"))
+		return
+	}
+
+	copy, err := h.usecase.CheckCopy(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
 		return
 	}
 
 	c.JSON(http.StatusOK, copy)
 }
+
+// CheckCompliance: 任意のテキストの表現をチェック（結果は保存しない）
+func (h *handler) CheckCompliance(c *gin.Context) {
+	var req CheckComplianceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(handlererr.InvalidRequest(err))
+		return
+	}
+
+	texts := make([]compliance.Text, len(req.Texts))
+	for i, text := range req.Texts {
+		texts[i] = compliance.Text{Field: text.Field, Value: text.Value}
+	}
+
+	c.JSON(http.StatusOK, gin.H{"findings": h.usecase.CheckTexts(c.Request.Context(), texts)})
+}
@@ -5,17 +5,26 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/sashabaranov/go-openai"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/auth"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/compliance"
 	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/feed"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/llm"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/middleware"
 	copy_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/copy"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/validation"
 )
 
 // モックリポジトリの定義
@@ -28,6 +37,21 @@ func (m *mockCopyRepository) Create(ctx context.Context, copy *entity.Copy) erro
 	return args.Error(0)
 }
 
+func (m *mockCopyRepository) CreateBatch(ctx context.Context, copies []*entity.Copy) error {
+	args := m.Called(ctx, copies)
+	return args.Error(0)
+}
+
+func (m *mockCopyRepository) CreateWithRevision(ctx context.Context, copy *entity.Copy, revision *entity.CopyRevision) error {
+	args := m.Called(ctx, copy, revision)
+	return args.Error(0)
+}
+
+func (m *mockCopyRepository) CreateBatchWithRevisions(ctx context.Context, copies []*entity.Copy, revisions []*entity.CopyRevision) error {
+	args := m.Called(ctx, copies, revisions)
+	return args.Error(0)
+}
+
 func (m *mockCopyRepository) Get(ctx context.Context, id int) (*entity.Copy, error) {
 	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
@@ -36,83 +60,221 @@ func (m *mockCopyRepository) Get(ctx context.Context, id int) (*entity.Copy, err
 	return args.Get(0).(*entity.Copy), args.Error(1)
 }
 
-func (m *mockCopyRepository) GetPublished(ctx context.Context) ([]*entity.Copy, error) {
-	args := m.Called(ctx)
+func (m *mockCopyRepository) GetByIDs(ctx context.Context, ids []int) ([]*entity.Copy, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Copy), args.Error(1)
+}
+
+func (m *mockCopyRepository) ListPublished(ctx context.Context, query repository.CopyListQuery) ([]*entity.Copy, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Copy), args.Error(1)
+}
+
+func (m *mockCopyRepository) Like(ctx context.Context, id int, voter string) error {
+	args := m.Called(ctx, id, voter)
+	return args.Error(0)
+}
+
+func (m *mockCopyRepository) Unlike(ctx context.Context, id int, voter string) error {
+	args := m.Called(ctx, id, voter)
+	return args.Error(0)
+}
+
+func (m *mockCopyRepository) GetByGenerationID(ctx context.Context, generationID string) ([]*entity.Copy, error) {
+	args := m.Called(ctx, generationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Copy), args.Error(1)
+}
+
+func (m *mockCopyRepository) ListByProduct(ctx context.Context, productID int) ([]*entity.Copy, error) {
+	args := m.Called(ctx, productID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Copy), args.Error(1)
+}
+
+func (m *mockCopyRepository) ListByCampaign(ctx context.Context, campaignID int) ([]*entity.Copy, error) {
+	args := m.Called(ctx, campaignID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Copy), args.Error(1)
+}
+
+func (m *mockCopyRepository) ListInReview(ctx context.Context, reviewer string) ([]*entity.Copy, error) {
+	args := m.Called(ctx, reviewer)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Copy), args.Error(1)
+}
+
+func (m *mockCopyRepository) ListDueToPublish(ctx context.Context, now time.Time) ([]*entity.Copy, error) {
+	args := m.Called(ctx, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Copy), args.Error(1)
+}
+
+func (m *mockCopyRepository) ListDueToExpire(ctx context.Context, now time.Time) ([]*entity.Copy, error) {
+	args := m.Called(ctx, now)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]*entity.Copy), args.Error(1)
 }
 
-func (m *mockCopyRepository) UpdateLikes(ctx context.Context, id int, likes int) error {
-	args := m.Called(ctx, id, likes)
+func (m *mockCopyRepository) SelectVariant(ctx context.Context, generationID string, id int) error {
+	args := m.Called(ctx, generationID, id)
+	return args.Error(0)
+}
+
+func (m *mockCopyRepository) UpdateFindings(ctx context.Context, id int, findings []entity.ComplianceFinding) error {
+	args := m.Called(ctx, id, findings)
+	return args.Error(0)
+}
+
+func (m *mockCopyRepository) Update(ctx context.Context, copy *entity.Copy, fields []string) error {
+	args := m.Called(ctx, copy, fields)
+	return args.Error(0)
+}
+
+func (m *mockCopyRepository) UpdateWithRevision(ctx context.Context, copy *entity.Copy, fields []string, revision *entity.CopyRevision) error {
+	args := m.Called(ctx, copy, fields, revision)
+	return args.Error(0)
+}
+
+func (m *mockCopyRepository) UpdateStatus(ctx context.Context, copy *entity.Copy, guard repository.CopyStatusGuard, fields []string, revision *entity.CopyRevision) error {
+	args := m.Called(ctx, copy, guard, fields, revision)
+	return args.Error(0)
+}
+
+func (m *mockCopyRepository) Delete(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *mockCopyRepository) Restore(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
 	return args.Error(0)
 }
 
-// OpenAIクライアントのモック
-type mockOpenAIClient struct {
+// 版のリポジトリのモック
+type mockRevisionRepository struct {
 	mock.Mock
 }
 
-func (m *mockOpenAIClient) CreateChatCompletion(ctx context.Context, req openai.ChatCompletionRequest) (openai.ChatCompletionResponse, error) {
-	args := m.Called(ctx, req)
-	return args.Get(0).(openai.ChatCompletionResponse), args.Error(1)
+func (m *mockRevisionRepository) Create(ctx context.Context, revision *entity.CopyRevision) error {
+	args := m.Called(ctx, revision)
+	return args.Error(0)
+}
+
+func (m *mockRevisionRepository) List(ctx context.Context, copyID int) ([]*entity.CopyRevision, error) {
+	args := m.Called(ctx, copyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.CopyRevision), args.Error(1)
+}
+
+func (m *mockRevisionRepository) Get(ctx context.Context, copyID, revision int) (*entity.CopyRevision, error) {
+	args := m.Called(ctx, copyID, revision)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.CopyRevision), args.Error(1)
+}
+
+// 検索の索引のモック
+type mockSearchIndex struct {
+	mock.Mock
+}
+
+func (m *mockSearchIndex) Index(ctx context.Context, copy *entity.Copy) error {
+	args := m.Called(ctx, copy)
+	return args.Error(0)
 }
 
-// OpenAIレスポンスの定義
-type openAIResponse struct {
-	Title       string `json:"title"`
-	Description string `json:"description"`
+func (m *mockSearchIndex) Remove(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *mockSearchIndex) Search(ctx context.Context, query repository.CopySearchQuery) ([]repository.CopySearchHit, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]repository.CopySearchHit), args.Error(1)
+}
+
+// Generatorのモック
+type mockGenerator struct {
+	mock.Mock
+}
+
+func (m *mockGenerator) Generate(ctx context.Context, req llm.Request) (*llm.Response, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*llm.Response), args.Error(1)
 }
 
+// testNow: 公開日時・公開終了日時の判定に使う現在時刻
+var testNow = time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+
 // モックユースケースの定義
 type mockUseCase struct {
-	repo         *mockCopyRepository
-	openaiClient *mockOpenAIClient
+	repo      *mockCopyRepository
+	generator *mockGenerator
 }
 
 func (u *mockUseCase) CreateCopy(ctx context.Context, input copy_usecase.CreateCopyInput) (*entity.Copy, error) {
 	// プロンプトの生成
 	prompt := generatePrompt(input)
 
-	// OpenAI APIの呼び出し
-	resp, err := u.openaiClient.CreateChatCompletion(
-		ctx,
-		openai.ChatCompletionRequest{
-			Model: openai.GPT3Dot5Turbo,
-			Messages: []openai.ChatCompletionMessage{
-				{
-					Role:    openai.ChatMessageRoleUser,
-					Content: prompt,
-				},
+	// LLMプロバイダーの呼び出し
+	resp, err := u.generator.Generate(ctx, llm.Request{
+		Messages: []llm.Message{
+			{
+				Role:    llm.RoleUser,
+				Content: prompt,
 			},
 		},
-	)
+		Brief: llm.Brief{
+			ProductName:     input.ProductName,
+			ProductFeatures: input.ProductFeatures,
+			Target:          input.Target,
+			Channel:         string(input.Channel),
+			Tone:            string(input.Tone),
+		},
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if len(resp.Choices) == 0 {
-		return nil, errors.New("no response from OpenAI")
-	}
-
-	// レスポンスの解析
-	var aiResp openAIResponse
-	if err := json.Unmarshal([]byte(resp.Choices[0].Message.Content), &aiResp); err != nil {
-		return nil, err
-	}
-
 	// エンティティの作成
 	copy := &entity.Copy{
-		Title:           aiResp.Title,
-		Description:     aiResp.Description,
+		Title:           resp.Title,
+		Description:     resp.Description,
 		ProductName:     input.ProductName,
 		ProductFeatures: input.ProductFeatures,
 		Target:          input.Target,
 		Channel:         input.Channel,
 		Tone:            input.Tone,
 		Likes:           0,
-		IsPublished:     input.IsPublished,
+		Status:          entity.CopyStatusDraft,
 	}
 
 	// リポジトリへの保存
@@ -123,26 +285,128 @@ func (u *mockUseCase) CreateCopy(ctx context.Context, input copy_usecase.CreateC
 	return copy, nil
 }
 
+func (u *mockUseCase) CreateVariants(ctx context.Context, input copy_usecase.CreateCopyInput) (*copy_usecase.Generation, error) {
+	return copy_usecase.NewUseCase(u.repo, u.generator).CreateVariants(ctx, input)
+}
+
+func (u *mockUseCase) CreateBulk(ctx context.Context, inputs []copy_usecase.CreateCopyInput) []copy_usecase.BulkResult {
+	return copy_usecase.NewUseCase(u.repo, u.generator).CreateBulk(ctx, inputs)
+}
+
+func (u *mockUseCase) CreateCopyStream(ctx context.Context, input copy_usecase.CreateCopyInput, onEvent func(copy_usecase.StreamEvent) error) (*entity.Copy, error) {
+	return copy_usecase.NewUseCase(u.repo, u.generator).CreateCopyStream(ctx, input, onEvent)
+}
+
+func (u *mockUseCase) CreateCampaignSet(ctx context.Context, input copy_usecase.CreateCopyInput, channels []entity.Channel) (*copy_usecase.CampaignSet, error) {
+	return copy_usecase.NewUseCase(u.repo, u.generator).CreateCampaignSet(ctx, input, channels)
+}
+
+func (u *mockUseCase) GetGeneration(ctx context.Context, generationID string) (*copy_usecase.Generation, error) {
+	return copy_usecase.NewUseCase(u.repo, u.generator).GetGeneration(ctx, generationID)
+}
+
+func (u *mockUseCase) SelectVariant(ctx context.Context, generationID string, id int) (*entity.Copy, error) {
+	return copy_usecase.NewUseCase(u.repo, u.generator).SelectVariant(ctx, generationID, id)
+}
+
 func (u *mockUseCase) GetCopy(ctx context.Context, id int) (*entity.Copy, error) {
 	return u.repo.Get(ctx, id)
 }
 
-func (u *mockUseCase) GetPublishedCopies(ctx context.Context) ([]*entity.Copy, error) {
-	return u.repo.GetPublished(ctx)
+func (u *mockUseCase) Visible(copy *entity.Copy) bool {
+	return copy.Visible(testNow)
 }
 
-func (u *mockUseCase) UpdateLikes(ctx context.Context, id int) (*entity.Copy, error) {
-	copy, err := u.repo.Get(ctx, id)
-	if err != nil {
-		return nil, err
-	}
+func (u *mockUseCase) GetPublishedCopies(ctx context.Context, input copy_usecase.ListCopiesInput) (*copy_usecase.CopyPage, error) {
+	return copy_usecase.NewUseCase(u.repo, u.generator).GetPublishedCopies(ctx, input)
+}
 
-	copy.Likes++
-	if err := u.repo.UpdateLikes(ctx, id, copy.Likes); err != nil {
-		return nil, err
-	}
+func (u *mockUseCase) CheckCopy(ctx context.Context, id int) (*entity.Copy, error) {
+	return copy_usecase.NewUseCase(u.repo, u.generator).CheckCopy(ctx, id)
+}
 
-	return copy, nil
+func (u *mockUseCase) CheckTexts(ctx context.Context, texts []compliance.Text) []entity.ComplianceFinding {
+	return copy_usecase.NewUseCase(u.repo, u.generator).CheckTexts(ctx, texts)
+}
+
+func (u *mockUseCase) UpdateCopy(ctx context.Context, id int, input copy_usecase.UpdateCopyInput) (*entity.Copy, error) {
+	return copy_usecase.NewUseCase(u.repo, u.generator).UpdateCopy(ctx, id, input)
+}
+
+func (u *mockUseCase) DeleteCopy(ctx context.Context, id int) error {
+	return copy_usecase.NewUseCase(u.repo, u.generator).DeleteCopy(ctx, id)
+}
+
+func (u *mockUseCase) RestoreCopy(ctx context.Context, id int) (*entity.Copy, error) {
+	return copy_usecase.NewUseCase(u.repo, u.generator).RestoreCopy(ctx, id)
+}
+
+func (u *mockUseCase) ListRevisions(ctx context.Context, copyID int) ([]*entity.CopyRevision, error) {
+	return copy_usecase.NewUseCase(u.repo, u.generator).ListRevisions(ctx, copyID)
+}
+
+func (u *mockUseCase) DiffRevisions(ctx context.Context, copyID, from, to int) (*copy_usecase.RevisionDiff, error) {
+	return copy_usecase.NewUseCase(u.repo, u.generator).DiffRevisions(ctx, copyID, from, to)
+}
+
+func (u *mockUseCase) RollbackCopy(ctx context.Context, copyID, revision int, author string) (*entity.Copy, error) {
+	return copy_usecase.NewUseCase(u.repo, u.generator).RollbackCopy(ctx, copyID, revision, author)
+}
+
+func (u *mockUseCase) SearchCopies(ctx context.Context, input copy_usecase.SearchCopiesInput) (*copy_usecase.SearchPage, error) {
+	return copy_usecase.NewUseCase(u.repo, u.generator).SearchCopies(ctx, input)
+}
+
+func (u *mockUseCase) LikeCopy(ctx context.Context, id int, voter string) (*entity.Copy, error) {
+	return copy_usecase.NewUseCase(u.repo, u.generator).LikeCopy(ctx, id, voter)
+}
+
+func (u *mockUseCase) UnlikeCopy(ctx context.Context, id int, voter string) (*entity.Copy, error) {
+	return copy_usecase.NewUseCase(u.repo, u.generator).UnlikeCopy(ctx, id, voter)
+}
+
+func (u *mockUseCase) ValidateInput(ctx context.Context, input copy_usecase.CreateCopyInput) error {
+	return copy_usecase.NewUseCase(u.repo, u.generator).ValidateInput(ctx, input)
+}
+
+func (u *mockUseCase) SubmitForReview(ctx context.Context, id int, input copy_usecase.WorkflowInput) (*entity.Copy, error) {
+	return copy_usecase.NewUseCase(u.repo, u.generator).SubmitForReview(ctx, id, input)
+}
+
+func (u *mockUseCase) AssignReviewer(ctx context.Context, id int, input copy_usecase.WorkflowInput) (*entity.Copy, error) {
+	return copy_usecase.NewUseCase(u.repo, u.generator).AssignReviewer(ctx, id, input)
+}
+
+func (u *mockUseCase) Approve(ctx context.Context, id int, input copy_usecase.WorkflowInput) (*entity.Copy, error) {
+	return copy_usecase.NewUseCase(u.repo, u.generator).Approve(ctx, id, input)
+}
+
+func (u *mockUseCase) Reject(ctx context.Context, id int, input copy_usecase.WorkflowInput) (*entity.Copy, error) {
+	return copy_usecase.NewUseCase(u.repo, u.generator).Reject(ctx, id, input)
+}
+
+func (u *mockUseCase) Publish(ctx context.Context, id int, input copy_usecase.WorkflowInput) (*entity.Copy, error) {
+	return copy_usecase.NewUseCase(u.repo, u.generator).Publish(ctx, id, input)
+}
+
+func (u *mockUseCase) Archive(ctx context.Context, id int, input copy_usecase.WorkflowInput) (*entity.Copy, error) {
+	return copy_usecase.NewUseCase(u.repo, u.generator).Archive(ctx, id, input)
+}
+
+func (u *mockUseCase) Reopen(ctx context.Context, id int, input copy_usecase.WorkflowInput) (*entity.Copy, error) {
+	return copy_usecase.NewUseCase(u.repo, u.generator).Reopen(ctx, id, input)
+}
+
+func (u *mockUseCase) ListInReview(ctx context.Context, reviewer string) ([]*entity.Copy, error) {
+	return copy_usecase.NewUseCase(u.repo, u.generator).ListInReview(ctx, reviewer)
+}
+
+func (u *mockUseCase) Schedule(ctx context.Context, id int, input copy_usecase.ScheduleInput) (*entity.Copy, error) {
+	return copy_usecase.NewUseCase(u.repo, u.generator).Schedule(ctx, id, input)
+}
+
+func (u *mockUseCase) RunSchedule(ctx context.Context) (*copy_usecase.ScheduleResult, error) {
+	return copy_usecase.NewUseCase(u.repo, u.generator).RunSchedule(ctx)
 }
 
 func generatePrompt(input copy_usecase.CreateCopyInput) string {
@@ -157,29 +421,59 @@ func generatePrompt(input copy_usecase.CreateCopyInput) string {
 
 func setupTestRouter(h Handler) *gin.Engine {
 	gin.SetMode(gin.TestMode)
+	// handlerを直接作成するテストがあるため、NewHandlerを経由しなくても登録する
+	validation.Register()
 	r := gin.Default()
+	r.Use(middleware.ErrorHandler())
 
 	r.POST("/api/copies", h.CreateCopy)
+	r.POST("/api/copies/stream", h.CreateCopyStream)
+	r.POST("/api/copies/bulk", h.CreateCopiesBulk)
+	r.GET("/api/copies/search", h.SearchCopies)
 	r.GET("/api/copies/:id", h.GetCopy)
 	r.GET("/api/copies/published", h.GetPublishedCopies)
-	r.PUT("/api/copies/:id/likes", h.UpdateLikes)
+	r.PATCH("/api/copies/:id", h.UpdateCopy)
+	r.DELETE("/api/copies/:id", h.DeleteCopy)
+	r.POST("/api/copies/:id/restore", h.RestoreCopy)
+	r.GET("/api/copies/:id/revisions", h.ListRevisions)
+	r.GET("/api/copies/:id/revisions/diff", h.DiffRevisions)
+	r.POST("/api/copies/:id/revisions/:revision/rollback", h.RollbackCopy)
+	r.GET("/api/copies/in-review", h.ListInReview)
+	r.POST("/api/copies/:id/review", h.SubmitForReview)
+	r.PUT("/api/copies/:id/reviewer", h.AssignReviewer)
+	r.POST("/api/copies/:id/approve", h.ApproveCopy)
+	r.POST("/api/copies/:id/reject", h.RejectCopy)
+	r.POST("/api/copies/:id/publish", h.PublishCopy)
+	r.POST("/api/copies/:id/archive", h.ArchiveCopy)
+	r.POST("/api/copies/:id/reopen", h.ReopenCopy)
+	r.PUT("/api/copies/:id/schedule", h.ScheduleCopy)
+	r.PUT("/api/copies/:id/likes", h.LikeCopy)
+	r.DELETE("/api/copies/:id/likes", h.UnlikeCopy)
+	r.GET("/api/generations/:id", h.GetGeneration)
+	r.PUT("/api/generations/:id/winner", h.SelectVariant)
+	r.POST("/api/copies/:id/compliance", h.CheckCopyCompliance)
+	r.POST("/api/compliance/check", h.CheckCompliance)
 
 	return r
 }
 
+// problem: RFC 7807のエラーレスポンスの期待値（JSONの数値はfloat64として比較する）
+func problem(status int, code, detail, instance string) gin.H {
+	return gin.H{
+		"type":     "about:blank",
+		"title":    http.StatusText(status),
+		"status":   float64(status),
+		"code":     code,
+		"detail":   detail,
+		"instance": instance,
+	}
+}
+
 func TestCreateCopy(t *testing.T) {
-	// OpenAIのモックレスポンスを準備
-	mockResponse := openai.ChatCompletionResponse{
-		Choices: []openai.ChatCompletionChoice{
-			{
-				Message: openai.ChatCompletionMessage{
-					Content: `{
-						"title": "テストタイトル",
-						"description": "テスト説明"
-					}`,
-				},
-			},
-		},
+	// Generatorのモックレスポンスを準備
+	mockResponse := &llm.Response{
+		Title:       "テストタイトル",
+		Description: "テスト説明",
 	}
 
 	tests := []struct {
@@ -187,7 +481,7 @@ func TestCreateCopy(t *testing.T) {
 		request    CreateCopyRequest
 		wantStatus int
 		wantBody   interface{}
-		setupMock  func(*mockCopyRepository, *mockOpenAIClient)
+		setupMock  func(*mockCopyRepository, *mockGenerator)
 	}{
 		{
 			name: "正常系",
@@ -197,7 +491,6 @@ func TestCreateCopy(t *testing.T) {
 				Target:          "20-30代女性",
 				Channel:         entity.ChannelSNS,
 				Tone:            entity.ToneCasual,
-				IsPublished:     true,
 			},
 			wantStatus: http.StatusCreated,
 			wantBody: &entity.Copy{
@@ -209,10 +502,10 @@ func TestCreateCopy(t *testing.T) {
 				Channel:         entity.ChannelSNS,
 				Tone:            entity.ToneCasual,
 				Likes:           0,
-				IsPublished:     true,
+				Status:          entity.CopyStatusDraft,
 			},
-			setupMock: func(mockRepo *mockCopyRepository, mockOpenAI *mockOpenAIClient) {
-				mockOpenAI.On("CreateChatCompletion", mock.Anything, mock.Anything).Return(mockResponse, nil)
+			setupMock: func(mockRepo *mockCopyRepository, mockGen *mockGenerator) {
+				mockGen.On("Generate", mock.Anything, mock.Anything).Return(mockResponse, nil)
 				mockRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
 			},
 		},
@@ -222,10 +515,21 @@ func TestCreateCopy(t *testing.T) {
 				ProductName: "",
 			},
 			wantStatus: http.StatusBadRequest,
-			wantBody: gin.H{
-				"error": "Key: 'CreateCopyRequest.ProductName' Error:Field validation for 'ProductName' failed on the 'required' tag\nKey: 'CreateCopyRequest.ProductFeatures' Error:Field validation for 'ProductFeatures' failed on the 'required' tag\nKey: 'CreateCopyRequest.Target' Error:Field validation for 'Target' failed on the 'required' tag\nKey: 'CreateCopyRequest.Channel' Error:Field validation for 'Channel' failed on the 'required' tag\nKey: 'CreateCopyRequest.Tone' Error:Field validation for 'Tone' failed on the 'required' tag",
+			wantBody:   problem(http.StatusBadRequest, "invalid_request", "Key: 'CreateCopyRequest.ProductName' Error:Field validation for 'ProductName' failed on the 'required_without' tag\nKey: 'CreateCopyRequest.ProductFeatures' Error:Field validation for 'ProductFeatures' failed on the 'required_without' tag\nKey: 'CreateCopyRequest.Target' Error:Field validation for 'Target' failed on the 'required_without' tag\nKey: 'CreateCopyRequest.Channel' Error:Field validation for 'Channel' failed on the 'required' tag\nKey: 'CreateCopyRequest.Tone' Error:Field validation for 'Tone' failed on the 'required' tag", "/api/copies"),
+			setupMock:  func(mockRepo *mockCopyRepository, mockGen *mockGenerator) {},
+		},
+		{
+			name: "異常系_形式が不正なチャネル",
+			request: CreateCopyRequest{
+				ProductName:     "テスト商品",
+				ProductFeatures: "高品質、使いやすい",
+				Target:          "20-30代女性",
+				Channel:         entity.Channel("TV!"),
+				Tone:            entity.ToneCasual,
 			},
-			setupMock: func(mockRepo *mockCopyRepository, mockOpenAI *mockOpenAIClient) {},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   problem(http.StatusBadRequest, "invalid_request", "Key: 'CreateCopyRequest.Channel' Error:Field validation for 'Channel' failed on the 'channel' tag", "/api/copies"),
+			setupMock:  func(mockRepo *mockCopyRepository, mockGen *mockGenerator) {},
 		},
 	}
 
@@ -233,14 +537,14 @@ func TestCreateCopy(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// モックの準備
 			mockRepo := new(mockCopyRepository)
-			mockOpenAI := new(mockOpenAIClient)
-			tt.setupMock(mockRepo, mockOpenAI)
+			mockGen := new(mockGenerator)
+			tt.setupMock(mockRepo, mockGen)
 
 			// ハンドラーの初期化
 			h := &handler{
 				usecase: &mockUseCase{
-					repo:         mockRepo,
-					openaiClient: mockOpenAI,
+					repo:      mockRepo,
+					generator: mockGen,
 				},
 			}
 			router := setupTestRouter(h)
@@ -294,16 +598,14 @@ func TestGetCopy(t *testing.T) {
 				Channel:         entity.ChannelSNS,
 				Tone:            entity.ToneCasual,
 				Likes:           0,
-				IsPublished:     true,
+				Status:          entity.CopyStatusPublished,
 			},
 		},
 		{
 			name:       "異常系_無効なID",
 			id:         "invalid",
 			wantStatus: http.StatusBadRequest,
-			wantBody: gin.H{
-				"error": "invalid id parameter",
-			},
+			wantBody:   problem(http.StatusBadRequest, "invalid_parameter", "invalid id parameter", "/api/copies/invalid"),
 		},
 	}
 
@@ -316,7 +618,7 @@ func TestGetCopy(t *testing.T) {
 			}
 
 			// ハンドラーの初期化
-			h := NewHandler(mockRepo)
+			h := NewHandler(mockRepo, new(mockGenerator))
 			router := setupTestRouter(h)
 
 			// リクエストの作成
@@ -346,8 +648,38 @@ func TestGetCopy(t *testing.T) {
 }
 
 func TestGetPublishedCopies(t *testing.T) {
+	testCopies := []*entity.Copy{
+		{
+			ID:              2,
+			Title:           "テストタイトル2",
+			Description:     "テスト説明2",
+			ProductName:     "テスト商品2",
+			ProductFeatures: "高品質、使いやすい",
+			Target:          "20-30代女性",
+			Channel:         entity.ChannelSNS,
+			Tone:            entity.ToneCasual,
+			Likes:           0,
+			Status:          entity.CopyStatusPublished,
+		},
+		{
+			ID:              1,
+			Title:           "テストタイトル1",
+			Description:     "テスト説明1",
+			ProductName:     "テスト商品1",
+			ProductFeatures: "高品質、使いやすい",
+			Target:          "20-30代女性",
+			Channel:         entity.ChannelSNS,
+			Tone:            entity.ToneCasual,
+			Likes:           0,
+			Status:          entity.CopyStatusPublished,
+		},
+	}
+	createdFrom := time.Date(2025, 6, 1, 0, 0, 0, 0, time.Local)
+	createdTo := time.Date(2025, 7, 1, 0, 0, 0, 0, time.Local)
+
 	tests := []struct {
 		name       string
+		query      string
 		wantStatus int
 		wantBody   interface{}
 		setupMock  func(*mockCopyRepository)
@@ -355,69 +687,59 @@ func TestGetPublishedCopies(t *testing.T) {
 		{
 			name:       "正常系",
 			wantStatus: http.StatusOK,
-			wantBody: []*entity.Copy{
-				{
-					ID:              1,
-					Title:           "テストタイトル1",
-					Description:     "テスト説明1",
-					ProductName:     "テスト商品1",
-					ProductFeatures: "高品質、使いやすい",
-					Target:          "20-30代女性",
-					Channel:         entity.ChannelSNS,
-					Tone:            entity.ToneCasual,
-					Likes:           0,
-					IsPublished:     true,
-				},
-				{
-					ID:              2,
-					Title:           "テストタイトル2",
-					Description:     "テスト説明2",
-					ProductName:     "テスト商品2",
-					ProductFeatures: "高品質、使いやすい",
-					Target:          "20-30代女性",
-					Channel:         entity.ChannelSNS,
-					Tone:            entity.ToneCasual,
-					Likes:           0,
-					IsPublished:     true,
-				},
+			wantBody:   &copy_usecase.CopyPage{Copies: testCopies},
+			setupMock: func(mockRepo *mockCopyRepository) {
+				mockRepo.On("ListPublished", mock.Anything, repository.CopyListQuery{
+					Sort:  repository.CopySortNewest,
+					Limit: copy_usecase.DefaultPageSize + 1,
+					Now:   testNow,
+				}).Return(testCopies, nil)
 			},
+		},
+		{
+			name:       "正常系_絞り込みと並び順",
+			query:      "?channel=sns&tone=casual&target=20-30代女性&productName=テスト&createdFrom=2025-06-01&createdTo=2025-06-30&sort=likes&limit=1",
+			wantStatus: http.StatusOK,
 			setupMock: func(mockRepo *mockCopyRepository) {
-				mockRepo.On("GetPublished", mock.Anything).Return([]*entity.Copy{
-					{
-						ID:              1,
-						Title:           "テストタイトル1",
-						Description:     "テスト説明1",
-						ProductName:     "テスト商品1",
-						ProductFeatures: "高品質、使いやすい",
-						Target:          "20-30代女性",
-						Channel:         entity.ChannelSNS,
-						Tone:            entity.ToneCasual,
-						Likes:           0,
-						IsPublished:     true,
-					},
-					{
-						ID:              2,
-						Title:           "テストタイトル2",
-						Description:     "テスト説明2",
-						ProductName:     "テスト商品2",
-						ProductFeatures: "高品質、使いやすい",
-						Target:          "20-30代女性",
-						Channel:         entity.ChannelSNS,
-						Tone:            entity.ToneCasual,
-						Likes:           0,
-						IsPublished:     true,
+				mockRepo.On("ListPublished", mock.Anything, repository.CopyListQuery{
+					Filter: repository.CopyFilter{
+						Channel:     entity.ChannelSNS,
+						Tone:        entity.ToneCasual,
+						Target:      "20-30代女性",
+						ProductName: "テスト",
+						CreatedFrom: &createdFrom,
+						CreatedTo:   &createdTo,
 					},
-				}, nil)
+					Sort:  repository.CopySortLikes,
+					Limit: 2,
+					Now:   testNow,
+				}).Return(testCopies, nil)
 			},
 		},
+		{
+			name:       "異常系_不正な並び順",
+			query:      "?sort=oldest",
+			wantStatus: http.StatusBadRequest,
+			setupMock:  func(mockRepo *mockCopyRepository) {},
+		},
+		{
+			name:       "異常系_不正なカーソル",
+			query:      "?cursor=invalid",
+			wantStatus: http.StatusBadRequest,
+			setupMock:  func(mockRepo *mockCopyRepository) {},
+		},
+		{
+			name:       "異常系_不正な日付",
+			query:      "?createdFrom=2025/06/01",
+			wantStatus: http.StatusBadRequest,
+			setupMock:  func(mockRepo *mockCopyRepository) {},
+		},
 		{
 			name:       "異常系_リポジトリエラー",
 			wantStatus: http.StatusInternalServerError,
-			wantBody: gin.H{
-				"error": "internal server error",
-			},
+			wantBody:   problem(http.StatusInternalServerError, "internal_error", "internal server error", "/api/copies/published"),
 			setupMock: func(mockRepo *mockCopyRepository) {
-				mockRepo.On("GetPublished", mock.Anything).Return(nil, errors.New("repository error"))
+				mockRepo.On("ListPublished", mock.Anything, mock.Anything).Return(nil, errors.New("repository error"))
 			},
 		},
 	}
@@ -429,11 +751,11 @@ func TestGetPublishedCopies(t *testing.T) {
 			tt.setupMock(mockRepo)
 
 			// ハンドラーの初期化
-			h := NewHandler(mockRepo)
+			h := NewHandler(mockRepo, new(mockGenerator), copy_usecase.WithClock(func() time.Time { return testNow }))
 			router := setupTestRouter(h)
 
 			// リクエストの作成
-			req := httptest.NewRequest(http.MethodGet, "/api/copies/published", nil)
+			req := httptest.NewRequest(http.MethodGet, "/api/copies/published"+tt.query, nil)
 			rec := httptest.NewRecorder()
 
 			// リクエストの実行
@@ -441,12 +763,16 @@ func TestGetPublishedCopies(t *testing.T) {
 
 			// アサーション
 			assert.Equal(t, tt.wantStatus, rec.Code)
+			mockRepo.AssertExpectations(t)
+			if tt.wantBody == nil {
+				return
+			}
 
 			var response interface{}
 			if tt.wantStatus == http.StatusOK {
-				var copies []*entity.Copy
-				json.Unmarshal(rec.Body.Bytes(), &copies)
-				response = copies
+				var page copy_usecase.CopyPage
+				json.Unmarshal(rec.Body.Bytes(), &page)
+				response = &page
 			} else {
 				var errorResponse gin.H
 				json.Unmarshal(rec.Body.Bytes(), &errorResponse)
@@ -458,66 +784,343 @@ func TestGetPublishedCopies(t *testing.T) {
 	}
 }
 
-func TestUpdateLikes(t *testing.T) {
+func TestGetCopy_Unpublished(t *testing.T) {
+	past := testNow.Add(-time.Hour)
+	future := testNow.Add(time.Hour)
 	tests := []struct {
-		name       string
-		id         string
-		wantStatus int
-		wantBody   interface{}
-		setupMock  func(*mockCopyRepository)
+		name         string
+		status       entity.CopyStatus
+		publishAt    *time.Time
+		expireAt     *time.Time
+		authRequired bool
+		identity     *entity.Identity
+		wantStatus   int
 	}{
 		{
-			name:       "正常系",
-			id:         "1",
+			name:         "正常系_公開済みは認証不要",
+			status:       entity.CopyStatusPublished,
+			authRequired: true,
+			wantStatus:   http.StatusOK,
+		},
+		{
+			name:         "正常系_認証済みなら下書きも取得",
+			status:       entity.CopyStatusDraft,
+			authRequired: true,
+			identity:     &entity.Identity{UserID: 1},
+			wantStatus:   http.StatusOK,
+		},
+		{
+			name:       "正常系_認証を必須にしていない",
+			status:     entity.CopyStatusInReview,
 			wantStatus: http.StatusOK,
-			wantBody: &entity.Copy{
-				ID:              1,
-				Title:           "テストタイトル",
-				Description:     "テスト説明",
-				ProductName:     "テスト商品",
-				ProductFeatures: "高品質、使いやすい",
-				Target:          "20-30代女性",
-				Channel:         entity.ChannelSNS,
-				Tone:            entity.ToneCasual,
-				Likes:           1,
-				IsPublished:     true,
-			},
-			setupMock: func(mockRepo *mockCopyRepository) {
-				initialCopy := &entity.Copy{
-					ID:              1,
-					Title:           "テストタイトル",
-					Description:     "テスト説明",
-					ProductName:     "テスト商品",
-					ProductFeatures: "高品質、使いやすい",
-					Target:          "20-30代女性",
-					Channel:         entity.ChannelSNS,
-					Tone:            entity.ToneCasual,
-					Likes:           0,
-					IsPublished:     true,
-				}
-				mockRepo.On("Get", mock.Anything, 1).Return(initialCopy, nil)
-				mockRepo.On("UpdateLikes", mock.Anything, 1, 1).Return(nil)
-			},
 		},
 		{
-			name:       "異常系_無効なID",
-			id:         "invalid",
-			wantStatus: http.StatusBadRequest,
-			wantBody: gin.H{
-				"error": "invalid id parameter",
-			},
-			setupMock: func(mockRepo *mockCopyRepository) {},
+			name:         "正常系_公開日時・公開終了日時の期間内は認証不要",
+			status:       entity.CopyStatusPublished,
+			publishAt:    &past,
+			expireAt:     &future,
+			authRequired: true,
+			wantStatus:   http.StatusOK,
 		},
 		{
-			name:       "異常系_存在しないID",
-			id:         "999",
-			wantStatus: http.StatusNotFound,
-			wantBody: gin.H{
-				"error": "copy not found",
-			},
-			setupMock: func(mockRepo *mockCopyRepository) {
-				mockRepo.On("Get", mock.Anything, 999).Return(nil, errors.New("not found"))
-			},
+			name:         "正常系_認証済みなら公開日時前も取得",
+			status:       entity.CopyStatusPublished,
+			publishAt:    &future,
+			authRequired: true,
+			identity:     &entity.Identity{UserID: 1},
+			wantStatus:   http.StatusOK,
+		},
+		{
+			name:         "異常系_認証されずに下書きを取得",
+			status:       entity.CopyStatusDraft,
+			authRequired: true,
+			wantStatus:   http.StatusUnauthorized,
+		},
+		{
+			name:         "異常系_認証されずに公開日時前のコピーを取得",
+			status:       entity.CopyStatusPublished,
+			publishAt:    &future,
+			authRequired: true,
+			wantStatus:   http.StatusUnauthorized,
+		},
+		{
+			name:         "異常系_認証されずに公開終了日時後のコピーを取得",
+			status:       entity.CopyStatusPublished,
+			expireAt:     &past,
+			authRequired: true,
+			wantStatus:   http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockRepo := new(mockCopyRepository)
+			mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{ID: 1, Title: "夏のセール", Status: tt.status, PublishAt: tt.publishAt, ExpireAt: tt.expireAt}, nil)
+
+			// ハンドラーの初期化
+			router := setupTestRouter(NewHandler(mockRepo, new(mockGenerator), copy_usecase.WithClock(func() time.Time { return testNow })))
+
+			// リクエストの作成
+			req := httptest.NewRequest(http.MethodGet, "/api/copies/1", nil)
+			if tt.authRequired {
+				req = req.WithContext(auth.WithAuthRequired(req.Context()))
+			}
+			if tt.identity != nil {
+				req = req.WithContext(auth.WithIdentity(req.Context(), tt.identity))
+			}
+			rec := httptest.NewRecorder()
+
+			// リクエストの実行
+			router.ServeHTTP(rec, req)
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			var body gin.H
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			if tt.wantStatus == http.StatusUnauthorized {
+				assert.Equal(t, problem(http.StatusUnauthorized, "authentication_required", "authentication is required", "/api/copies/1"), body)
+				return
+			}
+			assert.Equal(t, "夏のセール", body["title"])
+		})
+	}
+}
+
+func TestSearchCopies(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name         string
+		query        string
+		withIndex    bool
+		authRequired bool
+		identity     *entity.Identity
+		wantStatus   int
+		setupMock    func(*mockCopyRepository, *mockSearchIndex)
+	}{
+		{
+			name:       "正常系",
+			query:      "?q=%E5%A4%8F+%E3%82%BB%E3%83%BC%E3%83%AB&limit=10",
+			withIndex:  true,
+			wantStatus: http.StatusOK,
+			setupMock: func(mockRepo *mockCopyRepository, mockSearch *mockSearchIndex) {
+				mockSearch.On("Search", mock.Anything, repository.CopySearchQuery{
+					Terms:         []string{"夏", "セール"},
+					PublishedOnly: true,
+					Now:           now,
+					Limit:         11,
+				}).Return([]repository.CopySearchHit{{ID: 1, Score: 4}}, nil)
+				mockRepo.On("GetByIDs", mock.Anything, []int{1}).Return([]*entity.Copy{
+					{ID: 1, Title: "夏のセール", Status: entity.CopyStatusPublished},
+				}, nil)
+			},
+		},
+		{
+			name:         "正常系_未公開を含む",
+			query:        "?q=%E9%80%81%E6%96%99%E7%84%A1%E6%96%99&includeUnpublished=true",
+			withIndex:    true,
+			authRequired: true,
+			identity:     &entity.Identity{UserID: 1},
+			wantStatus:   http.StatusOK,
+			setupMock: func(mockRepo *mockCopyRepository, mockSearch *mockSearchIndex) {
+				mockSearch.On("Search", mock.Anything, repository.CopySearchQuery{
+					Terms: []string{"送料無料"},
+					Now:   now,
+					Limit: 21,
+				}).Return([]repository.CopySearchHit{{ID: 2, Score: 3}}, nil)
+				mockRepo.On("GetByIDs", mock.Anything, []int{2}).Return([]*entity.Copy{
+					{ID: 2, Title: "今だけ送料無料"},
+				}, nil)
+			},
+		},
+		{
+			name:         "正常系_認証が必須で公開済みのみ",
+			query:        "?q=%E5%A4%8F+%E3%82%BB%E3%83%BC%E3%83%AB&limit=10",
+			withIndex:    true,
+			authRequired: true,
+			wantStatus:   http.StatusOK,
+			setupMock: func(mockRepo *mockCopyRepository, mockSearch *mockSearchIndex) {
+				mockSearch.On("Search", mock.Anything, repository.CopySearchQuery{
+					Terms:         []string{"夏", "セール"},
+					PublishedOnly: true,
+					Now:           now,
+					Limit:         11,
+				}).Return([]repository.CopySearchHit{{ID: 1, Score: 4}}, nil)
+				mockRepo.On("GetByIDs", mock.Anything, []int{1}).Return([]*entity.Copy{
+					{ID: 1, Title: "夏のセール", Status: entity.CopyStatusPublished},
+				}, nil)
+			},
+		},
+		{
+			name:         "異常系_認証されずに未公開を含む",
+			query:        "?q=%E9%80%81%E6%96%99%E7%84%A1%E6%96%99&includeUnpublished=true",
+			withIndex:    true,
+			authRequired: true,
+			wantStatus:   http.StatusUnauthorized,
+			setupMock:    func(*mockCopyRepository, *mockSearchIndex) {},
+		},
+		{
+			name:       "異常系_検索語なし",
+			query:      "?q=",
+			withIndex:  true,
+			wantStatus: http.StatusBadRequest,
+			setupMock:  func(*mockCopyRepository, *mockSearchIndex) {},
+		},
+		{
+			name:       "異常系_件数の上限超過",
+			query:      "?q=test&limit=101",
+			withIndex:  true,
+			wantStatus: http.StatusBadRequest,
+			setupMock:  func(*mockCopyRepository, *mockSearchIndex) {},
+		},
+		{
+			name:       "異常系_索引が未設定",
+			query:      "?q=test",
+			wantStatus: http.StatusServiceUnavailable,
+			setupMock:  func(*mockCopyRepository, *mockSearchIndex) {},
+		},
+		{
+			name:       "異常系_検索エラー",
+			query:      "?q=test",
+			withIndex:  true,
+			wantStatus: http.StatusInternalServerError,
+			setupMock: func(mockRepo *mockCopyRepository, mockSearch *mockSearchIndex) {
+				mockSearch.On("Search", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockRepo := new(mockCopyRepository)
+			mockSearch := new(mockSearchIndex)
+			tt.setupMock(mockRepo, mockSearch)
+
+			// ハンドラーの初期化
+			opts := []copy_usecase.Option{copy_usecase.WithClock(func() time.Time { return now })}
+			if tt.withIndex {
+				opts = append(opts, copy_usecase.WithSearchIndex(mockSearch))
+			}
+			h := NewHandler(mockRepo, new(mockGenerator), opts...)
+			router := setupTestRouter(h)
+
+			// リクエストの作成（認証が必須の場合はRequireAuthが認証せずに受け付けた状態にする）
+			req := httptest.NewRequest(http.MethodGet, "/api/copies/search"+tt.query, nil)
+			if tt.authRequired {
+				req = req.WithContext(auth.WithAuthRequired(req.Context()))
+			}
+			if tt.identity != nil {
+				req = req.WithContext(auth.WithIdentity(req.Context(), tt.identity))
+			}
+			rec := httptest.NewRecorder()
+
+			// リクエストの実行
+			router.ServeHTTP(rec, req)
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				var got copy_usecase.SearchPage
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+				assert.Len(t, got.Results, 1)
+				assert.False(t, got.HasMore)
+				assert.Equal(t, "title", got.Results[0].Highlights[0].Field)
+			}
+			if tt.wantStatus == http.StatusUnauthorized {
+				var body gin.H
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+				assert.Equal(t, "authentication_required", body["code"])
+				mockSearch.AssertNotCalled(t, "Search", mock.Anything, mock.Anything)
+			}
+			mockRepo.AssertExpectations(t)
+			mockSearch.AssertExpectations(t)
+		})
+	}
+}
+
+func TestLikeCopy(t *testing.T) {
+	liked, unliked := true, false
+	testCopy := func(likes int, state *bool) *entity.Copy {
+		return &entity.Copy{
+			ID:              1,
+			Title:           "テストタイトル",
+			Description:     "テスト説明",
+			ProductName:     "テスト商品",
+			ProductFeatures: "高品質、使いやすい",
+			Target:          "20-30代女性",
+			Channel:         entity.ChannelSNS,
+			Tone:            entity.ToneCasual,
+			Likes:           likes,
+			Status:          entity.CopyStatusPublished,
+			Liked:           state,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		method     string
+		id         string
+		headers    map[string]string
+		userID     int
+		wantStatus int
+		wantBody   interface{}
+		setupMock  func(*mockCopyRepository)
+	}{
+		{
+			name:       "正常系_ログイン中の利用者",
+			method:     http.MethodPut,
+			id:         "1",
+			userID:     1,
+			wantStatus: http.StatusOK,
+			wantBody:   testCopy(1, &liked),
+			setupMock: func(mockRepo *mockCopyRepository) {
+				mockRepo.On("Like", mock.Anything, 1, "user:1").Return(nil)
+				mockRepo.On("Get", mock.Anything, 1).Return(testCopy(1, nil), nil)
+			},
+		},
+		{
+			name:       "正常系_匿名の利用者",
+			method:     http.MethodPut,
+			id:         "1",
+			headers:    map[string]string{clientHeader: "client-1"},
+			wantStatus: http.StatusOK,
+			wantBody:   testCopy(1, &liked),
+			setupMock: func(mockRepo *mockCopyRepository) {
+				mockRepo.On("Like", mock.Anything, 1, entity.AnonymousVoter("client-1")).Return(nil)
+				mockRepo.On("Get", mock.Anything, 1).Return(testCopy(1, nil), nil)
+			},
+		},
+		{
+			name:       "正常系_取り消し",
+			method:     http.MethodDelete,
+			id:         "1",
+			userID:     1,
+			wantStatus: http.StatusOK,
+			wantBody:   testCopy(0, &unliked),
+			setupMock: func(mockRepo *mockCopyRepository) {
+				mockRepo.On("Unlike", mock.Anything, 1, "user:1").Return(nil)
+				mockRepo.On("Get", mock.Anything, 1).Return(testCopy(0, nil), nil)
+			},
+		},
+		{
+			name:       "異常系_無効なID",
+			method:     http.MethodPut,
+			id:         "invalid",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   problem(http.StatusBadRequest, "invalid_parameter", "invalid id parameter", "/api/copies/invalid/likes"),
+			setupMock:  func(mockRepo *mockCopyRepository) {},
+		},
+		{
+			name:       "異常系_存在しないID",
+			method:     http.MethodPut,
+			id:         "999",
+			userID:     1,
+			wantStatus: http.StatusNotFound,
+			wantBody:   problem(http.StatusNotFound, "copy_not_found", "copy not found", "/api/copies/999/likes"),
+			setupMock: func(mockRepo *mockCopyRepository) {
+				mockRepo.On("Like", mock.Anything, 999, "user:1").Return(repository.ErrCopyNotFound)
+			},
 		},
 	}
 
@@ -528,11 +1131,17 @@ func TestUpdateLikes(t *testing.T) {
 			tt.setupMock(mockRepo)
 
 			// ハンドラーの初期化
-			h := NewHandler(mockRepo)
+			h := NewHandler(mockRepo, new(mockGenerator))
 			router := setupTestRouter(h)
 
 			// リクエストの作成
-			req := httptest.NewRequest(http.MethodPut, "/api/copies/"+tt.id+"/likes", nil)
+			req := httptest.NewRequest(tt.method, "/api/copies/"+tt.id+"/likes", nil)
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			if tt.userID != 0 {
+				req = req.WithContext(auth.WithIdentity(req.Context(), &entity.Identity{UserID: tt.userID}))
+			}
 			rec := httptest.NewRecorder()
 
 			// リクエストの実行
@@ -553,6 +1162,1307 @@ func TestUpdateLikes(t *testing.T) {
 			}
 
 			assert.Equal(t, tt.wantBody, response)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestVoterFrom(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// ヘッダーがない場合はIPアドレスとUser-Agentから匿名の投票者を作成する
+	newContext := func(userAgent string) *gin.Context {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodPut, "/", nil)
+		c.Request.Header.Set("User-Agent", userAgent)
+		return c
+	}
+	a, b := voterFrom(newContext("browser-a")), voterFrom(newContext("browser-b"))
+	assert.True(t, strings.HasPrefix(a, "anon:"))
+	assert.NotEqual(t, a, b)
+	assert.Equal(t, a, voterFrom(newContext("browser-a")))
+
+	// 認証済みの場合は利用者のIDを使用する（X-User-IDヘッダーは受け付けない）
+	c := newContext("browser-a")
+	c.Request.Header.Set("X-User-ID", "2")
+	assert.Equal(t, a, voterFrom(c))
+	c.Request = c.Request.WithContext(auth.WithIdentity(c.Request.Context(), &entity.Identity{UserID: 1}))
+	assert.Equal(t, "user:1", voterFrom(c))
+}
+
+func TestAuthorFrom(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodPost, "/", nil)
+	c.Request.Header.Set("X-Author", "suzuki")
+
+	// 認証されていない場合はヘッダーを使用せず空
+	assert.Equal(t, "", authorFrom(c))
+
+	// 認証済みの場合は利用者のID（メールアドレスは使用しない）
+	c.Request = c.Request.WithContext(auth.WithIdentity(c.Request.Context(), &entity.Identity{UserID: 1, Email: "tanaka@example.com"}))
+	assert.Equal(t, "1", authorFrom(c))
+}
+
+func TestCreateCopyVariants(t *testing.T) {
+	tests := []struct {
+		name       string
+		variants   int
+		wantStatus int
+		wantLen    int
+		setupMock  func(*mockCopyRepository, *mockGenerator)
+	}{
+		{
+			name:       "正常系",
+			variants:   3,
+			wantStatus: http.StatusCreated,
+			wantLen:    3,
+			setupMock: func(mockRepo *mockCopyRepository, mockGen *mockGenerator) {
+				mockGen.On("Generate", mock.Anything, mock.Anything).Return(&llm.Response{
+					Title:       "テストタイトル",
+					Description: "高品質で使いやすいテスト商品です。毎日の暮らしをもっと快適にする工夫が詰まっています。今ならお得なキャンペーンも実施中です。",
+					Content:     `{"hashtags": ["テスト商品"]}`,
+				}, nil)
+				mockRepo.On("CreateBatchWithRevisions", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+		},
+		{
+			name:       "異常系_候補数が上限超過",
+			variants:   11,
+			wantStatus: http.StatusBadRequest,
+			setupMock:  func(mockRepo *mockCopyRepository, mockGen *mockGenerator) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockRepo := new(mockCopyRepository)
+			mockGen := new(mockGenerator)
+			tt.setupMock(mockRepo, mockGen)
+
+			// ハンドラーの初期化
+			h := NewHandler(mockRepo, mockGen)
+			router := setupTestRouter(h)
+
+			// リクエストの作成
+			body, _ := json.Marshal(CreateCopyRequest{
+				ProductName:     "テスト商品",
+				ProductFeatures: "高品質、使いやすい",
+				Target:          "20-30代女性",
+				Channel:         entity.ChannelSNS,
+				Tone:            entity.ToneCasual,
+				Variants:        tt.variants,
+			})
+			req := httptest.NewRequest(http.MethodPost, "/api/copies", bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			// リクエストの実行
+			router.ServeHTTP(rec, req)
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusCreated {
+				var generation copy_usecase.Generation
+				json.Unmarshal(rec.Body.Bytes(), &generation)
+				assert.NotEmpty(t, generation.ID)
+				assert.Len(t, generation.Variants, tt.wantLen)
+			}
+		})
+	}
+}
+
+func TestGetGeneration(t *testing.T) {
+	tests := []struct {
+		name       string
+		wantStatus int
+		setupMock  func(*mockCopyRepository)
+	}{
+		{
+			name:       "正常系",
+			wantStatus: http.StatusOK,
+			setupMock: func(mockRepo *mockCopyRepository) {
+				mockRepo.On("GetByGenerationID", mock.Anything, "gen-1").Return([]*entity.Copy{
+					{ID: 1, Title: "候補1", GenerationID: "gen-1"},
+				}, nil)
+			},
+		},
+		{
+			name:       "異常系_存在しない生成ID",
+			wantStatus: http.StatusNotFound,
+			setupMock: func(mockRepo *mockCopyRepository) {
+				mockRepo.On("GetByGenerationID", mock.Anything, "gen-1").Return([]*entity.Copy{}, nil)
+			},
+		},
+		{
+			name:       "異常系_リポジトリエラー",
+			wantStatus: http.StatusInternalServerError,
+			setupMock: func(mockRepo *mockCopyRepository) {
+				mockRepo.On("GetByGenerationID", mock.Anything, "gen-1").Return(nil, errors.New("repository error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockRepo := new(mockCopyRepository)
+			tt.setupMock(mockRepo)
+
+			// ハンドラーの初期化
+			h := NewHandler(mockRepo, new(mockGenerator))
+			router := setupTestRouter(h)
+
+			// リクエストの作成
+			req := httptest.NewRequest(http.MethodGet, "/api/generations/gen-1", nil)
+			rec := httptest.NewRecorder()
+
+			// リクエストの実行
+			router.ServeHTTP(rec, req)
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestSelectVariant(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		setupMock  func(*mockCopyRepository)
+	}{
+		{
+			name:       "正常系",
+			body:       `{"copyId": 1}`,
+			wantStatus: http.StatusOK,
+			setupMock: func(mockRepo *mockCopyRepository) {
+				mockRepo.On("GetByGenerationID", mock.Anything, "gen-1").Return([]*entity.Copy{
+					{ID: 1, Title: "候補1", GenerationID: "gen-1"},
+				}, nil)
+				mockRepo.On("SelectVariant", mock.Anything, "gen-1", 1).Return(nil)
+			},
+		},
+		{
+			name:       "異常系_copyIdなし",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+			setupMock:  func(mockRepo *mockCopyRepository) {},
+		},
+		{
+			name:       "異常系_候補に含まれないID",
+			body:       `{"copyId": 2}`,
+			wantStatus: http.StatusNotFound,
+			setupMock: func(mockRepo *mockCopyRepository) {
+				mockRepo.On("GetByGenerationID", mock.Anything, "gen-1").Return([]*entity.Copy{
+					{ID: 1, Title: "候補1", GenerationID: "gen-1"},
+				}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockRepo := new(mockCopyRepository)
+			tt.setupMock(mockRepo)
+
+			// ハンドラーの初期化
+			h := NewHandler(mockRepo, new(mockGenerator))
+			router := setupTestRouter(h)
+
+			// リクエストの作成
+			req := httptest.NewRequest(http.MethodPut, "/api/generations/gen-1/winner", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			// リクエストの実行
+			router.ServeHTTP(rec, req)
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				var copy entity.Copy
+				json.Unmarshal(rec.Body.Bytes(), &copy)
+				assert.True(t, copy.IsSelected)
+			}
+		})
+	}
+}
+
+func TestCreateCopyStream(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantEvents []string
+		setupMock  func(*mockCopyRepository)
+	}{
+		{
+			name:       "正常系",
+			body:       `{"productName": "テスト商品", "productFeatures": "高品質", "target": "20代", "channel": "sns", "tone": "pop"}`,
+			wantStatus: http.StatusOK,
+			wantEvents: []string{"event:title", "event:description", "event:copy"},
+			setupMock: func(mockRepo *mockCopyRepository) {
+				mockRepo.On("CreateWithRevision", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+		},
+		{
+			name:       "異常系_保存エラー",
+			body:       `{"productName": "テスト商品", "productFeatures": "高品質", "target": "20代", "channel": "sns", "tone": "pop"}`,
+			wantStatus: http.StatusOK,
+			wantEvents: []string{"event:title", "event:error"},
+			setupMock: func(mockRepo *mockCopyRepository) {
+				mockRepo.On("CreateWithRevision", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("repository error"))
+			},
+		},
+		{
+			name:       "異常系_必須項目なし",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+			setupMock:  func(mockRepo *mockCopyRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockRepo := new(mockCopyRepository)
+			tt.setupMock(mockRepo)
+
+			// ハンドラーの初期化
+			h := NewHandler(mockRepo, llm.NewFakeGenerator())
+			router := setupTestRouter(h)
+
+			// リクエストの作成
+			req := httptest.NewRequest(http.MethodPost, "/api/copies/stream", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			// リクエストの実行
+			router.ServeHTTP(rec, req)
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			for _, event := range tt.wantEvents {
+				assert.Contains(t, rec.Body.String(), event)
+			}
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
+
+func TestCreateCopy_InvalidOutput(t *testing.T) {
+	// モックの準備
+	mockRepo := new(mockCopyRepository)
+	mockGen := new(mockGenerator)
+	mockGen.On("Generate", mock.Anything, mock.Anything).Return(nil, &llm.ParseError{
+		Content: "申し訳ありません",
+		Err:     llm.ErrNoJSONObject,
+	})
+
+	// ハンドラーの初期化
+	h := NewHandler(mockRepo, mockGen, copy_usecase.WithMaxAttempts(2))
+	router := setupTestRouter(h)
+
+	// リクエストの作成
+	body, _ := json.Marshal(CreateCopyRequest{
+		ProductName:     "テスト商品",
+		ProductFeatures: "高品質、使いやすい",
+		Target:          "20-30代女性",
+		Channel:         entity.ChannelSNS,
+		Tone:            entity.ToneCasual,
+	})
+	req := httptest.NewRequest(http.MethodPost, "/api/copies", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	// リクエストの実行
+	router.ServeHTTP(rec, req)
+
+	// アサーション
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Equal(t, middleware.ProblemContentType, rec.Header().Get("Content-Type"))
+	var got gin.H
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	assert.Equal(t, "invalid_llm_output", got["code"])
+	assert.Equal(t, float64(2), got["attempts"])
+	mockGen.AssertNumberOfCalls(t, "Generate", 2)
+	mockRepo.AssertNotCalled(t, "CreateWithRevision", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCheckCopyCompliance(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		wantStatus int
+		setupMock  func(*mockCopyRepository)
+	}{
+		{
+			name:       "正常系",
+			id:         "1",
+			wantStatus: http.StatusOK,
+			setupMock: func(mockRepo *mockCopyRepository) {
+				mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{ID: 1, Title: "日本一のパン"}, nil)
+				mockRepo.On("UpdateFindings", mock.Anything, 1, mock.Anything).Return(nil)
+			},
+		},
+		{
+			name:       "異常系_不正なID",
+			id:         "invalid",
+			wantStatus: http.StatusBadRequest,
+			setupMock:  func(mockRepo *mockCopyRepository) {},
+		},
+		{
+			name:       "異常系_リポジトリエラー",
+			id:         "1",
+			wantStatus: http.StatusInternalServerError,
+			setupMock: func(mockRepo *mockCopyRepository) {
+				mockRepo.On("Get", mock.Anything, 1).Return(nil, errors.New("repository error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockRepo := new(mockCopyRepository)
+			tt.setupMock(mockRepo)
+
+			// ハンドラーの初期化
+			h := NewHandler(mockRepo, new(mockGenerator))
+			router := setupTestRouter(h)
+
+			// リクエストの作成
+			req := httptest.NewRequest(http.MethodPost, "/api/copies/"+tt.id+"/compliance", nil)
+			rec := httptest.NewRecorder()
+
+			// リクエストの実行
+			router.ServeHTTP(rec, req)
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				var got entity.Copy
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+				assert.Len(t, got.Findings, 1)
+				assert.Equal(t, "superlative-best-in-japan", got.Findings[0].RuleID)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCheckCompliance(t *testing.T) {
+	tests := []struct {
+		name         string
+		body         string
+		wantStatus   int
+		wantFindings int
+	}{
+		{
+			name:         "正常系",
+			body:         `{"texts": [{"field": "title", "value": "必ず痩せる"}, {"field": "description", "value": "毎日の暮らしに"}]}`,
+			wantStatus:   http.StatusOK,
+			wantFindings: 2,
+		},
+		{
+			name:       "正常系_検出なし",
+			body:       `{"texts": [{"field": "title", "value": "毎日の暮らしに"}]}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "異常系_テキストなし",
+			body:       `{"texts": []}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "異常系_項目名なし",
+			body:       `{"texts": [{"value": "必ず痩せる"}]}`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// ハンドラーの初期化
+			h := NewHandler(new(mockCopyRepository), new(mockGenerator))
+			router := setupTestRouter(h)
+
+			// リクエストの作成
+			req := httptest.NewRequest(http.MethodPost, "/api/compliance/check", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			// リクエストの実行
+			router.ServeHTTP(rec, req)
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				var got struct {
+					Findings []entity.ComplianceFinding `json:"findings"`
+				}
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+				assert.NotNil(t, got.Findings)
+				assert.Len(t, got.Findings, tt.wantFindings)
+			}
+		})
+	}
+}
+
+func TestCreateCopiesBulk(t *testing.T) {
+	jsonl := `{"productName": "テスト商品", "productFeatures": "高品質", "target": "20代", "channel": "sns", "tone": "casual"}
+{"productName": "商品2", "target": "30代"}`
+	csvFeed := "productName,productFeatures,target,channel,tone\nテスト商品,高品質,20代,sns,casual\n"
+
+	// multipartのfileでフィードを送信するリクエスト
+	multipartRequest := func(filename, content string) (*bytes.Buffer, string) {
+		var buf bytes.Buffer
+		w := multipart.NewWriter(&buf)
+		part, _ := w.CreateFormFile("file", filename)
+		part.Write([]byte(content))
+		w.Close()
+		return &buf, w.FormDataContentType()
+	}
+
+	tests := []struct {
+		name        string
+		url         string
+		body        func() (*bytes.Buffer, string)
+		accept      string
+		wantStatus  int
+		wantReport  *feed.Report
+		wantCSV     string
+		generateErr error
+	}{
+		{
+			name: "正常系_JSONL",
+			url:  "/api/copies/bulk",
+			body: func() (*bytes.Buffer, string) {
+				return bytes.NewBufferString(jsonl), "application/x-ndjson"
+			},
+			wantStatus: http.StatusOK,
+			wantReport: &feed.Report{Succeeded: 1, Failed: 1, Rows: []feed.ReportRow{
+				{Line: 1, ProductName: "テスト商品", Channel: entity.ChannelSNS, Tone: entity.ToneCasual, Status: feed.StatusSucceeded, CopyID: 1},
+				{Line: 2, ProductName: "商品2", Status: feed.StatusFailed, Error: "required: productFeatures, channel, tone"},
+			}},
+		},
+		{
+			name: "正常系_CSVのファイルとCSVの結果",
+			url:  "/api/copies/bulk",
+			body: func() (*bytes.Buffer, string) {
+				return multipartRequest("products.csv", csvFeed)
+			},
+			accept:     "text/csv",
+			wantStatus: http.StatusOK,
+			wantCSV:    "line,productName,channel,tone,status,copyId,error\n2,テスト商品,sns,casual,succeeded,1,\n",
+		},
+		{
+			name: "正常系_生成エラーは行の結果に含める",
+			url:  "/api/copies/bulk?format=csv",
+			body: func() (*bytes.Buffer, string) {
+				return bytes.NewBufferString(csvFeed), "text/plain"
+			},
+			generateErr: errors.New("generator error"),
+			wantStatus:  http.StatusOK,
+			wantReport: &feed.Report{Failed: 1, Rows: []feed.ReportRow{
+				{Line: 2, ProductName: "テスト商品", Channel: entity.ChannelSNS, Tone: entity.ToneCasual, Status: feed.StatusFailed, Error: "generator error"},
+			}},
+		},
+		{
+			name: "異常系_形式を判定できない",
+			url:  "/api/copies/bulk",
+			body: func() (*bytes.Buffer, string) {
+				return bytes.NewBufferString(csvFeed), "text/plain"
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "異常系_ヘッダーが不足",
+			url:  "/api/copies/bulk",
+			body: func() (*bytes.Buffer, string) {
+				return bytes.NewBufferString("productName\nテスト商品\n"), "text/csv"
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "異常系_行がない",
+			url:  "/api/copies/bulk",
+			body: func() (*bytes.Buffer, string) {
+				return bytes.NewBufferString(""), "application/x-ndjson"
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockRepo := new(mockCopyRepository)
+			mockGen := new(mockGenerator)
+			if tt.generateErr != nil {
+				mockGen.On("Generate", mock.Anything, mock.Anything).Return(nil, tt.generateErr)
+			} else {
+				mockGen.On("Generate", mock.Anything, mock.Anything).Return(&llm.Response{
+					Title:       "テストタイトル",
+					Description: "高品質で使いやすいテスト商品です。毎日の暮らしをもっと快適にする工夫が詰まっています。今ならお得なキャンペーンも実施中です。",
+					Content:     `{"hashtags": ["テスト商品"]}`,
+				}, nil)
+			}
+			mockRepo.On("CreateWithRevision", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				args.Get(1).(*entity.Copy).ID = 1
+			}).Return(nil)
+
+			// ハンドラーの初期化
+			h := NewHandler(mockRepo, mockGen)
+			router := setupTestRouter(h)
+
+			// リクエストの作成
+			body, contentType := tt.body()
+			req := httptest.NewRequest(http.MethodPost, tt.url, body)
+			req.Header.Set("Content-Type", contentType)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			rec := httptest.NewRecorder()
+
+			// リクエストの実行
+			router.ServeHTTP(rec, req)
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantReport != nil {
+				var got feed.Report
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+				assert.Equal(t, *tt.wantReport, got)
+			}
+			if tt.wantCSV != "" {
+				assert.Equal(t, "text/csv; charset=utf-8", rec.Header().Get("Content-Type"))
+				assert.Contains(t, rec.Header().Get("Content-Disposition"), "copy-report.csv")
+				assert.Equal(t, tt.wantCSV, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestUpdateCopy(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		body       string
+		wantStatus int
+		// wantCode: エラーレスポンスのエラーコード
+		wantCode  string
+		setupMock func(*mockCopyRepository)
+	}{
+		{
+			name:       "正常系",
+			id:         "1",
+			body:       `{"title": "新しいタイトル"}`,
+			wantStatus: http.StatusOK,
+			setupMock: func(mockRepo *mockCopyRepository) {
+				mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{ID: 1, Title: "テストタイトル", Channel: entity.ChannelSNS, Status: entity.CopyStatusDraft}, nil)
+				mockRepo.On("UpdateWithRevision", mock.Anything, mock.Anything, []string{"Title", "Findings"}, (*entity.CopyRevision)(nil)).Return(nil)
+			},
+		},
+		{
+			name:       "異常系_不正なID",
+			id:         "invalid",
+			body:       `{"title": "新しいタイトル"}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_parameter",
+			setupMock:  func(mockRepo *mockCopyRepository) {},
+		},
+		{
+			name:       "異常系_編集できない項目",
+			id:         "1",
+			body:       `{"likes": 100}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_request",
+			setupMock:  func(mockRepo *mockCopyRepository) {},
+		},
+		{
+			// 状態はワークフローの操作でのみ変更する
+			name:       "異常系_公開状態の変更",
+			id:         "1",
+			body:       `{"title": "新しいタイトル", "isPublished": true}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_request",
+			setupMock:  func(mockRepo *mockCopyRepository) {},
+		},
+		{
+			name:       "異常系_変更なし",
+			id:         "1",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "no_changes",
+			setupMock:  func(mockRepo *mockCopyRepository) {},
+		},
+		{
+			name:       "異常系_存在しないID",
+			id:         "999",
+			body:       `{"title": "新しいタイトル"}`,
+			wantStatus: http.StatusNotFound,
+			wantCode:   "copy_not_found",
+			setupMock: func(mockRepo *mockCopyRepository) {
+				mockRepo.On("Get", mock.Anything, 999).Return(nil, repository.ErrCopyNotFound)
+			},
+		},
+		{
+			name:       "異常系_長さの制限",
+			id:         "1",
+			body:       `{"title": "とても長いタイトルとても長いタイトルとても長いタイトル"}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_edit",
+			setupMock: func(mockRepo *mockCopyRepository) {
+				mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{ID: 1, Title: "テストタイトル", Channel: entity.ChannelSNS, Status: entity.CopyStatusDraft}, nil)
+			},
+		},
+		{
+			name:       "異常系_レビュー中は編集できない",
+			id:         "1",
+			body:       `{"title": "新しいタイトル"}`,
+			wantStatus: http.StatusConflict,
+			wantCode:   "copy_not_editable",
+			setupMock: func(mockRepo *mockCopyRepository) {
+				mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{ID: 1, Title: "テストタイトル", Channel: entity.ChannelSNS, Status: entity.CopyStatusInReview}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockRepo := new(mockCopyRepository)
+			tt.setupMock(mockRepo)
+
+			// ハンドラーの初期化
+			h := NewHandler(mockRepo, new(mockGenerator))
+			router := setupTestRouter(h)
+
+			// リクエストの作成
+			req := httptest.NewRequest(http.MethodPatch, "/api/copies/"+tt.id, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			// リクエストの実行
+			router.ServeHTTP(rec, req)
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				var got entity.Copy
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+				assert.Equal(t, "新しいタイトル", got.Title)
+				assert.Equal(t, entity.CopyStatusDraft, got.Status)
+			} else {
+				var got gin.H
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+				assert.Equal(t, tt.wantCode, got["code"])
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDeleteCopy(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		wantStatus int
+		setupMock  func(*mockCopyRepository)
+	}{
+		{
+			name:       "正常系",
+			id:         "1",
+			wantStatus: http.StatusNoContent,
+			setupMock: func(mockRepo *mockCopyRepository) {
+				mockRepo.On("Delete", mock.Anything, 1).Return(nil)
+			},
+		},
+		{
+			name:       "異常系_不正なID",
+			id:         "invalid",
+			wantStatus: http.StatusBadRequest,
+			setupMock:  func(mockRepo *mockCopyRepository) {},
+		},
+		{
+			name:       "異常系_存在しないID",
+			id:         "999",
+			wantStatus: http.StatusNotFound,
+			setupMock: func(mockRepo *mockCopyRepository) {
+				mockRepo.On("Delete", mock.Anything, 999).Return(repository.ErrNotFound)
+			},
+		},
+		{
+			name:       "異常系_リポジトリエラー",
+			id:         "1",
+			wantStatus: http.StatusInternalServerError,
+			setupMock: func(mockRepo *mockCopyRepository) {
+				mockRepo.On("Delete", mock.Anything, 1).Return(errors.New("repository error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockRepo := new(mockCopyRepository)
+			tt.setupMock(mockRepo)
+
+			// ハンドラーの初期化
+			h := NewHandler(mockRepo, new(mockGenerator))
+			router := setupTestRouter(h)
+
+			// リクエストの作成
+			req := httptest.NewRequest(http.MethodDelete, "/api/copies/"+tt.id, nil)
+			rec := httptest.NewRecorder()
+
+			// リクエストの実行
+			router.ServeHTTP(rec, req)
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRestoreCopy(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		wantStatus int
+		setupMock  func(*mockCopyRepository)
+	}{
+		{
+			name:       "正常系",
+			id:         "1",
+			wantStatus: http.StatusOK,
+			setupMock: func(mockRepo *mockCopyRepository) {
+				mockRepo.On("Restore", mock.Anything, 1).Return(nil)
+				mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{ID: 1, Title: "テストタイトル"}, nil)
+			},
+		},
+		{
+			name:       "異常系_削除されていないID",
+			id:         "1",
+			wantStatus: http.StatusNotFound,
+			setupMock: func(mockRepo *mockCopyRepository) {
+				mockRepo.On("Restore", mock.Anything, 1).Return(repository.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockRepo := new(mockCopyRepository)
+			tt.setupMock(mockRepo)
+
+			// ハンドラーの初期化
+			h := NewHandler(mockRepo, new(mockGenerator))
+			router := setupTestRouter(h)
+
+			// リクエストの作成
+			req := httptest.NewRequest(http.MethodPost, "/api/copies/"+tt.id+"/restore", nil)
+			rec := httptest.NewRecorder()
+
+			// リクエストの実行
+			router.ServeHTTP(rec, req)
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestListRevisions(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		wantStatus int
+		setupMock  func(*mockCopyRepository, *mockRevisionRepository)
+	}{
+		{
+			name:       "正常系",
+			id:         "1",
+			wantStatus: http.StatusOK,
+			setupMock: func(mockRepo *mockCopyRepository, mockRevisions *mockRevisionRepository) {
+				mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{ID: 1}, nil)
+				mockRevisions.On("List", mock.Anything, 1).Return([]*entity.CopyRevision{
+					{CopyID: 1, Revision: 1, Source: entity.RevisionSourceGenerated},
+					{CopyID: 1, Revision: 2, Source: entity.RevisionSourceEdited, Author: "editor"},
+				}, nil)
+			},
+		},
+		{
+			name:       "異常系_存在しないID",
+			id:         "999",
+			wantStatus: http.StatusNotFound,
+			setupMock: func(mockRepo *mockCopyRepository, mockRevisions *mockRevisionRepository) {
+				mockRepo.On("Get", mock.Anything, 999).Return(nil, repository.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockRepo := new(mockCopyRepository)
+			mockRevisions := new(mockRevisionRepository)
+			tt.setupMock(mockRepo, mockRevisions)
+
+			// ハンドラーの初期化
+			h := NewHandler(mockRepo, new(mockGenerator), copy_usecase.WithRevisionRepository(mockRevisions))
+			router := setupTestRouter(h)
+
+			// リクエストの作成
+			req := httptest.NewRequest(http.MethodGet, "/api/copies/"+tt.id+"/revisions", nil)
+			rec := httptest.NewRecorder()
+
+			// リクエストの実行
+			router.ServeHTTP(rec, req)
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				var got struct {
+					Revisions []entity.CopyRevision `json:"revisions"`
+				}
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+				assert.Len(t, got.Revisions, 2)
+				assert.Equal(t, "editor", got.Revisions[1].Author)
+			}
+			mockRevisions.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDiffRevisions(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+	}{
+		{
+			name:       "正常系",
+			query:      "?from=1&to=2",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "異常系_版の指定なし",
+			query:      "?from=1",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "異常系_存在しない版",
+			query:      "?from=1&to=9",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockRevisions := new(mockRevisionRepository)
+			mockRevisions.On("Get", mock.Anything, 1, 1).Return(&entity.CopyRevision{CopyID: 1, Revision: 1, Title: "春の新作"}, nil)
+			mockRevisions.On("Get", mock.Anything, 1, 2).Return(&entity.CopyRevision{CopyID: 1, Revision: 2, Title: "夏の新作"}, nil)
+			mockRevisions.On("Get", mock.Anything, 1, 9).Return(nil, repository.ErrNotFound)
+
+			// ハンドラーの初期化
+			h := NewHandler(new(mockCopyRepository), new(mockGenerator), copy_usecase.WithRevisionRepository(mockRevisions))
+			router := setupTestRouter(h)
+
+			// リクエストの作成
+			req := httptest.NewRequest(http.MethodGet, "/api/copies/1/revisions/diff"+tt.query, nil)
+			rec := httptest.NewRecorder()
+
+			// リクエストの実行
+			router.ServeHTTP(rec, req)
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				var got copy_usecase.RevisionDiff
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+				assert.Equal(t, 2, got.To)
+				assert.Len(t, got.Title, 3)
+				assert.Nil(t, got.Status)
+			}
+		})
+	}
+}
+
+func TestRollbackCopy(t *testing.T) {
+	tests := []struct {
+		name       string
+		revision   string
+		wantStatus int
+		setupMock  func(*mockCopyRepository, *mockRevisionRepository)
+	}{
+		{
+			name:       "正常系",
+			revision:   "1",
+			wantStatus: http.StatusOK,
+			setupMock: func(mockRepo *mockCopyRepository, mockRevisions *mockRevisionRepository) {
+				mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{ID: 1, Title: "編集したタイトル", Channel: entity.ChannelSNS, Status: entity.CopyStatusDraft}, nil)
+				mockRevisions.On("Get", mock.Anything, 1, 1).Return(&entity.CopyRevision{CopyID: 1, Revision: 1, Title: "生成したタイトル"}, nil)
+				mockRepo.On("UpdateWithRevision", mock.Anything, mock.Anything, mock.Anything, mock.MatchedBy(func(r *entity.CopyRevision) bool {
+					return r.RollbackOf == 1 && r.Author == "5"
+				})).Return(nil)
+			},
+		},
+		{
+			name:       "異常系_不正な版番号",
+			revision:   "latest",
+			wantStatus: http.StatusBadRequest,
+			setupMock:  func(mockRepo *mockCopyRepository, mockRevisions *mockRevisionRepository) {},
+		},
+		{
+			name:       "異常系_存在しない版",
+			revision:   "9",
+			wantStatus: http.StatusNotFound,
+			setupMock: func(mockRepo *mockCopyRepository, mockRevisions *mockRevisionRepository) {
+				mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{ID: 1, Status: entity.CopyStatusDraft}, nil)
+				mockRevisions.On("Get", mock.Anything, 1, 9).Return(nil, repository.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockRepo := new(mockCopyRepository)
+			mockRevisions := new(mockRevisionRepository)
+			tt.setupMock(mockRepo, mockRevisions)
+
+			// ハンドラーの初期化
+			h := NewHandler(mockRepo, new(mockGenerator), copy_usecase.WithRevisionRepository(mockRevisions))
+			router := setupTestRouter(h)
+
+			// リクエストの作成
+			req := httptest.NewRequest(http.MethodPost, "/api/copies/1/revisions/"+tt.revision+"/rollback", nil)
+			req = req.WithContext(auth.WithIdentity(req.Context(), &entity.Identity{UserID: 5}))
+			rec := httptest.NewRecorder()
+
+			// リクエストの実行
+			router.ServeHTTP(rec, req)
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				var got entity.Copy
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+				assert.Equal(t, "生成したタイトル", got.Title)
+			}
+			mockRepo.AssertExpectations(t)
+			mockRevisions.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWorkflow(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		status entity.CopyStatus
+		// author: 操作した利用者のID（省略時は担当者の2）
+		author     int
+		wantStatus int
+		wantCode   string
+		// wantCopyStatus: 操作後のコピーの状態
+		wantCopyStatus entity.CopyStatus
+	}{
+		{
+			name:           "正常系_レビューの依頼",
+			method:         http.MethodPost,
+			path:           "/api/copies/1/review",
+			body:           `{"reviewer": "2"}`,
+			status:         entity.CopyStatusDraft,
+			author:         1,
+			wantStatus:     http.StatusOK,
+			wantCopyStatus: entity.CopyStatusInReview,
+		},
+		{
+			name:           "正常系_承認（ボディなし）",
+			method:         http.MethodPost,
+			path:           "/api/copies/1/approve",
+			status:         entity.CopyStatusInReview,
+			wantStatus:     http.StatusOK,
+			wantCopyStatus: entity.CopyStatusApproved,
+		},
+		{
+			name:           "正常系_差し戻し",
+			method:         http.MethodPost,
+			path:           "/api/copies/1/reject",
+			body:           `{"comment": "価格の表記を修正してください"}`,
+			status:         entity.CopyStatusInReview,
+			wantStatus:     http.StatusOK,
+			wantCopyStatus: entity.CopyStatusDraft,
+		},
+		{
+			name:           "正常系_公開",
+			method:         http.MethodPost,
+			path:           "/api/copies/1/publish",
+			status:         entity.CopyStatusApproved,
+			wantStatus:     http.StatusOK,
+			wantCopyStatus: entity.CopyStatusPublished,
+		},
+		{
+			name:           "正常系_アーカイブ",
+			method:         http.MethodPost,
+			path:           "/api/copies/1/archive",
+			status:         entity.CopyStatusPublished,
+			wantStatus:     http.StatusOK,
+			wantCopyStatus: entity.CopyStatusArchived,
+		},
+		{
+			name:           "正常系_下書きに戻す",
+			method:         http.MethodPost,
+			path:           "/api/copies/1/reopen",
+			status:         entity.CopyStatusApproved,
+			wantStatus:     http.StatusOK,
+			wantCopyStatus: entity.CopyStatusDraft,
+		},
+		{
+			name:       "異常系_遷移できない状態",
+			method:     http.MethodPost,
+			path:       "/api/copies/1/publish",
+			status:     entity.CopyStatusDraft,
+			wantStatus: http.StatusConflict,
+			wantCode:   "invalid_transition",
+		},
+		{
+			name:       "異常系_担当者以外の承認",
+			method:     http.MethodPost,
+			path:       "/api/copies/1/approve",
+			body:       `{"comment": "OK"}`,
+			status:     entity.CopyStatusInReview,
+			author:     3,
+			wantStatus: http.StatusConflict,
+			wantCode:   "not_assigned_reviewer",
+		},
+		{
+			name:       "異常系_自分をレビューの担当者に指定",
+			method:     http.MethodPost,
+			path:       "/api/copies/1/review",
+			body:       `{"reviewer": "1"}`,
+			status:     entity.CopyStatusDraft,
+			author:     1,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "self_review",
+		},
+		{
+			name:       "異常系_担当者の変更はレビュー中のみ",
+			method:     http.MethodPut,
+			path:       "/api/copies/1/reviewer",
+			body:       `{"reviewer": "4"}`,
+			status:     entity.CopyStatusDraft,
+			wantStatus: http.StatusConflict,
+			wantCode:   "not_in_review",
+		},
+		{
+			name:       "異常系_コメントなしの差し戻し",
+			method:     http.MethodPost,
+			path:       "/api/copies/1/reject",
+			status:     entity.CopyStatusInReview,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "comment_required",
+		},
+		{
+			name:       "異常系_レビューの担当者なし",
+			method:     http.MethodPost,
+			path:       "/api/copies/1/review",
+			body:       `{}`,
+			status:     entity.CopyStatusDraft,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "reviewer_required",
+		},
+		{
+			name:       "異常系_不正なID",
+			method:     http.MethodPost,
+			path:       "/api/copies/abc/publish",
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_parameter",
+		},
+		{
+			name:       "異常系_不正なボディ",
+			method:     http.MethodPost,
+			path:       "/api/copies/1/reject",
+			body:       `{"comment": 1}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備（担当者は利用者2）
+			mockRepo := new(mockCopyRepository)
+			mockRevisions := new(mockRevisionRepository)
+			mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{ID: 1, Title: "テストタイトル", Status: tt.status, Reviewer: "2"}, nil)
+			mockRepo.On("Update", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			mockRepo.On("UpdateStatus", mock.Anything, mock.Anything, repository.CopyStatusGuard{Status: tt.status}, mock.Anything, mock.Anything).Return(nil)
+
+			// ハンドラーの初期化
+			h := NewHandler(mockRepo, new(mockGenerator), copy_usecase.WithRevisionRepository(mockRevisions))
+			router := setupTestRouter(h)
+
+			// リクエストの作成
+			var body *bytes.Buffer
+			if tt.body != "" {
+				body = bytes.NewBufferString(tt.body)
+			} else {
+				body = new(bytes.Buffer)
+			}
+			req := httptest.NewRequest(tt.method, tt.path, body)
+			req.Header.Set("Content-Type", "application/json")
+			author := tt.author
+			if author == 0 {
+				author = 2
+			}
+			req = req.WithContext(auth.WithIdentity(req.Context(), &entity.Identity{UserID: author}))
+			rec := httptest.NewRecorder()
+
+			// リクエストの実行
+			router.ServeHTTP(rec, req)
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				var got entity.Copy
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+				assert.Equal(t, tt.wantCopyStatus, got.Status)
+			} else {
+				var got gin.H
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+				assert.Equal(t, tt.wantCode, got["code"])
+			}
+		})
+	}
+}
+
+func TestListInReview(t *testing.T) {
+	// モックの準備
+	mockRepo := new(mockCopyRepository)
+	mockRepo.On("ListInReview", mock.Anything, "2").Return([]*entity.Copy{
+		{ID: 2, Title: "テストタイトル2", Status: entity.CopyStatusInReview, Reviewer: "2"},
+	}, nil)
+
+	// ハンドラーの初期化
+	router := setupTestRouter(NewHandler(mockRepo, new(mockGenerator)))
+
+	// リクエストの実行
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/copies/in-review?reviewer=2", nil))
+
+	// アサーション
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var got struct {
+		Copies []entity.Copy `json:"copies"`
+	}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	assert.Len(t, got.Copies, 1)
+	assert.Equal(t, "2", got.Copies[0].Reviewer)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestScheduleCopy(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		body       string
+		status     entity.CopyStatus
+		updateErr  error
+		wantStatus int
+		wantCode   string
+	}{
+		{
+			name:       "正常系",
+			id:         "1",
+			body:       `{"publishAt": "2025-06-16T09:00:00+09:00", "expireAt": "2025-06-30T00:00:00+09:00"}`,
+			status:     entity.CopyStatusApproved,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "異常系_公開終了日時が公開日時より前",
+			id:         "1",
+			body:       `{"publishAt": "2025-06-30T00:00:00+09:00", "expireAt": "2025-06-16T09:00:00+09:00"}`,
+			status:     entity.CopyStatusApproved,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_schedule",
+		},
+		{
+			name:       "異常系_アーカイブ済み",
+			id:         "1",
+			body:       `{"publishAt": "2025-06-16T09:00:00+09:00"}`,
+			status:     entity.CopyStatusArchived,
+			wantStatus: http.StatusConflict,
+			wantCode:   "copy_not_schedulable",
+		},
+		{
+			name:       "異常系_取得後に他の操作で状態が変更された",
+			id:         "1",
+			body:       `{"publishAt": "2025-06-16T09:00:00+09:00"}`,
+			status:     entity.CopyStatusApproved,
+			updateErr:  repository.ErrCopyStatusChanged,
+			wantStatus: http.StatusConflict,
+			wantCode:   "copy_status_changed",
+		},
+		{
+			name:       "異常系_日時の形式が不正",
+			id:         "1",
+			body:       `{"publishAt": "2025-06-16"}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_request",
+		},
+		{
+			name:       "異常系_不正なID",
+			id:         "abc",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_parameter",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockRepo := new(mockCopyRepository)
+			mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{ID: 1, Title: "テストタイトル", Status: tt.status}, nil)
+			mockRepo.On("UpdateStatus", mock.Anything, mock.Anything, repository.CopyStatusGuard{Status: tt.status}, []string{"PublishAt", "ExpireAt"}, mock.Anything).Return(tt.updateErr)
+
+			// ハンドラーの初期化
+			h := NewHandler(mockRepo, new(mockGenerator), copy_usecase.WithClock(func() time.Time { return testNow }))
+			router := setupTestRouter(h)
+
+			// リクエストの実行
+			req := httptest.NewRequest(http.MethodPut, "/api/copies/"+tt.id+"/schedule", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				var got entity.Copy
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+				assert.True(t, time.Date(2025, 6, 16, 0, 0, 0, 0, time.UTC).Equal(*got.PublishAt))
+				assert.True(t, time.Date(2025, 6, 29, 15, 0, 0, 0, time.UTC).Equal(*got.ExpireAt))
+			} else {
+				var got gin.H
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+				assert.Equal(t, tt.wantCode, got["code"])
+			}
 		})
 	}
 }
@@ -0,0 +1,55 @@
+package copy_handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/auth"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/handlererr"
+)
+
+// clientHeader: 匿名の利用者のクライアントごとの識別子（フロントエンドで発行して保持する）
+const clientHeader = "X-Client-ID"
+
+// LikeCopy: コピーにいいねする（同じ投票者の2回目以降はいいね数を変えない）
+func (h *handler) LikeCopy(c *gin.Context) {
+	h.like(c, h.usecase.LikeCopy)
+}
+
+// UnlikeCopy: コピーのいいねを取り消す
+func (h *handler) UnlikeCopy(c *gin.Context) {
+	h.like(c, h.usecase.UnlikeCopy)
+}
+
+func (h *handler) like(c *gin.Context, fn func(ctx context.Context, id int, voter string) (*entity.Copy, error)) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(handlererr.InvalidParameter("id"))
+		return
+	}
+
+	copy, err := fn(c.Request.Context(), id, voterFrom(c))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, copy)
+}
+
+// voterFrom: リクエストの投票者（認証済みの利用者のID、クライアントの識別子、IPアドレスとUser-Agentの順に使用）
+//
+// 認証を必須とする場合は常に利用者のIDです。クライアントの識別子などは認証を必須としない開発・テスト用の構成でのみ使用します。
+func voterFrom(c *gin.Context) string {
+	if identity := auth.IdentityFrom(c.Request.Context()); identity != nil {
+		return entity.UserVoter(strconv.Itoa(identity.UserID))
+	}
+	if clientID := c.GetHeader(clientHeader); clientID != "" {
+		return entity.AnonymousVoter(clientID)
+	}
+	return entity.AnonymousVoter(c.ClientIP() + "\x00" + c.Request.UserAgent())
+}
@@ -0,0 +1,87 @@
+package copy_handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/auth"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/handlererr"
+)
+
+// authorFrom: 編集・操作した利用者（認証済みの利用者のID、認証されていない場合は空）
+//
+// 版の記録・レビューの担当者の確認に使うため、リクエストのヘッダーなど呼び出し元が指定した値は使用しません。
+func authorFrom(c *gin.Context) string {
+	if identity := auth.IdentityFrom(c.Request.Context()); identity != nil {
+		return strconv.Itoa(identity.UserID)
+	}
+	return ""
+}
+
+// ListRevisions: コピーの版を古い順に取得
+func (h *handler) ListRevisions(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(handlererr.InvalidParameter("id"))
+		return
+	}
+
+	revisions, err := h.usecase.ListRevisions(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"revisions": revisions})
+}
+
+// DiffRevisions: 2つの版の差分（?from=1&to=2）
+func (h *handler) DiffRevisions(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(handlererr.InvalidParameter("id"))
+		return
+	}
+	from, err := strconv.Atoi(c.Query("from"))
+	if err != nil {
+		_ = c.Error(handlererr.InvalidParameter("from"))
+		return
+	}
+	to, err := strconv.Atoi(c.Query("to"))
+	if err != nil {
+		_ = c.Error(handlererr.InvalidParameter("to"))
+		return
+	}
+
+	diff, err := h.usecase.DiffRevisions(c.Request.Context(), id, from, to)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, diff)
+}
+
+// RollbackCopy: コピーを指定した版の内容に戻す（新しい版として記録）
+func (h *handler) RollbackCopy(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(handlererr.InvalidParameter("id"))
+		return
+	}
+	revision, err := strconv.Atoi(c.Param("revision"))
+	if err != nil {
+		_ = c.Error(handlererr.InvalidParameter("revision"))
+		return
+	}
+
+	copy, err := h.usecase.RollbackCopy(c.Request.Context(), id, revision, authorFrom(c))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, copy)
+}
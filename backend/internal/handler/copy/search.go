@@ -0,0 +1,50 @@
+package copy_handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/auth"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/handlererr"
+	copy_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/copy"
+)
+
+// SearchCopiesRequest: 全文検索のクエリパラメータ
+type SearchCopiesRequest struct {
+	// Q: 空白（全角を含む）で区切った検索語
+	Q                  string `form:"q"`
+	IncludeUnpublished bool   `form:"includeUnpublished"`
+	Limit              int    `form:"limit"`
+	Offset             int    `form:"offset"`
+}
+
+// SearchCopies: コピーを全文検索し、一致した箇所とともに返す（既定は公開済みのみ）
+//
+// 未公開のコピーを含める場合は、認証が必須の場合に呼び出し元が必要です。
+func (h *handler) SearchCopies(c *gin.Context) {
+	var req SearchCopiesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		_ = c.Error(handlererr.InvalidRequest(err))
+		return
+	}
+	if req.IncludeUnpublished {
+		if err := auth.RequireIdentity(c.Request.Context()); err != nil {
+			_ = c.Error(err)
+			return
+		}
+	}
+
+	page, err := h.usecase.SearchCopies(c.Request.Context(), copy_usecase.SearchCopiesInput{
+		Query:              req.Q,
+		IncludeUnpublished: req.IncludeUnpublished,
+		Limit:              req.Limit,
+		Offset:             req.Offset,
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
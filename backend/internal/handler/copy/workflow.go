@@ -0,0 +1,139 @@
+package copy_handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/handlererr"
+	copy_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/copy"
+)
+
+// WorkflowRequest: ワークフローの操作のリクエスト（ボディは省略可）
+//
+// 操作した利用者は認証済みの利用者です（承認・差し戻しはレビューの担当者のみ）。
+type WorkflowRequest struct {
+	// Reviewer: レビューを依頼する利用者のID（レビューの依頼・担当者の変更で必須）
+	Reviewer string `json:"reviewer"`
+	// Comment: 承認・差し戻しのコメント（差し戻しで必須）
+	Comment string `json:"comment"`
+}
+
+// ScheduleRequest: 公開日時・公開終了日時の設定のリクエスト（省略した項目は設定を解除する）
+type ScheduleRequest struct {
+	// PublishAt: 承認済みのコピーを公開する日時（RFC 3339）
+	PublishAt *time.Time `json:"publishAt"`
+	// ExpireAt: 公開済みのコピーをアーカイブする日時（RFC 3339、publishAtより後）
+	ExpireAt *time.Time `json:"expireAt"`
+}
+
+// workflowOperation: ワークフローの操作を行うユースケースのメソッド
+type workflowOperation func(ctx context.Context, id int, input copy_usecase.WorkflowInput) (*entity.Copy, error)
+
+// SubmitForReview: 下書きのコピーのレビューを依頼する
+func (h *handler) SubmitForReview(c *gin.Context) {
+	h.workflow(c, h.usecase.SubmitForReview)
+}
+
+// AssignReviewer: レビュー中のコピーの担当者を変更する
+func (h *handler) AssignReviewer(c *gin.Context) {
+	h.workflow(c, h.usecase.AssignReviewer)
+}
+
+// ApproveCopy: レビュー中のコピーを承認する
+func (h *handler) ApproveCopy(c *gin.Context) {
+	h.workflow(c, h.usecase.Approve)
+}
+
+// RejectCopy: レビュー中のコピーをコメントを付けて下書きに差し戻す
+func (h *handler) RejectCopy(c *gin.Context) {
+	h.workflow(c, h.usecase.Reject)
+}
+
+// PublishCopy: 承認済みのコピーを公開する
+func (h *handler) PublishCopy(c *gin.Context) {
+	h.workflow(c, h.usecase.Publish)
+}
+
+// ArchiveCopy: コピーをアーカイブする
+func (h *handler) ArchiveCopy(c *gin.Context) {
+	h.workflow(c, h.usecase.Archive)
+}
+
+// ReopenCopy: コピーを下書きに戻す
+func (h *handler) ReopenCopy(c *gin.Context) {
+	h.workflow(c, h.usecase.Reopen)
+}
+
+// ListInReview: レビュー中のコピーを依頼の古い順に返す（?reviewer=で担当者を絞り込む）
+func (h *handler) ListInReview(c *gin.Context) {
+	copies, err := h.usecase.ListInReview(c.Request.Context(), c.Query("reviewer"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"copies": copies})
+}
+
+// ScheduleCopy: コピーの公開日時・公開終了日時を設定する
+func (h *handler) ScheduleCopy(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(handlererr.InvalidParameter("id"))
+		return
+	}
+
+	var req ScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(handlererr.InvalidRequest(err))
+		return
+	}
+
+	copy, err := h.usecase.Schedule(c.Request.Context(), id, copy_usecase.ScheduleInput{
+		PublishAt: req.PublishAt,
+		ExpireAt:  req.ExpireAt,
+		Actor:     authorFrom(c),
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, copy)
+}
+
+// workflow: パスのIDとリクエストを解釈してワークフローの操作を行い、変更後のコピーを返す
+//
+// 現在の状態から遷移できない操作は409を返します。
+func (h *handler) workflow(c *gin.Context, operation workflowOperation) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(handlererr.InvalidParameter("id"))
+		return
+	}
+
+	var req WorkflowRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			_ = c.Error(handlererr.InvalidRequest(err))
+			return
+		}
+	}
+
+	copy, err := operation(c.Request.Context(), id, copy_usecase.WorkflowInput{
+		Actor:    authorFrom(c),
+		Reviewer: req.Reviewer,
+		Comment:  req.Comment,
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, copy)
+}
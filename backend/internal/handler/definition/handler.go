@@ -0,0 +1,197 @@
+package definition_handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/handlererr"
+	definition_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/definition"
+)
+
+type Handler interface {
+	ListChannels(c *gin.Context)
+	GetChannel(c *gin.Context)
+	CreateChannel(c *gin.Context)
+	UpdateChannel(c *gin.Context)
+	DeleteChannel(c *gin.Context)
+	ListTones(c *gin.Context)
+	GetTone(c *gin.Context)
+	CreateTone(c *gin.Context)
+	UpdateTone(c *gin.Context)
+	DeleteTone(c *gin.Context)
+}
+
+type handler struct {
+	usecase definition_usecase.UseCase
+}
+
+// ChannelRequest: 配信チャネルの登録・更新のリクエスト（idは登録時のみ使用）
+type ChannelRequest struct {
+	ID          entity.Channel       `json:"id"`
+	Label       string               `json:"label" binding:"required"`
+	Description string               `json:"description"`
+	Guidance    string               `json:"guidance"`
+	Fields      []entity.OutputField `json:"fields"`
+	SortOrder   int                  `json:"sortOrder"`
+}
+
+// ToneRequest: トーンの登録・更新のリクエスト（idは登録時のみ使用）
+type ToneRequest struct {
+	ID          entity.Tone `json:"id"`
+	Label       string      `json:"label" binding:"required"`
+	Description string      `json:"description"`
+	Guidance    string      `json:"guidance"`
+	Markers     []string    `json:"markers"`
+	SortOrder   int         `json:"sortOrder"`
+}
+
+func NewHandler(usecase definition_usecase.UseCase) Handler {
+	return &handler{usecase: usecase}
+}
+
+// ListChannels: 登録済みの配信チャネルを表示順に返す
+func (h *handler) ListChannels(c *gin.Context) {
+	channels, err := h.usecase.ListChannels(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, channels)
+}
+
+func (h *handler) GetChannel(c *gin.Context) {
+	channel, err := h.usecase.GetChannel(c.Request.Context(), entity.Channel(c.Param("id")))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, channel)
+}
+
+// CreateChannel: 配信チャネルを登録し、201 Createdで定義を返す
+func (h *handler) CreateChannel(c *gin.Context) {
+	var req ChannelRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(handlererr.InvalidRequest(err))
+		return
+	}
+
+	channel, err := h.usecase.CreateChannel(c.Request.Context(), req.input())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Header("Location", c.FullPath()+"/"+string(channel.ID))
+	c.JSON(http.StatusCreated, channel)
+}
+
+// UpdateChannel: 配信チャネルの定義を更新（組み込みのチャネルは出力項目を変更できない）
+func (h *handler) UpdateChannel(c *gin.Context) {
+	var req ChannelRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(handlererr.InvalidRequest(err))
+		return
+	}
+
+	channel, err := h.usecase.UpdateChannel(c.Request.Context(), entity.Channel(c.Param("id")), req.input())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, channel)
+}
+
+// DeleteChannel: 配信チャネルを削除（組み込みのチャネルは削除できない）
+func (h *handler) DeleteChannel(c *gin.Context) {
+	if err := h.usecase.DeleteChannel(c.Request.Context(), entity.Channel(c.Param("id"))); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// ListTones: 登録済みのトーンを表示順に返す
+func (h *handler) ListTones(c *gin.Context) {
+	tones, err := h.usecase.ListTones(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, tones)
+}
+
+func (h *handler) GetTone(c *gin.Context) {
+	tone, err := h.usecase.GetTone(c.Request.Context(), entity.Tone(c.Param("id")))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, tone)
+}
+
+// CreateTone: トーンを登録し、201 Createdで定義を返す
+func (h *handler) CreateTone(c *gin.Context) {
+	var req ToneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(handlererr.InvalidRequest(err))
+		return
+	}
+
+	tone, err := h.usecase.CreateTone(c.Request.Context(), req.input())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Header("Location", c.FullPath()+"/"+string(tone.ID))
+	c.JSON(http.StatusCreated, tone)
+}
+
+func (h *handler) UpdateTone(c *gin.Context) {
+	var req ToneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(handlererr.InvalidRequest(err))
+		return
+	}
+
+	tone, err := h.usecase.UpdateTone(c.Request.Context(), entity.Tone(c.Param("id")), req.input())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, tone)
+}
+
+// DeleteTone: トーンを削除（組み込みのトーンは削除できない）
+func (h *handler) DeleteTone(c *gin.Context) {
+	if err := h.usecase.DeleteTone(c.Request.Context(), entity.Tone(c.Param("id"))); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (r ChannelRequest) input() definition_usecase.ChannelInput {
+	return definition_usecase.ChannelInput{
+		ID:          r.ID,
+		Label:       r.Label,
+		Description: r.Description,
+		Guidance:    r.Guidance,
+		Fields:      r.Fields,
+		SortOrder:   r.SortOrder,
+	}
+}
+
+func (r ToneRequest) input() definition_usecase.ToneInput {
+	return definition_usecase.ToneInput{
+		ID:          r.ID,
+		Label:       r.Label,
+		Description: r.Description,
+		Guidance:    r.Guidance,
+		Markers:     r.Markers,
+		SortOrder:   r.SortOrder,
+	}
+}
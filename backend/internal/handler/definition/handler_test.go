@@ -0,0 +1,202 @@
+package definition_handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/middleware"
+	definition_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/definition"
+)
+
+// ユースケースのモック（配信チャネルの登録・取得・削除のみ）
+type mockUseCase struct {
+	definition_usecase.UseCase
+	mock.Mock
+}
+
+func (m *mockUseCase) GetChannel(ctx context.Context, id entity.Channel) (*entity.ChannelDefinition, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ChannelDefinition), args.Error(1)
+}
+
+func (m *mockUseCase) CreateChannel(ctx context.Context, input definition_usecase.ChannelInput) (*entity.ChannelDefinition, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ChannelDefinition), args.Error(1)
+}
+
+func (m *mockUseCase) DeleteChannel(ctx context.Context, id entity.Channel) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func setupTestRouter(h Handler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	r.Use(middleware.ErrorHandler())
+
+	r.POST("/api/channels", h.CreateChannel)
+	r.GET("/api/channels/:id", h.GetChannel)
+	r.DELETE("/api/channels/:id", h.DeleteChannel)
+
+	return r
+}
+
+func TestCreateChannel(t *testing.T) {
+	validBody := `{"id": "reels", "label": "Instagramリール", "fields": [{"name": "title", "description": "テロップ", "limit": {"unit": "graphemes", "max": 15}}]}`
+
+	tests := []struct {
+		name         string
+		body         string
+		wantStatus   int
+		wantCode     string
+		wantLocation string
+		setupMock    func(*mockUseCase)
+	}{
+		{
+			name:         "正常系",
+			body:         validBody,
+			wantStatus:   http.StatusCreated,
+			wantLocation: "/api/channels/reels",
+			setupMock: func(m *mockUseCase) {
+				m.On("CreateChannel", mock.Anything, mock.MatchedBy(func(input definition_usecase.ChannelInput) bool {
+					return input.ID == "reels" && len(input.Fields) == 1 && input.Fields[0].Limit.Max == 15
+				})).Return(&entity.ChannelDefinition{ID: "reels", Label: "Instagramリール"}, nil)
+			},
+		},
+		{
+			name:       "異常系_表示名なし",
+			body:       `{"id": "reels"}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_request",
+			setupMock:  func(*mockUseCase) {},
+		},
+		{
+			name:       "異常系_定義が不正",
+			body:       validBody,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_definition",
+			setupMock: func(m *mockUseCase) {
+				m.On("CreateChannel", mock.Anything, mock.Anything).Return(nil, &definition_usecase.InvalidDefinitionError{
+					Violations: []string{"出力項目にdescriptionがありません"},
+				})
+			},
+		},
+		{
+			name:       "異常系_登録済みのID",
+			body:       validBody,
+			wantStatus: http.StatusConflict,
+			wantCode:   "duplicated",
+			setupMock: func(m *mockUseCase) {
+				m.On("CreateChannel", mock.Anything, mock.Anything).Return(nil, repository.ErrDuplicated)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			m := new(mockUseCase)
+			tt.setupMock(m)
+			router := setupTestRouter(NewHandler(m))
+
+			// リクエストの実行
+			req := httptest.NewRequest(http.MethodPost, "/api/channels", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, tt.wantLocation, rec.Header().Get("Location"))
+			if tt.wantCode != "" {
+				var problem map[string]any
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+				assert.Equal(t, tt.wantCode, problem["code"])
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetChannel(t *testing.T) {
+	m := new(mockUseCase)
+	m.On("GetChannel", mock.Anything, entity.Channel("reels")).Return(&entity.ChannelDefinition{ID: "reels", Label: "Instagramリール"}, nil)
+	m.On("GetChannel", mock.Anything, entity.Channel("tv")).Return(nil, repository.ErrChannelNotFound)
+	router := setupTestRouter(NewHandler(m))
+
+	t.Run("正常系", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/channels/reels", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var got entity.ChannelDefinition
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+		assert.Equal(t, "Instagramリール", got.Label)
+	})
+
+	t.Run("異常系_存在しないチャネル", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/channels/tv", nil))
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestDeleteChannel(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		wantStatus int
+		setupMock  func(*mockUseCase)
+	}{
+		{
+			name:       "正常系",
+			id:         "reels",
+			wantStatus: http.StatusNoContent,
+			setupMock: func(m *mockUseCase) {
+				m.On("DeleteChannel", mock.Anything, entity.Channel("reels")).Return(nil)
+			},
+		},
+		{
+			name:       "異常系_組み込みのチャネル",
+			id:         "sns",
+			wantStatus: http.StatusConflict,
+			setupMock: func(m *mockUseCase) {
+				m.On("DeleteChannel", mock.Anything, entity.ChannelSNS).Return(definition_usecase.ErrBuiltinDefinition)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			m := new(mockUseCase)
+			tt.setupMock(m)
+			router := setupTestRouter(NewHandler(m))
+
+			// リクエストの実行
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/api/channels/"+tt.id, nil))
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			m.AssertExpectations(t)
+		})
+	}
+}
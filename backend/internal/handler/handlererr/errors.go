@@ -0,0 +1,19 @@
+// Package handlererr: ハンドラーに共通のリクエストの検証エラー
+package handlererr
+
+import (
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/domainerr"
+)
+
+// InvalidRequest: リクエストボディ・クエリパラメータを解釈できない場合のエラー（domainerrのエラーはそのまま返す）
+func InvalidRequest(err error) error {
+	if _, ok := domainerr.As(err); ok {
+		return err
+	}
+	return domainerr.Validation("invalid_request", err.Error())
+}
+
+// InvalidParameter: パスやクエリのパラメータの形式が不正な場合のエラー
+func InvalidParameter(name string) error {
+	return domainerr.Validation("invalid_parameter", "invalid "+name+" parameter")
+}
@@ -0,0 +1,57 @@
+package handlererr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/domainerr"
+)
+
+func TestInvalidRequest(t *testing.T) {
+	errInvalidChannel := domainerr.Validation("invalid_channel", "channel is not registered")
+
+	tests := []struct {
+		name     string
+		err      error
+		wantCode string
+		wantMsg  string
+	}{
+		{
+			name:     "正常系_バインドのエラー",
+			err:      errors.New("unexpected EOF"),
+			wantCode: "invalid_request",
+			wantMsg:  "unexpected EOF",
+		},
+		{
+			name:     "正常系_domainerrのエラーはそのまま返す",
+			err:      errInvalidChannel,
+			wantCode: "invalid_channel",
+			wantMsg:  "channel is not registered",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト実行
+			got, ok := domainerr.As(InvalidRequest(tt.err))
+
+			// アサーション
+			if assert.True(t, ok) {
+				assert.Equal(t, domainerr.KindValidation, got.Kind)
+				assert.Equal(t, tt.wantCode, got.Code)
+				assert.Equal(t, tt.wantMsg, got.Message)
+			}
+		})
+	}
+}
+
+func TestInvalidParameter(t *testing.T) {
+	got, ok := domainerr.As(InvalidParameter("id"))
+
+	if assert.True(t, ok) {
+		assert.Equal(t, "invalid_parameter", got.Code)
+		assert.Equal(t, "invalid id parameter", got.Message)
+	}
+}
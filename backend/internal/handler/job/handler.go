@@ -0,0 +1,95 @@
+package job_handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/domainerr"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	copy_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/copy"
+	job_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/job"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/validation"
+)
+
+type Handler interface {
+	CreateJob(c *gin.Context)
+	GetJob(c *gin.Context)
+}
+
+type handler struct {
+	usecase job_usecase.UseCase
+}
+
+type CreateJobRequest struct {
+	// ProductName / ProductFeatures: 商品名・特徴（productIdを指定した場合は省略でき、商品の内容で補う）
+	ProductName     string `json:"productName" binding:"required_without=ProductID"`
+	ProductFeatures string `json:"productFeatures" binding:"required_without=ProductID"`
+	// Target: ターゲット（campaignIdを指定した場合は省略でき、キャンペーンの対象者で補う）
+	Target  string         `json:"target" binding:"required_without=CampaignID"`
+	Channel entity.Channel `json:"channel" binding:"required,channel"`
+	Tone    entity.Tone    `json:"tone" binding:"required,tone"`
+	// Variants: 生成する候補数（省略時は1件のみ生成）
+	Variants int `json:"variants" binding:"omitempty,min=1,max=10"`
+	// Locale: プロンプトテンプレートのロケール（省略時はja）
+	Locale string `json:"locale"`
+	// BrandProfileID: 適用するブランドプロファイルのID（省略時はブランドの指定なし）
+	BrandProfileID *int `json:"brandProfileId" binding:"omitempty,min=1"`
+	// ProductID: 商品カタログの商品のID（省略時は商品名・特徴の入力が必要）
+	ProductID *int `json:"productId" binding:"omitempty,min=1"`
+	// CampaignID: コピーが属するキャンペーンのID（省略時はキャンペーンに属さない）
+	CampaignID *int `json:"campaignId" binding:"omitempty,min=1"`
+}
+
+func NewHandler(usecase job_usecase.UseCase) Handler {
+	// リクエストのchannel・toneを検証するタグを登録
+	validation.Register()
+	return &handler{usecase: usecase}
+}
+
+// CreateJob: 生成ジョブを登録し、202 Acceptedでジョブを返す
+func (h *handler) CreateJob(c *gin.Context) {
+	var req CreateJobRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(domainerr.Validation("invalid_request", err.Error()))
+		return
+	}
+
+	job, err := h.usecase.Enqueue(c.Request.Context(), copy_usecase.CreateCopyInput{
+		ProductName:     req.ProductName,
+		ProductFeatures: req.ProductFeatures,
+		Target:          req.Target,
+		Channel:         req.Channel,
+		Tone:            req.Tone,
+		Variants:        req.Variants,
+		Locale:          req.Locale,
+		BrandProfileID:  req.BrandProfileID,
+		ProductID:       req.ProductID,
+		CampaignID:      req.CampaignID,
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Header("Location", c.FullPath()+"/"+strconv.Itoa(job.ID))
+	c.JSON(http.StatusAccepted, job)
+}
+
+// GetJob: ジョブの状態と生成したコピーのIDを返す
+func (h *handler) GetJob(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(domainerr.Validation("invalid_parameter", "invalid id parameter"))
+		return
+	}
+
+	job, err := h.usecase.GetJob(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, job)
+}
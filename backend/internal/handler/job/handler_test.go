@@ -0,0 +1,179 @@
+package job_handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/middleware"
+	copy_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/copy"
+)
+
+// ユースケースのモック
+type mockUseCase struct {
+	mock.Mock
+}
+
+func (m *mockUseCase) Enqueue(ctx context.Context, input copy_usecase.CreateCopyInput) (*entity.GenerationJob, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.GenerationJob), args.Error(1)
+}
+
+func (m *mockUseCase) GetJob(ctx context.Context, id int) (*entity.GenerationJob, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.GenerationJob), args.Error(1)
+}
+
+func (m *mockUseCase) ProcessNext(ctx context.Context, workerID string) (bool, error) {
+	args := m.Called(ctx, workerID)
+	return args.Bool(0), args.Error(1)
+}
+
+func setupTestRouter(h Handler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	r.Use(middleware.ErrorHandler())
+
+	r.POST("/api/generation-jobs", h.CreateJob)
+	r.GET("/api/generation-jobs/:id", h.GetJob)
+
+	return r
+}
+
+func TestCreateJob(t *testing.T) {
+	validBody := `{"productName": "テスト商品", "productFeatures": "高品質", "target": "20代", "channel": "sns", "tone": "casual", "variants": 3}`
+
+	tests := []struct {
+		name         string
+		body         string
+		wantStatus   int
+		wantLocation string
+		setupMock    func(*mockUseCase)
+	}{
+		{
+			name:         "正常系",
+			body:         validBody,
+			wantStatus:   http.StatusAccepted,
+			wantLocation: "/api/generation-jobs/1",
+			setupMock: func(m *mockUseCase) {
+				m.On("Enqueue", mock.Anything, copy_usecase.CreateCopyInput{
+					ProductName:     "テスト商品",
+					ProductFeatures: "高品質",
+					Target:          "20代",
+					Channel:         entity.ChannelSNS,
+					Tone:            entity.ToneCasual,
+					Variants:        3,
+				}).Return(&entity.GenerationJob{ID: 1, Status: entity.JobStatusQueued}, nil)
+			},
+		},
+		{
+			name:       "異常系_必須項目なし",
+			body:       `{"productName": "テスト商品"}`,
+			wantStatus: http.StatusBadRequest,
+			setupMock:  func(*mockUseCase) {},
+		},
+		{
+			name:       "異常系_ユースケースエラー",
+			body:       validBody,
+			wantStatus: http.StatusInternalServerError,
+			setupMock: func(m *mockUseCase) {
+				m.On("Enqueue", mock.Anything, mock.Anything).Return(nil, errors.New("repository error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			m := new(mockUseCase)
+			tt.setupMock(m)
+			router := setupTestRouter(NewHandler(m))
+
+			// リクエストの実行
+			req := httptest.NewRequest(http.MethodPost, "/api/generation-jobs", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, tt.wantLocation, rec.Header().Get("Location"))
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetJob(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		wantStatus int
+		setupMock  func(*mockUseCase)
+	}{
+		{
+			name:       "正常系",
+			id:         "1",
+			wantStatus: http.StatusOK,
+			setupMock: func(m *mockUseCase) {
+				m.On("GetJob", mock.Anything, 1).Return(&entity.GenerationJob{
+					ID:      1,
+					Status:  entity.JobStatusSucceeded,
+					CopyIDs: []int{10},
+				}, nil)
+			},
+		},
+		{
+			name:       "異常系_不正なID",
+			id:         "invalid",
+			wantStatus: http.StatusBadRequest,
+			setupMock:  func(*mockUseCase) {},
+		},
+		{
+			name:       "異常系_存在しないID",
+			id:         "999",
+			wantStatus: http.StatusNotFound,
+			setupMock: func(m *mockUseCase) {
+				m.On("GetJob", mock.Anything, 999).Return(nil, repository.ErrJobNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			m := new(mockUseCase)
+			tt.setupMock(m)
+			router := setupTestRouter(NewHandler(m))
+
+			// リクエストの実行
+			req := httptest.NewRequest(http.MethodGet, "/api/generation-jobs/"+tt.id, nil)
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				var got entity.GenerationJob
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+				assert.Equal(t, entity.JobStatusSucceeded, got.Status)
+				assert.Equal(t, []int{10}, got.CopyIDs)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package meta_handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	definition_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/definition"
+)
+
+type Handler interface {
+	GetOptions(c *gin.Context)
+}
+
+type handler struct {
+	usecase definition_usecase.UseCase
+}
+
+func NewHandler(usecase definition_usecase.UseCase) Handler {
+	return &handler{usecase: usecase}
+}
+
+// GetOptions: フロントエンドの選択肢に使用する配信チャネル・トーンの一覧を返す（登録済みの定義を表示順に返す）
+func (h *handler) GetOptions(c *gin.Context) {
+	options, err := h.usecase.Options(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, options)
+}
@@ -0,0 +1,92 @@
+package meta_handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/middleware"
+	definition_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/definition"
+)
+
+// ユースケースのモック（Options以外は使用しない）
+type mockUseCase struct {
+	definition_usecase.UseCase
+	mock.Mock
+}
+
+func (m *mockUseCase) Options(ctx context.Context) (*definition_usecase.Options, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*definition_usecase.Options), args.Error(1)
+}
+
+func TestGetOptions(t *testing.T) {
+	options := &definition_usecase.Options{
+		Channels: []entity.Option{
+			entity.BuiltinChannels[3].Option(),
+			{Value: "reels", Label: "Instagramリール"},
+		},
+		Tones: []entity.Option{entity.BuiltinTones[0].Option()},
+	}
+
+	tests := []struct {
+		name       string
+		wantStatus int
+		setupMock  func(*mockUseCase)
+	}{
+		{
+			name:       "正常系",
+			wantStatus: http.StatusOK,
+			setupMock: func(m *mockUseCase) {
+				m.On("Options", mock.Anything).Return(options, nil)
+			},
+		},
+		{
+			name:       "異常系_ユースケースエラー",
+			wantStatus: http.StatusInternalServerError,
+			setupMock: func(m *mockUseCase) {
+				m.On("Options", mock.Anything).Return(nil, errors.New("repository error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := new(mockUseCase)
+			tt.setupMock(m)
+
+			gin.SetMode(gin.TestMode)
+			r := gin.Default()
+			r.Use(middleware.ErrorHandler())
+			r.GET("/api/meta/options", NewHandler(m).GetOptions)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/meta/options", nil)
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				var got definition_usecase.Options
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+				assert.Equal(t, *options, got)
+				assert.Equal(t, entity.Option{
+					Value:       "sns",
+					Label:       "SNS投稿",
+					Description: "SNSへの投稿文。ハッシュタグを含めて全角140文字以内に収めます",
+				}, got.Channels[0])
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package product_handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/handlererr"
+	product_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/product"
+)
+
+type Handler interface {
+	List(c *gin.Context)
+	Get(c *gin.Context)
+	Create(c *gin.Context)
+	Update(c *gin.Context)
+	Delete(c *gin.Context)
+	ListCopies(c *gin.Context)
+}
+
+type handler struct {
+	usecase product_usecase.UseCase
+}
+
+// ProductRequest: 商品の登録・更新のリクエスト
+type ProductRequest struct {
+	SKU      string                `json:"sku" binding:"required"`
+	Name     string                `json:"name" binding:"required"`
+	Features string                `json:"features" binding:"required"`
+	Price    *int                  `json:"price"`
+	Category string                `json:"category"`
+	Images   []entity.ProductImage `json:"images"`
+}
+
+func NewHandler(usecase product_usecase.UseCase) Handler {
+	return &handler{usecase: usecase}
+}
+
+// List: 登録済みの商品をSKU順に返す
+func (h *handler) List(c *gin.Context) {
+	products, err := h.usecase.List(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, products)
+}
+
+func (h *handler) Get(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(handlererr.InvalidParameter("id"))
+		return
+	}
+
+	product, err := h.usecase.Get(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, product)
+}
+
+// Create: 商品を登録し、201 Createdで商品を返す（SKUが登録済みの場合は409）
+func (h *handler) Create(c *gin.Context) {
+	var req ProductRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(handlererr.InvalidRequest(err))
+		return
+	}
+
+	product, err := h.usecase.Create(c.Request.Context(), req.input())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Header("Location", c.FullPath()+"/"+strconv.Itoa(product.ID))
+	c.JSON(http.StatusCreated, product)
+}
+
+func (h *handler) Update(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(handlererr.InvalidParameter("id"))
+		return
+	}
+
+	var req ProductRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(handlererr.InvalidRequest(err))
+		return
+	}
+
+	product, err := h.usecase.Update(c.Request.Context(), id, req.input())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, product)
+}
+
+// Delete: 商品を削除（商品を指定して生成した既存のコピーは残る）
+func (h *handler) Delete(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(handlererr.InvalidParameter("id"))
+		return
+	}
+
+	if err := h.usecase.Delete(c.Request.Context(), id); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// ListCopies: 商品を指定して生成したすべてのコピーを新しい順に返す
+func (h *handler) ListCopies(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(handlererr.InvalidParameter("id"))
+		return
+	}
+
+	copies, err := h.usecase.ListCopies(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, copies)
+}
+
+func (r ProductRequest) input() product_usecase.Input {
+	return product_usecase.Input{
+		SKU:      r.SKU,
+		Name:     r.Name,
+		Features: r.Features,
+		Price:    r.Price,
+		Category: r.Category,
+		Images:   r.Images,
+	}
+}
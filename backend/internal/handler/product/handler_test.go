@@ -0,0 +1,197 @@
+package product_handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/middleware"
+	product_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/product"
+)
+
+// ユースケースのモック（登録と商品ごとのコピーの一覧のみ）
+type mockUseCase struct {
+	product_usecase.UseCase
+	mock.Mock
+}
+
+func (m *mockUseCase) Create(ctx context.Context, input product_usecase.Input) (*entity.Product, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Product), args.Error(1)
+}
+
+func (m *mockUseCase) ListCopies(ctx context.Context, id int) ([]*entity.Copy, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Copy), args.Error(1)
+}
+
+func setupTestRouter(h Handler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	r.Use(middleware.ErrorHandler())
+
+	r.POST("/api/products", h.Create)
+	r.GET("/api/products/:id/copies", h.ListCopies)
+
+	return r
+}
+
+func TestCreate(t *testing.T) {
+	validBody := `{"sku": "SKU-001", "name": "テスト商品", "features": "軽量、防水", "price": 3980, "images": [{"url": "https://example.com/1.jpg", "alt": "正面"}]}`
+
+	tests := []struct {
+		name         string
+		body         string
+		wantStatus   int
+		wantCode     string
+		wantLocation string
+		setupMock    func(*mockUseCase)
+	}{
+		{
+			name:         "正常系",
+			body:         validBody,
+			wantStatus:   http.StatusCreated,
+			wantLocation: "/api/products/1",
+			setupMock: func(m *mockUseCase) {
+				m.On("Create", mock.Anything, mock.MatchedBy(func(input product_usecase.Input) bool {
+					return input.SKU == "SKU-001" && *input.Price == 3980 && len(input.Images) == 1
+				})).Return(&entity.Product{ID: 1, SKU: "SKU-001"}, nil)
+			},
+		},
+		{
+			name:       "異常系_SKUなし",
+			body:       `{"name": "テスト商品", "features": "軽量"}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_request",
+			setupMock:  func(*mockUseCase) {},
+		},
+		{
+			name:       "異常系_内容が不正",
+			body:       validBody,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_product_input",
+			setupMock: func(m *mockUseCase) {
+				m.On("Create", mock.Anything, mock.Anything).Return(nil, &product_usecase.InvalidProductError{
+					Violations: []string{"priceは0以上にしてください"},
+				})
+			},
+		},
+		{
+			name:       "異常系_登録済みのSKU",
+			body:       validBody,
+			wantStatus: http.StatusConflict,
+			wantCode:   "duplicated",
+			setupMock: func(m *mockUseCase) {
+				m.On("Create", mock.Anything, mock.Anything).Return(nil, repository.ErrDuplicated)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			m := new(mockUseCase)
+			tt.setupMock(m)
+			router := setupTestRouter(NewHandler(m))
+
+			// リクエストの実行
+			req := httptest.NewRequest(http.MethodPost, "/api/products", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, tt.wantLocation, rec.Header().Get("Location"))
+			if tt.wantCode != "" {
+				var problem map[string]any
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+				assert.Equal(t, tt.wantCode, problem["code"])
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func TestListCopies(t *testing.T) {
+	productID := 1
+
+	tests := []struct {
+		name       string
+		id         string
+		wantStatus int
+		wantCode   string
+		wantLen    int
+		setupMock  func(*mockUseCase)
+	}{
+		{
+			name:       "正常系",
+			id:         "1",
+			wantStatus: http.StatusOK,
+			wantLen:    2,
+			setupMock: func(m *mockUseCase) {
+				m.On("ListCopies", mock.Anything, 1).Return([]*entity.Copy{
+					{ID: 3, ProductID: &productID, Status: entity.CopyStatusDraft},
+					{ID: 1, ProductID: &productID, Status: entity.CopyStatusPublished},
+				}, nil)
+			},
+		},
+		{
+			name:       "異常系_存在しない商品",
+			id:         "999",
+			wantStatus: http.StatusNotFound,
+			wantCode:   "product_not_found",
+			setupMock: func(m *mockUseCase) {
+				m.On("ListCopies", mock.Anything, 999).Return(nil, repository.ErrProductNotFound)
+			},
+		},
+		{
+			name:       "異常系_IDが数値でない",
+			id:         "abc",
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_parameter",
+			setupMock:  func(*mockUseCase) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			m := new(mockUseCase)
+			tt.setupMock(m)
+			router := setupTestRouter(NewHandler(m))
+
+			// リクエストの実行
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/products/"+tt.id+"/copies", nil))
+
+			// アサーション
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantCode != "" {
+				var problem map[string]any
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+				assert.Equal(t, tt.wantCode, problem["code"])
+			} else {
+				var got []entity.Copy
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+				assert.Len(t, got, tt.wantLen)
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
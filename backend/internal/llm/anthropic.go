@@ -0,0 +1,247 @@
+package llm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	anthropicVersion   = "2023-06-01"
+	anthropicMaxTokens = 1024
+)
+
+// anthropicGenerator: Anthropic Messages API形式のアダプター
+type anthropicGenerator struct {
+	httpClient *http.Client
+	baseURL    string
+	apiKey     string
+	model      string
+}
+
+type anthropicMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type anthropicRequest struct {
+	Model     string             `json:"model"`
+	MaxTokens int                `json:"max_tokens"`
+	System    string             `json:"system,omitempty"`
+	Messages  []anthropicMessage `json:"messages"`
+	Stream    bool               `json:"stream,omitempty"`
+}
+
+type anthropicResponse struct {
+	Model   string `json:"model"`
+	Content []struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	} `json:"content"`
+	Usage struct {
+		InputTokens  int `json:"input_tokens"`
+		OutputTokens int `json:"output_tokens"`
+	} `json:"usage"`
+}
+
+// anthropicStreamEvent: ストリーミング時に受け取るイベント（使用するフィールドのみ）
+type anthropicStreamEvent struct {
+	Type    string `json:"type"`
+	Message struct {
+		Usage struct {
+			InputTokens int `json:"input_tokens"`
+		} `json:"usage"`
+	} `json:"message"`
+	Delta struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	} `json:"delta"`
+	Usage struct {
+		OutputTokens int `json:"output_tokens"`
+	} `json:"usage"`
+	Error struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+type anthropicErrorResponse struct {
+	Error struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func newAnthropicGenerator(cfg Config) *anthropicGenerator {
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = defaultAnthropicBaseURL
+	}
+	model := cfg.Model
+	if model == "" {
+		model = defaultAnthropicModel
+	}
+
+	return &anthropicGenerator{
+		httpClient: &http.Client{},
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		apiKey:     cfg.APIKey,
+		model:      model,
+	}
+}
+
+func (g *anthropicGenerator) Generate(ctx context.Context, req Request) (*Response, error) {
+	httpResp, err := g.post(ctx, req, false)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp anthropicResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, err
+	}
+
+	var text strings.Builder
+	for _, c := range resp.Content {
+		if c.Type == "text" {
+			text.WriteString(c.Text)
+		}
+	}
+	if text.Len() == 0 {
+		return nil, ErrEmptyResponse
+	}
+
+	usage := Usage{
+		PromptTokens:     resp.Usage.InputTokens,
+		CompletionTokens: resp.Usage.OutputTokens,
+		TotalTokens:      resp.Usage.InputTokens + resp.Usage.OutputTokens,
+	}
+	return newResponse(ProviderAnthropic, g.model, text.String(), usage)
+}
+
+func (g *anthropicGenerator) GenerateStream(ctx context.Context, req Request, onDelta func(delta string) error) (*Response, error) {
+	httpResp, err := g.post(ctx, req, true)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	var (
+		text  strings.Builder
+		usage Usage
+	)
+	scanner := bufio.NewScanner(httpResp.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "data:") {
+			continue
+		}
+
+		var event anthropicStreamEvent
+		if err := json.Unmarshal([]byte(strings.TrimSpace(strings.TrimPrefix(line, "data:"))), &event); err != nil {
+			return nil, err
+		}
+
+		switch event.Type {
+		case "message_start":
+			usage.PromptTokens = event.Message.Usage.InputTokens
+		case "content_block_delta":
+			if event.Delta.Type != "text_delta" || event.Delta.Text == "" {
+				continue
+			}
+			text.WriteString(event.Delta.Text)
+			if err := onDelta(event.Delta.Text); err != nil {
+				return nil, err
+			}
+		case "message_delta":
+			usage.CompletionTokens = event.Usage.OutputTokens
+		case "error":
+			err := fmt.Errorf("anthropic: %s: %s", event.Error.Type, event.Error.Message)
+			switch event.Error.Type {
+			case "rate_limit_error":
+				return nil, ErrRateLimited.Wrap(err)
+			case "overloaded_error", "api_error":
+				return nil, ErrUnavailable.Wrap(err)
+			}
+			return nil, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if text.Len() == 0 {
+		return nil, ErrEmptyResponse
+	}
+
+	usage.TotalTokens = usage.PromptTokens + usage.CompletionTokens
+	return newResponse(ProviderAnthropic, g.model, text.String(), usage)
+}
+
+// post: Messages APIを呼び出し、正常なレスポンスのみを返す
+func (g *anthropicGenerator) post(ctx context.Context, req Request, stream bool) (*http.Response, error) {
+	// systemメッセージはMessages APIではトップレベルのフィールドで渡す
+	body := anthropicRequest{
+		Model:     g.model,
+		MaxTokens: anthropicMaxTokens,
+		Stream:    stream,
+	}
+	var system []string
+	for _, m := range req.Messages {
+		if m.Role == RoleSystem {
+			system = append(system, m.Content)
+			continue
+		}
+		body.Messages = append(body.Messages, anthropicMessage{
+			Role:    string(m.Role),
+			Content: m.Content,
+		})
+	}
+	body.System = strings.Join(system, "\n\n")
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, g.baseURL+"/v1/messages", bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("x-api-key", g.apiKey)
+	httpReq.Header.Set("anthropic-version", anthropicVersion)
+
+	httpResp, err := g.httpClient.Do(httpReq)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		return nil, ErrUnavailable.Wrap(err)
+	}
+
+	if httpResp.StatusCode != http.StatusOK {
+		defer httpResp.Body.Close()
+		respBody, _ := io.ReadAll(httpResp.Body)
+
+		err := fmt.Errorf("anthropic: unexpected status %d", httpResp.StatusCode)
+		var errResp anthropicErrorResponse
+		if jerr := json.Unmarshal(respBody, &errResp); jerr == nil && errResp.Error.Message != "" {
+			err = fmt.Errorf("anthropic: %s (status %d): %s", errResp.Error.Type, httpResp.StatusCode, errResp.Error.Message)
+		}
+		return nil, statusError(httpResp.StatusCode, httpResp.Header.Get("Retry-After"), err)
+	}
+
+	return httpResp, nil
+}
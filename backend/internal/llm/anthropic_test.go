@@ -0,0 +1,97 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnthropicGenerator_Generate(t *testing.T) {
+	req := Request{
+		Messages: []Message{
+			{Role: RoleSystem, Content: "あなたはコピーライターです"},
+			{Role: RoleUser, Content: "コピーを生成してください"},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		status     int
+		body       string
+		want       *Response
+		wantErrMsg string
+		// wantErr: エラーの連鎖に含まれるエラー
+		wantErr error
+	}{
+		{
+			name:   "正常系",
+			status: http.StatusOK,
+			body:   `{"model": "claude-test", "content": [{"type": "text", "text": "{\"title\": \"テストタイトル\", \"description\": \"テスト説明\"}"}], "usage": {"input_tokens": 12, "output_tokens": 8}}`,
+			want: &Response{
+				Title:       "テストタイトル",
+				Description: "テスト説明",
+				Content:     `{"title": "テストタイトル", "description": "テスト説明"}`,
+				Provider:    ProviderAnthropic,
+				Model:       "claude-test",
+				Usage:       Usage{PromptTokens: 12, CompletionTokens: 8, TotalTokens: 20},
+			},
+		},
+		{
+			name:       "異常系_APIエラー",
+			status:     http.StatusTooManyRequests,
+			body:       `{"type": "error", "error": {"type": "rate_limit_error", "message": "rate limited"}}`,
+			wantErrMsg: "LLM provider rate limit exceeded: anthropic: rate_limit_error (status 429): rate limited",
+			wantErr:    ErrRateLimited,
+		},
+		{
+			name:       "異常系_過負荷",
+			status:     529,
+			body:       `{"type": "error", "error": {"type": "overloaded_error", "message": "overloaded"}}`,
+			wantErrMsg: "LLM provider is unavailable: anthropic: overloaded_error (status 529): overloaded",
+			wantErr:    ErrUnavailable,
+		},
+		{
+			name:       "異常系_空のレスポンス",
+			status:     http.StatusOK,
+			body:       `{"model": "claude-test", "content": []}`,
+			wantErrMsg: ErrEmptyResponse.Error(),
+			wantErr:    ErrEmptyResponse,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/v1/messages", r.URL.Path)
+				assert.Equal(t, "test-key", r.Header.Get("x-api-key"))
+				assert.Equal(t, anthropicVersion, r.Header.Get("anthropic-version"))
+
+				var body anthropicRequest
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, "あなたはコピーライターです", body.System)
+				assert.Equal(t, []anthropicMessage{{Role: "user", Content: "コピーを生成してください"}}, body.Messages)
+
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			g := newAnthropicGenerator(Config{APIKey: "test-key", BaseURL: server.URL, Model: "claude-test"})
+			got, err := g.Generate(context.Background(), req)
+
+			if tt.wantErrMsg != "" {
+				assert.EqualError(t, err, tt.wantErrMsg)
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package llm
+
+import (
+	"fmt"
+	"os"
+)
+
+// Provider: LLMプロバイダーの種類
+type Provider string
+
+const (
+	ProviderOpenAI    Provider = "openai"
+	ProviderAzure     Provider = "azure"
+	ProviderAnthropic Provider = "anthropic"
+	// ProviderLocal: Ollama等のOpenAI互換エンドポイント
+	ProviderLocal Provider = "local"
+	// ProviderFake: ネットワークを使用しない決定的な生成（開発・テスト用）
+	ProviderFake Provider = "fake"
+)
+
+const (
+	defaultAnthropicModel   = "claude-3-haiku-20240307"
+	defaultAnthropicBaseURL = "https://api.anthropic.com"
+	defaultLocalModel       = "llama3"
+	defaultLocalBaseURL     = "http://localhost:11434/v1"
+	defaultAzureAPIVersion  = "2023-05-15"
+)
+
+// Config: 生成に使用するプロバイダーの設定
+type Config struct {
+	Provider Provider
+	APIKey   string
+	Model    string
+	BaseURL  string
+	// AzureDeployment: Azure OpenAIのデプロイ名（未指定の場合はModelから導出）
+	AzureDeployment string
+	AzureAPIVersion string
+}
+
+// LoadConfig: 環境変数から設定を読み込む
+//
+// LLM_PROVIDERが未指定の場合は従来通りOpenAIを使用します。
+// APIキーはLLM_API_KEYを優先し、未設定の場合はプロバイダーごとの環境変数を参照します。
+func LoadConfig() Config {
+	cfg := Config{
+		Provider:        Provider(os.Getenv("LLM_PROVIDER")),
+		APIKey:          os.Getenv("LLM_API_KEY"),
+		Model:           os.Getenv("LLM_MODEL"),
+		BaseURL:         os.Getenv("LLM_BASE_URL"),
+		AzureDeployment: os.Getenv("AZURE_OPENAI_DEPLOYMENT"),
+		AzureAPIVersion: os.Getenv("AZURE_OPENAI_API_VERSION"),
+	}
+	if cfg.Provider == "" {
+		cfg.Provider = ProviderOpenAI
+	}
+
+	if cfg.APIKey == "" {
+		switch cfg.Provider {
+		case ProviderOpenAI:
+			cfg.APIKey = os.Getenv("OPENAI_API_KEY")
+		case ProviderAzure:
+			cfg.APIKey = os.Getenv("AZURE_OPENAI_API_KEY")
+		case ProviderAnthropic:
+			cfg.APIKey = os.Getenv("ANTHROPIC_API_KEY")
+		}
+	}
+	if cfg.BaseURL == "" && cfg.Provider == ProviderAzure {
+		cfg.BaseURL = os.Getenv("AZURE_OPENAI_ENDPOINT")
+	}
+
+	return cfg
+}
+
+// New: 設定に応じたGeneratorを生成
+func New(cfg Config) (Generator, error) {
+	switch cfg.Provider {
+	case ProviderOpenAI, "":
+		return newOpenAIGenerator(cfg), nil
+	case ProviderAzure:
+		if cfg.BaseURL == "" {
+			return nil, fmt.Errorf("llm: base URL is required for provider %q", cfg.Provider)
+		}
+		return newAzureGenerator(cfg), nil
+	case ProviderAnthropic:
+		return newAnthropicGenerator(cfg), nil
+	case ProviderLocal:
+		return newLocalGenerator(cfg), nil
+	case ProviderFake:
+		return NewFakeGenerator(), nil
+	default:
+		return nil, fmt.Errorf("llm: unknown provider %q", cfg.Provider)
+	}
+}
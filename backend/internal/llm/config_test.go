@@ -0,0 +1,133 @@
+package llm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want Config
+	}{
+		{
+			name: "正常系_未指定の場合はOpenAI",
+			env: map[string]string{
+				"OPENAI_API_KEY": "openai-key",
+			},
+			want: Config{
+				Provider: ProviderOpenAI,
+				APIKey:   "openai-key",
+			},
+		},
+		{
+			name: "正常系_Azure",
+			env: map[string]string{
+				"LLM_PROVIDER":             "azure",
+				"AZURE_OPENAI_API_KEY":     "azure-key",
+				"AZURE_OPENAI_ENDPOINT":    "https://example.openai.azure.com",
+				"AZURE_OPENAI_DEPLOYMENT":  "copy-gpt",
+				"AZURE_OPENAI_API_VERSION": "2024-02-01",
+			},
+			want: Config{
+				Provider:        ProviderAzure,
+				APIKey:          "azure-key",
+				BaseURL:         "https://example.openai.azure.com",
+				AzureDeployment: "copy-gpt",
+				AzureAPIVersion: "2024-02-01",
+			},
+		},
+		{
+			name: "正常系_LLM_API_KEYを優先",
+			env: map[string]string{
+				"LLM_PROVIDER":      "anthropic",
+				"LLM_API_KEY":       "llm-key",
+				"ANTHROPIC_API_KEY": "anthropic-key",
+				"LLM_MODEL":         "claude-3-5-sonnet-latest",
+			},
+			want: Config{
+				Provider: ProviderAnthropic,
+				APIKey:   "llm-key",
+				Model:    "claude-3-5-sonnet-latest",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{
+				"LLM_PROVIDER", "LLM_API_KEY", "LLM_MODEL", "LLM_BASE_URL",
+				"OPENAI_API_KEY", "AZURE_OPENAI_API_KEY", "AZURE_OPENAI_ENDPOINT",
+				"AZURE_OPENAI_DEPLOYMENT", "AZURE_OPENAI_API_VERSION", "ANTHROPIC_API_KEY",
+			} {
+				t.Setenv(key, "")
+			}
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			assert.Equal(t, tt.want, LoadConfig())
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "正常系_OpenAI",
+			cfg:  Config{Provider: ProviderOpenAI, APIKey: "key"},
+			want: &openAIGenerator{},
+		},
+		{
+			name: "正常系_Azure",
+			cfg:  Config{Provider: ProviderAzure, APIKey: "key", BaseURL: "https://example.openai.azure.com"},
+			want: &openAIGenerator{},
+		},
+		{
+			name: "正常系_Anthropic",
+			cfg:  Config{Provider: ProviderAnthropic, APIKey: "key"},
+			want: &anthropicGenerator{},
+		},
+		{
+			name: "正常系_ローカル",
+			cfg:  Config{Provider: ProviderLocal},
+			want: &openAIGenerator{},
+		},
+		{
+			name:    "異常系_Azureのエンドポイントなし",
+			cfg:     Config{Provider: ProviderAzure, APIKey: "key"},
+			wantErr: true,
+		},
+		{
+			name: "正常系_fake",
+			cfg:  Config{Provider: ProviderFake},
+			want: &fakeGenerator{},
+		},
+		{
+			name:    "異常系_未知のプロバイダー",
+			cfg:     Config{Provider: "unknown"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := New(tt.cfg)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.IsType(t, tt.want, got)
+		})
+	}
+}
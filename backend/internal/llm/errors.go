@@ -0,0 +1,55 @@
+package llm
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/sashabaranov/go-openai"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/domainerr"
+)
+
+var (
+	// ErrEmptyResponse: プロバイダーから生成結果が返らなかった場合のエラー
+	ErrEmptyResponse = domainerr.UpstreamUnavailable("llm_empty_response", "no response from LLM provider")
+	// ErrRateLimited: プロバイダーの呼び出し回数の上限に達した場合のエラー（429）
+	ErrRateLimited = domainerr.RateLimited("llm_rate_limited", "LLM provider rate limit exceeded")
+	// ErrUnavailable: プロバイダーに接続できない、またはプロバイダー側の障害の場合のエラー（5xx）
+	ErrUnavailable = domainerr.UpstreamUnavailable("llm_unavailable", "LLM provider is unavailable")
+)
+
+// statusError: プロバイダーのHTTPステータスに応じてエラーを包む（4xxはリクエストの不具合のためそのまま返す）
+//
+// retryAfterはRetry-Afterヘッダーの値（秒数）で、不明な場合は空文字です。
+func statusError(status int, retryAfter string, err error) error {
+	switch {
+	case status == http.StatusTooManyRequests:
+		e := ErrRateLimited.Wrap(err)
+		if seconds, perr := strconv.Atoi(retryAfter); perr == nil && seconds > 0 {
+			e = e.WithRetryAfter(time.Duration(seconds) * time.Second)
+		}
+		return e
+	case status >= http.StatusInternalServerError:
+		return ErrUnavailable.Wrap(err)
+	default:
+		return err
+	}
+}
+
+// openAIError: go-openaiのエラーをHTTPステータスに応じて包む
+func openAIError(err error) error {
+	var (
+		apiErr *openai.APIError
+		reqErr *openai.RequestError
+	)
+	switch {
+	case errors.As(err, &apiErr):
+		return statusError(apiErr.HTTPStatusCode, "", err)
+	case errors.As(err, &reqErr):
+		return statusError(reqErr.HTTPStatusCode, "", err)
+	default:
+		return err
+	}
+}
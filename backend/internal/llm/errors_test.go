@@ -0,0 +1,94 @@
+package llm
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sashabaranov/go-openai"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/domainerr"
+)
+
+func TestStatusError(t *testing.T) {
+	cause := errors.New("provider error")
+
+	tests := []struct {
+		name           string
+		status         int
+		retryAfter     string
+		wantKind       domainerr.Kind
+		wantRetryAfter time.Duration
+	}{
+		{
+			name:           "正常系_呼び出し回数の上限",
+			status:         http.StatusTooManyRequests,
+			retryAfter:     "30",
+			wantKind:       domainerr.KindRateLimited,
+			wantRetryAfter: 30 * time.Second,
+		},
+		{
+			name:       "正常系_Retry-Afterが日時の場合は設定しない",
+			status:     http.StatusTooManyRequests,
+			retryAfter: "Wed, 21 Oct 2015 07:28:00 GMT",
+			wantKind:   domainerr.KindRateLimited,
+		},
+		{
+			name:     "正常系_プロバイダーの障害",
+			status:   http.StatusServiceUnavailable,
+			wantKind: domainerr.KindUpstreamUnavailable,
+		},
+		{
+			name:     "正常系_リクエストの不具合はそのまま返す",
+			status:   http.StatusBadRequest,
+			wantKind: domainerr.KindInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := statusError(tt.status, tt.retryAfter, cause)
+
+			assert.ErrorIs(t, err, cause)
+			assert.Equal(t, tt.wantKind, domainerr.KindOf(err))
+			if e, ok := domainerr.As(err); ok {
+				assert.Equal(t, tt.wantRetryAfter, e.RetryAfter)
+			}
+		})
+	}
+}
+
+func TestOpenAIError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantKind domainerr.Kind
+	}{
+		{
+			name:     "正常系_APIエラー",
+			err:      &openai.APIError{HTTPStatusCode: http.StatusTooManyRequests, Message: "rate limited"},
+			wantKind: domainerr.KindRateLimited,
+		},
+		{
+			name:     "正常系_リクエストエラー",
+			err:      &openai.RequestError{HTTPStatusCode: http.StatusBadGateway, Err: errors.New("bad gateway")},
+			wantKind: domainerr.KindUpstreamUnavailable,
+		},
+		{
+			name:     "正常系_その他のエラー",
+			err:      errors.New("api error"),
+			wantKind: domainerr.KindInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := openAIError(tt.err)
+
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.wantKind, domainerr.KindOf(err))
+		})
+	}
+}
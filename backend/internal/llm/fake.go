@@ -0,0 +1,253 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	fakeModel = "fake-v1"
+
+	// 本番のプロンプトで要求している文字数制限に合わせる
+	fakeTitleMaxLength   = 20
+	fakeHashtagMaxLength = 10
+	// fakeFieldMaxLength: 組み込み以外のチャネルの項目の文字数
+	fakeFieldMaxLength = 10
+)
+
+// fakeDescriptionLengths: チャネルごとの本文の文字数制限
+var fakeDescriptionLengths = map[string]struct{ min, max int }{
+	"app":   {20, 60},
+	"line":  {50, 100},
+	"pop":   {20, 50},
+	"sns":   {50, 100},
+	"email": {100, 400},
+}
+
+// fakePriceCallouts: POPの価格訴求
+var fakePriceCallouts = []string{"今だけの特別価格", "お買い得価格でご奉仕"}
+
+// fakeOutput: チャネル固有の項目を含む出力
+//
+// 組み込み以外のチャネルの項目はFieldsに設定し、JSONではトップレベルの項目として出力します。
+type fakeOutput struct {
+	Title        string            `json:"title"`
+	Description  string            `json:"description"`
+	AltText      string            `json:"altText,omitempty"`
+	ButtonLabel  string            `json:"buttonLabel,omitempty"`
+	PriceCallout string            `json:"priceCallout,omitempty"`
+	Hashtags     []string          `json:"hashtags,omitempty"`
+	Preheader    string            `json:"preheader,omitempty"`
+	Fields       map[string]string `json:"-"`
+}
+
+func (o fakeOutput) MarshalJSON() ([]byte, error) {
+	type output fakeOutput
+	b, err := json.Marshal(output(o))
+	if err != nil || len(o.Fields) == 0 {
+		return b, err
+	}
+
+	var m map[string]any
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	for k, v := range o.Fields {
+		m[k] = v
+	}
+	return json.Marshal(m)
+}
+
+// fakeChannelLeads: チャネルごとの書き出し
+var fakeChannelLeads = map[string][]string{
+	"app":   {"アプリ限定のお知らせ！", "アプリからの注文がお得！"},
+	"line":  {"LINE友だち限定のご案内です。", "LINEをご覧のあなたへ。"},
+	"pop":   {"店頭イチオシ！", "当店スタッフのおすすめ！"},
+	"sns":   {"話題の新定番！", "みんなが注目！"},
+	"email": {"いつもご利用ありがとうございます。", "本日は特別なご案内です。"},
+}
+
+// fakeToneStyles: トーンごとのタイトルと締めの一文
+var fakeToneStyles = map[string]struct {
+	titles   []string
+	closings []string
+}{
+	"pop": {
+		titles:   []string{"%sでワクワク！", "今こそ%s！"},
+		closings: []string{"今すぐチェックしてね！", "お見逃しなく！"},
+	},
+	"trust": {
+		titles:   []string{"確かな品質の%s", "安心の%s"},
+		closings: []string{"品質にこだわる方に自信を持っておすすめします。", "長く安心してお使いいただけます。"},
+	},
+	"value": {
+		titles:   []string{"お得に%s", "%sがお買い得"},
+		closings: []string{"この機会にお得に手に入れてください。", "コスパ重視の方に最適です。"},
+	},
+	"luxury": {
+		titles:   []string{"上質な%sを", "特別な%s"},
+		closings: []string{"日常にワンランク上のひとときを。", "上質な時間をお届けします。"},
+	},
+	"casual": {
+		titles:   []string{"%s、はじめよう", "気軽に%s"},
+		closings: []string{"気軽に試してみてね。", "毎日がちょっと楽しくなるよ。"},
+	},
+}
+
+const fakeFiller = "詳しくは公式サイトをご覧ください。"
+
+// fakeGenerator: ネットワークを使用せず、入力から決定的にコピーを生成する
+type fakeGenerator struct{}
+
+// NewFakeGenerator: オフライン用のGeneratorを生成
+func NewFakeGenerator() Generator {
+	return &fakeGenerator{}
+}
+
+func (g *fakeGenerator) Generate(ctx context.Context, req Request) (*Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	brief := req.Brief
+	// 同じ入力に対しては常に同じ候補を選ぶ（候補番号ごとに組み合わせを変える）
+	seed := fakeSeed(brief, req.Messages) + uint32(req.Variant)
+
+	productName := brief.ProductName
+	if productName == "" {
+		productName = "おすすめ商品"
+	}
+
+	style, ok := fakeToneStyles[brief.Tone]
+	if !ok {
+		style = fakeToneStyles["casual"]
+	}
+	channel := brief.Channel
+	if _, ok := fakeChannelLeads[channel]; !ok {
+		channel = "sns"
+	}
+	leads := fakeChannelLeads[channel]
+	lengths := fakeDescriptionLengths[channel]
+
+	title := fmt.Sprintf(pick(style.titles, seed), productName)
+	title = truncateRunes(title, fakeTitleMaxLength)
+
+	var desc strings.Builder
+	desc.WriteString(pick(leads, seed>>1))
+	if brief.Target != "" {
+		desc.WriteString(brief.Target + "の方へ。")
+	}
+	if brief.ProductFeatures != "" {
+		desc.WriteString(brief.ProductFeatures + "が魅力の")
+	}
+	desc.WriteString(productName + "。")
+	desc.WriteString(pick(style.closings, seed>>2))
+
+	description := desc.String()
+	for utf8.RuneCountInString(description) < lengths.min {
+		description += fakeFiller
+	}
+	if utf8.RuneCountInString(description) > lengths.max {
+		description = truncateRunes(description, lengths.max-1) + "。"
+	}
+
+	out := fakeOutput{Title: title, Description: description}
+	switch channel {
+	case "line":
+		out.AltText = title
+		out.ButtonLabel = "詳しく見る"
+	case "pop":
+		out.PriceCallout = pick(fakePriceCallouts, seed>>3)
+	case "sns":
+		out.Hashtags = []string{truncateRunes(fakeHashtag(productName), fakeHashtagMaxLength), "新商品"}
+	case "email":
+		out.Preheader = truncateRunes(productName+"のご案内です。", fakeTitleMaxLength)
+	}
+	if len(brief.Fields) > 0 {
+		// 組み込み以外のチャネルの項目は長さの制限がわからないため、短い文言にする
+		out.Fields = make(map[string]string, len(brief.Fields))
+		for _, name := range brief.Fields {
+			out.Fields[name] = truncateRunes(productName, fakeFieldMaxLength)
+		}
+	}
+
+	content, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := Usage{CompletionTokens: utf8.RuneCount(content)}
+	for _, m := range req.Messages {
+		usage.PromptTokens += utf8.RuneCountInString(m.Content)
+	}
+	usage.TotalTokens = usage.PromptTokens + usage.CompletionTokens
+
+	return newResponse(ProviderFake, fakeModel, string(content), usage)
+}
+
+// fakeChunkSize: ストリーミング時に1回で通知する文字数
+const fakeChunkSize = 8
+
+func (g *fakeGenerator) GenerateStream(ctx context.Context, req Request, onDelta func(delta string) error) (*Response, error) {
+	resp, err := g.Generate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	runes := []rune(resp.Content)
+	for start := 0; start < len(runes); start += fakeChunkSize {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		end := start + fakeChunkSize
+		if end > len(runes) {
+			end = len(runes)
+		}
+		if err := onDelta(string(runes[start:end])); err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+func fakeSeed(brief Brief, messages []Message) uint32 {
+	h := fnv.New32a()
+	for _, s := range []string{brief.ProductName, brief.ProductFeatures, brief.Target, brief.Channel, brief.Tone} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	// Briefが空の場合はプロンプトの内容で揺らす
+	if brief.empty() {
+		for _, m := range messages {
+			h.Write([]byte(m.Content))
+		}
+	}
+	return h.Sum32()
+}
+
+// fakeHashtag: ハッシュタグに使用できない空白と#を取り除く
+func fakeHashtag(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '　', '#', '＃':
+			return -1
+		}
+		return r
+	}, s)
+}
+
+func pick(candidates []string, seed uint32) string {
+	return candidates[int(seed%uint32(len(candidates)))]
+}
+
+func truncateRunes(s string, max int) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	return string([]rune(s)[:max])
+}
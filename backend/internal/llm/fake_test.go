@@ -0,0 +1,161 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFakeGenerator_Generate(t *testing.T) {
+	tests := []struct {
+		name         string
+		brief        Brief
+		wantTitle    string
+		wantContains []string
+	}{
+		{
+			name: "アプリ_ポップ",
+			brief: Brief{
+				ProductName:     "冷感Tシャツ",
+				ProductFeatures: "接触冷感素材",
+				Target:          "20代男性",
+				Channel:         "app",
+				Tone:            "pop",
+			},
+			wantContains: []string{"アプリ", "20代男性の方へ。", "接触冷感素材が魅力の冷感Tシャツ。"},
+		},
+		{
+			name: "メール_信頼",
+			brief: Brief{
+				ProductName:     "国産はちみつ",
+				ProductFeatures: "無添加",
+				Target:          "40代女性",
+				Channel:         "email",
+				Tone:            "trust",
+			},
+			wantContains: []string{"40代女性の方へ。", "無添加が魅力の国産はちみつ。"},
+		},
+		{
+			name: "長い入力は文字数制限内に収める",
+			brief: Brief{
+				ProductName:     "とても長い商品名が付いたプレミアムオーガニックコットンバスタオル",
+				ProductFeatures: "今治産の最高級オーガニックコットンを贅沢に使用し、吸水性と速乾性を両立させた、ホテル仕様の厚手でふんわりした肌触り",
+				Target:          "上質な暮らしを求める30代から50代の共働き夫婦",
+				Channel:         "line",
+				Tone:            "luxury",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewFakeGenerator()
+			req := Request{
+				Messages: []Message{{Role: RoleUser, Content: "コピーを生成してください"}},
+				Brief:    tt.brief,
+			}
+
+			got, err := g.Generate(context.Background(), req)
+			require.NoError(t, err)
+
+			// 同じ入力に対しては同じ出力になること
+			again, err := g.Generate(context.Background(), req)
+			require.NoError(t, err)
+			assert.Equal(t, got, again)
+
+			assert.Equal(t, ProviderFake, got.Provider)
+			assert.LessOrEqual(t, utf8.RuneCountInString(got.Title), 20)
+			// 本文はチャネルごとの文字数制限内であること
+			lengths := fakeDescriptionLengths[tt.brief.Channel]
+			assert.GreaterOrEqual(t, utf8.RuneCountInString(got.Description), lengths.min)
+			assert.LessOrEqual(t, utf8.RuneCountInString(got.Description), lengths.max)
+			for _, s := range tt.wantContains {
+				assert.Contains(t, got.Description, s)
+			}
+
+			// Contentはプロンプトで要求しているJSON形式であること
+			var out fakeOutput
+			require.NoError(t, json.Unmarshal([]byte(got.Content), &out))
+			assert.Equal(t, got.Title, out.Title)
+			assert.Equal(t, got.Description, out.Description)
+		})
+	}
+
+	t.Run("トーンによってタイトルが変わる", func(t *testing.T) {
+		g := NewFakeGenerator()
+		brief := Brief{ProductName: "コーヒー", Channel: "sns", Tone: "luxury"}
+		luxury, err := g.Generate(context.Background(), Request{Brief: brief})
+		require.NoError(t, err)
+
+		brief.Tone = "value"
+		value, err := g.Generate(context.Background(), Request{Brief: brief})
+		require.NoError(t, err)
+
+		assert.NotEqual(t, luxury.Title, value.Title)
+	})
+
+	t.Run("キャンセル済みのコンテキスト", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		got, err := NewFakeGenerator().Generate(ctx, Request{})
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Nil(t, got)
+	})
+}
+
+func TestFakeGenerator_ChannelOutput(t *testing.T) {
+	tests := []struct {
+		channel string
+		check   func(t *testing.T, out fakeOutput)
+	}{
+		{
+			channel: "app",
+			check: func(t *testing.T, out fakeOutput) {
+				assert.Equal(t, fakeOutput{Title: out.Title, Description: out.Description}, out)
+			},
+		},
+		{
+			channel: "line",
+			check: func(t *testing.T, out fakeOutput) {
+				assert.NotEmpty(t, out.AltText)
+				assert.NotEmpty(t, out.ButtonLabel)
+			},
+		},
+		{
+			channel: "pop",
+			check: func(t *testing.T, out fakeOutput) {
+				assert.Contains(t, fakePriceCallouts, out.PriceCallout)
+			},
+		},
+		{
+			channel: "sns",
+			check: func(t *testing.T, out fakeOutput) {
+				// ハッシュタグには空白や#を含めない
+				assert.Equal(t, []string{"プレミアムコーヒー", "新商品"}, out.Hashtags)
+			},
+		},
+		{
+			channel: "email",
+			check: func(t *testing.T, out fakeOutput) {
+				assert.Equal(t, "#プレミアム コーヒーのご案内です。", out.Preheader)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.channel, func(t *testing.T) {
+			brief := Brief{ProductName: "#プレミアム コーヒー", Channel: tt.channel, Tone: "luxury"}
+			got, err := NewFakeGenerator().Generate(context.Background(), Request{Brief: brief})
+			require.NoError(t, err)
+
+			var out fakeOutput
+			require.NoError(t, json.Unmarshal([]byte(got.Content), &out))
+			tt.check(t, out)
+		})
+	}
+}
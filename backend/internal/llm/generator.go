@@ -0,0 +1,107 @@
+package llm
+
+import (
+	"context"
+)
+
+// Role: メッセージの発話者
+type Role string
+
+const (
+	RoleSystem    Role = "system"
+	RoleUser      Role = "user"
+	RoleAssistant Role = "assistant"
+)
+
+// Message: プロンプトを構成するメッセージ
+type Message struct {
+	Role    Role
+	Content string
+}
+
+// Brief: 生成対象の構造化された入力
+//
+// 実プロバイダーはMessagesのみを使用しますが、オフラインのfakeプロバイダーは
+// Briefからチャネル・トーンに応じた出力を組み立てます。
+type Brief struct {
+	ProductName     string
+	ProductFeatures string
+	Target          string
+	Channel         string
+	Tone            string
+	// Fields: 組み込み以外のチャネルでtitle・description以外に出力する項目
+	Fields []string
+}
+
+// empty: 構造化された入力がないかどうか
+func (b Brief) empty() bool {
+	return b.ProductName == "" && b.ProductFeatures == "" && b.Target == "" && b.Channel == "" && b.Tone == "" && len(b.Fields) == 0
+}
+
+// Request: プロバイダー非依存の生成リクエスト
+type Request struct {
+	Messages []Message
+	Brief    Brief
+	// Variant: 複数候補を生成する場合の候補番号（0始まり）
+	Variant int
+}
+
+// Usage: トークン使用量
+type Usage struct {
+	PromptTokens     int `json:"promptTokens"`
+	CompletionTokens int `json:"completionTokens"`
+	TotalTokens      int `json:"totalTokens"`
+}
+
+// Response: プロバイダー非依存の生成結果
+type Response struct {
+	Title       string
+	Description string
+	// Content: モデルが返した生のテキスト
+	Content  string
+	Provider Provider
+	Model    string
+	Usage    Usage
+}
+
+// Generator: 販促コピーを生成するLLMプロバイダーの抽象
+//
+// 各アダプターはプロバイダー固有のリクエスト/レスポンス型を内部に閉じ込め、
+// ユースケース層にはRequest/Responseのみを公開します。
+type Generator interface {
+	Generate(ctx context.Context, req Request) (*Response, error)
+}
+
+// output: モデルに要求しているJSON出力
+type output struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+// decodeOutput: モデルの出力をタイトルと本文に変換
+//
+// 解析できない場合は*ParseErrorを返します。必須項目や文字数の検証は呼び出し側で行います。
+func decodeOutput(content string) (output, error) {
+	var out output
+	if err := decodeJSON(content, &out); err != nil {
+		return output{}, err
+	}
+	return out, nil
+}
+
+// newResponse: 生の出力からResponseを組み立てる
+func newResponse(provider Provider, model, content string, usage Usage) (*Response, error) {
+	out, err := decodeOutput(content)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Title:       out.Title,
+		Description: out.Description,
+		Content:     content,
+		Provider:    provider,
+		Model:       model,
+		Usage:       usage,
+	}, nil
+}
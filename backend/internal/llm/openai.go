@@ -0,0 +1,157 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/sashabaranov/go-openai"
+)
+
+// chatCompletionClient: go-openaiクライアントのうち使用するメソッド
+type chatCompletionClient interface {
+	CreateChatCompletion(ctx context.Context, req openai.ChatCompletionRequest) (openai.ChatCompletionResponse, error)
+	CreateChatCompletionStream(ctx context.Context, req openai.ChatCompletionRequest) (*openai.ChatCompletionStream, error)
+}
+
+// openAIGenerator: OpenAI互換APIのアダプター（OpenAI / Azure OpenAI / ローカル）
+type openAIGenerator struct {
+	client   chatCompletionClient
+	provider Provider
+	model    string
+}
+
+func newOpenAIGenerator(cfg Config) *openAIGenerator {
+	clientConfig := openai.DefaultConfig(cfg.APIKey)
+	if cfg.BaseURL != "" {
+		clientConfig.BaseURL = cfg.BaseURL
+	}
+
+	model := cfg.Model
+	if model == "" {
+		model = openai.GPT3Dot5Turbo
+	}
+
+	return &openAIGenerator{
+		client:   openai.NewClientWithConfig(clientConfig),
+		provider: ProviderOpenAI,
+		model:    model,
+	}
+}
+
+func newAzureGenerator(cfg Config) *openAIGenerator {
+	clientConfig := openai.DefaultAzureConfig(cfg.APIKey, cfg.BaseURL)
+	if cfg.AzureAPIVersion != "" {
+		clientConfig.APIVersion = cfg.AzureAPIVersion
+	} else {
+		clientConfig.APIVersion = defaultAzureAPIVersion
+	}
+	if cfg.AzureDeployment != "" {
+		deployment := cfg.AzureDeployment
+		clientConfig.AzureModelMapperFunc = func(string) string { return deployment }
+	}
+
+	model := cfg.Model
+	if model == "" {
+		model = openai.GPT3Dot5Turbo
+	}
+
+	return &openAIGenerator{
+		client:   openai.NewClientWithConfig(clientConfig),
+		provider: ProviderAzure,
+		model:    model,
+	}
+}
+
+func newLocalGenerator(cfg Config) *openAIGenerator {
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = defaultLocalBaseURL
+	}
+	// ローカルのエンドポイントはAPIキーを検証しないことが多いが、空文字は送らない
+	apiKey := cfg.APIKey
+	if apiKey == "" {
+		apiKey = "local"
+	}
+	clientConfig := openai.DefaultConfig(apiKey)
+	clientConfig.BaseURL = baseURL
+
+	model := cfg.Model
+	if model == "" {
+		model = defaultLocalModel
+	}
+
+	return &openAIGenerator{
+		client:   openai.NewClientWithConfig(clientConfig),
+		provider: ProviderLocal,
+		model:    model,
+	}
+}
+
+func (g *openAIGenerator) Generate(ctx context.Context, req Request) (*Response, error) {
+	resp, err := g.client.CreateChatCompletion(ctx, g.newChatCompletionRequest(req))
+	if err != nil {
+		return nil, openAIError(err)
+	}
+
+	if len(resp.Choices) == 0 {
+		return nil, ErrEmptyResponse
+	}
+
+	usage := Usage{
+		PromptTokens:     resp.Usage.PromptTokens,
+		CompletionTokens: resp.Usage.CompletionTokens,
+		TotalTokens:      resp.Usage.TotalTokens,
+	}
+	return newResponse(g.provider, g.model, resp.Choices[0].Message.Content, usage)
+}
+
+func (g *openAIGenerator) GenerateStream(ctx context.Context, req Request, onDelta func(delta string) error) (*Response, error) {
+	stream, err := g.client.CreateChatCompletionStream(ctx, g.newChatCompletionRequest(req))
+	if err != nil {
+		return nil, openAIError(err)
+	}
+	defer stream.Close()
+
+	var content strings.Builder
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, openAIError(err)
+		}
+		if len(chunk.Choices) == 0 || chunk.Choices[0].Delta.Content == "" {
+			continue
+		}
+
+		delta := chunk.Choices[0].Delta.Content
+		content.WriteString(delta)
+		if err := onDelta(delta); err != nil {
+			return nil, err
+		}
+	}
+
+	if content.Len() == 0 {
+		return nil, ErrEmptyResponse
+	}
+	// ストリーミングではトークン使用量が返らないため空のまま
+	return newResponse(g.provider, g.model, content.String(), Usage{})
+}
+
+func (g *openAIGenerator) newChatCompletionRequest(req Request) openai.ChatCompletionRequest {
+	messages := make([]openai.ChatCompletionMessage, 0, len(req.Messages))
+	for _, m := range req.Messages {
+		messages = append(messages, openai.ChatCompletionMessage{
+			Role:    string(m.Role),
+			Content: m.Content,
+		})
+	}
+
+	return openai.ChatCompletionRequest{
+		Model:    g.model,
+		Messages: messages,
+	}
+}
@@ -0,0 +1,137 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sashabaranov/go-openai"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// go-openaiクライアントのモック
+type mockChatCompletionClient struct {
+	mock.Mock
+}
+
+func (m *mockChatCompletionClient) CreateChatCompletion(ctx context.Context, req openai.ChatCompletionRequest) (openai.ChatCompletionResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(openai.ChatCompletionResponse), args.Error(1)
+}
+
+func (m *mockChatCompletionClient) CreateChatCompletionStream(ctx context.Context, req openai.ChatCompletionRequest) (*openai.ChatCompletionStream, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*openai.ChatCompletionStream), args.Error(1)
+}
+
+func TestOpenAIGenerator_Generate(t *testing.T) {
+	req := Request{
+		Messages: []Message{
+			{Role: RoleSystem, Content: "あなたはコピーライターです"},
+			{Role: RoleUser, Content: "コピーを生成してください"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(*mockChatCompletionClient)
+		want      *Response
+		wantErr   error
+	}{
+		{
+			name: "正常系",
+			setupMock: func(m *mockChatCompletionClient) {
+				m.On("CreateChatCompletion", mock.Anything, openai.ChatCompletionRequest{
+					Model: "gpt-test",
+					Messages: []openai.ChatCompletionMessage{
+						{Role: "system", Content: "あなたはコピーライターです"},
+						{Role: "user", Content: "コピーを生成してください"},
+					},
+				}).Return(openai.ChatCompletionResponse{
+					Choices: []openai.ChatCompletionChoice{
+						{Message: openai.ChatCompletionMessage{Content: `{"title": "テストタイトル", "description": "テスト説明"}`}},
+					},
+					Usage: openai.Usage{PromptTokens: 10, CompletionTokens: 20, TotalTokens: 30},
+				}, nil)
+			},
+			want: &Response{
+				Title:       "テストタイトル",
+				Description: "テスト説明",
+				Content:     `{"title": "テストタイトル", "description": "テスト説明"}`,
+				Provider:    ProviderOpenAI,
+				Model:       "gpt-test",
+				Usage:       Usage{PromptTokens: 10, CompletionTokens: 20, TotalTokens: 30},
+			},
+		},
+		{
+			name: "異常系_空のレスポンス",
+			setupMock: func(m *mockChatCompletionClient) {
+				m.On("CreateChatCompletion", mock.Anything, mock.Anything).Return(openai.ChatCompletionResponse{}, nil)
+			},
+			wantErr: ErrEmptyResponse,
+		},
+		{
+			name: "異常系_APIエラー",
+			setupMock: func(m *mockChatCompletionClient) {
+				m.On("CreateChatCompletion", mock.Anything, mock.Anything).Return(openai.ChatCompletionResponse{}, errors.New("api error"))
+			},
+			wantErr: errors.New("api error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := new(mockChatCompletionClient)
+			tt.setupMock(client)
+
+			g := &openAIGenerator{client: client, provider: ProviderOpenAI, model: "gpt-test"}
+			got, err := g.Generate(context.Background(), req)
+
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func TestLocalGenerator_Generate(t *testing.T) {
+	// OpenAI互換エンドポイントのスタブ
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/chat/completions", r.URL.Path)
+
+		var body openai.ChatCompletionRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "local-model", body.Model)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(openai.ChatCompletionResponse{
+			Model: "local-model",
+			Choices: []openai.ChatCompletionChoice{
+				{Message: openai.ChatCompletionMessage{Role: "assistant", Content: `{"title": "夏の新作", "description": "涼しい着心地"}`}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	g, err := New(Config{Provider: ProviderLocal, BaseURL: server.URL + "/v1", Model: "local-model"})
+	require.NoError(t, err)
+
+	got, err := g.Generate(context.Background(), Request{Messages: []Message{{Role: RoleUser, Content: "生成"}}})
+	require.NoError(t, err)
+	assert.Equal(t, "夏の新作", got.Title)
+	assert.Equal(t, "涼しい着心地", got.Description)
+	assert.Equal(t, ProviderLocal, got.Provider)
+}
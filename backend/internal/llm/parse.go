@@ -0,0 +1,101 @@
+package llm
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// ErrNoJSONObject: 出力にJSONオブジェクトが含まれていない場合のエラー
+var ErrNoJSONObject = errors.New("no JSON object found in output")
+
+// ParseError: モデルの出力を解析できなかった場合のエラー
+//
+// 再生成の際にモデルへ前回の出力を示せるよう、生の出力を保持します。
+type ParseError struct {
+	Content string
+	Err     error
+}
+
+func (e *ParseError) Error() string {
+	return "llm: failed to parse output: " + e.Err.Error()
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ExtractJSON: モデルの出力から最初のJSONオブジェクトを取り出す
+//
+// コードフェンス（```json ... ```）や前後の説明文が付いていても、
+// 最初に現れる対応の取れた{...}を返します。
+func ExtractJSON(content string) (string, error) {
+	content = stripCodeFence(content)
+
+	start := strings.IndexByte(content, '{')
+	if start < 0 {
+		return "", ErrNoJSONObject
+	}
+
+	depth := 0
+	inString := false
+	escaped := false
+	for i := start; i < len(content); i++ {
+		c := content[i]
+		if inString {
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				inString = false
+			}
+			continue
+		}
+
+		switch c {
+		case '"':
+			inString = true
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return content[start : i+1], nil
+			}
+		}
+	}
+
+	return "", ErrNoJSONObject
+}
+
+// stripCodeFence: 最初のコードフェンスで囲まれた部分があれば、その中身のみを返す
+func stripCodeFence(content string) string {
+	start := strings.Index(content, "```")
+	if start < 0 {
+		return content
+	}
+
+	body := content[start+3:]
+	// 言語指定（```json）を読み飛ばす
+	if nl := strings.IndexByte(body, '\n'); nl >= 0 {
+		body = body[nl+1:]
+	}
+	if end := strings.Index(body, "```"); end >= 0 {
+		body = body[:end]
+	}
+	return body
+}
+
+// decodeJSON: 出力から取り出したJSONオブジェクトをvに変換
+func decodeJSON(content string, v interface{}) error {
+	obj, err := ExtractJSON(content)
+	if err != nil {
+		return &ParseError{Content: content, Err: err}
+	}
+	if err := json.Unmarshal([]byte(obj), v); err != nil {
+		return &ParseError{Content: content, Err: err}
+	}
+	return nil
+}
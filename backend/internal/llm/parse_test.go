@@ -0,0 +1,78 @@
+package llm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+		wantErr error
+	}{
+		{
+			name:    "JSONのみ",
+			content: `{"title": "夏の新作", "description": "涼しい"}`,
+			want:    `{"title": "夏の新作", "description": "涼しい"}`,
+		},
+		{
+			name:    "コードフェンス付き",
+			content: "```json\n{\"title\": \"夏の新作\"}\n```",
+			want:    `{"title": "夏の新作"}`,
+		},
+		{
+			name:    "前後に説明文",
+			content: "以下のとおり作成しました。\n{\"title\": \"夏の新作\"}\nいかがでしょうか？{\"title\": \"別案\"}",
+			want:    `{"title": "夏の新作"}`,
+		},
+		{
+			name:    "文字列内の括弧とエスケープ",
+			content: `{"title": "{限定} \"夏\"", "meta": {"a": "}"}} 以上`,
+			want:    `{"title": "{限定} \"夏\"", "meta": {"a": "}"}}`,
+		},
+		{
+			name:    "JSONなし",
+			content: "申し訳ありませんが作成できません。",
+			wantErr: ErrNoJSONObject,
+		},
+		{
+			name:    "閉じていないJSON",
+			content: `{"title": "夏の新作"`,
+			wantErr: ErrNoJSONObject,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ExtractJSON(tt.content)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDecodeOutput(t *testing.T) {
+	t.Run("正常系_説明文付き", func(t *testing.T) {
+		got, err := decodeOutput("はい。\n```json\n{\"title\": \"夏の新作\", \"description\": \"涼しい\"}\n```")
+
+		assert.NoError(t, err)
+		assert.Equal(t, output{Title: "夏の新作", Description: "涼しい"}, got)
+	})
+
+	t.Run("異常系_ParseErrorに生の出力を保持", func(t *testing.T) {
+		content := `{"title": 123}`
+		_, err := decodeOutput(content)
+
+		var parseErr *ParseError
+		assert.ErrorAs(t, err, &parseErr)
+		assert.Equal(t, content, parseErr.Content)
+	})
+}
@@ -0,0 +1,28 @@
+package llm
+
+import "context"
+
+// StreamGenerator: 生成途中のテキストを逐次受け取れるGenerator
+//
+// onDeltaがエラーを返した場合は生成を中断し、そのエラーを返します。
+type StreamGenerator interface {
+	Generator
+	GenerateStream(ctx context.Context, req Request, onDelta func(delta string) error) (*Response, error)
+}
+
+// GenerateStream: ストリーミングに対応していればストリーミングで生成し、
+// 対応していない場合は生成後に全文を1回で通知する
+func GenerateStream(ctx context.Context, g Generator, req Request, onDelta func(delta string) error) (*Response, error) {
+	if sg, ok := g.(StreamGenerator); ok {
+		return sg.GenerateStream(ctx, req, onDelta)
+	}
+
+	resp, err := g.Generate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := onDelta(resp.Content); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
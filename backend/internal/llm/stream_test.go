@@ -0,0 +1,120 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ストリーミングに対応していないGenerator
+type staticGenerator struct {
+	resp *Response
+}
+
+func (g *staticGenerator) Generate(ctx context.Context, req Request) (*Response, error) {
+	return g.resp, nil
+}
+
+func TestGenerateStream(t *testing.T) {
+	t.Run("非対応のGeneratorは全文を1回で通知", func(t *testing.T) {
+		g := &staticGenerator{resp: &Response{Title: "タイトル", Content: `{"title": "タイトル"}`}}
+
+		var deltas []string
+		got, err := GenerateStream(context.Background(), g, Request{}, func(delta string) error {
+			deltas = append(deltas, delta)
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, g.resp, got)
+		assert.Equal(t, []string{`{"title": "タイトル"}`}, deltas)
+	})
+
+	t.Run("fakeは分割して通知", func(t *testing.T) {
+		g := NewFakeGenerator()
+		req := Request{Brief: Brief{ProductName: "テスト商品", Channel: "app", Tone: "pop"}}
+
+		var content strings.Builder
+		count := 0
+		got, err := GenerateStream(context.Background(), g, req, func(delta string) error {
+			content.WriteString(delta)
+			count++
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, got.Content, content.String())
+		assert.Greater(t, count, 1)
+	})
+
+	t.Run("コールバックのエラーで中断", func(t *testing.T) {
+		stop := errors.New("stop")
+		got, err := GenerateStream(context.Background(), NewFakeGenerator(), Request{}, func(delta string) error {
+			return stop
+		})
+
+		assert.ErrorIs(t, err, stop)
+		assert.Nil(t, got)
+	})
+}
+
+func TestOpenAIGenerator_GenerateStream(t *testing.T) {
+	chunks := []string{`{"title": "夏`, `の新作", "desc`, `ription": "涼しい"}`}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		for _, chunk := range chunks {
+			fmt.Fprintf(w, "data: {\"choices\": [{\"index\": 0, \"delta\": {\"content\": %q}}]}\n\n", chunk)
+		}
+		fmt.Fprint(w, "data: [DONE]\n\n")
+	}))
+	defer server.Close()
+
+	g, err := New(Config{Provider: ProviderLocal, BaseURL: server.URL + "/v1", Model: "local-model"})
+	require.NoError(t, err)
+
+	var deltas []string
+	got, err := g.(StreamGenerator).GenerateStream(context.Background(), Request{}, func(delta string) error {
+		deltas = append(deltas, delta)
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, chunks, deltas)
+	assert.Equal(t, "夏の新作", got.Title)
+	assert.Equal(t, "涼しい", got.Description)
+}
+
+func TestAnthropicGenerator_GenerateStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, "event: message_start\ndata: {\"type\": \"message_start\", \"message\": {\"usage\": {\"input_tokens\": 10}}}\n\n")
+		for _, chunk := range []string{`{"title": "冬`, `の新作", "description": "暖かい"}`} {
+			fmt.Fprintf(w, "event: content_block_delta\ndata: {\"type\": \"content_block_delta\", \"delta\": {\"type\": \"text_delta\", \"text\": %q}}\n\n", chunk)
+		}
+		fmt.Fprint(w, "event: message_delta\ndata: {\"type\": \"message_delta\", \"usage\": {\"output_tokens\": 5}}\n\n")
+		fmt.Fprint(w, "event: message_stop\ndata: {\"type\": \"message_stop\"}\n\n")
+	}))
+	defer server.Close()
+
+	g := newAnthropicGenerator(Config{APIKey: "test-key", BaseURL: server.URL, Model: "claude-test"})
+
+	count := 0
+	got, err := g.GenerateStream(context.Background(), Request{}, func(delta string) error {
+		count++
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, "冬の新作", got.Title)
+	assert.Equal(t, "暖かい", got.Description)
+	assert.Equal(t, Usage{PromptTokens: 10, CompletionTokens: 5, TotalTokens: 15}, got.Usage)
+}
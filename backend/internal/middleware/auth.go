@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/auth"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+)
+
+// APIKeyHeader: APIキーを指定するヘッダー（Authorization: Bearerでも指定できる）
+const APIKeyHeader = "X-API-Key"
+
+// Authenticator: トークン・APIキーから呼び出し元を特定する
+type Authenticator interface {
+	AuthenticateToken(ctx context.Context, token string) (*entity.Identity, error)
+	AuthenticateAPIKey(ctx context.Context, key string) (*entity.Identity, error)
+}
+
+// Authenticate: リクエストの呼び出し元を特定し、リクエストのコンテキストに設定する
+//
+// Authorization: Bearer <トークンまたはAPIキー>、またはX-API-Keyヘッダーで認証します。
+// 認証情報がない場合は匿名の呼び出しとして続行し、認証情報が不正な場合は401を返します。
+func Authenticate(a Authenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		credential := bearerToken(c.GetHeader("Authorization"))
+		if credential == "" {
+			credential = strings.TrimSpace(c.GetHeader(APIKeyHeader))
+		}
+		if credential == "" {
+			c.Next()
+			return
+		}
+
+		ctx := c.Request.Context()
+		var identity *entity.Identity
+		var err error
+		if auth.IsAPIKey(credential) {
+			identity, err = a.AuthenticateAPIKey(ctx, credential)
+		} else {
+			identity, err = a.AuthenticateToken(ctx, credential)
+		}
+		if err != nil {
+			_ = c.Error(err)
+			c.Abort()
+			return
+		}
+		c.Request = c.Request.WithContext(auth.WithIdentity(ctx, identity))
+		c.Next()
+	}
+}
+
+// RequireAuth: 認証されていないリクエストを401で拒否する（Authenticateの後に使用する）
+//
+// exceptのいずれかに該当するリクエストは認証せずに受け付けます。
+// その場合も、未公開のコピーの参照などはハンドラーでauth.RequireIdentityにより拒否します。
+func RequireAuth(except ...func(*gin.Context) bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if auth.IdentityFrom(c.Request.Context()) == nil {
+			for _, skip := range except {
+				if skip(c) {
+					c.Request = c.Request.WithContext(auth.WithAuthRequired(c.Request.Context()))
+					c.Next()
+					return
+				}
+			}
+			_ = c.Error(auth.ErrAuthenticationRequired)
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// SafeMethod: 参照のみのリクエスト（GET / HEAD / OPTIONS）かどうか
+func SafeMethod(c *gin.Context) bool {
+	switch c.Request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// SafeMethodExcept: 参照のみのリクエストのうち、ルートのパスがpathsのいずれとも一致しないものかどうか
+func SafeMethodExcept(paths ...string) func(*gin.Context) bool {
+	private := Paths(paths...)
+	return func(c *gin.Context) bool {
+		return SafeMethod(c) && !private(c)
+	}
+}
+
+// Paths: ルートのパス（例: /api/v1/copies/:id/likes）がpathsのいずれかと一致するかどうか
+func Paths(paths ...string) func(*gin.Context) bool {
+	return func(c *gin.Context) bool {
+		for _, path := range paths {
+			if c.FullPath() == path {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// bearerToken: Authorizationヘッダーのトークン（Bearerでない場合は空文字列）
+func bearerToken(header string) string {
+	scheme, token, ok := strings.Cut(strings.TrimSpace(header), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
@@ -0,0 +1,243 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/auth"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+)
+
+// fakeAuthenticator: "valid-token"と"ascg_valid"のみ受け付ける
+type fakeAuthenticator struct{}
+
+func (fakeAuthenticator) AuthenticateToken(_ context.Context, token string) (*entity.Identity, error) {
+	if token != "valid-token" {
+		return nil, auth.ErrInvalidToken
+	}
+	return &entity.Identity{UserID: 1, Email: "tanaka@example.com", Method: entity.AuthMethodSession}, nil
+}
+
+func (fakeAuthenticator) AuthenticateAPIKey(_ context.Context, key string) (*entity.Identity, error) {
+	if key != "ascg_valid" {
+		return nil, auth.ErrInvalidToken
+	}
+	return &entity.Identity{UserID: 2, Email: "script@example.com", Method: entity.AuthMethodAPIKey, APIKeyID: 3}, nil
+}
+
+func TestAuthenticate(t *testing.T) {
+	tests := []struct {
+		name       string
+		headers    map[string]string
+		wantStatus int
+		wantUserID int
+		wantMethod entity.AuthMethod
+	}{
+		{
+			name:       "正常系_トークン",
+			headers:    map[string]string{"Authorization": "Bearer valid-token"},
+			wantStatus: http.StatusOK,
+			wantUserID: 1,
+			wantMethod: entity.AuthMethodSession,
+		},
+		{
+			name:       "正常系_AuthorizationヘッダーのAPIキー",
+			headers:    map[string]string{"Authorization": "bearer ascg_valid"},
+			wantStatus: http.StatusOK,
+			wantUserID: 2,
+			wantMethod: entity.AuthMethodAPIKey,
+		},
+		{
+			name:       "正常系_X-API-KeyヘッダーのAPIキー",
+			headers:    map[string]string{APIKeyHeader: "ascg_valid"},
+			wantStatus: http.StatusOK,
+			wantUserID: 2,
+			wantMethod: entity.AuthMethodAPIKey,
+		},
+		{
+			name:       "正常系_認証情報なし",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "正常系_Bearerでない認証情報は無視",
+			headers:    map[string]string{"Authorization": "Basic dXNlcjpwYXNz"},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "異常系_不正なトークン",
+			headers:    map[string]string{"Authorization": "Bearer invalid"},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "異常系_不正なAPIキー",
+			headers:    map[string]string{APIKeyHeader: "ascg_invalid"},
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			r := gin.New()
+			r.Use(ErrorHandler(), Authenticate(fakeAuthenticator{}))
+			var got *entity.Identity
+			r.GET("/test", func(c *gin.Context) {
+				got = auth.IdentityFrom(c.Request.Context())
+				c.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/test", nil)
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus != http.StatusOK {
+				var body gin.H
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+				assert.Equal(t, "invalid_token", body["code"])
+				return
+			}
+			if tt.wantUserID == 0 {
+				assert.Nil(t, got)
+				return
+			}
+			if assert.NotNil(t, got) {
+				assert.Equal(t, tt.wantUserID, got.UserID)
+				assert.Equal(t, tt.wantMethod, got.Method)
+			}
+		})
+	}
+}
+
+func TestRequireAuth(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		token      string
+		wantStatus int
+	}{
+		{
+			name:       "正常系_認証済み",
+			method:     http.MethodPost,
+			path:       "/copies",
+			token:      "valid-token",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "正常系_参照のみは認証不要",
+			method:     http.MethodGet,
+			path:       "/copies",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "異常系_認証が必要な参照",
+			method:     http.MethodGet,
+			path:       "/copies/in-review",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "正常系_認証が必要な参照を認証済みで実行",
+			method:     http.MethodGet,
+			path:       "/copies/in-review",
+			token:      "valid-token",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "正常系_除外したパスは認証不要",
+			method:     http.MethodPost,
+			path:       "/login",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "異常系_認証されていない",
+			method:     http.MethodPost,
+			path:       "/copies",
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			r := gin.New()
+			r.Use(ErrorHandler(), Authenticate(fakeAuthenticator{}), RequireAuth(SafeMethodExcept("/copies/in-review"), Paths("/login")))
+			ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+			r.GET("/copies", ok)
+			r.GET("/copies/in-review", ok)
+			r.POST("/copies", ok)
+			r.POST("/login", ok)
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusUnauthorized {
+				var body gin.H
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+				assert.Equal(t, "authentication_required", body["code"])
+			}
+		})
+	}
+}
+
+func TestRequireAuth_RequireIdentity(t *testing.T) {
+	tests := []struct {
+		name       string
+		middleware []gin.HandlerFunc
+		token      string
+		wantErr    error
+	}{
+		{
+			name:       "正常系_認証を必須にしていない",
+			middleware: nil,
+		},
+		{
+			name:       "正常系_認証済み",
+			middleware: []gin.HandlerFunc{RequireAuth(SafeMethod)},
+			token:      "valid-token",
+		},
+		{
+			name:       "異常系_認証せずに受け付けたリクエスト",
+			middleware: []gin.HandlerFunc{RequireAuth(SafeMethod)},
+			wantErr:    auth.ErrAuthenticationRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			r := gin.New()
+			r.Use(ErrorHandler(), Authenticate(fakeAuthenticator{}))
+			r.Use(tt.middleware...)
+			var got error
+			r.GET("/copies/:id", func(c *gin.Context) {
+				got = auth.RequireIdentity(c.Request.Context())
+				c.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/copies/1", nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.ErrorIs(t, got, tt.wantErr)
+		})
+	}
+}
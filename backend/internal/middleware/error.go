@@ -0,0 +1,121 @@
+// Package middleware: ルーター全体で共通のginミドルウェア
+package middleware
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/domainerr"
+)
+
+// ProblemContentType: RFC 7807のエラーレスポンスのContent-Type
+const ProblemContentType = "application/problem+json"
+
+// CodeInternal: domainerrのエラーでない（想定していない）エラーのエラーコード
+const CodeInternal = "internal_error"
+
+// statusByKind: エラーの種類に対応するステータスコード
+var statusByKind = map[domainerr.Kind]int{
+	domainerr.KindNotFound:            http.StatusNotFound,
+	domainerr.KindValidation:          http.StatusBadRequest,
+	domainerr.KindConflict:            http.StatusConflict,
+	domainerr.KindUpstreamUnavailable: http.StatusServiceUnavailable,
+	domainerr.KindRateLimited:         http.StatusTooManyRequests,
+	domainerr.KindUnauthenticated:     http.StatusUnauthorized,
+}
+
+// Problem: RFC 7807のエラーレスポンス
+//
+// typeは常にabout:blankとし、フロントエンドはcodeでエラーを判定します。
+// エラーの追加の情報（violationsなど）はトップレベルのメンバーとして含めます。
+type Problem struct {
+	Type     string
+	Title    string
+	Status   int
+	Detail   string
+	Instance string
+	// Code: 安定したエラーコード（domainerr.Error.Code、コードがない場合は種類）
+	Code       string
+	Extensions map[string]any
+	// RetryAfter: Retry-Afterヘッダーに設定する秒数（0の場合は設定しない）
+	RetryAfter int
+}
+
+func (p Problem) MarshalJSON() ([]byte, error) {
+	m := make(map[string]any, len(p.Extensions)+6)
+	for k, v := range p.Extensions {
+		m[k] = v
+	}
+	m["type"] = p.Type
+	m["title"] = p.Title
+	m["status"] = p.Status
+	m["code"] = p.Code
+	if p.Detail != "" {
+		m["detail"] = p.Detail
+	}
+	if p.Instance != "" {
+		m["instance"] = p.Instance
+	}
+	return json.Marshal(m)
+}
+
+// NewProblem: エラーからRFC 7807のエラーレスポンスを作成
+//
+// domainerrのエラーでない場合は500とし、内部のエラーの内容はレスポンスに含めません。
+func NewProblem(err error, instance string) Problem {
+	e, ok := domainerr.As(err)
+	if !ok {
+		return Problem{
+			Type:     "about:blank",
+			Title:    http.StatusText(http.StatusInternalServerError),
+			Status:   http.StatusInternalServerError,
+			Detail:   "internal server error",
+			Instance: instance,
+			Code:     CodeInternal,
+		}
+	}
+
+	status, ok := statusByKind[e.Kind]
+	if !ok {
+		status = http.StatusInternalServerError
+	}
+	code := e.Code
+	if code == "" {
+		code = string(e.Kind)
+	}
+	return Problem{
+		Type:       "about:blank",
+		Title:      http.StatusText(status),
+		Status:     status,
+		Detail:     e.Message,
+		Instance:   instance,
+		Code:       code,
+		Extensions: e.Details,
+		RetryAfter: int(math.Ceil(e.RetryAfter.Seconds())),
+	}
+}
+
+// ErrorHandler: ハンドラーがc.Errorで登録したエラーをRFC 7807のレスポンスとして返す
+//
+// ハンドラーはエラーを登録してreturnするだけとし、ステータスコードはエラーの種類から決めます。
+// すでにレスポンスを書き込んでいる場合（Server-Sent Eventsなど）は何もしません。
+func ErrorHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+
+		problem := NewProblem(c.Errors.Last().Err, c.Request.URL.Path)
+		if problem.RetryAfter > 0 {
+			c.Header("Retry-After", strconv.Itoa(problem.RetryAfter))
+		}
+		c.Header("Content-Type", ProblemContentType)
+		c.JSON(problem.Status, problem)
+	}
+}
@@ -0,0 +1,144 @@
+package middleware
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/domainerr"
+)
+
+func TestErrorHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		wantStatus     int
+		wantBody       gin.H
+		wantRetryAfter string
+	}{
+		{
+			name:       "正常系_存在しない",
+			err:        domainerr.NotFound("copy_not_found", "copy not found").Wrap(errors.New("record not found")),
+			wantStatus: http.StatusNotFound,
+			wantBody: gin.H{
+				"type":     "about:blank",
+				"title":    "Not Found",
+				"status":   float64(http.StatusNotFound),
+				"code":     "copy_not_found",
+				"detail":   "copy not found",
+				"instance": "/test",
+			},
+		},
+		{
+			name:       "正常系_追加の情報を含める",
+			err:        domainerr.Validation("invalid_edit", "invalid edit").WithDetail("violations", []string{"title is too long"}),
+			wantStatus: http.StatusBadRequest,
+			wantBody: gin.H{
+				"type":       "about:blank",
+				"title":      "Bad Request",
+				"status":     float64(http.StatusBadRequest),
+				"code":       "invalid_edit",
+				"detail":     "invalid edit",
+				"instance":   "/test",
+				"violations": []interface{}{"title is too long"},
+			},
+		},
+		{
+			name:       "正常系_コードがない場合は種類",
+			err:        domainerr.Conflict("", "conflict"),
+			wantStatus: http.StatusConflict,
+			wantBody: gin.H{
+				"type":     "about:blank",
+				"title":    "Conflict",
+				"status":   float64(http.StatusConflict),
+				"code":     "conflict",
+				"detail":   "conflict",
+				"instance": "/test",
+			},
+		},
+		{
+			name:           "正常系_呼び出し回数の上限",
+			err:            domainerr.RateLimited("llm_rate_limited", "rate limited").WithRetryAfter(1500 * time.Millisecond),
+			wantStatus:     http.StatusTooManyRequests,
+			wantRetryAfter: "2",
+			wantBody: gin.H{
+				"type":     "about:blank",
+				"title":    "Too Many Requests",
+				"status":   float64(http.StatusTooManyRequests),
+				"code":     "llm_rate_limited",
+				"detail":   "rate limited",
+				"instance": "/test",
+			},
+		},
+		{
+			name:       "正常系_認証されていない",
+			err:        domainerr.Unauthenticated("authentication_required", "authentication required"),
+			wantStatus: http.StatusUnauthorized,
+			wantBody: gin.H{
+				"type":     "about:blank",
+				"title":    "Unauthorized",
+				"status":   float64(http.StatusUnauthorized),
+				"code":     "authentication_required",
+				"detail":   "authentication required",
+				"instance": "/test",
+			},
+		},
+		{
+			name:       "正常系_内部のエラーの内容は返さない",
+			err:        errors.New("dial tcp: connection refused"),
+			wantStatus: http.StatusInternalServerError,
+			wantBody: gin.H{
+				"type":     "about:blank",
+				"title":    "Internal Server Error",
+				"status":   float64(http.StatusInternalServerError),
+				"code":     CodeInternal,
+				"detail":   "internal server error",
+				"instance": "/test",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			r := gin.New()
+			r.Use(ErrorHandler())
+			r.GET("/test", func(c *gin.Context) {
+				_ = c.Error(tt.err)
+			})
+
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/test", nil))
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, ProblemContentType, rec.Header().Get("Content-Type"))
+			assert.Equal(t, tt.wantRetryAfter, rec.Header().Get("Retry-After"))
+			var got gin.H
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			assert.Equal(t, tt.wantBody, got)
+		})
+	}
+}
+
+func TestErrorHandler_Written(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(ErrorHandler())
+	r.GET("/test", func(c *gin.Context) {
+		c.String(http.StatusOK, "partial")
+		_ = c.Error(errors.New("write failed"))
+	})
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/test", nil))
+
+	// 書き込み済みのレスポンスは変更しない
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "partial", rec.Body.String())
+}
@@ -0,0 +1,304 @@
+package prompt
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/llm"
+)
+
+// DefaultLocale: ロケールが指定されていない場合に使用するロケール
+const DefaultLocale = "ja"
+
+// テンプレート内で定義するブロック名
+const (
+	blockVersion = "version"
+	blockSystem  = "system"
+	blockUser    = "user"
+	blockSchema  = "schema"
+	// few-shotの例は"example:<番号>:user"と"example:<番号>:assistant"の組で定義する
+	blockExamplePrefix = "example:"
+)
+
+// ErrTemplateNotFound: ロケールに対応するテンプレートが存在しない場合のエラー
+var ErrTemplateNotFound = errors.New("prompt template not found")
+
+//go:embed templates
+var embedded embed.FS
+
+// Data: テンプレートに渡す値
+type Data struct {
+	ProductName     string
+	ProductFeatures string
+	Target          string
+	Channel         string
+	Tone            string
+	// ChannelGuidance / ToneGuidance: 配信チャネル・トーンの定義に登録された指示（空の場合は記載しない）
+	ChannelGuidance string
+	ToneGuidance    string
+	// OutputSchema: 組み込み以外のチャネルの出力形式（組み込みのチャネルはテンプレートに記載する）
+	OutputSchema string
+	// Brand: ブランドプロファイルを指定した場合のブランドのボイス（nilの場合は記載しない）
+	Brand *Brand
+	// Campaign: キャンペーンを指定した場合のキャンペーンの内容（nilの場合は記載しない）
+	Campaign *Campaign
+	// Variants: 生成する候補数（1以下の場合は単一の生成）
+	Variants int
+	// VariantNumber: 候補番号（1始まり）
+	VariantNumber int
+}
+
+// Brand: プロンプトに記載するブランドのボイス
+type Brand struct {
+	Name  string
+	Voice string
+	// Ending: 文末の表現の名前（例: です・ます調、空の場合は指定しない）
+	Ending         string
+	PreferredWords []string
+	BannedWords    []string
+	// Samples: 承認済みのコピーの例
+	Samples []string
+}
+
+// Campaign: プロンプトに記載するキャンペーンの内容
+type Campaign struct {
+	Name string
+	Goal string
+	// Period: キャンペーンの期間（例: 2026-11-01〜2026-11-30）
+	Period string
+	// OtherChannels: 同じキャンペーンで同時に生成する他の配信チャネル（訴求を揃えるために記載する）
+	OtherChannels []string
+}
+
+// funcs: テンプレートで使用できる関数
+var funcs = template.FuncMap{
+	"join": strings.Join,
+}
+
+// Key: テンプレートの検索キー
+type Key struct {
+	Channel string
+	Tone    string
+	Locale  string
+}
+
+// Prompt: テンプレートから組み立てたプロンプト
+type Prompt struct {
+	// TemplateID: テンプレートのパス（例: ja/sns.casual）
+	TemplateID string
+	Version    string
+	Schema     string
+	// Messages: system、few-shotの例、userの順に並んだメッセージ
+	Messages []llm.Message
+}
+
+// Store: text/templateで記述したプロンプトテンプレートの集合
+//
+// テンプレートは templates/<ロケール>/<名前>.tmpl に配置し、
+// <チャネル>.<トーン> → <チャネル> → default の順に検索します。
+type Store struct {
+	// 優先度の高い順
+	sources []fs.FS
+
+	mu    sync.Mutex
+	cache map[string]*template.Template
+}
+
+// NewStore: 指定したファイルシステムからテンプレートを読み込むStoreを生成
+//
+// 先に指定したファイルシステムが優先されます。
+func NewStore(sources ...fs.FS) *Store {
+	return &Store{
+		sources: sources,
+		cache:   map[string]*template.Template{},
+	}
+}
+
+// Default: バイナリに埋め込んだテンプレートのみを使用するStore
+func Default() *Store {
+	sub, err := fs.Sub(embedded, "templates")
+	if err != nil {
+		panic(err)
+	}
+	return NewStore(sub)
+}
+
+// Load: dirのテンプレートで埋め込みのテンプレートを上書きするStoreを生成
+//
+// dirが空の場合は埋め込みのテンプレートのみを使用します。
+func Load(dir string) (*Store, error) {
+	defaults := Default()
+	if dir == "" {
+		return defaults, nil
+	}
+
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("prompt: %s is not a directory", dir)
+	}
+
+	return NewStore(append([]fs.FS{os.DirFS(dir)}, defaults.sources...)...), nil
+}
+
+// Render: キーに対応するテンプレートでプロンプトを組み立てる
+func (s *Store) Render(key Key, data Data) (*Prompt, error) {
+	if key.Locale == "" {
+		key.Locale = DefaultLocale
+	}
+
+	id, tmpl, err := s.lookup(key)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Prompt{TemplateID: id}
+	if p.Version, err = execute(tmpl, blockVersion, data); err != nil {
+		return nil, err
+	}
+	if p.Schema, err = execute(tmpl, blockSchema, data); err != nil {
+		return nil, err
+	}
+
+	system, err := execute(tmpl, blockSystem, data)
+	if err != nil {
+		return nil, err
+	}
+	if system != "" {
+		p.Messages = append(p.Messages, llm.Message{Role: llm.RoleSystem, Content: system})
+	}
+
+	examples, err := renderExamples(tmpl, data)
+	if err != nil {
+		return nil, err
+	}
+	p.Messages = append(p.Messages, examples...)
+
+	user, err := execute(tmpl, blockUser, data)
+	if err != nil {
+		return nil, err
+	}
+	if user == "" {
+		return nil, fmt.Errorf("prompt: template %s has no %q block", id, blockUser)
+	}
+	p.Messages = append(p.Messages, llm.Message{Role: llm.RoleUser, Content: user})
+
+	return p, nil
+}
+
+// lookup: キーに対応するテンプレートを検索する
+func (s *Store) lookup(key Key) (string, *template.Template, error) {
+	var candidates []string
+	if key.Channel != "" && key.Tone != "" {
+		candidates = append(candidates, key.Channel+"."+key.Tone)
+	}
+	if key.Channel != "" {
+		candidates = append(candidates, key.Channel)
+	}
+	candidates = append(candidates, "default")
+
+	for _, name := range candidates {
+		id := path.Join(key.Locale, name)
+		tmpl, err := s.load(id)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return "", nil, err
+		}
+		return id, tmpl, nil
+	}
+
+	return "", nil, fmt.Errorf("%w: locale %q", ErrTemplateNotFound, key.Locale)
+}
+
+// load: テンプレートを読み込んでキャッシュする
+func (s *Store) load(id string) (*template.Template, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if tmpl, ok := s.cache[id]; ok {
+		return tmpl, nil
+	}
+
+	for _, source := range s.sources {
+		b, err := fs.ReadFile(source, id+".tmpl")
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		tmpl, err := template.New(id).Funcs(funcs).Option("missingkey=error").Parse(string(b))
+		if err != nil {
+			return nil, fmt.Errorf("prompt: failed to parse %s: %w", id, err)
+		}
+		s.cache[id] = tmpl
+		return tmpl, nil
+	}
+
+	return nil, fs.ErrNotExist
+}
+
+// execute: 指定したブロックを実行する（ブロックが未定義の場合は空文字）
+func execute(tmpl *template.Template, name string, data Data) (string, error) {
+	if tmpl.Lookup(name) == nil {
+		return "", nil
+	}
+
+	var b strings.Builder
+	if err := tmpl.ExecuteTemplate(&b, name, data); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(b.String()), nil
+}
+
+// renderExamples: few-shotの例を番号順にuser/assistantのメッセージとして組み立てる
+func renderExamples(tmpl *template.Template, data Data) ([]llm.Message, error) {
+	var numbers []int
+	for _, t := range tmpl.Templates() {
+		rest, ok := strings.CutPrefix(t.Name(), blockExamplePrefix)
+		if !ok || !strings.HasSuffix(rest, ":"+string(llm.RoleUser)) {
+			continue
+		}
+		n, err := strconv.Atoi(strings.TrimSuffix(rest, ":"+string(llm.RoleUser)))
+		if err != nil {
+			return nil, fmt.Errorf("prompt: invalid example block %q", t.Name())
+		}
+		numbers = append(numbers, n)
+	}
+	sort.Ints(numbers)
+
+	var messages []llm.Message
+	for _, n := range numbers {
+		prefix := blockExamplePrefix + strconv.Itoa(n) + ":"
+		user, err := execute(tmpl, prefix+string(llm.RoleUser), data)
+		if err != nil {
+			return nil, err
+		}
+		assistant, err := execute(tmpl, prefix+string(llm.RoleAssistant), data)
+		if err != nil {
+			return nil, err
+		}
+		if assistant == "" {
+			return nil, fmt.Errorf("prompt: example %d has no assistant block", n)
+		}
+		messages = append(messages,
+			llm.Message{Role: llm.RoleUser, Content: user},
+			llm.Message{Role: llm.RoleAssistant, Content: assistant},
+		)
+	}
+	return messages, nil
+}
@@ -0,0 +1,258 @@
+package prompt
+
+import (
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/llm"
+)
+
+func testData() Data {
+	return Data{
+		ProductName:     "テスト商品",
+		ProductFeatures: "高品質、使いやすい",
+		Target:          "20-30代女性",
+		Channel:         "sns",
+		Tone:            "casual",
+	}
+}
+
+func TestRender_Lookup(t *testing.T) {
+	tests := []struct {
+		name       string
+		key        Key
+		wantID     string
+		wantErr    error
+		wantInUser string
+	}{
+		{
+			name:       "正常系_チャネルのテンプレート",
+			key:        Key{Channel: "sns", Tone: "casual"},
+			wantID:     "ja/sns",
+			wantInUser: "SNS投稿",
+		},
+		{
+			name:       "正常系_defaultにフォールバック",
+			key:        Key{Channel: "app", Tone: "pop"},
+			wantID:     "ja/default",
+			wantInUser: "テスト商品",
+		},
+		{
+			name:       "正常系_英語のテンプレート",
+			key:        Key{Channel: "sns", Tone: "casual", Locale: "en"},
+			wantID:     "en/default",
+			wantInUser: "テスト商品",
+		},
+		{
+			name:    "異常系_存在しないロケール",
+			key:     Key{Channel: "sns", Tone: "casual", Locale: "fr"},
+			wantErr: ErrTemplateNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Default().Render(tt.key, testData())
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantID, got.TemplateID)
+			assert.NotEmpty(t, got.Version)
+			assert.NotEmpty(t, got.Schema)
+
+			// 最後のメッセージがuserのプロンプトであること
+			last := got.Messages[len(got.Messages)-1]
+			assert.Equal(t, llm.RoleUser, last.Role)
+			assert.Contains(t, last.Content, tt.wantInUser)
+			assert.Contains(t, last.Content, got.Schema)
+		})
+	}
+}
+
+func TestRender_Examples(t *testing.T) {
+	source := fstest.MapFS{
+		"ja/default.tmpl": {Data: []byte(`{{define "version"}}2{{end}}
+{{define "system"}}system{{end}}
+{{define "user"}}user {{.ProductName}}{{end}}
+{{define "example:2:user"}}user2{{end}}
+{{define "example:2:assistant"}}assistant2{{end}}
+{{define "example:1:user"}}user1{{end}}
+{{define "example:1:assistant"}}assistant1{{end}}`)},
+	}
+
+	got, err := NewStore(source).Render(Key{Channel: "sns"}, testData())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "2", got.Version)
+	// system、番号順のfew-shotの例、userの順に並ぶこと
+	assert.Equal(t, []llm.Message{
+		{Role: llm.RoleSystem, Content: "system"},
+		{Role: llm.RoleUser, Content: "user1"},
+		{Role: llm.RoleAssistant, Content: "assistant1"},
+		{Role: llm.RoleUser, Content: "user2"},
+		{Role: llm.RoleAssistant, Content: "assistant2"},
+		{Role: llm.RoleUser, Content: "user テスト商品"},
+	}, got.Messages)
+}
+
+func TestRender_Override(t *testing.T) {
+	override := fstest.MapFS{
+		"ja/sns.casual.tmpl": {Data: []byte(`{{define "version"}}override{{end}}{{define "user"}}custom {{.Tone}}{{end}}`)},
+	}
+	store := NewStore(append([]fs.FS{override}, Default().sources...)...)
+
+	tests := []struct {
+		name        string
+		key         Key
+		wantID      string
+		wantVersion string
+	}{
+		{
+			name:        "正常系_チャネルとトーンのテンプレートを優先",
+			key:         Key{Channel: "sns", Tone: "casual"},
+			wantID:      "ja/sns.casual",
+			wantVersion: "override",
+		},
+		{
+			name:        "正常系_上書きがない場合は埋め込みを使用",
+			key:         Key{Channel: "sns", Tone: "pop"},
+			wantID:      "ja/sns",
+			wantVersion: "5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := store.Render(tt.key, testData())
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantID, got.TemplateID)
+			assert.Equal(t, tt.wantVersion, got.Version)
+		})
+	}
+}
+
+func TestRender_Variants(t *testing.T) {
+	data := testData()
+	data.Variants = 3
+	data.VariantNumber = 2
+
+	got, err := Default().Render(Key{Channel: "sns", Tone: "casual"}, data)
+	assert.NoError(t, err)
+	assert.Contains(t, got.Messages[len(got.Messages)-1].Content, "3案中2案目")
+
+	// 単一の生成では候補の指示を含めない
+	got, err = Default().Render(Key{Channel: "sns", Tone: "casual"}, testData())
+	assert.NoError(t, err)
+	assert.NotContains(t, got.Messages[len(got.Messages)-1].Content, "案目")
+}
+
+func TestRender_Definition(t *testing.T) {
+	data := testData()
+	data.Channel = "reels"
+	data.ChannelGuidance = "冒頭の1秒で惹きつける"
+	data.OutputSchema = `{
+  "title": "見出し（20文字以内）",
+  "hook": "冒頭のテロップ（15文字以内）"
+}`
+
+	got, err := Default().Render(Key{Channel: "reels", Tone: "casual"}, data)
+	assert.NoError(t, err)
+	assert.Equal(t, "ja/default", got.TemplateID)
+	// 組み込み以外のチャネルは定義から組み立てた出力形式を使用する
+	assert.Equal(t, data.OutputSchema, got.Schema)
+	user := got.Messages[len(got.Messages)-1].Content
+	assert.Contains(t, user, "配信チャネルについての指示: 冒頭の1秒で惹きつける")
+	assert.NotContains(t, user, "トーンについての指示")
+
+	// 指示が登録されていない場合は記載しない
+	got, err = Default().Render(Key{Channel: "sns", Tone: "casual"}, testData())
+	assert.NoError(t, err)
+	assert.NotContains(t, got.Messages[len(got.Messages)-1].Content, "についての指示")
+}
+
+func TestRender_Brand(t *testing.T) {
+	data := testData()
+	data.Brand = &Brand{
+		Name:           "ACME",
+		Voice:          "誠実で飾らない語り口",
+		Ending:         "です・ます調",
+		PreferredWords: []string{"こだわり", "ていねい"},
+		BannedWords:    []string{"激安", "最強"},
+		Samples:        []string{"毎日に、ちょうどいい。"},
+	}
+
+	got, err := Default().Render(Key{Channel: "sns", Tone: "casual"}, data)
+	assert.NoError(t, err)
+	assert.Contains(t, got.Messages[len(got.Messages)-1].Content, `ブランド『ACME』のボイスに合わせてください。
+ボイス: 誠実で飾らない語り口
+文末: です・ます調で統一してください。
+積極的に使う言葉: こだわり、ていねい
+使用しない言葉: 激安、最強
+承認済みのコピーの例:
+- 毎日に、ちょうどいい。`)
+
+	// 指定していない項目は記載しない
+	data.Brand = &Brand{Name: "ACME", BannedWords: []string{"激安"}}
+	got, err = Default().Render(Key{Channel: "app", Tone: "pop", Locale: "en"}, data)
+	assert.NoError(t, err)
+	user := got.Messages[len(got.Messages)-1].Content
+	assert.Contains(t, user, "Match the voice of the brand \"ACME\".\nWords never to use: 激安")
+	assert.NotContains(t, user, "Voice:")
+
+	// ブランドプロファイルを指定しない場合は記載しない
+	got, err = Default().Render(Key{Channel: "sns", Tone: "casual"}, testData())
+	assert.NoError(t, err)
+	assert.NotContains(t, got.Messages[len(got.Messages)-1].Content, "ブランド")
+}
+
+func TestRender_Campaign(t *testing.T) {
+	data := testData()
+	data.Campaign = &Campaign{
+		Name:          "冬のセール",
+		Goal:          "新規会員の獲得",
+		Period:        "2026-12-01〜2026-12-25",
+		OtherChannels: []string{"line", "email"},
+	}
+
+	got, err := Default().Render(Key{Channel: "sns", Tone: "casual"}, data)
+	assert.NoError(t, err)
+	assert.Contains(t, got.Messages[len(got.Messages)-1].Content, `キャンペーン『冬のセール』のコピーとして作成してください。
+期間: 2026-12-01〜2026-12-25
+目的: 新規会員の獲得
+同じキャンペーンでline、emailにも配信します。チャネルをまたいで訴求点と言葉選びを揃えてください。`)
+
+	// 単独で生成する場合は他のチャネルを記載しない
+	data.Campaign = &Campaign{Name: "Winter Sale"}
+	got, err = Default().Render(Key{Channel: "app", Tone: "pop", Locale: "en"}, data)
+	assert.NoError(t, err)
+	user := got.Messages[len(got.Messages)-1].Content
+	assert.Contains(t, user, "This copy is part of the campaign \"Winter Sale\".")
+	assert.NotContains(t, user, "also runs on")
+
+	// キャンペーンを指定しない場合は記載しない
+	got, err = Default().Render(Key{Channel: "sns", Tone: "casual"}, testData())
+	assert.NoError(t, err)
+	assert.NotContains(t, got.Messages[len(got.Messages)-1].Content, "キャンペーン")
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("正常系_ディレクトリ未指定", func(t *testing.T) {
+		store, err := Load("")
+		assert.NoError(t, err)
+		assert.NotNil(t, store)
+	})
+
+	t.Run("異常系_存在しないディレクトリ", func(t *testing.T) {
+		store, err := Load(t.TempDir() + "/missing")
+		assert.Error(t, err)
+		assert.Nil(t, store)
+	})
+}
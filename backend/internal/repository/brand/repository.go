@@ -0,0 +1,63 @@
+package brand_repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+// profileColumns: 更新で変更できる項目
+var profileColumns = []string{"Name", "Voice", "PreferredWords", "BannedWords", "Samples", "Ending"}
+
+type brandProfileRepository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) repository.BrandProfileRepository {
+	return &brandProfileRepository{db: db}
+}
+
+func (r *brandProfileRepository) List(ctx context.Context) ([]*entity.BrandProfile, error) {
+	var profiles []*entity.BrandProfile
+	if err := r.db.WithContext(ctx).Order("name ASC, id ASC").Find(&profiles).Error; err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+func (r *brandProfileRepository) Get(ctx context.Context, id int) (*entity.BrandProfile, error) {
+	var profile entity.BrandProfile
+	if err := r.db.WithContext(ctx).First(&profile, id).Error; err != nil {
+		return nil, repository.TranslateError(err, repository.ErrBrandProfileNotFound)
+	}
+	return &profile, nil
+}
+
+func (r *brandProfileRepository) Create(ctx context.Context, profile *entity.BrandProfile) error {
+	return repository.TranslateWriteError(r.db.WithContext(ctx).Create(profile).Error)
+}
+
+func (r *brandProfileRepository) Update(ctx context.Context, profile *entity.BrandProfile) error {
+	result := r.db.WithContext(ctx).Model(profile).Select(profileColumns).Updates(profile)
+	if result.Error != nil {
+		return repository.TranslateWriteError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrBrandProfileNotFound
+	}
+	return nil
+}
+
+func (r *brandProfileRepository) Delete(ctx context.Context, id int) error {
+	result := r.db.WithContext(ctx).Delete(&entity.BrandProfile{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrBrandProfileNotFound
+	}
+	return nil
+}
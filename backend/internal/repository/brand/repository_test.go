@@ -0,0 +1,166 @@
+package brand_repository
+
+import (
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+func setupTestDB() (*gorm.DB, sqlmock.Sqlmock, error) {
+	// SQLMockの作成
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// GORMでSQLMockを使用するための設定
+	dialector := mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	})
+
+	// ログを無効化する設定
+	db, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return db, mock, nil
+}
+
+var profileColumnNames = []string{"id", "name", "voice", "preferred_words", "banned_words", "samples", "ending", "created_at", "updated_at", "deleted_at"}
+
+func TestGet(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name    string
+		rows    *sqlmock.Rows
+		wantErr error
+	}{
+		{
+			name: "正常系",
+			rows: sqlmock.NewRows(profileColumnNames).
+				AddRow(1, "ACME", "誠実で飾らない語り口", `["こだわり"]`, `["激安","最強"]`, `["毎日に、ちょうどいい。"]`, "desu_masu", now, now, nil),
+		},
+		{
+			name:    "異常系_存在しない",
+			rows:    sqlmock.NewRows(profileColumnNames),
+			wantErr: repository.ErrBrandProfileNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト用DBのセットアップ
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			// SQLクエリのモック（論理削除したプロファイルは除く）
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `brand_profiles` WHERE `brand_profiles`.`id` = ? AND `brand_profiles`.`deleted_at` IS NULL ORDER BY `brand_profiles`.`id` LIMIT 1")).
+				WithArgs(1).
+				WillReturnRows(tt.rows)
+
+			// テスト実行
+			profile, err := NewRepository(db).Get(context.Background(), 1)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorIs(t, err, repository.ErrNotFound)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "ACME", profile.Name)
+				assert.Equal(t, []string{"激安", "最強"}, profile.BannedWords)
+				assert.Equal(t, entity.EndingPolite, profile.Ending)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	// テスト用DBのセットアップ
+	db, mock, err := setupTestDB()
+	assert.NoError(t, err)
+
+	// SQLクエリのモック
+	now := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `brand_profiles` WHERE `brand_profiles`.`deleted_at` IS NULL ORDER BY name ASC, id ASC")).
+		WillReturnRows(sqlmock.NewRows(profileColumnNames).
+			AddRow(2, "ACME", "", `[]`, `[]`, `[]`, "", now, now, nil).
+			AddRow(1, "Blue Shop", "", `[]`, `["激安"]`, `[]`, "da_dearu", now, now, nil))
+
+	// テスト実行
+	profiles, err := NewRepository(db).List(context.Background())
+
+	// アサーション
+	assert.NoError(t, err)
+	assert.Len(t, profiles, 2)
+	assert.Equal(t, "Blue Shop", profiles[1].Name)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		result  driver.Result
+		wantErr error
+	}{
+		{name: "正常系", result: sqlmock.NewResult(0, 1)},
+		{name: "異常系_存在しない", result: sqlmock.NewResult(0, 0), wantErr: repository.ErrBrandProfileNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト用DBのセットアップ
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			// SQLクエリのモック
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE `brand_profiles` SET `name`=?,`voice`=?,`preferred_words`=?,`banned_words`=?,`samples`=?,`ending`=?,`updated_at`=? WHERE `brand_profiles`.`deleted_at` IS NULL AND `id` = ?")).
+				WillReturnResult(tt.result)
+			mock.ExpectCommit()
+
+			// テスト実行
+			err = NewRepository(db).Update(context.Background(), &entity.BrandProfile{ID: 1, Name: "ACME"})
+
+			// アサーション
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	// テスト用DBのセットアップ
+	db, mock, err := setupTestDB()
+	assert.NoError(t, err)
+
+	// SQLクエリのモック（削除済み・存在しない場合）
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `brand_profiles` SET `deleted_at`=? WHERE `brand_profiles`.`id` = ? AND `brand_profiles`.`deleted_at` IS NULL")).
+		WithArgs(sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	// テスト実行
+	err = NewRepository(db).Delete(context.Background(), 1)
+
+	// アサーション
+	assert.ErrorIs(t, err, repository.ErrBrandProfileNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,63 @@
+package campaign_repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+// campaignColumns: 更新で変更できる項目
+var campaignColumns = []string{"Name", "Goal", "Target", "StartsAt", "EndsAt"}
+
+type campaignRepository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) repository.CampaignRepository {
+	return &campaignRepository{db: db}
+}
+
+func (r *campaignRepository) List(ctx context.Context) ([]*entity.Campaign, error) {
+	var campaigns []*entity.Campaign
+	if err := r.db.WithContext(ctx).Order("starts_at DESC, id DESC").Find(&campaigns).Error; err != nil {
+		return nil, err
+	}
+	return campaigns, nil
+}
+
+func (r *campaignRepository) Get(ctx context.Context, id int) (*entity.Campaign, error) {
+	var campaign entity.Campaign
+	if err := r.db.WithContext(ctx).First(&campaign, id).Error; err != nil {
+		return nil, repository.TranslateError(err, repository.ErrCampaignNotFound)
+	}
+	return &campaign, nil
+}
+
+func (r *campaignRepository) Create(ctx context.Context, campaign *entity.Campaign) error {
+	return repository.TranslateWriteError(r.db.WithContext(ctx).Create(campaign).Error)
+}
+
+func (r *campaignRepository) Update(ctx context.Context, campaign *entity.Campaign) error {
+	result := r.db.WithContext(ctx).Model(campaign).Select(campaignColumns).Updates(campaign)
+	if result.Error != nil {
+		return repository.TranslateWriteError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrCampaignNotFound
+	}
+	return nil
+}
+
+func (r *campaignRepository) Delete(ctx context.Context, id int) error {
+	result := r.db.WithContext(ctx).Delete(&entity.Campaign{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrCampaignNotFound
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package campaign_repository
+
+import (
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+func setupTestDB() (*gorm.DB, sqlmock.Sqlmock, error) {
+	// SQLMockの作成
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// GORMでSQLMockを使用するための設定
+	dialector := mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	})
+
+	// ログを無効化する設定
+	db, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return db, mock, nil
+}
+
+var campaignColumnNames = []string{"id", "name", "goal", "target", "starts_at", "ends_at", "created_at", "updated_at", "deleted_at"}
+
+func TestGet(t *testing.T) {
+	now := time.Now()
+	startsAt := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		rows    *sqlmock.Rows
+		wantErr error
+	}{
+		{
+			name: "正常系_生成に使う対象者と期間",
+			rows: sqlmock.NewRows(campaignColumnNames).
+				AddRow(1, "冬のセール", "新規会員の獲得", "30代女性", startsAt, startsAt.AddDate(0, 0, 30), now, now, nil),
+		},
+		{
+			// 論理削除したキャンペーンは取得できないため、新しいコピーを紐付けられない
+			name:    "異常系_削除済み",
+			rows:    sqlmock.NewRows(campaignColumnNames),
+			wantErr: repository.ErrCampaignNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト用DBのセットアップ
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			// SQLクエリのモック（論理削除したキャンペーンは除く）
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `campaigns` WHERE `campaigns`.`id` = ? AND `campaigns`.`deleted_at` IS NULL ORDER BY `campaigns`.`id` LIMIT 1")).
+				WithArgs(1).
+				WillReturnRows(tt.rows)
+
+			// テスト実行
+			campaign, err := NewRepository(db).Get(context.Background(), 1)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorIs(t, err, repository.ErrNotFound)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "30代女性", campaign.Target)
+				assert.True(t, campaign.StartsAt.Equal(startsAt))
+				assert.True(t, campaign.EndsAt.Equal(startsAt.AddDate(0, 0, 30)))
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	tests := []struct {
+		name    string
+		result  driver.Result
+		wantErr error
+	}{
+		{name: "正常系_生成済みのコピーの紐付けは残す", result: sqlmock.NewResult(0, 1)},
+		{name: "異常系_削除済み・存在しない", result: sqlmock.NewResult(0, 0), wantErr: repository.ErrCampaignNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト用DBのセットアップ
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			// SQLクエリのモック（キャンペーンのみを論理削除し、コピーのcampaign_idは変更しない）
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE `campaigns` SET `deleted_at`=? WHERE `campaigns`.`id` = ? AND `campaigns`.`deleted_at` IS NULL")).
+				WithArgs(sqlmock.AnyArg(), 1).
+				WillReturnResult(tt.result)
+			mock.ExpectCommit()
+
+			// テスト実行
+			err = NewRepository(db).Delete(context.Background(), 1)
+
+			// アサーション（copiesを更新した場合は予期しないクエリとしてエラーになる）
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -2,12 +2,15 @@ package copy_repository
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
 	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	revision_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/revision"
 )
 
 type copyRepository struct {
@@ -23,27 +26,424 @@ func (r *copyRepository) Create(ctx context.Context, copy *entity.Copy) error {
 	copy.Likes = 0
 	copy.CreatedAt = time.Now()
 	copy.UpdatedAt = time.Now()
+	copy.TrendingScore = entity.TrendingScore(copy.Likes, copy.CreatedAt)
 
 	return r.db.WithContext(ctx).Create(copy).Error
 }
 
+// CreateBatch: 複数のコピーを1トランザクションで保存
+func (r *copyRepository) CreateBatch(ctx context.Context, copies []*entity.Copy) error {
+	return r.CreateBatchWithRevisions(ctx, copies, nil)
+}
+
+// CreateWithRevision: コピーを保存し、同じトランザクションで最初の版revisionを記録
+//
+// revisionがnilの場合は保存のみ行います。版の記録に失敗した場合は保存も取り消します。
+func (r *copyRepository) CreateWithRevision(ctx context.Context, copy *entity.Copy, revision *entity.CopyRevision) error {
+	return r.CreateBatchWithRevisions(ctx, []*entity.Copy{copy}, []*entity.CopyRevision{revision})
+}
+
+// CreateBatchWithRevisions: 複数のコピーを1トランザクションで保存し、同じトランザクションでcopies[i]の最初の版revisions[i]を記録
+//
+// revisionsがnil・revisions[i]がnilの場合はそのコピーの版を記録しません。
+func (r *copyRepository) CreateBatchWithRevisions(ctx context.Context, copies []*entity.Copy, revisions []*entity.CopyRevision) error {
+	now := time.Now()
+	for _, copy := range copies {
+		copy.Likes = 0
+		copy.CreatedAt = now
+		copy.UpdatedAt = now
+		copy.TrendingScore = entity.TrendingScore(copy.Likes, copy.CreatedAt)
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(copies).Error; err != nil {
+			return err
+		}
+		for i, revision := range revisions {
+			if revision == nil {
+				continue
+			}
+			// 保存したコピーのIDで版を記録する
+			revision.CopyID = copies[i].ID
+			if err := revision_repository.Insert(tx.Session(&gorm.Session{NewDB: true}), revision); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 func (r *copyRepository) Get(ctx context.Context, id int) (*entity.Copy, error) {
 	var copy entity.Copy
 	if err := r.db.WithContext(ctx).First(&copy, id).Error; err != nil {
-		return nil, err
+		return nil, repository.TranslateError(err, repository.ErrCopyNotFound)
 	}
 	return &copy, nil
 }
 
-// GetPublished: 公開済みのコピーのみを取得
-func (r *copyRepository) GetPublished(ctx context.Context) ([]*entity.Copy, error) {
+// GetByIDs: 指定したIDのコピーをidsの順に取得（存在しない・論理削除済みのIDは除く）
+func (r *copyRepository) GetByIDs(ctx context.Context, ids []int) ([]*entity.Copy, error) {
+	if len(ids) == 0 {
+		return []*entity.Copy{}, nil
+	}
+
+	var found []*entity.Copy
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&found).Error; err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int]*entity.Copy, len(found))
+	for _, copy := range found {
+		byID[copy.ID] = copy
+	}
+	copies := make([]*entity.Copy, 0, len(found))
+	for _, id := range ids {
+		if copy, ok := byID[id]; ok {
+			copies = append(copies, copy)
+		}
+	}
+	return copies, nil
+}
+
+// ListPublished: 公開済みのコピーを条件で絞り込み、並び順のキーとIDの降順で取得
+//
+// OFFSETではなく前のページの最後のコピーのキーより後を取得するため、
+// ページが深くなっても(status, キー, id)のインデックスで範囲検索できます。
+// 公開日時前・公開終了後のコピーは、スケジューラーが状態を変更する前でも含めません。
+func (r *copyRepository) ListPublished(ctx context.Context, query repository.CopyListQuery) ([]*entity.Copy, error) {
+	db := r.db.WithContext(ctx).
+		Where("status = ?", entity.CopyStatusPublished).
+		Where("(publish_at IS NULL OR publish_at <= ?) AND (expire_at IS NULL OR expire_at > ?)", query.Now, query.Now)
+
+	f := query.Filter
+	if f.Channel != "" {
+		db = db.Where("channel = ?", f.Channel)
+	}
+	if f.Tone != "" {
+		db = db.Where("tone = ?", f.Tone)
+	}
+	if f.Target != "" {
+		db = db.Where("target = ?", f.Target)
+	}
+	if f.ProductName != "" {
+		db = db.Where("product_name LIKE ? ESCAPE '!'", "%"+escapeLike(f.ProductName)+"%")
+	}
+	if f.CreatedFrom != nil {
+		db = db.Where("created_at >= ?", *f.CreatedFrom)
+	}
+	if f.CreatedTo != nil {
+		db = db.Where("created_at < ?", *f.CreatedTo)
+	}
+
+	var (
+		key   string
+		value interface{}
+	)
+	switch query.Sort {
+	case repository.CopySortLikes:
+		key = "likes"
+		if query.After != nil {
+			value = query.After.Likes
+		}
+	case repository.CopySortTrending:
+		key = "trending_score"
+		if query.After != nil {
+			value = query.After.TrendingScore
+		}
+	default:
+		key = "created_at"
+		if query.After != nil {
+			value = query.After.CreatedAt
+		}
+	}
+	if query.After != nil {
+		db = db.Where(key+" < ? OR ("+key+" = ? AND id < ?)", value, value, query.After.ID)
+	}
+	if query.Limit > 0 {
+		db = db.Limit(query.Limit)
+	}
+
+	copies := []*entity.Copy{}
+	if err := db.Order(key + " DESC").Order("id DESC").Find(&copies).Error; err != nil {
+		return nil, err
+	}
+	return copies, nil
+}
+
+// Like: 投票者のいいねを記録し、初めてのいいねの場合のみいいね数と話題順のスコアを加算
+//
+// いいね数はGoで読み書きせずSQLで加算するため、同時のいいねでも更新は失われません。
+// 先にコピーの行ロックを取得してからいいねを記録し、外部キーの確認による共有ロックから
+// 排他ロックへの昇格で同時のいいね同士がデッドロックしないようにしています。
+func (r *copyRepository) Like(ctx context.Context, id int, voter string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockCopy(tx, id); err != nil {
+			return err
+		}
+
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&entity.Like{CopyID: id, Voter: voter, CreatedAt: time.Now()})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			// いいね済みの場合は数えない
+			return nil
+		}
+		return addLikes(tx, id, 1)
+	})
+}
+
+// Unlike: 投票者のいいねを取り消し、いいね済みだった場合のみいいね数と話題順のスコアを減算
+func (r *copyRepository) Unlike(ctx context.Context, id int, voter string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockCopy(tx, id); err != nil {
+			return err
+		}
+
+		result := tx.Where("copy_id = ? AND voter = ?", id, voter).Delete(&entity.Like{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		return addLikes(tx, id, -1)
+	})
+}
+
+// lockCopy: いいね数を更新するコピーの行ロックを取得（存在しない場合はErrCopyNotFoundを返す）
+func lockCopy(tx *gorm.DB, id int) error {
+	var copy entity.Copy
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&copy, id).Error
+	return repository.TranslateError(err, repository.ErrCopyNotFound)
+}
+
+// addLikes: いいね数と話題順のスコアをdelta分だけSQLで加算
+func addLikes(tx *gorm.DB, id int, delta int) error {
+	return tx.Model(&entity.Copy{ID: id}).
+		UpdateColumns(map[string]interface{}{
+			"likes":          gorm.Expr("likes + ?", delta),
+			"trending_score": gorm.Expr("trending_score + ?", int64(delta)*entity.TrendingLikeScore),
+		}).Error
+}
+
+// escapeLike: LIKEのワイルドカードを「!」でエスケープ（MySQLとSQLiteで同じ結果になるようにESCAPEを明示）
+func escapeLike(s string) string {
+	return strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(s)
+}
+
+// GetByGenerationID: 同じ生成IDの候補をスコアの高い順に取得
+func (r *copyRepository) GetByGenerationID(ctx context.Context, generationID string) ([]*entity.Copy, error) {
+	var copies []*entity.Copy
+	if err := r.db.WithContext(ctx).
+		Where("generation_id = ?", generationID).
+		Order("score DESC").
+		Order("id ASC").
+		Find(&copies).Error; err != nil {
+		return nil, err
+	}
+	return copies, nil
+}
+
+func (r *copyRepository) ListByProduct(ctx context.Context, productID int) ([]*entity.Copy, error) {
+	var copies []*entity.Copy
+	if err := r.db.WithContext(ctx).
+		Where("product_id = ?", productID).
+		Order("created_at DESC").
+		Order("id DESC").
+		Find(&copies).Error; err != nil {
+		return nil, err
+	}
+	return copies, nil
+}
+
+func (r *copyRepository) ListByCampaign(ctx context.Context, campaignID int) ([]*entity.Copy, error) {
 	var copies []*entity.Copy
-	if err := r.db.WithContext(ctx).Where("is_published = ?", true).Find(&copies).Error; err != nil {
+	if err := r.db.WithContext(ctx).
+		Where("campaign_id = ?", campaignID).
+		Order("created_at DESC").
+		Order("id DESC").
+		Find(&copies).Error; err != nil {
 		return nil, err
 	}
 	return copies, nil
 }
 
-func (r *copyRepository) UpdateLikes(ctx context.Context, id int, likes int) error {
-	return r.db.WithContext(ctx).Model(&entity.Copy{}).Where("id = ?", id).Update("likes", likes).Error
+func (r *copyRepository) ListInReview(ctx context.Context, reviewer string) ([]*entity.Copy, error) {
+	db := r.db.WithContext(ctx).Where("status = ?", entity.CopyStatusInReview)
+	if reviewer != "" {
+		db = db.Where("reviewer = ?", reviewer)
+	}
+
+	var copies []*entity.Copy
+	if err := db.Order("updated_at ASC").Order("id ASC").Find(&copies).Error; err != nil {
+		return nil, err
+	}
+	return copies, nil
+}
+
+func (r *copyRepository) ListDueToPublish(ctx context.Context, now time.Time) ([]*entity.Copy, error) {
+	var copies []*entity.Copy
+	if err := r.db.WithContext(ctx).
+		Where("status = ? AND publish_at <= ?", entity.CopyStatusApproved, now).
+		Order("publish_at ASC").Order("id ASC").
+		Find(&copies).Error; err != nil {
+		return nil, err
+	}
+	return copies, nil
+}
+
+func (r *copyRepository) ListDueToExpire(ctx context.Context, now time.Time) ([]*entity.Copy, error) {
+	var copies []*entity.Copy
+	if err := r.db.WithContext(ctx).
+		Where("status = ? AND expire_at <= ?", entity.CopyStatusPublished, now).
+		Order("expire_at ASC").Order("id ASC").
+		Find(&copies).Error; err != nil {
+		return nil, err
+	}
+	return copies, nil
+}
+
+// SelectVariant: 指定した候補のみを採用済みにする
+func (r *copyRepository) SelectVariant(ctx context.Context, generationID string, id int) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&entity.Copy{}).
+			Where("generation_id = ? AND id <> ?", generationID, id).
+			Update("is_selected", false).Error; err != nil {
+			return err
+		}
+
+		result := tx.Model(&entity.Copy{}).
+			Where("generation_id = ? AND id = ?", generationID, id).
+			Update("is_selected", true)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return notFoundUnlessExists(tx.Where("generation_id = ? AND id = ?", generationID, id), repository.ErrCopyNotFound)
+		}
+		return nil
+	})
+}
+
+// UpdateFindings: 表現規制のチェック結果を更新
+func (r *copyRepository) UpdateFindings(ctx context.Context, id int, findings []entity.ComplianceFinding) error {
+	result := r.db.WithContext(ctx).
+		Model(&entity.Copy{ID: id}).
+		Select("findings").
+		Updates(&entity.Copy{Findings: findings})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return notFoundUnlessExists(r.db.WithContext(ctx).Where("id = ?", id), repository.ErrCopyNotFound)
+	}
+	return nil
+}
+
+// Update: fieldsに指定したフィールドのみを更新
+func (r *copyRepository) Update(ctx context.Context, copy *entity.Copy, fields []string) error {
+	result := r.db.WithContext(ctx).Model(copy).Select(fields).Updates(copy)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return notFoundUnlessExists(r.db.WithContext(ctx).Where("id = ?", copy.ID), repository.ErrCopyNotFound)
+	}
+	return nil
+}
+
+// UpdateWithRevision: fieldsに指定したフィールドを更新し、同じトランザクションでrevisionを記録
+//
+// revisionがnilの場合は更新のみ行います。版の記録に失敗した場合は更新も取り消します。
+func (r *copyRepository) UpdateWithRevision(ctx context.Context, copy *entity.Copy, fields []string, revision *entity.CopyRevision) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return updateWithRevision(tx, copy, fields, revision, noGuard, repository.ErrCopyNotFound)
+	})
+}
+
+// UpdateStatus: guardの条件を満たす場合のみfieldsに指定したフィールドを更新し、同じトランザクションでrevisionを記録
+//
+// 条件の確認と更新を1つのUPDATE文で行うため、同時に状態・公開日時などを変更した場合も一方のみが成功します。
+// 他の操作で状態・公開日時・公開終了日時が変わっていた（または削除されていた）場合はErrCopyStatusChangedを返し、版も記録しません。
+func (r *copyRepository) UpdateStatus(ctx context.Context, copy *entity.Copy, guard repository.CopyStatusGuard, fields []string, revision *entity.CopyRevision) error {
+	cond := func(db *gorm.DB) *gorm.DB {
+		db = db.Where("status = ?", guard.Status)
+		if guard.PublishDue != nil {
+			db = db.Where("publish_at <= ?", *guard.PublishDue)
+		}
+		if guard.ExpireDue != nil {
+			db = db.Where("expire_at <= ?", *guard.ExpireDue)
+		}
+		return db
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return updateWithRevision(tx, copy, fields, revision, cond, repository.ErrCopyStatusChanged)
+	})
+}
+
+// noGuard: 更新の条件を追加しない
+func noGuard(db *gorm.DB) *gorm.DB {
+	return db
+}
+
+// updateWithRevision: condの条件を満たすコピーを更新して版を記録（対象が存在しない場合はnotUpdatedを返す）
+func updateWithRevision(tx *gorm.DB, copy *entity.Copy, fields []string, revision *entity.CopyRevision, cond func(*gorm.DB) *gorm.DB, notUpdated error) error {
+	result := cond(tx).Model(copy).Select(fields).Updates(copy)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		if err := notFoundUnlessExists(cond(tx).Where("id = ?", copy.ID), notUpdated); err != nil {
+			return err
+		}
+	}
+	if revision == nil {
+		return nil
+	}
+	// 更新の条件を版の採番に引き継がないよう、条件のないセッションで記録する
+	return revision_repository.Insert(tx.Session(&gorm.Session{NewDB: true}), revision)
+}
+
+// notFoundUnlessExists: 更新した件数が0件の場合に、dbの条件を満たすコピーが存在するかを確認
+//
+// MySQLは値が変わらなかった行を更新した件数に含めない（DSNでclientFoundRowsを指定しない場合）ため、
+// 同じ値での更新を対象がないと誤って判定しないよう、存在する場合はnilを返し、存在しない場合のみnotFoundを返します。
+func notFoundUnlessExists(db *gorm.DB, notFound error) error {
+	var count int64
+	if err := db.Model(&entity.Copy{}).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return notFound
+	}
+	return nil
+}
+
+// Delete: コピーを論理削除
+func (r *copyRepository) Delete(ctx context.Context, id int) error {
+	result := r.db.WithContext(ctx).Delete(&entity.Copy{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return notFoundUnlessExists(r.db.WithContext(ctx).Where("id = ?", id), repository.ErrCopyNotFound)
+	}
+	return nil
+}
+
+// Restore: 論理削除したコピーを元に戻す
+func (r *copyRepository) Restore(ctx context.Context, id int) error {
+	result := r.db.WithContext(ctx).Unscoped().
+		Model(&entity.Copy{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return notFoundUnlessExists(r.db.WithContext(ctx).Unscoped().Where("id = ? AND deleted_at IS NOT NULL", id), repository.ErrCopyNotFound)
+	}
+	return nil
 }
@@ -2,8 +2,10 @@ package copy_repository
 
 import (
 	"context"
+	"database/sql/driver"
 	"errors"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -14,6 +16,7 @@ import (
 	"gorm.io/gorm/logger"
 
 	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
 )
 
 func setupTestDB() (*gorm.DB, sqlmock.Sqlmock, error) {
@@ -51,7 +54,7 @@ func TestCreate(t *testing.T) {
 		Target:          "20-30代女性",
 		Channel:         entity.ChannelSNS,
 		Tone:            entity.ToneCasual,
-		IsPublished:     true,
+		Status:          entity.CopyStatusPublished,
 		CreatedAt:       now,
 		UpdatedAt:       now,
 	}
@@ -124,7 +127,7 @@ func TestGet(t *testing.T) {
 		Channel:         entity.ChannelSNS,
 		Tone:            entity.ToneCasual,
 		Likes:           0,
-		IsPublished:     true,
+		Status:          entity.CopyStatusPublished,
 		CreatedAt:       now,
 		UpdatedAt:       now,
 	}
@@ -157,23 +160,23 @@ func TestGet(t *testing.T) {
 
 			// SQLクエリのモック
 			if tt.wantErr {
-				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `copies` WHERE `copies`.`id` = ? ORDER BY `copies`.`id` LIMIT 1")).
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `copies` WHERE `copies`.`id` = ? AND `copies`.`deleted_at` IS NULL ORDER BY `copies`.`id` LIMIT 1")).
 					WithArgs(tt.id).
 					WillReturnError(gorm.ErrRecordNotFound)
 			} else {
 				rows := sqlmock.NewRows([]string{
 					"id", "title", "description", "product_name", "product_features",
-					"target", "channel", "tone", "likes", "is_published",
+					"target", "channel", "tone", "likes", "status",
 					"created_at", "updated_at",
 				}).AddRow(
 					testCopy.ID, testCopy.Title, testCopy.Description,
 					testCopy.ProductName, testCopy.ProductFeatures,
 					testCopy.Target, testCopy.Channel, testCopy.Tone,
-					testCopy.Likes, testCopy.IsPublished,
+					testCopy.Likes, testCopy.Status,
 					testCopy.CreatedAt, testCopy.UpdatedAt,
 				)
 
-				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `copies` WHERE `copies`.`id` = ? ORDER BY `copies`.`id` LIMIT 1")).
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `copies` WHERE `copies`.`id` = ? AND `copies`.`deleted_at` IS NULL ORDER BY `copies`.`id` LIMIT 1")).
 					WithArgs(tt.id).
 					WillReturnRows(rows)
 			}
@@ -186,7 +189,7 @@ func TestGet(t *testing.T) {
 
 			// アサーション
 			if tt.wantErr {
-				assert.Error(t, err)
+				assert.ErrorIs(t, err, repository.ErrCopyNotFound)
 				assert.Nil(t, got)
 			} else {
 				assert.NoError(t, err)
@@ -199,54 +202,97 @@ func TestGet(t *testing.T) {
 	}
 }
 
-func TestGetPublished(t *testing.T) {
+func TestListPublished(t *testing.T) {
 	// テストデータの準備
 	now := time.Now()
 	testCopies := []*entity.Copy{
 		{
-			ID:              1,
-			Title:           "テストタイトル1",
-			Description:     "テスト説明1",
-			ProductName:     "テスト商品1",
+			ID:              2,
+			Title:           "テストタイトル2",
+			Description:     "テスト説明2",
+			ProductName:     "テスト商品2",
 			ProductFeatures: "高品質、使いやすい",
 			Target:          "20-30代女性",
 			Channel:         entity.ChannelSNS,
 			Tone:            entity.ToneCasual,
 			Likes:           0,
-			IsPublished:     true,
+			Status:          entity.CopyStatusPublished,
 			CreatedAt:       now,
 			UpdatedAt:       now,
 		},
 		{
-			ID:              2,
-			Title:           "テストタイトル2",
-			Description:     "テスト説明2",
-			ProductName:     "テスト商品2",
+			ID:              1,
+			Title:           "テストタイトル1",
+			Description:     "テスト説明1",
+			ProductName:     "テスト商品1",
 			ProductFeatures: "高品質、使いやすい",
 			Target:          "20-30代女性",
 			Channel:         entity.ChannelSNS,
 			Tone:            entity.ToneCasual,
 			Likes:           0,
-			IsPublished:     true,
+			Status:          entity.CopyStatusPublished,
 			CreatedAt:       now,
 			UpdatedAt:       now,
 		},
 	}
+	createdFrom := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	createdTo := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
 
 	tests := []struct {
-		name    string
-		want    []*entity.Copy
-		wantErr bool
+		name     string
+		query    repository.CopyListQuery
+		wantSQL  string
+		wantArgs []driver.Value
+		want     []*entity.Copy
+		wantErr  bool
 	}{
 		{
-			name:    "正常系",
-			want:    testCopies,
-			wantErr: false,
+			name:     "正常系_新しい順",
+			query:    repository.CopyListQuery{Sort: repository.CopySortNewest, Limit: 21, Now: now},
+			wantSQL:  "SELECT * FROM `copies` WHERE status = ? AND ((publish_at IS NULL OR publish_at <= ?) AND (expire_at IS NULL OR expire_at > ?)) AND `copies`.`deleted_at` IS NULL ORDER BY created_at DESC,id DESC LIMIT 21",
+			wantArgs: []driver.Value{entity.CopyStatusPublished, now, now},
+			want:     testCopies,
 		},
 		{
-			name:    "異常系_DBエラー",
-			want:    nil,
-			wantErr: true,
+			name: "正常系_絞り込みとカーソル",
+			query: repository.CopyListQuery{
+				Filter: repository.CopyFilter{
+					Channel:     entity.ChannelSNS,
+					Tone:        entity.ToneCasual,
+					Target:      "20-30代女性",
+					ProductName: "50%_OFF",
+					CreatedFrom: &createdFrom,
+					CreatedTo:   &createdTo,
+				},
+				Sort:  repository.CopySortLikes,
+				After: &repository.CopyCursor{ID: 3, Likes: 10},
+				Limit: 11,
+				Now:   now,
+			},
+			wantSQL: "SELECT * FROM `copies` WHERE status = ? AND ((publish_at IS NULL OR publish_at <= ?) AND (expire_at IS NULL OR expire_at > ?)) AND channel = ? AND tone = ? AND target = ? AND product_name LIKE ? ESCAPE '!' " +
+				"AND created_at >= ? AND created_at < ? AND (likes < ? OR (likes = ? AND id < ?)) AND `copies`.`deleted_at` IS NULL " +
+				"ORDER BY likes DESC,id DESC LIMIT 11",
+			wantArgs: []driver.Value{entity.CopyStatusPublished, now, now, entity.ChannelSNS, entity.ToneCasual, "20-30代女性", "%50!%!_OFF%", createdFrom, createdTo, 10, 10, 3},
+			want:     testCopies,
+		},
+		{
+			name: "正常系_話題順",
+			query: repository.CopyListQuery{
+				Sort:  repository.CopySortTrending,
+				After: &repository.CopyCursor{ID: 3, TrendingScore: 1750000000},
+				Limit: 21,
+				Now:   now,
+			},
+			wantSQL:  "SELECT * FROM `copies` WHERE status = ? AND ((publish_at IS NULL OR publish_at <= ?) AND (expire_at IS NULL OR expire_at > ?)) AND (trending_score < ? OR (trending_score = ? AND id < ?)) AND `copies`.`deleted_at` IS NULL ORDER BY trending_score DESC,id DESC LIMIT 21",
+			wantArgs: []driver.Value{entity.CopyStatusPublished, now, now, 1750000000, 1750000000, 3},
+			want:     testCopies,
+		},
+		{
+			name:     "異常系_DBエラー",
+			query:    repository.CopyListQuery{Sort: repository.CopySortNewest, Limit: 21, Now: now},
+			wantSQL:  "SELECT * FROM `copies` WHERE status = ? AND ((publish_at IS NULL OR publish_at <= ?) AND (expire_at IS NULL OR expire_at > ?)) AND `copies`.`deleted_at` IS NULL ORDER BY created_at DESC,id DESC LIMIT 21",
+			wantArgs: []driver.Value{entity.CopyStatusPublished, now, now},
+			wantErr:  true,
 		},
 	}
 
@@ -257,37 +303,33 @@ func TestGetPublished(t *testing.T) {
 			assert.NoError(t, err)
 
 			// SQLクエリのモック
+			expect := mock.ExpectQuery(regexp.QuoteMeta(tt.wantSQL)).WithArgs(tt.wantArgs...)
 			if tt.wantErr {
-				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `copies` WHERE is_published = ?")).
-					WithArgs(true).
-					WillReturnError(errors.New("database error"))
+				expect.WillReturnError(errors.New("database error"))
 			} else {
 				rows := sqlmock.NewRows([]string{
 					"id", "title", "description", "product_name", "product_features",
-					"target", "channel", "tone", "likes", "is_published",
+					"target", "channel", "tone", "likes", "status",
 					"created_at", "updated_at",
 				})
 
-				for _, copy := range testCopies {
+				for _, copy := range tt.want {
 					rows.AddRow(
 						copy.ID, copy.Title, copy.Description,
 						copy.ProductName, copy.ProductFeatures,
 						copy.Target, copy.Channel, copy.Tone,
-						copy.Likes, copy.IsPublished,
+						copy.Likes, copy.Status,
 						copy.CreatedAt, copy.UpdatedAt,
 					)
 				}
-
-				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `copies` WHERE is_published = ?")).
-					WithArgs(true).
-					WillReturnRows(rows)
+				expect.WillReturnRows(rows)
 			}
 
 			// リポジトリの作成
 			repo := NewRepository(db)
 
 			// テスト実行
-			got, err := repo.GetPublished(context.Background())
+			got, err := repo.ListPublished(context.Background(), tt.query)
 
 			// アサーション
 			if tt.wantErr {
@@ -304,23 +346,181 @@ func TestGetPublished(t *testing.T) {
 	}
 }
 
-func TestUpdateLikes(t *testing.T) {
+func TestLike(t *testing.T) {
+	lockQuery := regexp.QuoteMeta("SELECT `id` FROM `copies` WHERE `copies`.`id` = ? AND `copies`.`deleted_at` IS NULL ORDER BY `copies`.`id` LIMIT 1 FOR UPDATE")
+	insertQuery := regexp.QuoteMeta("INSERT INTO `likes` (`copy_id`,`voter`,`created_at`) VALUES (?,?,?) ON DUPLICATE KEY UPDATE `copy_id`=`copy_id`")
+	updateQuery := regexp.QuoteMeta("UPDATE `copies` SET `likes`=likes + ?,`trending_score`=trending_score + ? WHERE `copies`.`deleted_at` IS NULL AND `id` = ?")
+
+	tests := []struct {
+		name     string
+		mockFunc func(mock sqlmock.Sqlmock)
+		wantErr  error
+	}{
+		{
+			name: "正常系_初めてのいいね",
+			mockFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(lockQuery).WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectExec(insertQuery).WithArgs(1, "user:1", sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(updateQuery).WithArgs(1, entity.TrendingLikeScore, 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "正常系_いいね済みは数えない",
+			mockFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(lockQuery).WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectExec(insertQuery).WithArgs(1, "user:1", sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "異常系_存在しないID",
+			mockFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(lockQuery).WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectRollback()
+			},
+			wantErr: repository.ErrCopyNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト用DBのセットアップ
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			// SQLクエリのモック（行ロックを取得してから記録し、SQLで加算する）
+			tt.mockFunc(mock)
+
+			// テスト実行
+			err = NewRepository(db).Like(context.Background(), 1, "user:1")
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			// すべてのモックが呼び出されたことを確認
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUnlike(t *testing.T) {
+	lockQuery := regexp.QuoteMeta("SELECT `id` FROM `copies` WHERE `copies`.`id` = ? AND `copies`.`deleted_at` IS NULL ORDER BY `copies`.`id` LIMIT 1 FOR UPDATE")
+	deleteQuery := regexp.QuoteMeta("DELETE FROM `likes` WHERE copy_id = ? AND voter = ?")
+	updateQuery := regexp.QuoteMeta("UPDATE `copies` SET `likes`=likes + ?,`trending_score`=trending_score + ? WHERE `copies`.`deleted_at` IS NULL AND `id` = ?")
+
+	tests := []struct {
+		name     string
+		mockFunc func(mock sqlmock.Sqlmock)
+		wantErr  error
+	}{
+		{
+			name: "正常系_いいねを取り消す",
+			mockFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(lockQuery).WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectExec(deleteQuery).WithArgs(1, "user:1").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(updateQuery).WithArgs(-1, -entity.TrendingLikeScore, 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "正常系_いいねしていない場合は数えない",
+			mockFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(lockQuery).WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectExec(deleteQuery).WithArgs(1, "user:1").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "異常系_存在しないID",
+			mockFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(lockQuery).WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectRollback()
+			},
+			wantErr: repository.ErrCopyNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト用DBのセットアップ
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+			tt.mockFunc(mock)
+
+			// テスト実行
+			err = NewRepository(db).Unlike(context.Background(), 1, "user:1")
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			// すべてのモックが呼び出されたことを確認
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCreateBatch(t *testing.T) {
+	// テストデータの準備
+	newCopies := func() []*entity.Copy {
+		return []*entity.Copy{
+			{
+				Title:        "テストタイトル1",
+				Description:  "テスト説明1",
+				ProductName:  "テスト商品",
+				Channel:      entity.ChannelSNS,
+				Tone:         entity.ToneCasual,
+				GenerationID: "gen-1",
+				Score:        0.9,
+			},
+			{
+				Title:        "テストタイトル2",
+				Description:  "テスト説明2",
+				ProductName:  "テスト商品",
+				Channel:      entity.ChannelSNS,
+				Tone:         entity.ToneCasual,
+				GenerationID: "gen-1",
+				Score:        0.5,
+			},
+		}
+	}
+
 	tests := []struct {
 		name    string
-		id      int
-		likes   int
 		wantErr bool
 	}{
 		{
 			name:    "正常系",
-			id:      1,
-			likes:   1,
 			wantErr: false,
 		},
 		{
-			name:    "異常系_存在しないID",
-			id:      999,
-			likes:   1,
+			name:    "異常系_DBエラー",
 			wantErr: true,
 		},
 	}
@@ -332,31 +532,226 @@ func TestUpdateLikes(t *testing.T) {
 			assert.NoError(t, err)
 
 			// SQLクエリのモック
+			mock.ExpectBegin()
 			if tt.wantErr {
-				mock.ExpectBegin()
-				mock.ExpectExec("UPDATE `copies` SET").
-					WithArgs(tt.likes, sqlmock.AnyArg(), tt.id).
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `copies`")).
 					WillReturnError(errors.New("database error"))
 				mock.ExpectRollback()
 			} else {
-				mock.ExpectBegin()
-				mock.ExpectExec("UPDATE `copies` SET").
-					WithArgs(tt.likes, sqlmock.AnyArg(), tt.id).
-					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `copies`")).
+					WillReturnResult(sqlmock.NewResult(1, 2))
+				mock.ExpectCommit()
+			}
+
+			// リポジトリの作成
+			repo := NewRepository(db)
+
+			// テスト実行
+			copies := newCopies()
+			err = repo.CreateBatch(context.Background(), copies)
+
+			// アサーション
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				for _, copy := range copies {
+					assert.False(t, copy.CreatedAt.IsZero())
+				}
+			}
+
+			// すべてのモックが呼び出されたことを確認
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCreateBatchWithRevisions(t *testing.T) {
+	tests := []struct {
+		name      string
+		insertErr error
+		wantErr   error
+	}{
+		{
+			name: "正常系_保存したコピーのIDで最初の版を記録",
+		},
+		{
+			name:      "異常系_版の記録に失敗した場合は保存も取り消す",
+			insertErr: errors.New("insert error"),
+			wantErr:   errors.New("insert error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト用DBのセットアップ
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			// SQLクエリのモック（保存と版の記録を1トランザクションで行う）
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `copies`")).
+				WillReturnResult(sqlmock.NewResult(5, 2))
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT `revision` FROM `copy_revisions` WHERE copy_id = ? ORDER BY revision DESC LIMIT 1 FOR UPDATE")).
+				WithArgs(5).
+				WillReturnRows(sqlmock.NewRows([]string{"revision"}))
+			insert := mock.ExpectExec("INSERT INTO `copy_revisions`")
+			if tt.insertErr != nil {
+				insert.WillReturnError(tt.insertErr)
+				mock.ExpectRollback()
+			} else {
+				insert.WillReturnResult(sqlmock.NewResult(10, 1))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT `revision` FROM `copy_revisions` WHERE copy_id = ? ORDER BY revision DESC LIMIT 1 FOR UPDATE")).
+					WithArgs(6).
+					WillReturnRows(sqlmock.NewRows([]string{"revision"}))
+				mock.ExpectExec("INSERT INTO `copy_revisions`").
+					WillReturnResult(sqlmock.NewResult(11, 1))
 				mock.ExpectCommit()
 			}
 
+			// テスト実行
+			copies := []*entity.Copy{
+				{Title: "テストタイトル1", GenerationID: "gen-1"},
+				{Title: "テストタイトル2", GenerationID: "gen-1"},
+			}
+			revisions := []*entity.CopyRevision{
+				entity.NewCopyRevision(copies[0], entity.RevisionSourceGenerated, ""),
+				entity.NewCopyRevision(copies[1], entity.RevisionSourceGenerated, ""),
+			}
+			err = NewRepository(db).CreateBatchWithRevisions(context.Background(), copies, revisions)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				for i, revision := range revisions {
+					assert.Equal(t, copies[i].ID, revision.CopyID)
+					assert.Equal(t, 1, revision.Revision)
+				}
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetByGenerationID(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantLen int
+		wantErr bool
+	}{
+		{
+			name:    "正常系",
+			wantLen: 2,
+			wantErr: false,
+		},
+		{
+			name:    "異常系_DBエラー",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト用DBのセットアップ
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			// SQLクエリのモック
+			query := mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `copies` WHERE generation_id = ? AND `copies`.`deleted_at` IS NULL ORDER BY score DESC,id ASC")).
+				WithArgs("gen-1")
+			if tt.wantErr {
+				query.WillReturnError(errors.New("database error"))
+			} else {
+				query.WillReturnRows(sqlmock.NewRows([]string{"id", "title", "generation_id", "score"}).
+					AddRow(2, "テストタイトル2", "gen-1", 0.9).
+					AddRow(1, "テストタイトル1", "gen-1", 0.5))
+			}
+
 			// リポジトリの作成
 			repo := NewRepository(db)
 
 			// テスト実行
-			err = repo.UpdateLikes(context.Background(), tt.id, tt.likes)
+			got, err := repo.GetByGenerationID(context.Background(), "gen-1")
+
+			// アサーション
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, got, tt.wantLen)
+				assert.Equal(t, 2, got[0].ID)
+			}
+
+			// すべてのモックが呼び出されたことを確認
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetByIDs(t *testing.T) {
+	tests := []struct {
+		name    string
+		ids     []int
+		wantIDs []int
+		wantErr bool
+	}{
+		{
+			name:    "正常系_指定した順に並べる",
+			ids:     []int{3, 1, 2},
+			wantIDs: []int{3, 1},
+		},
+		{
+			name:    "正常系_IDの指定なし",
+			ids:     []int{},
+			wantIDs: []int{},
+		},
+		{
+			name:    "異常系_DBエラー",
+			ids:     []int{1},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト用DBのセットアップ
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			// SQLクエリのモック（IDの指定がない場合はクエリを実行しない）
+			if len(tt.ids) > 0 {
+				args := make([]driver.Value, len(tt.ids))
+				for i, id := range tt.ids {
+					args[i] = id
+				}
+				query := mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `copies` WHERE id IN (" + strings.TrimSuffix(strings.Repeat("?,", len(tt.ids)), ",") + ") AND `copies`.`deleted_at` IS NULL")).
+					WithArgs(args...)
+				if tt.wantErr {
+					query.WillReturnError(errors.New("database error"))
+				} else {
+					query.WillReturnRows(sqlmock.NewRows([]string{"id", "title"}).
+						AddRow(1, "テストタイトル1").
+						AddRow(3, "テストタイトル3"))
+				}
+			}
+
+			// テスト実行
+			got, err := NewRepository(db).GetByIDs(context.Background(), tt.ids)
 
 			// アサーション
 			if tt.wantErr {
 				assert.Error(t, err)
+				assert.Nil(t, got)
 			} else {
 				assert.NoError(t, err)
+				gotIDs := []int{}
+				for _, copy := range got {
+					gotIDs = append(gotIDs, copy.ID)
+				}
+				assert.Equal(t, tt.wantIDs, gotIDs)
 			}
 
 			// すべてのモックが呼び出されたことを確認
@@ -364,3 +759,664 @@ func TestUpdateLikes(t *testing.T) {
 		})
 	}
 }
+
+func TestSelectVariant(t *testing.T) {
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		exists       bool
+		wantErr      error
+	}{
+		{
+			name:         "正常系",
+			rowsAffected: 1,
+		},
+		{
+			name:         "正常系_値が変わらない場合",
+			rowsAffected: 0,
+			exists:       true,
+		},
+		{
+			name:         "異常系_候補に含まれないID",
+			rowsAffected: 0,
+			wantErr:      repository.ErrCopyNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト用DBのセットアップ
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			// SQLクエリのモック
+			mock.ExpectBegin()
+			mock.ExpectExec("UPDATE `copies` SET").
+				WithArgs(false, sqlmock.AnyArg(), "gen-1", 1).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec("UPDATE `copies` SET").
+				WithArgs(true, sqlmock.AnyArg(), "gen-1", 1).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+			if tt.rowsAffected == 0 {
+				// 更新した件数が0件の場合は対象の存在を確認する（MySQLは値が変わらない行を数えない）
+				count := 0
+				if tt.exists {
+					count = 1
+				}
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `copies` WHERE (generation_id = ? AND id = ?) AND `copies`.`deleted_at` IS NULL")).
+					WithArgs("gen-1", 1).
+					WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(count))
+			}
+			if tt.wantErr != nil {
+				mock.ExpectRollback()
+			} else {
+				mock.ExpectCommit()
+			}
+
+			// リポジトリの作成
+			repo := NewRepository(db)
+
+			// テスト実行
+			err = repo.SelectVariant(context.Background(), "gen-1", 1)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			// すべてのモックが呼び出されたことを確認
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUpdateFindings(t *testing.T) {
+	findings := []entity.ComplianceFinding{
+		{RuleID: "absolute-claim", Category: "景品表示法", Severity: entity.SeverityHigh, Field: "title", Match: "必ず", Start: 0, End: 2, Message: "断定的な表現"},
+	}
+
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		exists       bool
+		wantErr      error
+	}{
+		{
+			name:         "正常系",
+			rowsAffected: 1,
+		},
+		{
+			name:         "正常系_値が変わらない場合",
+			rowsAffected: 0,
+			exists:       true,
+		},
+		{
+			name:         "異常系_存在しないID",
+			rowsAffected: 0,
+			wantErr:      repository.ErrCopyNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト用DBのセットアップ
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			// SQLクエリのモック（検出結果はJSONで保存される）
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE `copies` SET `updated_at`=?,`findings`=? WHERE `copies`.`deleted_at` IS NULL AND `id` = ?")).
+				WithArgs(sqlmock.AnyArg(), `[{"ruleId":"absolute-claim","category":"景品表示法","severity":"high","field":"title","match":"必ず","start":0,"end":2,"message":"断定的な表現"}]`, 1).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+			mock.ExpectCommit()
+			if tt.rowsAffected == 0 {
+				// 更新した件数が0件の場合は対象の存在を確認する（MySQLは値が変わらない行を数えない）
+				count := 0
+				if tt.exists {
+					count = 1
+				}
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `copies` WHERE id = ? AND `copies`.`deleted_at` IS NULL")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(count))
+			}
+
+			// リポジトリの作成
+			repo := NewRepository(db)
+
+			// テスト実行
+			err = repo.UpdateFindings(context.Background(), 1, findings)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			// すべてのモックが呼び出されたことを確認
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		exists       bool
+		wantErr      error
+	}{
+		{
+			name:         "正常系",
+			rowsAffected: 1,
+		},
+		{
+			name:         "正常系_値が変わらない場合",
+			rowsAffected: 0,
+			exists:       true,
+		},
+		{
+			name:         "異常系_存在しないID",
+			rowsAffected: 0,
+			wantErr:      repository.ErrCopyNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト用DBのセットアップ
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			// SQLクエリのモック（指定したフィールドのみを更新する）
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE `copies` SET `title`=?,`updated_at`=?,`status`=? WHERE `copies`.`deleted_at` IS NULL AND `id` = ?")).
+				WithArgs("新しいタイトル", sqlmock.AnyArg(), entity.CopyStatusInReview, 1).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+			mock.ExpectCommit()
+			if tt.rowsAffected == 0 {
+				// 更新した件数が0件の場合は対象の存在を確認する（MySQLは値が変わらない行を数えない）
+				count := 0
+				if tt.exists {
+					count = 1
+				}
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `copies` WHERE id = ? AND `copies`.`deleted_at` IS NULL")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(count))
+			}
+
+			// リポジトリの作成
+			repo := NewRepository(db)
+
+			// テスト実行
+			copy := &entity.Copy{ID: 1, Title: "新しいタイトル", Description: "変更しない本文", Status: entity.CopyStatusInReview}
+			err = repo.Update(context.Background(), copy, []string{"Title", "Status"})
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			// すべてのモックが呼び出されたことを確認
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUpdateWithRevision(t *testing.T) {
+	tests := []struct {
+		name         string
+		revision     *entity.CopyRevision
+		rowsAffected int64
+		exists       bool
+		insertErr    error
+		wantErr      error
+	}{
+		{
+			name:         "正常系_版を記録",
+			revision:     &entity.CopyRevision{CopyID: 1, Source: entity.RevisionSourceEdited, Title: "新しいタイトル"},
+			rowsAffected: 1,
+		},
+		{
+			name:         "正常系_版なし",
+			rowsAffected: 1,
+		},
+		{
+			name:         "正常系_値が変わらない場合も版を記録",
+			revision:     &entity.CopyRevision{CopyID: 1, Source: entity.RevisionSourceEdited, Title: "新しいタイトル"},
+			rowsAffected: 0,
+			exists:       true,
+		},
+		{
+			name:         "異常系_存在しないID",
+			revision:     &entity.CopyRevision{CopyID: 1, Source: entity.RevisionSourceEdited, Title: "新しいタイトル"},
+			rowsAffected: 0,
+			wantErr:      repository.ErrCopyNotFound,
+		},
+		{
+			name:         "異常系_版の記録に失敗した場合は更新も取り消す",
+			revision:     &entity.CopyRevision{CopyID: 1, Source: entity.RevisionSourceEdited, Title: "新しいタイトル"},
+			rowsAffected: 1,
+			insertErr:    errors.New("insert error"),
+			wantErr:      errors.New("insert error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト用DBのセットアップ
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			// SQLクエリのモック（更新と版の記録を1トランザクションで行う）
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE `copies` SET `title`=?,`updated_at`=? WHERE `copies`.`deleted_at` IS NULL AND `id` = ?")).
+				WithArgs("新しいタイトル", sqlmock.AnyArg(), 1).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+			if tt.rowsAffected == 0 {
+				// 更新した件数が0件の場合は対象の存在を確認する（MySQLは値が変わらない行を数えない）
+				count := 0
+				if tt.exists {
+					count = 1
+				}
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `copies` WHERE id = ? AND `copies`.`deleted_at` IS NULL")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(count))
+			}
+			if tt.revision != nil && (tt.rowsAffected > 0 || tt.exists) {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT `revision` FROM `copy_revisions` WHERE copy_id = ? ORDER BY revision DESC LIMIT 1 FOR UPDATE")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"revision"}).AddRow(2))
+				insert := mock.ExpectExec("INSERT INTO `copy_revisions`")
+				if tt.insertErr != nil {
+					insert.WillReturnError(tt.insertErr)
+				} else {
+					insert.WillReturnResult(sqlmock.NewResult(10, 1))
+				}
+			}
+			if tt.wantErr != nil {
+				mock.ExpectRollback()
+			} else {
+				mock.ExpectCommit()
+			}
+
+			// テスト実行
+			copy := &entity.Copy{ID: 1, Title: "新しいタイトル"}
+			err = NewRepository(db).UpdateWithRevision(context.Background(), copy, []string{"Title"}, tt.revision)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.wantErr == nil && tt.revision != nil {
+				assert.Equal(t, 3, tt.revision.Revision)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUpdateStatus(t *testing.T) {
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		exists       bool
+		wantErr      error
+	}{
+		{
+			name:         "正常系_状態を変更して版を記録",
+			rowsAffected: 1,
+		},
+		{
+			name:         "正常系_値が変わらない場合も条件を満たせば版を記録",
+			rowsAffected: 0,
+			exists:       true,
+		},
+		{
+			name:         "異常系_他の操作で状態が変わっていた",
+			rowsAffected: 0,
+			wantErr:      repository.ErrCopyStatusChanged,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト用DBのセットアップ
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			// SQLクエリのモック（取得時の状態を条件に更新し、更新できた場合のみ同じトランザクションで版を記録する）
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE `copies` SET `updated_at`=?,`status`=? WHERE status = ? AND `copies`.`deleted_at` IS NULL AND `id` = ?")).
+				WithArgs(sqlmock.AnyArg(), entity.CopyStatusApproved, entity.CopyStatusInReview, 1).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+			if tt.rowsAffected == 0 {
+				// 更新した件数が0件の場合は対象の存在を確認する（MySQLは値が変わらない行を数えない）
+				count := 0
+				if tt.exists {
+					count = 1
+				}
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `copies` WHERE status = ? AND id = ? AND `copies`.`deleted_at` IS NULL")).
+					WithArgs(entity.CopyStatusInReview, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(count))
+			}
+			if tt.wantErr == nil {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT `revision` FROM `copy_revisions` WHERE copy_id = ? ORDER BY revision DESC LIMIT 1 FOR UPDATE")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"revision"}).AddRow(1))
+				mock.ExpectExec("INSERT INTO `copy_revisions`").
+					WillReturnResult(sqlmock.NewResult(10, 1))
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
+
+			// テスト実行
+			copy := &entity.Copy{ID: 1, Status: entity.CopyStatusApproved}
+			revision := &entity.CopyRevision{CopyID: 1, Source: entity.RevisionSourceWorkflow, Status: entity.CopyStatusApproved}
+			err = NewRepository(db).UpdateStatus(context.Background(), copy, repository.CopyStatusGuard{Status: entity.CopyStatusInReview}, []string{"Status"}, revision)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 2, revision.Revision)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUpdateStatus_Due(t *testing.T) {
+	// テスト用DBのセットアップ
+	db, mock, err := setupTestDB()
+	assert.NoError(t, err)
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	// SQLクエリのモック（一覧の時点の状態に加え、公開日時が現在時刻以前であることを条件に更新する）
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `copies` SET `updated_at`=?,`status`=? WHERE status = ? AND publish_at <= ? AND `copies`.`deleted_at` IS NULL AND `id` = ?")).
+		WithArgs(sqlmock.AnyArg(), entity.CopyStatusPublished, entity.CopyStatusApproved, now, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// テスト実行
+	copy := &entity.Copy{ID: 1, Status: entity.CopyStatusPublished}
+	err = NewRepository(db).UpdateStatus(context.Background(), copy, repository.CopyStatusGuard{Status: entity.CopyStatusApproved, PublishDue: &now}, []string{"Status"}, nil)
+
+	// アサーション
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDelete(t *testing.T) {
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		exists       bool
+		wantErr      error
+	}{
+		{
+			name:         "正常系",
+			rowsAffected: 1,
+		},
+		{
+			name:         "異常系_存在しないID",
+			rowsAffected: 0,
+			wantErr:      repository.ErrCopyNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト用DBのセットアップ
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			// SQLクエリのモック（論理削除はdeleted_atの更新）
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE `copies` SET `deleted_at`=? WHERE `copies`.`id` = ? AND `copies`.`deleted_at` IS NULL")).
+				WithArgs(sqlmock.AnyArg(), 1).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+			mock.ExpectCommit()
+			if tt.rowsAffected == 0 {
+				// 更新した件数が0件の場合は対象の存在を確認する（MySQLは値が変わらない行を数えない）
+				count := 0
+				if tt.exists {
+					count = 1
+				}
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `copies` WHERE id = ? AND `copies`.`deleted_at` IS NULL")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(count))
+			}
+
+			// リポジトリの作成
+			repo := NewRepository(db)
+
+			// テスト実行
+			err = repo.Delete(context.Background(), 1)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			// すべてのモックが呼び出されたことを確認
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRestore(t *testing.T) {
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		exists       bool
+		wantErr      error
+	}{
+		{
+			name:         "正常系",
+			rowsAffected: 1,
+		},
+		{
+			name:         "異常系_削除されていないID",
+			rowsAffected: 0,
+			wantErr:      repository.ErrCopyNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト用DBのセットアップ
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			// SQLクエリのモック
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE `copies` SET `deleted_at`=?,`updated_at`=? WHERE id = ? AND deleted_at IS NOT NULL")).
+				WithArgs(nil, sqlmock.AnyArg(), 1).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+			mock.ExpectCommit()
+			if tt.rowsAffected == 0 {
+				// 更新した件数が0件の場合は対象の存在を確認する（MySQLは値が変わらない行を数えない）
+				count := 0
+				if tt.exists {
+					count = 1
+				}
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `copies` WHERE id = ? AND deleted_at IS NOT NULL")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(count))
+			}
+
+			// リポジトリの作成
+			repo := NewRepository(db)
+
+			// テスト実行
+			err = repo.Restore(context.Background(), 1)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			// すべてのモックが呼び出されたことを確認
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestListByProduct(t *testing.T) {
+	// テスト用DBのセットアップ
+	db, mock, err := setupTestDB()
+	assert.NoError(t, err)
+
+	// SQLクエリのモック（公開状態で絞り込まない）
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `copies` WHERE product_id = ? AND `copies`.`deleted_at` IS NULL ORDER BY created_at DESC,id DESC")).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "product_id", "status"}).
+			AddRow(3, "テストタイトル3", 7, entity.CopyStatusDraft).
+			AddRow(1, "テストタイトル1", 7, entity.CopyStatusPublished))
+
+	// テスト実行
+	got, err := NewRepository(db).ListByProduct(context.Background(), 7)
+
+	// アサーション
+	assert.NoError(t, err)
+	assert.Len(t, got, 2)
+	assert.Equal(t, 3, got[0].ID)
+	assert.Equal(t, 7, *got[0].ProductID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListByCampaign(t *testing.T) {
+	// テスト用DBのセットアップ
+	db, mock, err := setupTestDB()
+	assert.NoError(t, err)
+
+	// SQLクエリのモック（公開状態で絞り込まない）
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `copies` WHERE campaign_id = ? AND `copies`.`deleted_at` IS NULL ORDER BY created_at DESC,id DESC")).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "channel", "campaign_id"}).
+			AddRow(4, "アプリ向け", "app", 5).
+			AddRow(3, "LINE向け", "line", 5))
+
+	// テスト実行
+	got, err := NewRepository(db).ListByCampaign(context.Background(), 5)
+
+	// アサーション
+	assert.NoError(t, err)
+	assert.Len(t, got, 2)
+	assert.Equal(t, entity.ChannelApp, got[0].Channel)
+	assert.Equal(t, 5, *got[1].CampaignID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListInReview(t *testing.T) {
+	tests := []struct {
+		name     string
+		reviewer string
+		wantSQL  string
+		wantArgs []driver.Value
+	}{
+		{
+			name:     "正常系_全員",
+			wantSQL:  "SELECT * FROM `copies` WHERE status = ? AND `copies`.`deleted_at` IS NULL ORDER BY updated_at ASC,id ASC",
+			wantArgs: []driver.Value{entity.CopyStatusInReview},
+		},
+		{
+			name:     "正常系_担当者で絞り込み",
+			reviewer: "suzuki",
+			wantSQL:  "SELECT * FROM `copies` WHERE status = ? AND reviewer = ? AND `copies`.`deleted_at` IS NULL ORDER BY updated_at ASC,id ASC",
+			wantArgs: []driver.Value{entity.CopyStatusInReview, "suzuki"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト用DBのセットアップ
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			// SQLクエリのモック
+			mock.ExpectQuery(regexp.QuoteMeta(tt.wantSQL)).
+				WithArgs(tt.wantArgs...).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "title", "status", "reviewer"}).
+					AddRow(1, "テストタイトル1", entity.CopyStatusInReview, "suzuki"))
+
+			// テスト実行
+			got, err := NewRepository(db).ListInReview(context.Background(), tt.reviewer)
+
+			// アサーション
+			assert.NoError(t, err)
+			assert.Len(t, got, 1)
+			assert.Equal(t, "suzuki", got[0].Reviewer)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestListDue(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		list     func(repository.CopyRepository) ([]*entity.Copy, error)
+		wantSQL  string
+		wantArgs []driver.Value
+		status   entity.CopyStatus
+	}{
+		{
+			name: "正常系_公開日時になった承認済みのコピー",
+			list: func(r repository.CopyRepository) ([]*entity.Copy, error) {
+				return r.ListDueToPublish(context.Background(), now)
+			},
+			wantSQL:  "SELECT * FROM `copies` WHERE (status = ? AND publish_at <= ?) AND `copies`.`deleted_at` IS NULL ORDER BY publish_at ASC,id ASC",
+			wantArgs: []driver.Value{entity.CopyStatusApproved, now},
+			status:   entity.CopyStatusApproved,
+		},
+		{
+			name: "正常系_公開終了日時になった公開済みのコピー",
+			list: func(r repository.CopyRepository) ([]*entity.Copy, error) {
+				return r.ListDueToExpire(context.Background(), now)
+			},
+			wantSQL:  "SELECT * FROM `copies` WHERE (status = ? AND expire_at <= ?) AND `copies`.`deleted_at` IS NULL ORDER BY expire_at ASC,id ASC",
+			wantArgs: []driver.Value{entity.CopyStatusPublished, now},
+			status:   entity.CopyStatusPublished,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト用DBのセットアップ
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			// SQLクエリのモック
+			mock.ExpectQuery(regexp.QuoteMeta(tt.wantSQL)).
+				WithArgs(tt.wantArgs...).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "title", "status", "publish_at", "expire_at"}).
+					AddRow(1, "テストタイトル1", tt.status, now.Add(-time.Hour), now))
+
+			// テスト実行
+			got, err := tt.list(NewRepository(db))
+
+			// アサーション
+			assert.NoError(t, err)
+			assert.Len(t, got, 1)
+			assert.Equal(t, now.Add(-time.Hour), *got[0].PublishAt)
+			assert.Equal(t, now, *got[0].ExpireAt)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package definition_repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+// channelColumns / toneColumns: 定義の更新で変更できる項目（IDと組み込みかどうかは変更しない）
+var (
+	channelColumns = []string{"Label", "Description", "Guidance", "Fields", "SortOrder"}
+	toneColumns    = []string{"Label", "Description", "Guidance", "Markers", "SortOrder"}
+)
+
+type channelRepository struct {
+	db *gorm.DB
+}
+
+func NewChannelRepository(db *gorm.DB) repository.ChannelRepository {
+	return &channelRepository{db: db}
+}
+
+func (r *channelRepository) List(ctx context.Context) ([]*entity.ChannelDefinition, error) {
+	var definitions []*entity.ChannelDefinition
+	if err := r.db.WithContext(ctx).Order("sort_order ASC, id ASC").Find(&definitions).Error; err != nil {
+		return nil, err
+	}
+	return definitions, nil
+}
+
+func (r *channelRepository) Get(ctx context.Context, id entity.Channel) (*entity.ChannelDefinition, error) {
+	var definition entity.ChannelDefinition
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&definition).Error; err != nil {
+		return nil, repository.TranslateError(err, repository.ErrChannelNotFound)
+	}
+	return &definition, nil
+}
+
+func (r *channelRepository) Create(ctx context.Context, definition *entity.ChannelDefinition) error {
+	return repository.TranslateError(r.db.WithContext(ctx).Create(definition).Error, repository.ErrChannelNotFound)
+}
+
+func (r *channelRepository) Update(ctx context.Context, definition *entity.ChannelDefinition) error {
+	result := r.db.WithContext(ctx).Model(definition).Select(channelColumns).Updates(definition)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrChannelNotFound
+	}
+	return nil
+}
+
+func (r *channelRepository) Delete(ctx context.Context, id entity.Channel) error {
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&entity.ChannelDefinition{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrChannelNotFound
+	}
+	return nil
+}
+
+type toneRepository struct {
+	db *gorm.DB
+}
+
+func NewToneRepository(db *gorm.DB) repository.ToneRepository {
+	return &toneRepository{db: db}
+}
+
+func (r *toneRepository) List(ctx context.Context) ([]*entity.ToneDefinition, error) {
+	var definitions []*entity.ToneDefinition
+	if err := r.db.WithContext(ctx).Order("sort_order ASC, id ASC").Find(&definitions).Error; err != nil {
+		return nil, err
+	}
+	return definitions, nil
+}
+
+func (r *toneRepository) Get(ctx context.Context, id entity.Tone) (*entity.ToneDefinition, error) {
+	var definition entity.ToneDefinition
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&definition).Error; err != nil {
+		return nil, repository.TranslateError(err, repository.ErrToneNotFound)
+	}
+	return &definition, nil
+}
+
+func (r *toneRepository) Create(ctx context.Context, definition *entity.ToneDefinition) error {
+	return repository.TranslateError(r.db.WithContext(ctx).Create(definition).Error, repository.ErrToneNotFound)
+}
+
+func (r *toneRepository) Update(ctx context.Context, definition *entity.ToneDefinition) error {
+	result := r.db.WithContext(ctx).Model(definition).Select(toneColumns).Updates(definition)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrToneNotFound
+	}
+	return nil
+}
+
+func (r *toneRepository) Delete(ctx context.Context, id entity.Tone) error {
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&entity.ToneDefinition{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrToneNotFound
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+package definition_repository
+
+import (
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/textmetrics"
+)
+
+func setupTestDB() (*gorm.DB, sqlmock.Sqlmock, error) {
+	// SQLMockの作成
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// GORMでSQLMockを使用するための設定
+	dialector := mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	})
+
+	// ログを無効化する設定
+	db, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return db, mock, nil
+}
+
+var (
+	channelColumnNames = []string{"id", "label", "description", "guidance", "fields", "built_in", "sort_order", "created_at", "updated_at", "deleted_at"}
+	toneColumnNames    = []string{"id", "label", "description", "guidance", "markers", "built_in", "sort_order", "created_at", "updated_at", "deleted_at"}
+)
+
+func TestChannelList(t *testing.T) {
+	// テスト用DBのセットアップ
+	db, mock, err := setupTestDB()
+	assert.NoError(t, err)
+
+	// SQLクエリのモック（論理削除したチャネルは除く）
+	now := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `channels` WHERE `channels`.`deleted_at` IS NULL ORDER BY sort_order ASC, id ASC")).
+		WillReturnRows(sqlmock.NewRows(channelColumnNames).
+			AddRow("app", "アプリ通知", "プッシュ通知", "", `[{"name":"title","description":"タイトル","limit":{"unit":"width","min":0,"max":40}}]`, true, 1, now, now, nil).
+			AddRow("reels", "Instagramリール", "縦型動画のキャプション", "冒頭で惹きつける", `[{"name":"title","description":"見出し","limit":{"unit":"graphemes","min":0,"max":20}},{"name":"hook","description":"冒頭のテロップ","limit":{"unit":"graphemes","min":0,"max":15}}]`, false, 6, now, now, nil))
+
+	// テスト実行
+	definitions, err := NewChannelRepository(db).List(context.Background())
+
+	// アサーション
+	assert.NoError(t, err)
+	assert.Len(t, definitions, 2)
+	assert.True(t, definitions[0].BuiltIn)
+	assert.Equal(t, textmetrics.Limit{Unit: textmetrics.UnitWidth, Max: 40}, definitions[0].Fields[0].Limit)
+	assert.Equal(t, entity.Channel("reels"), definitions[1].ID)
+	assert.Equal(t, "hook", definitions[1].Fields[1].Name)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChannelGet(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name    string
+		rows    *sqlmock.Rows
+		wantErr error
+	}{
+		{
+			name: "正常系",
+			rows: sqlmock.NewRows(channelColumnNames).
+				AddRow("reels", "Instagramリール", "", "", `[]`, false, 6, now, now, nil),
+		},
+		{
+			name:    "異常系_存在しない",
+			rows:    sqlmock.NewRows(channelColumnNames),
+			wantErr: repository.ErrChannelNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト用DBのセットアップ
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			// SQLクエリのモック
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `channels` WHERE id = ? AND `channels`.`deleted_at` IS NULL ORDER BY `channels`.`id` LIMIT 1")).
+				WithArgs("reels").
+				WillReturnRows(tt.rows)
+
+			// テスト実行
+			definition, err := NewChannelRepository(db).Get(context.Background(), "reels")
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorIs(t, err, repository.ErrNotFound)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "Instagramリール", definition.Label)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestChannelUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		result  driver.Result
+		wantErr error
+	}{
+		{name: "正常系", result: sqlmock.NewResult(0, 1)},
+		{name: "異常系_存在しない", result: sqlmock.NewResult(0, 0), wantErr: repository.ErrChannelNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト用DBのセットアップ
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			// SQLクエリのモック（IDと組み込みかどうかは更新しない）
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE `channels` SET `label`=?,`description`=?,`guidance`=?,`fields`=?,`sort_order`=?,`updated_at`=? WHERE `channels`.`deleted_at` IS NULL AND `id` = ?")).
+				WillReturnResult(tt.result)
+			mock.ExpectCommit()
+
+			// テスト実行
+			err = NewChannelRepository(db).Update(context.Background(), &entity.ChannelDefinition{ID: "reels", Label: "リール"})
+
+			// アサーション
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestChannelDelete(t *testing.T) {
+	// テスト用DBのセットアップ
+	db, mock, err := setupTestDB()
+	assert.NoError(t, err)
+
+	// SQLクエリのモック（論理削除）
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `channels` SET `deleted_at`=? WHERE id = ? AND `channels`.`deleted_at` IS NULL")).
+		WithArgs(sqlmock.AnyArg(), "reels").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// テスト実行
+	err = NewChannelRepository(db).Delete(context.Background(), "reels")
+
+	// アサーション
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestToneList(t *testing.T) {
+	// テスト用DBのセットアップ
+	db, mock, err := setupTestDB()
+	assert.NoError(t, err)
+
+	// SQLクエリのモック
+	now := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `tones` WHERE `tones`.`deleted_at` IS NULL ORDER BY sort_order ASC, id ASC")).
+		WillReturnRows(sqlmock.NewRows(toneColumnNames).
+			AddRow("urgent", "限定感", "今だけ・ここだけを強調します", "期限や数量を明示する", `["限定","今だけ","残りわずか"]`, false, 6, now, now, nil))
+
+	// テスト実行
+	definitions, err := NewToneRepository(db).List(context.Background())
+
+	// アサーション
+	assert.NoError(t, err)
+	assert.Len(t, definitions, 1)
+	assert.Equal(t, []string{"限定", "今だけ", "残りわずか"}, definitions[0].Markers)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestToneDelete(t *testing.T) {
+	// テスト用DBのセットアップ
+	db, mock, err := setupTestDB()
+	assert.NoError(t, err)
+
+	// SQLクエリのモック（削除済み・存在しない場合）
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `tones` SET `deleted_at`=? WHERE id = ? AND `tones`.`deleted_at` IS NULL")).
+		WithArgs(sqlmock.AnyArg(), "urgent").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	// テスト実行
+	err = NewToneRepository(db).Delete(context.Background(), "urgent")
+
+	// アサーション
+	assert.ErrorIs(t, err, repository.ErrToneNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,125 @@
+package job_repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+type jobRepository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) repository.GenerationJobRepository {
+	return &jobRepository{db: db}
+}
+
+func (r *jobRepository) Create(ctx context.Context, job *entity.GenerationJob) error {
+	now := time.Now()
+	job.Status = entity.JobStatusQueued
+	job.CreatedAt = now
+	job.UpdatedAt = now
+	if job.RunAt.IsZero() {
+		job.RunAt = now
+	}
+
+	return r.db.WithContext(ctx).Create(job).Error
+}
+
+func (r *jobRepository) Get(ctx context.Context, id int) (*entity.GenerationJob, error) {
+	var job entity.GenerationJob
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&job).Error; err != nil {
+		return nil, repository.TranslateError(err, repository.ErrJobNotFound)
+	}
+	return &job, nil
+}
+
+// Claim: 実行可能なジョブを行ロック（SKIP LOCKED）で取得し、実行中にする
+//
+// 複数のワーカーが同時に取得しても、同じジョブを重複して実行しません。
+func (r *jobRepository) Claim(ctx context.Context, workerID string, now, staleBefore time.Time) (*entity.GenerationJob, error) {
+	var claimed *entity.GenerationJob
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var jobs []*entity.GenerationJob
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("(status = ? AND run_at <= ?) OR (status = ? AND locked_at < ?)",
+				entity.JobStatusQueued, now, entity.JobStatusRunning, staleBefore).
+			Order("run_at ASC").
+			Order("id ASC").
+			Limit(1).
+			Find(&jobs).Error; err != nil {
+			return err
+		}
+		if len(jobs) == 0 {
+			return nil
+		}
+
+		job := jobs[0]
+		job.Status = entity.JobStatusRunning
+		job.Attempts++
+		job.LockedBy = workerID
+		job.LockedAt = &now
+		if err := tx.Model(job).
+			Select("status", "attempts", "locked_by", "locked_at").
+			Updates(job).Error; err != nil {
+			return err
+		}
+		claimed = job
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claimed, nil
+}
+
+// Complete: 生成したコピーのIDを記録し、ジョブを完了にする
+func (r *jobRepository) Complete(ctx context.Context, id int, workerID string, copyIDs []int, generationID string, completedAt time.Time) error {
+	return r.release(ctx, id, workerID, &entity.GenerationJob{
+		Status:       entity.JobStatusSucceeded,
+		CopyIDs:      copyIDs,
+		GenerationID: generationID,
+		CompletedAt:  &completedAt,
+	}, "copy_ids", "generation_id", "last_error", "completed_at")
+}
+
+// Retry: ロックを解除し、runAt以降に再実行する
+func (r *jobRepository) Retry(ctx context.Context, id int, workerID string, runAt time.Time, lastError string) error {
+	return r.release(ctx, id, workerID, &entity.GenerationJob{
+		Status:    entity.JobStatusQueued,
+		RunAt:     runAt,
+		LastError: lastError,
+	}, "run_at", "last_error")
+}
+
+// Fail: 再試行せずにジョブを失敗にする
+func (r *jobRepository) Fail(ctx context.Context, id int, workerID string, lastError string, completedAt time.Time) error {
+	return r.release(ctx, id, workerID, &entity.GenerationJob{
+		Status:      entity.JobStatusFailed,
+		LastError:   lastError,
+		CompletedAt: &completedAt,
+	}, "last_error", "completed_at")
+}
+
+// release: workerIDが実行中のジョブの状態とfieldsを更新し、ロックを解除する
+//
+// 実行中にロックの期限が切れ、他のワーカーが再取得したジョブは更新しません（ErrJobLeaseLost）。
+func (r *jobRepository) release(ctx context.Context, id int, workerID string, job *entity.GenerationJob, fields ...string) error {
+	result := r.db.WithContext(ctx).
+		Model(&entity.GenerationJob{ID: id}).
+		Where("status = ? AND locked_by = ?", entity.JobStatusRunning, workerID).
+		Select(append([]string{"status", "locked_by", "locked_at"}, fields...)).
+		Updates(job)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrJobLeaseLost
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+package job_repository
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+func setupTestDB() (*gorm.DB, sqlmock.Sqlmock, error) {
+	// SQLMockの作成
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// GORMでSQLMockを使用するための設定
+	dialector := mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	})
+
+	// ログを無効化する設定
+	db, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return db, mock, nil
+}
+
+var jobColumns = []string{"id", "status", "input", "attempts", "max_attempts", "run_at", "locked_by", "locked_at", "last_error", "copy_ids", "generation_id", "created_at", "updated_at", "completed_at"}
+
+func TestCreate(t *testing.T) {
+	db, mock, err := setupTestDB()
+	assert.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `generation_jobs`").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	job := &entity.GenerationJob{
+		Input:       entity.GenerationJobInput{ProductName: "テスト商品"},
+		MaxAttempts: 3,
+	}
+	err = NewRepository(db).Create(context.Background(), job)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, job.ID)
+	assert.Equal(t, entity.JobStatusQueued, job.Status)
+	assert.False(t, job.RunAt.IsZero())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGet(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name    string
+		id      int
+		rows    *sqlmock.Rows
+		wantErr error
+	}{
+		{
+			name: "正常系",
+			id:   1,
+			rows: sqlmock.NewRows(jobColumns).
+				AddRow(1, "succeeded", `{"productName":"テスト商品"}`, 1, 3, now, "", nil, "", `[10]`, "", now, now, now),
+		},
+		{
+			name:    "異常系_存在しないID",
+			id:      999,
+			rows:    sqlmock.NewRows(jobColumns),
+			wantErr: repository.ErrJobNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `generation_jobs` WHERE id = ? ORDER BY `generation_jobs`.`id` LIMIT 1")).
+				WithArgs(tt.id).
+				WillReturnRows(tt.rows)
+
+			got, err := NewRepository(db).Get(context.Background(), tt.id)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, entity.JobStatusSucceeded, got.Status)
+				assert.Equal(t, "テスト商品", got.Input.ProductName)
+				assert.Equal(t, []int{10}, got.CopyIDs)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestClaim(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	staleBefore := now.Add(-10 * time.Minute)
+	claimQuery := regexp.QuoteMeta("SELECT * FROM `generation_jobs` WHERE (status = ? AND run_at <= ?) OR (status = ? AND locked_at < ?) ORDER BY run_at ASC,id ASC LIMIT 1 FOR UPDATE SKIP LOCKED")
+
+	tests := []struct {
+		name      string
+		rows      *sqlmock.Rows
+		updateErr error
+		wantJob   bool
+		wantErr   bool
+	}{
+		{
+			name: "正常系",
+			rows: sqlmock.NewRows(jobColumns).
+				AddRow(1, "queued", `{}`, 0, 3, now, "", nil, "", nil, "", now, now, nil),
+			wantJob: true,
+		},
+		{
+			name: "正常系_実行可能なジョブがない",
+			rows: sqlmock.NewRows(jobColumns),
+		},
+		{
+			name: "異常系_更新エラー",
+			rows: sqlmock.NewRows(jobColumns).
+				AddRow(1, "queued", `{}`, 0, 3, now, "", nil, "", nil, "", now, now, nil),
+			updateErr: errors.New("database error"),
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			// SQLクエリのモック（行ロックで取得してから実行中にする）
+			mock.ExpectBegin()
+			mock.ExpectQuery(claimQuery).
+				WithArgs(entity.JobStatusQueued, now, entity.JobStatusRunning, staleBefore).
+				WillReturnRows(tt.rows)
+			if tt.wantJob || tt.updateErr != nil {
+				exec := mock.ExpectExec(regexp.QuoteMeta("UPDATE `generation_jobs` SET `status`=?,`attempts`=?,`locked_by`=?,`locked_at`=?,`updated_at`=? WHERE `id` = ?")).
+					WithArgs(entity.JobStatusRunning, 1, "worker-1", now, sqlmock.AnyArg(), 1)
+				if tt.updateErr != nil {
+					exec.WillReturnError(tt.updateErr)
+					mock.ExpectRollback()
+				} else {
+					exec.WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectCommit()
+				}
+			} else {
+				mock.ExpectCommit()
+			}
+
+			got, err := NewRepository(db).Claim(context.Background(), "worker-1", now, staleBefore)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.wantJob {
+				assert.Equal(t, entity.JobStatusRunning, got.Status)
+				assert.Equal(t, 1, got.Attempts)
+				assert.Equal(t, "worker-1", got.LockedBy)
+			} else {
+				assert.Nil(t, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestComplete(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name    string
+		result  driver.Result
+		wantErr error
+	}{
+		{name: "正常系", result: sqlmock.NewResult(0, 1)},
+		{name: "異常系_他のワーカーが再取得済み", result: sqlmock.NewResult(0, 0), wantErr: repository.ErrJobLeaseLost},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE `generation_jobs` SET `status`=?,`locked_by`=?,`locked_at`=?,`last_error`=?,`copy_ids`=?,`generation_id`=?,`updated_at`=?,`completed_at`=? WHERE (status = ? AND locked_by = ?) AND `id` = ?")).
+				WithArgs(entity.JobStatusSucceeded, "", nil, "", "[11,12]", "gen-1", sqlmock.AnyArg(), now, entity.JobStatusRunning, "worker-1", 1).
+				WillReturnResult(tt.result)
+			mock.ExpectCommit()
+
+			err = NewRepository(db).Complete(context.Background(), 1, "worker-1", []int{11, 12}, "gen-1", now)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRetry(t *testing.T) {
+	db, mock, err := setupTestDB()
+	assert.NoError(t, err)
+	runAt := time.Now().Add(time.Minute)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `generation_jobs` SET `status`=?,`run_at`=?,`locked_by`=?,`locked_at`=?,`last_error`=?,`updated_at`=? WHERE (status = ? AND locked_by = ?) AND `id` = ?")).
+		WithArgs(entity.JobStatusQueued, runAt, "", nil, "llm error", sqlmock.AnyArg(), entity.JobStatusRunning, "worker-1", 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = NewRepository(db).Retry(context.Background(), 1, "worker-1", runAt, "llm error")
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFail(t *testing.T) {
+	db, mock, err := setupTestDB()
+	assert.NoError(t, err)
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `generation_jobs` SET `status`=?,`locked_by`=?,`locked_at`=?,`last_error`=?,`updated_at`=?,`completed_at`=? WHERE (status = ? AND locked_by = ?) AND `id` = ?")).
+		WithArgs(entity.JobStatusFailed, "", nil, "llm error", sqlmock.AnyArg(), now, entity.JobStatusRunning, "worker-1", 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = NewRepository(db).Fail(context.Background(), 1, "worker-1", "llm error", now)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,63 @@
+package product_repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+// productColumns: 更新で変更できる項目
+var productColumns = []string{"SKU", "Name", "Features", "Price", "Category", "Images"}
+
+type productRepository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) repository.ProductRepository {
+	return &productRepository{db: db}
+}
+
+func (r *productRepository) List(ctx context.Context) ([]*entity.Product, error) {
+	var products []*entity.Product
+	if err := r.db.WithContext(ctx).Order("sku ASC").Find(&products).Error; err != nil {
+		return nil, err
+	}
+	return products, nil
+}
+
+func (r *productRepository) Get(ctx context.Context, id int) (*entity.Product, error) {
+	var product entity.Product
+	if err := r.db.WithContext(ctx).First(&product, id).Error; err != nil {
+		return nil, repository.TranslateError(err, repository.ErrProductNotFound)
+	}
+	return &product, nil
+}
+
+func (r *productRepository) Create(ctx context.Context, product *entity.Product) error {
+	return repository.TranslateWriteError(r.db.WithContext(ctx).Create(product).Error)
+}
+
+func (r *productRepository) Update(ctx context.Context, product *entity.Product) error {
+	result := r.db.WithContext(ctx).Model(product).Select(productColumns).Updates(product)
+	if result.Error != nil {
+		return repository.TranslateWriteError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrProductNotFound
+	}
+	return nil
+}
+
+func (r *productRepository) Delete(ctx context.Context, id int) error {
+	result := r.db.WithContext(ctx).Delete(&entity.Product{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrProductNotFound
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package product_repository
+
+import (
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	mysql_driver "github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+func setupTestDB() (*gorm.DB, sqlmock.Sqlmock, error) {
+	// SQLMockの作成
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// GORMでSQLMockを使用するための設定
+	dialector := mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	})
+
+	// ログを無効化し、一意制約の違反をgorm.ErrDuplicatedKeyに変換する設定（main.goと同じ）
+	db, err := gorm.Open(dialector, &gorm.Config{
+		Logger:         logger.Default.LogMode(logger.Silent),
+		TranslateError: true,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return db, mock, nil
+}
+
+var productColumnNames = []string{"id", "sku", "name", "features", "price", "category", "images", "created_at", "updated_at", "deleted_at"}
+
+// errDuplicateSKU: SKUの一意制約に違反した場合のMySQLのエラー
+var errDuplicateSKU = &mysql_driver.MySQLError{Number: 1062, Message: "Duplicate entry 'SKU-001' for key 'products.idx_products_sku'"}
+
+func TestCreate(t *testing.T) {
+	images := []entity.ProductImage{
+		{URL: "https://example.com/1.jpg", Alt: "正面", Width: 800, Height: 600},
+		{URL: "https://example.com/2.jpg", Alt: "側面"},
+	}
+
+	tests := []struct {
+		name    string
+		execErr error
+		wantErr error
+	}{
+		{name: "正常系_画像の一覧をJSONで保存"},
+		{name: "異常系_SKUの重複", execErr: errDuplicateSKU, wantErr: repository.ErrDuplicated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト用DBのセットアップ
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			// SQLクエリのモック（画像の一覧は1つのJSONの列に保存し、幅・高さが0の項目は省略する）
+			mock.ExpectBegin()
+			exec := mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `products`")).
+				WithArgs("SKU-001", "テスト商品", "軽量、防水", nil, "アウトドア",
+					`[{"url":"https://example.com/1.jpg","alt":"正面","width":800,"height":600},{"url":"https://example.com/2.jpg","alt":"側面"}]`,
+					sqlmock.AnyArg(), sqlmock.AnyArg(), nil)
+			if tt.execErr != nil {
+				exec.WillReturnError(tt.execErr)
+				mock.ExpectRollback()
+			} else {
+				exec.WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			}
+
+			// テスト実行
+			product := &entity.Product{SKU: "SKU-001", Name: "テスト商品", Features: "軽量、防水", Category: "アウトドア", Images: images}
+			err = NewRepository(db).Create(context.Background(), product)
+
+			// アサーション（重複は存在しないエラーとしない）
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.NotErrorIs(t, err, repository.ErrNotFound)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 1, product.ID)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name       string
+		images     string
+		wantImages []entity.ProductImage
+	}{
+		{
+			name:       "正常系_画像の一覧",
+			images:     `[{"url":"https://example.com/1.jpg","alt":"正面","width":800,"height":600},{"url":"https://example.com/2.jpg","alt":"側面"}]`,
+			wantImages: []entity.ProductImage{{URL: "https://example.com/1.jpg", Alt: "正面", Width: 800, Height: 600}, {URL: "https://example.com/2.jpg", Alt: "側面"}},
+		},
+		{
+			name:       "正常系_画像なし",
+			images:     `[]`,
+			wantImages: []entity.ProductImage{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト用DBのセットアップ
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			// SQLクエリのモック
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `products` WHERE `products`.`id` = ? AND `products`.`deleted_at` IS NULL ORDER BY `products`.`id` LIMIT 1")).
+				WithArgs(1).
+				WillReturnRows(sqlmock.NewRows(productColumnNames).
+					AddRow(1, "SKU-001", "テスト商品", "軽量、防水", nil, "アウトドア", tt.images, now, now, nil))
+
+			// テスト実行
+			product, err := NewRepository(db).Get(context.Background(), 1)
+
+			// アサーション
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantImages, product.Images)
+			assert.Nil(t, product.Price)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		result  driver.Result
+		execErr error
+		wantErr error
+	}{
+		{name: "正常系", result: sqlmock.NewResult(0, 1)},
+		{name: "異常系_他の商品とSKUが重複", execErr: errDuplicateSKU, wantErr: repository.ErrDuplicated},
+		{name: "異常系_存在しない", result: sqlmock.NewResult(0, 0), wantErr: repository.ErrProductNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト用DBのセットアップ
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			// SQLクエリのモック（SKUも変更できる）
+			mock.ExpectBegin()
+			exec := mock.ExpectExec(regexp.QuoteMeta("UPDATE `products` SET `sku`=?,`name`=?,`features`=?,`price`=?,`category`=?,`images`=?,`updated_at`=? WHERE `products`.`deleted_at` IS NULL AND `id` = ?"))
+			if tt.execErr != nil {
+				exec.WillReturnError(tt.execErr)
+				mock.ExpectRollback()
+			} else {
+				exec.WillReturnResult(tt.result)
+				mock.ExpectCommit()
+			}
+
+			// テスト実行
+			err = NewRepository(db).Update(context.Background(), &entity.Product{ID: 1, SKU: "SKU-001", Name: "テスト商品"})
+
+			// アサーション
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
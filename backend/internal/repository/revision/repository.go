@@ -0,0 +1,65 @@
+package revision_repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+type revisionRepository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) repository.CopyRevisionRepository {
+	return &revisionRepository{db: db}
+}
+
+// Create: 最新の版を行ロックした上で次の版番号を採番して保存
+//
+// 同時に編集された場合も版番号は重複しません（copy_idとrevisionの一意制約でも保証）。
+func (r *revisionRepository) Create(ctx context.Context, revision *entity.CopyRevision) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return Insert(tx, revision)
+	})
+}
+
+// Insert: トランザクションtxの中で次の版番号を採番して版を保存
+//
+// コピーの更新と同じトランザクションで版を記録するため、コピーのリポジトリからも使用します。
+func Insert(tx *gorm.DB, revision *entity.CopyRevision) error {
+	var latest []*entity.CopyRevision
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("revision").
+		Where("copy_id = ?", revision.CopyID).
+		Order("revision DESC").
+		Limit(1).
+		Find(&latest).Error; err != nil {
+		return err
+	}
+
+	revision.Revision = 1
+	if len(latest) > 0 {
+		revision.Revision = latest[0].Revision + 1
+	}
+	return repository.TranslateWriteError(tx.Create(revision).Error)
+}
+
+func (r *revisionRepository) List(ctx context.Context, copyID int) ([]*entity.CopyRevision, error) {
+	revisions := []*entity.CopyRevision{}
+	if err := r.db.WithContext(ctx).Where("copy_id = ?", copyID).Order("revision ASC").Find(&revisions).Error; err != nil {
+		return nil, err
+	}
+	return revisions, nil
+}
+
+func (r *revisionRepository) Get(ctx context.Context, copyID, revision int) (*entity.CopyRevision, error) {
+	var rev entity.CopyRevision
+	if err := r.db.WithContext(ctx).Where("copy_id = ? AND revision = ?", copyID, revision).First(&rev).Error; err != nil {
+		return nil, repository.TranslateError(err, repository.ErrRevisionNotFound)
+	}
+	return &rev, nil
+}
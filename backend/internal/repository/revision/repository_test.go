@@ -0,0 +1,182 @@
+package revision_repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	mysql_driver "github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+func setupTestDB() (*gorm.DB, sqlmock.Sqlmock, error) {
+	// SQLMockの作成
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// GORMでSQLMockを使用するための設定
+	dialector := mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	})
+
+	// ログを無効化し、一意制約の違反をgorm.ErrDuplicatedKeyに変換する設定（main.goと同じ）
+	db, err := gorm.Open(dialector, &gorm.Config{
+		Logger:         logger.Default.LogMode(logger.Silent),
+		TranslateError: true,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return db, mock, nil
+}
+
+var revisionColumns = []string{"id", "copy_id", "revision", "source", "author", "title", "description", "status", "content", "rollback_of", "created_at"}
+
+func TestCreate(t *testing.T) {
+	tests := []struct {
+		name         string
+		latest       *sqlmock.Rows
+		insertErr    error
+		wantRevision int
+		wantErr      error
+	}{
+		{
+			name:         "正常系_最初の版",
+			latest:       sqlmock.NewRows([]string{"revision"}),
+			wantRevision: 1,
+		},
+		{
+			name:         "正常系_次の版",
+			latest:       sqlmock.NewRows([]string{"revision"}).AddRow(3),
+			wantRevision: 4,
+		},
+		{
+			name:         "異常系_版番号の重複",
+			latest:       sqlmock.NewRows([]string{"revision"}).AddRow(3),
+			insertErr:    &mysql_driver.MySQLError{Number: 1062, Message: "Duplicate entry '1-4' for key 'copy_revisions.idx_copy_revisions_copy_id_revision'"},
+			wantRevision: 4,
+			wantErr:      repository.ErrDuplicated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト用DBのセットアップ
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			// SQLクエリのモック（最新の版を行ロックしてから採番する）
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT `revision` FROM `copy_revisions` WHERE copy_id = ? ORDER BY revision DESC LIMIT 1 FOR UPDATE")).
+				WithArgs(1).
+				WillReturnRows(tt.latest)
+			if tt.insertErr != nil {
+				mock.ExpectExec("INSERT INTO `copy_revisions`").
+					WillReturnError(tt.insertErr)
+				mock.ExpectRollback()
+			} else {
+				mock.ExpectExec("INSERT INTO `copy_revisions`").
+					WillReturnResult(sqlmock.NewResult(10, 1))
+				mock.ExpectCommit()
+			}
+
+			// テスト実行
+			revision := &entity.CopyRevision{CopyID: 1, Source: entity.RevisionSourceEdited, Title: "テストタイトル"}
+			err = NewRepository(db).Create(context.Background(), revision)
+
+			// アサーション
+			assert.Equal(t, tt.wantRevision, revision.Revision)
+			assert.NoError(t, mock.ExpectationsWereMet())
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 10, revision.ID)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	// テスト用DBのセットアップ
+	db, mock, err := setupTestDB()
+	assert.NoError(t, err)
+
+	// SQLクエリのモック
+	now := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `copy_revisions` WHERE copy_id = ? ORDER BY revision ASC")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(revisionColumns).
+			AddRow(1, 1, 1, "generated", "", "生成したタイトル", "生成した本文", "draft", nil, 0, now).
+			AddRow(2, 1, 2, "edited", "editor", "編集したタイトル", "生成した本文", "draft", `{"app":{"title":"編集したタイトル","body":"生成した本文"}}`, 0, now))
+
+	// テスト実行
+	revisions, err := NewRepository(db).List(context.Background(), 1)
+
+	// アサーション
+	assert.NoError(t, err)
+	assert.Len(t, revisions, 2)
+	assert.Equal(t, entity.RevisionSourceGenerated, revisions[0].Source)
+	assert.Equal(t, "editor", revisions[1].Author)
+	assert.Equal(t, "編集したタイトル", revisions[1].Content.App.Title)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGet(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name    string
+		rows    *sqlmock.Rows
+		wantErr error
+	}{
+		{
+			name: "正常系",
+			rows: sqlmock.NewRows(revisionColumns).
+				AddRow(2, 1, 2, "edited", "", "編集したタイトル", "本文", "draft", nil, 0, now),
+		},
+		{
+			name:    "異常系_存在しない版",
+			rows:    sqlmock.NewRows(revisionColumns),
+			wantErr: repository.ErrRevisionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト用DBのセットアップ
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			// SQLクエリのモック
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `copy_revisions` WHERE copy_id = ? AND revision = ? ORDER BY `copy_revisions`.`id` LIMIT 1")).
+				WithArgs(1, 2).
+				WillReturnRows(tt.rows)
+
+			// テスト実行
+			revision, err := NewRepository(db).Get(context.Background(), 1, 2)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, revision)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 2, revision.Revision)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package search_repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/textsearch"
+)
+
+// loadBatchSize: 起動時に既存のコピーを読み込む件数の単位
+const loadBatchSize = 500
+
+// searchField: 検索の対象の項目とスコアの重み
+type searchField struct {
+	weight float64
+	value  func(copy *entity.Copy) string
+}
+
+var searchFields = []searchField{
+	{weight: 3, value: func(c *entity.Copy) string { return c.Title }},
+	{weight: 2, value: func(c *entity.Copy) string { return c.ProductName }},
+	{weight: 1, value: func(c *entity.Copy) string { return c.Description }},
+	{weight: 1, value: func(c *entity.Copy) string { return c.ProductFeatures }},
+}
+
+// document: 索引に登録したコピー（正規化済みの各項目）
+type document struct {
+	fields    []string
+	tokens    []string
+	published bool
+	publishAt *time.Time
+	expireAt  *time.Time
+}
+
+type bigramIndex struct {
+	mu       sync.RWMutex
+	postings map[string]map[int]struct{}
+	docs     map[int]*document
+}
+
+// NewBigramIndex: 文字のbigramによるメモリ上の転置索引（SQLiteやテストなどFULLTEXTインデックスがない環境向け）
+//
+// 作成時に論理削除していないコピーをすべて読み込みます。
+// 索引はプロセスごとに持つため、複数のプロセスから更新する環境では使用できません。
+func NewBigramIndex(ctx context.Context, db *gorm.DB) (repository.CopySearchIndex, error) {
+	i := &bigramIndex{
+		postings: map[string]map[int]struct{}{},
+		docs:     map[int]*document{},
+	}
+
+	var batch []*entity.Copy
+	err := db.WithContext(ctx).FindInBatches(&batch, loadBatchSize, func(tx *gorm.DB, _ int) error {
+		for _, copy := range batch {
+			i.add(copy)
+		}
+		return nil
+	}).Error
+	if err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+func (i *bigramIndex) Index(ctx context.Context, copy *entity.Copy) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.remove(copy.ID)
+	i.add(copy)
+	return nil
+}
+
+func (i *bigramIndex) Remove(ctx context.Context, id int) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.remove(id)
+	return nil
+}
+
+// Search: 検索語のトークンの出現リストの共通部分を候補とし、正規化した項目に検索語が含まれるかで絞り込む
+//
+// スコアは項目ごとの検索語の出現回数に項目の重み（タイトル3・商品名2・説明文と商品の特徴1）を掛けた合計です。
+func (i *bigramIndex) Search(ctx context.Context, query repository.CopySearchQuery) ([]repository.CopySearchHit, error) {
+	hits := []repository.CopySearchHit{}
+	if len(query.Terms) == 0 {
+		return hits, nil
+	}
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	for id := range i.candidates(query.Terms) {
+		doc := i.docs[id]
+		if query.PublishedOnly && !doc.visible(query.Now) {
+			continue
+		}
+		if score, ok := doc.score(query.Terms); ok {
+			hits = append(hits, repository.CopySearchHit{ID: id, Score: score})
+		}
+	}
+
+	sort.Slice(hits, func(a, b int) bool {
+		if hits[a].Score != hits[b].Score {
+			return hits[a].Score > hits[b].Score
+		}
+		return hits[a].ID > hits[b].ID
+	})
+
+	if query.Offset >= len(hits) {
+		return []repository.CopySearchHit{}, nil
+	}
+	hits = hits[query.Offset:]
+	if query.Limit > 0 && len(hits) > query.Limit {
+		hits = hits[:query.Limit]
+	}
+	return hits, nil
+}
+
+// candidates: すべての検索語のトークンを含むコピーのID
+func (i *bigramIndex) candidates(terms []string) map[int]struct{} {
+	var result map[int]struct{}
+	for _, term := range terms {
+		for _, token := range textsearch.Tokens(term) {
+			posting := i.postings[token]
+			if result == nil {
+				result = make(map[int]struct{}, len(posting))
+				for id := range posting {
+					result[id] = struct{}{}
+				}
+				continue
+			}
+			for id := range result {
+				if _, ok := posting[id]; !ok {
+					delete(result, id)
+				}
+			}
+		}
+	}
+	return result
+}
+
+// visible: 公開済みで、nowが公開日時以降かつ公開終了日時より前かどうか（ListPublishedと同じ条件）
+func (d *document) visible(now time.Time) bool {
+	if !d.published {
+		return false
+	}
+	if d.publishAt != nil && d.publishAt.After(now) {
+		return false
+	}
+	return d.expireAt == nil || d.expireAt.After(now)
+}
+
+// score: 検索語がすべていずれかの項目に含まれる場合のスコア
+func (d *document) score(terms []string) (float64, bool) {
+	var score float64
+	for _, term := range terms {
+		found := false
+		for n, field := range d.fields {
+			if count := textsearch.Count(field, []string{term}); count > 0 {
+				found = true
+				score += searchFields[n].weight * float64(count)
+			}
+		}
+		if !found {
+			return 0, false
+		}
+	}
+	return score, true
+}
+
+func (i *bigramIndex) add(copy *entity.Copy) {
+	doc := &document{
+		published: copy.Status == entity.CopyStatusPublished,
+		publishAt: copy.PublishAt,
+		expireAt:  copy.ExpireAt,
+	}
+	seen := map[string]bool{}
+	for _, field := range searchFields {
+		value := field.value(copy)
+		doc.fields = append(doc.fields, textsearch.Normalize(value))
+		for _, token := range textsearch.Tokens(value) {
+			if seen[token] {
+				continue
+			}
+			seen[token] = true
+			doc.tokens = append(doc.tokens, token)
+			if i.postings[token] == nil {
+				i.postings[token] = map[int]struct{}{}
+			}
+			i.postings[token][copy.ID] = struct{}{}
+		}
+	}
+	i.docs[copy.ID] = doc
+}
+
+func (i *bigramIndex) remove(id int) {
+	doc, ok := i.docs[id]
+	if !ok {
+		return
+	}
+	for _, token := range doc.tokens {
+		delete(i.postings[token], id)
+		if len(i.postings[token]) == 0 {
+			delete(i.postings, token)
+		}
+	}
+	delete(i.docs, id)
+}
@@ -0,0 +1,198 @@
+package search_repository
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/textsearch"
+)
+
+const loadQuery = "SELECT * FROM `copies` WHERE `copies`.`deleted_at` IS NULL ORDER BY `copies`.`id` LIMIT 500"
+
+// setupBigramIndex: 既存のコピーを読み込んだ索引を作成
+func setupBigramIndex(t *testing.T) repository.CopySearchIndex {
+	db, mock, err := setupTestDB()
+	assert.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta(loadQuery)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "product_name", "product_features", "status"}).
+			AddRow(1, "夏のセール開催中", "暑い夏を涼しく過ごすアイテム", "ハンディファン", "軽量、静音", entity.CopyStatusPublished).
+			AddRow(2, "新作ファン登場", "夏にぴったりの静音モデル", "デスクファン", "静音、首振り", entity.CopyStatusPublished).
+			AddRow(3, "冬のセール", "あたたかいブランケット", "ブランケット", "洗える", entity.CopyStatusDraft))
+
+	index, err := NewBigramIndex(context.Background(), db)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+	return index
+}
+
+func TestNewBigramIndex(t *testing.T) {
+	db, mock, err := setupTestDB()
+	assert.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta(loadQuery)).
+		WillReturnError(errors.New("database error"))
+
+	index, err := NewBigramIndex(context.Background(), db)
+
+	assert.Error(t, err)
+	assert.Nil(t, index)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBigramSearch(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   repository.CopySearchQuery
+		wantIDs []int
+	}{
+		{
+			name:    "正常系_タイトルの一致を優先",
+			query:   repository.CopySearchQuery{Terms: textsearch.Terms("ファン")},
+			wantIDs: []int{2, 1},
+		},
+		{
+			name:    "正常系_すべての検索語を含む",
+			query:   repository.CopySearchQuery{Terms: textsearch.Terms("夏　静音")},
+			wantIDs: []int{1, 2},
+		},
+		{
+			name:    "正常系_1文字の検索語",
+			query:   repository.CopySearchQuery{Terms: textsearch.Terms("冬")},
+			wantIDs: []int{3},
+		},
+		{
+			name:    "正常系_公開済みのみ",
+			query:   repository.CopySearchQuery{Terms: textsearch.Terms("セール"), PublishedOnly: true},
+			wantIDs: []int{1},
+		},
+		{
+			name:    "正常系_半角カナで検索",
+			query:   repository.CopySearchQuery{Terms: textsearch.Terms("ﾌﾞﾗﾝｹｯﾄ")},
+			wantIDs: []int{3},
+		},
+		{
+			name:    "正常系_トークンは含むが連続しない",
+			query:   repository.CopySearchQuery{Terms: textsearch.Terms("セール夏")},
+			wantIDs: []int{},
+		},
+		{
+			name:    "正常系_件数と開始位置",
+			query:   repository.CopySearchQuery{Terms: textsearch.Terms("セール"), Limit: 1, Offset: 1},
+			wantIDs: []int{1},
+		},
+		{
+			name:    "正常系_開始位置が件数を超える",
+			query:   repository.CopySearchQuery{Terms: textsearch.Terms("セール"), Offset: 5},
+			wantIDs: []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index := setupBigramIndex(t)
+
+			got, err := index.Search(context.Background(), tt.query)
+
+			assert.NoError(t, err)
+			gotIDs := []int{}
+			for _, hit := range got {
+				gotIDs = append(gotIDs, hit.ID)
+			}
+			assert.Equal(t, tt.wantIDs, gotIDs)
+		})
+	}
+}
+
+func TestBigramIndexAndRemove(t *testing.T) {
+	ctx := context.Background()
+	index := setupBigramIndex(t)
+	terms := textsearch.Terms("ブランケット")
+
+	// 更新した内容で置き換える
+	err := index.Index(ctx, &entity.Copy{ID: 3, Title: "冬のセール", ProductName: "電気毛布", Status: entity.CopyStatusPublished})
+	assert.NoError(t, err)
+	got, err := index.Search(ctx, repository.CopySearchQuery{Terms: terms})
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+
+	// 新しいコピーを追加する
+	err = index.Index(ctx, &entity.Copy{ID: 4, Title: "ひざ掛けブランケット", Status: entity.CopyStatusPublished})
+	assert.NoError(t, err)
+	got, err = index.Search(ctx, repository.CopySearchQuery{Terms: terms})
+	assert.NoError(t, err)
+	assert.Equal(t, []repository.CopySearchHit{{ID: 4, Score: 3}}, got)
+
+	// 索引から除く
+	err = index.Remove(ctx, 4)
+	assert.NoError(t, err)
+	got, err = index.Search(ctx, repository.CopySearchQuery{Terms: terms})
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestBigramSearch_Schedule(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	// 11: 公開日時・公開終了日時なし
+	// 12: 公開日時を過ぎ、公開終了日時前
+	// 13: 公開日時前（スケジューラーが状態を変更する前）
+	// 14: 公開終了日時を過ぎた
+	// 15: 公開終了日時ちょうど
+	index := setupBigramIndex(t)
+	for _, copy := range []*entity.Copy{
+		{ID: 11, Title: "秋の限定品", Status: entity.CopyStatusPublished},
+		{ID: 12, Title: "秋の限定品", Status: entity.CopyStatusPublished, PublishAt: &past, ExpireAt: &future},
+		{ID: 13, Title: "秋の限定品", Status: entity.CopyStatusPublished, PublishAt: &future},
+		{ID: 14, Title: "秋の限定品", Status: entity.CopyStatusPublished, ExpireAt: &past},
+		{ID: 15, Title: "秋の限定品", Status: entity.CopyStatusPublished, ExpireAt: &now},
+	} {
+		assert.NoError(t, index.Index(ctx, copy))
+	}
+
+	tests := []struct {
+		name    string
+		query   repository.CopySearchQuery
+		wantIDs []int
+	}{
+		{
+			name:    "正常系_公開期間内のみ",
+			query:   repository.CopySearchQuery{Terms: textsearch.Terms("限定"), PublishedOnly: true, Now: now},
+			wantIDs: []int{12, 11},
+		},
+		{
+			name:    "正常系_公開日時を過ぎた後",
+			query:   repository.CopySearchQuery{Terms: textsearch.Terms("限定"), PublishedOnly: true, Now: future},
+			wantIDs: []int{13, 11},
+		},
+		{
+			name:    "正常系_未公開を含む場合は公開期間で絞り込まない",
+			query:   repository.CopySearchQuery{Terms: textsearch.Terms("限定"), Now: now},
+			wantIDs: []int{15, 14, 13, 12, 11},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := index.Search(ctx, tt.query)
+
+			assert.NoError(t, err)
+			gotIDs := []int{}
+			for _, hit := range got {
+				gotIDs = append(gotIDs, hit.ID)
+			}
+			assert.Equal(t, tt.wantIDs, gotIDs)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package search_repository
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+// matchExpr: ngramパーサーのFULLTEXTインデックス（ft_copies_search）を使用する検索条件
+const matchExpr = "MATCH(title, description, product_name, product_features) AGAINST (? IN BOOLEAN MODE)"
+
+type mysqlIndex struct {
+	db *gorm.DB
+}
+
+// NewMySQLIndex: MySQLのFULLTEXTインデックス（ngramパーサー）による索引
+//
+// インデックスはcopiesテーブルの更新に合わせてMySQLが更新するため、Index・Removeでは何もしません。
+func NewMySQLIndex(db *gorm.DB) repository.CopySearchIndex {
+	return &mysqlIndex{db: db}
+}
+
+func (i *mysqlIndex) Index(ctx context.Context, copy *entity.Copy) error {
+	return nil
+}
+
+func (i *mysqlIndex) Remove(ctx context.Context, id int) error {
+	return nil
+}
+
+func (i *mysqlIndex) Search(ctx context.Context, query repository.CopySearchQuery) ([]repository.CopySearchHit, error) {
+	hits := []repository.CopySearchHit{}
+	if len(query.Terms) == 0 {
+		return hits, nil
+	}
+
+	against := booleanQuery(query.Terms)
+	db := i.db.WithContext(ctx).
+		Model(&entity.Copy{}).
+		Select("id, "+matchExpr+" AS score", against).
+		Where(matchExpr, against)
+	if query.PublishedOnly {
+		db = db.Where("status = ?", entity.CopyStatusPublished).
+			Where("(publish_at IS NULL OR publish_at <= ?) AND (expire_at IS NULL OR expire_at > ?)", query.Now, query.Now)
+	}
+	if query.Limit > 0 {
+		db = db.Limit(query.Limit)
+	}
+	if query.Offset > 0 {
+		db = db.Offset(query.Offset)
+	}
+
+	if err := db.Order("score DESC").Order("id DESC").Scan(&hits).Error; err != nil {
+		return nil, err
+	}
+	return hits, nil
+}
+
+// booleanQuery: 検索語をすべて含むことを条件にしたBOOLEAN MODEの検索式
+//
+// 検索語はフレーズとして一致させ、演算子として解釈されないよう二重引用符で囲みます。
+// ngram_token_size（既定値2）より短い1文字の検索語は前方一致で検索します。
+func booleanQuery(terms []string) string {
+	parts := make([]string, 0, len(terms))
+	for _, term := range terms {
+		term = strings.ReplaceAll(term, `"`, "")
+		if utf8.RuneCountInString(term) == 1 {
+			parts = append(parts, "+"+term+"*")
+			continue
+		}
+		parts = append(parts, `+"`+term+`"`)
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,132 @@
+package search_repository
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+func setupTestDB() (*gorm.DB, sqlmock.Sqlmock, error) {
+	// SQLMockの作成
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// GORMでSQLMockを使用するための設定
+	dialector := mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	})
+
+	// ログを無効化する設定
+	db, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return db, mock, nil
+}
+
+func TestBooleanQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		terms []string
+		want  string
+	}{
+		{
+			name:  "正常系_フレーズとして一致",
+			terms: []string{"夏セール", "限定"},
+			want:  `+"夏セール" +"限定"`,
+		},
+		{
+			name:  "正常系_1文字は前方一致",
+			terms: []string{"夏"},
+			want:  `+夏*`,
+		},
+		{
+			name:  "正常系_二重引用符を除く",
+			terms: []string{`"新作"`},
+			want:  `+"新作"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, booleanQuery(tt.terms))
+		})
+	}
+}
+
+func TestMySQLSearch(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	searchQuery := regexp.QuoteMeta("SELECT id, MATCH(title, description, product_name, product_features) AGAINST (? IN BOOLEAN MODE) AS score FROM `copies` WHERE MATCH(title, description, product_name, product_features) AGAINST (? IN BOOLEAN MODE) AND status = ? AND ((publish_at IS NULL OR publish_at <= ?) AND (expire_at IS NULL OR expire_at > ?)) AND `copies`.`deleted_at` IS NULL ORDER BY score DESC,id DESC LIMIT 10 OFFSET 10")
+
+	tests := []struct {
+		name     string
+		query    repository.CopySearchQuery
+		mockFunc func(mock sqlmock.Sqlmock)
+		want     []repository.CopySearchHit
+		wantErr  bool
+	}{
+		{
+			name:  "正常系",
+			query: repository.CopySearchQuery{Terms: []string{"夏セール"}, PublishedOnly: true, Now: now, Limit: 10, Offset: 10},
+			mockFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(searchQuery).
+					WithArgs(`+"夏セール"`, `+"夏セール"`, entity.CopyStatusPublished, now, now).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "score"}).
+						AddRow(3, 1.5).
+						AddRow(1, 0.5))
+			},
+			want: []repository.CopySearchHit{{ID: 3, Score: 1.5}, {ID: 1, Score: 0.5}},
+		},
+		{
+			name:     "正常系_検索語なし",
+			query:    repository.CopySearchQuery{},
+			mockFunc: func(mock sqlmock.Sqlmock) {},
+			want:     []repository.CopySearchHit{},
+		},
+		{
+			name:  "異常系_DBエラー",
+			query: repository.CopySearchQuery{Terms: []string{"夏セール"}, PublishedOnly: true, Now: now, Limit: 10, Offset: 10},
+			mockFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(searchQuery).
+					WillReturnError(errors.New("database error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+			tt.mockFunc(mock)
+
+			got, err := NewMySQLIndex(db).Search(context.Background(), tt.query)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
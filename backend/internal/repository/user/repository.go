@@ -0,0 +1,122 @@
+package user_repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+type userRepository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) repository.UserRepository {
+	return &userRepository{db: db}
+}
+
+func (r *userRepository) Get(ctx context.Context, id int) (*entity.User, error) {
+	var user entity.User
+	if err := r.db.WithContext(ctx).First(&user, id).Error; err != nil {
+		return nil, repository.TranslateError(err, repository.ErrUserNotFound)
+	}
+	return &user, nil
+}
+
+func (r *userRepository) GetByEmail(ctx context.Context, email string) (*entity.User, error) {
+	var user entity.User
+	if err := r.db.WithContext(ctx).Where("email = ?", email).First(&user).Error; err != nil {
+		return nil, repository.TranslateError(err, repository.ErrUserNotFound)
+	}
+	return &user, nil
+}
+
+func (r *userRepository) Create(ctx context.Context, user *entity.User) error {
+	return repository.TranslateError(r.db.WithContext(ctx).Create(user).Error, repository.ErrUserNotFound)
+}
+
+// CreateFirst: 利用者が登録されていない場合のみ、FirstUserを設定して登録する
+//
+// 同時に登録した場合もFirstUserの一意制約により1人だけが登録され、他はErrUsersExistになります。
+// 利用者がいない状態での一意制約の違反は、他の利用者が先に登録したことを意味します。
+func (r *userRepository) CreateFirst(ctx context.Context, user *entity.User) error {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&entity.User{}).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return repository.ErrUsersExist
+	}
+
+	first := true
+	user.FirstUser = &first
+	err := repository.TranslateWriteError(r.db.WithContext(ctx).Create(user).Error)
+	if err != nil {
+		user.FirstUser = nil
+		if errors.Is(err, repository.ErrDuplicated) {
+			return repository.ErrUsersExist
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *userRepository) Count(ctx context.Context) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&entity.User{}).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+type apiKeyRepository struct {
+	db *gorm.DB
+}
+
+func NewAPIKeyRepository(db *gorm.DB) repository.APIKeyRepository {
+	return &apiKeyRepository{db: db}
+}
+
+func (r *apiKeyRepository) ListByUser(ctx context.Context, userID int) ([]*entity.APIKey, error) {
+	var keys []*entity.APIKey
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").Order("id DESC").
+		Find(&keys).Error; err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (r *apiKeyRepository) GetByHash(ctx context.Context, hash string) (*entity.APIKey, error) {
+	var key entity.APIKey
+	if err := r.db.WithContext(ctx).Where("key_hash = ?", hash).First(&key).Error; err != nil {
+		return nil, repository.TranslateError(err, repository.ErrAPIKeyNotFound)
+	}
+	return &key, nil
+}
+
+func (r *apiKeyRepository) Create(ctx context.Context, key *entity.APIKey) error {
+	return repository.TranslateError(r.db.WithContext(ctx).Create(key).Error, repository.ErrAPIKeyNotFound)
+}
+
+func (r *apiKeyRepository) Delete(ctx context.Context, userID, id int) error {
+	result := r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&entity.APIKey{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+func (r *apiKeyRepository) Touch(ctx context.Context, id int, usedAt time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&entity.APIKey{ID: id}).
+		UpdateColumn("last_used_at", usedAt).Error
+}
@@ -0,0 +1,242 @@
+package user_repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	mysql_driver "github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+func setupTestDB() (*gorm.DB, sqlmock.Sqlmock, error) {
+	// SQLMockの作成
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// GORMでSQLMockを使用するための設定
+	dialector := mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	})
+
+	// ログを無効化する設定
+	db, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+		// cmd/apiと同じく一意制約の違反をgorm.ErrDuplicatedKeyに変換する
+		TranslateError: true,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return db, mock, nil
+}
+
+var (
+	userColumnNames   = []string{"id", "email", "name", "password_hash", "created_at", "updated_at"}
+	apiKeyColumnNames = []string{"id", "user_id", "name", "prefix", "key_hash", "last_used_at", "created_at", "deleted_at"}
+)
+
+func TestGetByEmail(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name    string
+		rows    *sqlmock.Rows
+		wantErr error
+	}{
+		{
+			name: "正常系",
+			rows: sqlmock.NewRows(userColumnNames).
+				AddRow(1, "tanaka@example.com", "田中", "$2a$10$hash", now, now),
+		},
+		{
+			name:    "異常系_存在しない",
+			rows:    sqlmock.NewRows(userColumnNames),
+			wantErr: repository.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト用DBのセットアップ
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			// SQLクエリのモック
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `users` WHERE email = ? ORDER BY `users`.`id` LIMIT 1")).
+				WithArgs("tanaka@example.com").
+				WillReturnRows(tt.rows)
+
+			// テスト実行
+			user, err := NewRepository(db).GetByEmail(context.Background(), "tanaka@example.com")
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorIs(t, err, repository.ErrNotFound)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 1, user.ID)
+				assert.Equal(t, "$2a$10$hash", user.PasswordHash)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCreateFirst(t *testing.T) {
+	countQuery := regexp.QuoteMeta("SELECT count(*) FROM `users`")
+	insertQuery := regexp.QuoteMeta("INSERT INTO `users` (`email`,`name`,`password_hash`,`first_user`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?)")
+
+	tests := []struct {
+		name     string
+		mockFunc func(mock sqlmock.Sqlmock)
+		wantErr  error
+	}{
+		{
+			name: "正常系",
+			mockFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(countQuery).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectBegin()
+				mock.ExpectExec(insertQuery).
+					WithArgs("tanaka@example.com", "田中", "$2a$10$hash", true, sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "異常系_利用者が登録済み",
+			mockFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(countQuery).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+			},
+			wantErr: repository.ErrUsersExist,
+		},
+		{
+			name: "異常系_同時に他の利用者が最初の利用者として登録",
+			mockFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(countQuery).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectBegin()
+				mock.ExpectExec(insertQuery).
+					WillReturnError(&mysql_driver.MySQLError{Number: 1062, Message: "Duplicate entry '1' for key 'users.idx_users_first_user'"})
+				mock.ExpectRollback()
+			},
+			wantErr: repository.ErrUsersExist,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト用DBのセットアップ
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+			tt.mockFunc(mock)
+
+			// テスト実行
+			user := &entity.User{Email: "tanaka@example.com", Name: "田中", PasswordHash: "$2a$10$hash"}
+			err = NewRepository(db).CreateFirst(context.Background(), user)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, user.FirstUser)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 1, user.ID)
+				if assert.NotNil(t, user.FirstUser) {
+					assert.True(t, *user.FirstUser)
+				}
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetByHash(t *testing.T) {
+	// テスト用DBのセットアップ
+	db, mock, err := setupTestDB()
+	assert.NoError(t, err)
+
+	// SQLクエリのモック（無効にしたキーは除く）
+	now := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `api_keys` WHERE key_hash = ? AND `api_keys`.`deleted_at` IS NULL ORDER BY `api_keys`.`id` LIMIT 1")).
+		WithArgs("hash").
+		WillReturnRows(sqlmock.NewRows(apiKeyColumnNames).
+			AddRow(3, 1, "一括生成", "ascg_abcdefgh", "hash", nil, now, nil))
+
+	// テスト実行
+	key, err := NewAPIKeyRepository(db).GetByHash(context.Background(), "hash")
+
+	// アサーション
+	assert.NoError(t, err)
+	assert.Equal(t, 3, key.ID)
+	assert.Equal(t, 1, key.UserID)
+	assert.Nil(t, key.LastUsedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListByUser(t *testing.T) {
+	// テスト用DBのセットアップ
+	db, mock, err := setupTestDB()
+	assert.NoError(t, err)
+
+	// SQLクエリのモック
+	now := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `api_keys` WHERE user_id = ? AND `api_keys`.`deleted_at` IS NULL ORDER BY created_at DESC,id DESC")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(apiKeyColumnNames).
+			AddRow(4, 1, "CI", "ascg_ijklmnop", "hash2", now, now, nil).
+			AddRow(3, 1, "一括生成", "ascg_abcdefgh", "hash1", nil, now, nil))
+
+	// テスト実行
+	keys, err := NewAPIKeyRepository(db).ListByUser(context.Background(), 1)
+
+	// アサーション
+	assert.NoError(t, err)
+	assert.Len(t, keys, 2)
+	assert.NotNil(t, keys[0].LastUsedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteAPIKey(t *testing.T) {
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		wantErr      error
+	}{
+		{name: "正常系", rowsAffected: 1},
+		{name: "異常系_他の利用者のキー", rowsAffected: 0, wantErr: repository.ErrAPIKeyNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト用DBのセットアップ
+			db, mock, err := setupTestDB()
+			assert.NoError(t, err)
+
+			// SQLクエリのモック（利用者のキーのみ論理削除する）
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE `api_keys` SET `deleted_at`=? WHERE user_id = ? AND `api_keys`.`id` = ? AND `api_keys`.`deleted_at` IS NULL")).
+				WithArgs(sqlmock.AnyArg(), 1, 3).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+			mock.ExpectCommit()
+
+			// テスト実行
+			err = NewAPIKeyRepository(db).Delete(context.Background(), 1, 3)
+
+			// アサーション
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
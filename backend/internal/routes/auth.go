@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	auth_handler "github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/auth"
+)
+
+// SetupAuthRoutes: 利用者の登録・ログインとAPIキーを管理するルート
+func SetupAuthRoutes(r *gin.Engine, handler auth_handler.Handler) {
+	v1 := r.Group("/api/v1")
+	{
+		v1.POST("/auth/login", handler.Login)
+		v1.POST("/users", handler.Register)
+		v1.GET("/users/me", handler.Me)
+		v1.GET("/users/me/api-keys", handler.ListAPIKeys)
+		v1.POST("/users/me/api-keys", handler.CreateAPIKey)
+		v1.DELETE("/users/me/api-keys/:id", handler.RevokeAPIKey)
+	}
+}
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	brand_handler "github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/brand"
+)
+
+// SetupBrandRoutes: ブランドプロファイルを管理するルート
+func SetupBrandRoutes(r *gin.Engine, handler brand_handler.Handler) {
+	v1 := r.Group("/api/v1")
+	{
+		v1.GET("/brand-profiles", handler.List)
+		v1.POST("/brand-profiles", handler.Create)
+		v1.GET("/brand-profiles/:id", handler.Get)
+		v1.PUT("/brand-profiles/:id", handler.Update)
+		v1.DELETE("/brand-profiles/:id", handler.Delete)
+	}
+}
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	campaign_handler "github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/campaign"
+)
+
+// SetupCampaignRoutes: キャンペーンとキャンペーン単位のコピーのルート
+func SetupCampaignRoutes(r *gin.Engine, handler campaign_handler.Handler) {
+	v1 := r.Group("/api/v1")
+	{
+		v1.GET("/campaigns", handler.List)
+		v1.POST("/campaigns", handler.Create)
+		v1.GET("/campaigns/:id", handler.Get)
+		v1.PUT("/campaigns/:id", handler.Update)
+		v1.DELETE("/campaigns/:id", handler.Delete)
+		v1.POST("/campaigns/:id/copies", handler.GenerateSet)
+		v1.GET("/campaigns/:id/copies", handler.Copies)
+	}
+}
@@ -10,8 +10,31 @@ func SetupCopyRoutes(r *gin.Engine, handler copy_handler.Handler) {
 	v1 := r.Group("/api/v1")
 	{
 		v1.POST("/copies", handler.CreateCopy)
+		v1.POST("/copies/stream", handler.CreateCopyStream)
+		v1.POST("/copies/bulk", handler.CreateCopiesBulk)
+		v1.GET("/copies/search", handler.SearchCopies)
+		v1.GET("/copies/in-review", handler.ListInReview)
 		v1.GET("/copies/:id", handler.GetCopy)
 		v1.GET("/copies", handler.GetPublishedCopies)
-		v1.PUT("/copies/:id/likes", handler.UpdateLikes)
+		v1.PATCH("/copies/:id", handler.UpdateCopy)
+		v1.DELETE("/copies/:id", handler.DeleteCopy)
+		v1.POST("/copies/:id/restore", handler.RestoreCopy)
+		v1.GET("/copies/:id/revisions", handler.ListRevisions)
+		v1.GET("/copies/:id/revisions/diff", handler.DiffRevisions)
+		v1.POST("/copies/:id/revisions/:revision/rollback", handler.RollbackCopy)
+		v1.POST("/copies/:id/review", handler.SubmitForReview)
+		v1.PUT("/copies/:id/reviewer", handler.AssignReviewer)
+		v1.POST("/copies/:id/approve", handler.ApproveCopy)
+		v1.POST("/copies/:id/reject", handler.RejectCopy)
+		v1.POST("/copies/:id/publish", handler.PublishCopy)
+		v1.POST("/copies/:id/archive", handler.ArchiveCopy)
+		v1.POST("/copies/:id/reopen", handler.ReopenCopy)
+		v1.PUT("/copies/:id/schedule", handler.ScheduleCopy)
+		v1.PUT("/copies/:id/likes", handler.LikeCopy)
+		v1.DELETE("/copies/:id/likes", handler.UnlikeCopy)
+		v1.POST("/copies/:id/compliance", handler.CheckCopyCompliance)
+		v1.POST("/compliance/check", handler.CheckCompliance)
+		v1.GET("/generations/:id", handler.GetGeneration)
+		v1.PUT("/generations/:id/winner", handler.SelectVariant)
 	}
 }
@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	definition_handler "github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/definition"
+)
+
+// SetupDefinitionRoutes: 配信チャネル・トーンの定義を管理するルート
+func SetupDefinitionRoutes(r *gin.Engine, handler definition_handler.Handler) {
+	v1 := r.Group("/api/v1")
+	{
+		v1.GET("/channels", handler.ListChannels)
+		v1.POST("/channels", handler.CreateChannel)
+		v1.GET("/channels/:id", handler.GetChannel)
+		v1.PUT("/channels/:id", handler.UpdateChannel)
+		v1.DELETE("/channels/:id", handler.DeleteChannel)
+
+		v1.GET("/tones", handler.ListTones)
+		v1.POST("/tones", handler.CreateTone)
+		v1.GET("/tones/:id", handler.GetTone)
+		v1.PUT("/tones/:id", handler.UpdateTone)
+		v1.DELETE("/tones/:id", handler.DeleteTone)
+	}
+}
@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	job_handler "github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/job"
+)
+
+func SetupJobRoutes(r *gin.Engine, handler job_handler.Handler) {
+	v1 := r.Group("/api/v1")
+	{
+		v1.POST("/generation-jobs", handler.CreateJob)
+		v1.GET("/generation-jobs/:id", handler.GetJob)
+	}
+}
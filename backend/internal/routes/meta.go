@@ -0,0 +1,14 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	meta_handler "github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/meta"
+)
+
+func SetupMetaRoutes(r *gin.Engine, handler meta_handler.Handler) {
+	v1 := r.Group("/api/v1")
+	{
+		v1.GET("/meta/options", handler.GetOptions)
+	}
+}
@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	product_handler "github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/product"
+)
+
+// SetupProductRoutes: 商品カタログを管理するルート
+func SetupProductRoutes(r *gin.Engine, handler product_handler.Handler) {
+	v1 := r.Group("/api/v1")
+	{
+		v1.GET("/products", handler.List)
+		v1.POST("/products", handler.Create)
+		v1.GET("/products/:id", handler.Get)
+		v1.PUT("/products/:id", handler.Update)
+		v1.DELETE("/products/:id", handler.Delete)
+		v1.GET("/products/:id/copies", handler.ListCopies)
+	}
+}
@@ -0,0 +1,120 @@
+// Package textdiff: 日本語のテキストの文字単位の差分
+package textdiff
+
+import (
+	"strings"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/textmetrics"
+)
+
+// OpType: 差分の種類
+type OpType string
+
+const (
+	OpEqual  OpType = "equal"
+	OpInsert OpType = "insert"
+	OpDelete OpType = "delete"
+)
+
+// maxCells: 最長共通部分列を求める表の大きさの上限（超える場合は全体の置き換えとする）
+const maxCells = 4_000_000
+
+// Op: 差分の1区間（同じ種類の連続する文字はまとめる）
+type Op struct {
+	Type OpType `json:"type"`
+	Text string `json:"text"`
+}
+
+// Diff: aからbへの差分を書記素クラスタ単位で求める
+//
+// 日本語は単語の区切りがないため、単語ではなく文字（書記素クラスタ）を単位とします。
+// 濁点の結合文字や絵文字の結合は1文字として扱うため、文字の途中で分割されません。
+func Diff(a, b string) []Op {
+	x, y := textmetrics.Graphemes(a), textmetrics.Graphemes(b)
+
+	// 共通の先頭・末尾は表を作らずに一致とする
+	prefix := 0
+	for prefix < len(x) && prefix < len(y) && x[prefix] == y[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(x)-prefix && suffix < len(y)-prefix && x[len(x)-1-suffix] == y[len(y)-1-suffix] {
+		suffix++
+	}
+
+	var d differ
+	d.add(OpEqual, x[:prefix]...)
+	d.middle(x[prefix:len(x)-suffix], y[prefix:len(y)-suffix])
+	d.add(OpEqual, x[len(x)-suffix:]...)
+	return d.ops
+}
+
+type differ struct {
+	ops []Op
+}
+
+// middle: 最長共通部分列から差分を組み立てる（削除を挿入より先に並べる）
+func (d *differ) middle(x, y []string) {
+	n, m := len(x), len(y)
+	if n == 0 || m == 0 || (n+1)*(m+1) > maxCells {
+		d.add(OpDelete, x...)
+		d.add(OpInsert, y...)
+		return
+	}
+
+	// lcs[i][j]: x[i:]とy[j:]の最長共通部分列の長さ
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case x[i] == y[j]:
+			d.add(OpEqual, x[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			d.add(OpDelete, x[i])
+			i++
+		default:
+			d.add(OpInsert, y[j])
+			j++
+		}
+	}
+	d.add(OpDelete, x[i:]...)
+	d.add(OpInsert, y[j:]...)
+}
+
+// add: 直前と同じ種類の場合は同じ区間にまとめる
+func (d *differ) add(t OpType, chars ...string) {
+	if len(chars) == 0 {
+		return
+	}
+	text := strings.Join(chars, "")
+	if last := len(d.ops) - 1; last >= 0 && d.ops[last].Type == t {
+		d.ops[last].Text += text
+		return
+	}
+	d.ops = append(d.ops, Op{Type: t, Text: text})
+}
+
+// Changed: 差分に挿入・削除が含まれるかどうか
+func Changed(ops []Op) bool {
+	for _, op := range ops {
+		if op.Type != OpEqual {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+package textdiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want []Op
+	}{
+		{
+			name: "正常系_変更なし",
+			a:    "毎日の暮らしを快適に",
+			b:    "毎日の暮らしを快適に",
+			want: []Op{{Type: OpEqual, Text: "毎日の暮らしを快適に"}},
+		},
+		{
+			name: "正常系_置き換え",
+			a:    "毎日の暮らしを快適に",
+			b:    "毎日の生活をもっと快適に",
+			want: []Op{
+				{Type: OpEqual, Text: "毎日の"},
+				{Type: OpDelete, Text: "暮らし"},
+				{Type: OpInsert, Text: "生活"},
+				{Type: OpEqual, Text: "を"},
+				{Type: OpInsert, Text: "もっと"},
+				{Type: OpEqual, Text: "快適に"},
+			},
+		},
+		{
+			name: "正常系_結合文字と絵文字は1文字",
+			a:    "がっこいい👍🏻",
+			b:    "か゚っこいい👍🏽",
+			want: []Op{
+				{Type: OpDelete, Text: "が"},
+				{Type: OpInsert, Text: "か゚"},
+				{Type: OpEqual, Text: "っこいい"},
+				{Type: OpDelete, Text: "👍🏻"},
+				{Type: OpInsert, Text: "👍🏽"},
+			},
+		},
+		{
+			name: "正常系_空からの追加",
+			a:    "",
+			b:    "新商品",
+			want: []Op{{Type: OpInsert, Text: "新商品"}},
+		},
+		{
+			name: "正常系_すべて削除",
+			a:    "新商品",
+			b:    "",
+			want: []Op{{Type: OpDelete, Text: "新商品"}},
+		},
+		{
+			name: "正常系_両方空",
+			a:    "",
+			b:    "",
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Diff(tt.a, tt.b)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.a != tt.b, Changed(got))
+		})
+	}
+}
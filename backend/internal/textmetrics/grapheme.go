@@ -0,0 +1,126 @@
+package textmetrics
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	zwj               = '\u200d'
+	emojiPresentation = '\ufe0f'
+)
+
+// Graphemes: テキストを書記素クラスタに分割する
+//
+// Unicode標準附属書29（UAX #29）の拡張書記素クラスタを簡略化した規則で分割します。
+// 結合文字（濁点・半濁点の結合文字を含む）、異体字セレクタ、絵文字の肌の色の修飾子、
+// ZWJによる絵文字の結合、国旗（Regional Indicatorの組）、ハングルの字母、CR LFを1つにまとめます。
+func Graphemes(s string) []string {
+	var clusters []string
+	for len(s) > 0 {
+		n := nextBoundary(s)
+		clusters = append(clusters, s[:n])
+		s = s[n:]
+	}
+	return clusters
+}
+
+// nextBoundary: 先頭の書記素クラスタのバイト長
+func nextBoundary(s string) int {
+	first, size := utf8.DecodeRuneInString(s)
+	if first == '\r' && len(s) > size && s[size] == '\n' {
+		return size + 1
+	}
+	if unicode.IsControl(first) {
+		return size
+	}
+
+	prev := first
+	regionalIndicators := 0
+	if isRegionalIndicator(first) {
+		regionalIndicators = 1
+	}
+
+	i := size
+	for i < len(s) {
+		r, n := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case isExtend(r) || r == zwj || unicode.Is(unicode.Mc, r):
+			// 結合文字・異体字セレクタ・修飾子・ZWJは直前の文字に結合する
+		case prev == zwj && isPictographic(r):
+			// ZWJの後の絵文字は結合する
+		case isRegionalIndicator(r) && regionalIndicators%2 == 1:
+			regionalIndicators++
+		case joinsHangul(prev, r):
+		default:
+			return i
+		}
+		prev = r
+		i += n
+	}
+	return i
+}
+
+// isExtend: 直前の文字に結合する文字
+func isExtend(r rune) bool {
+	return unicode.Is(unicode.Mn, r) ||
+		unicode.Is(unicode.Me, r) ||
+		(r >= 0x1f3fb && r <= 0x1f3ff) || // 絵文字の肌の色の修飾子
+		(r >= 0xe0020 && r <= 0xe007f) // タグ文字（地域の旗）
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1f1e6 && r <= 0x1f1ff
+}
+
+// isPictographic: 絵文字として扱う文字（Extended_Pictographicの主な範囲）
+func isPictographic(r rune) bool {
+	return (r >= 0x1f000 && r <= 0x1faff) ||
+		(r >= 0x2600 && r <= 0x27bf) ||
+		(r >= 0x2300 && r <= 0x23ff) ||
+		r == 0x00a9 || r == 0x00ae || r == 0x203c || r == 0x2049 || r == 0x2122 ||
+		(r >= 0x2190 && r <= 0x21ff) ||
+		(r >= 0x2b00 && r <= 0x2bff) ||
+		r == 0x3030 || r == 0x303d || r == 0x3297 || r == 0x3299
+}
+
+// ハングルの字母の種類
+const (
+	hangulOther = iota
+	hangulL
+	hangulV
+	hangulT
+	hangulLV
+	hangulLVT
+)
+
+func hangulType(r rune) int {
+	switch {
+	case (r >= 0x1100 && r <= 0x115f) || (r >= 0xa960 && r <= 0xa97c):
+		return hangulL
+	case (r >= 0x1160 && r <= 0x11a7) || (r >= 0xd7b0 && r <= 0xd7c6):
+		return hangulV
+	case (r >= 0x11a8 && r <= 0x11ff) || (r >= 0xd7cb && r <= 0xd7fb):
+		return hangulT
+	case r >= 0xac00 && r <= 0xd7a3:
+		if (r-0xac00)%28 == 0 {
+			return hangulLV
+		}
+		return hangulLVT
+	}
+	return hangulOther
+}
+
+// joinsHangul: ハングルの字母が音節として結合するかどうか
+func joinsHangul(prev, r rune) bool {
+	p, c := hangulType(prev), hangulType(r)
+	switch p {
+	case hangulL:
+		return c == hangulL || c == hangulV || c == hangulLV || c == hangulLVT
+	case hangulLV, hangulV:
+		return c == hangulV || c == hangulT
+	case hangulLVT, hangulT:
+		return c == hangulT
+	}
+	return false
+}
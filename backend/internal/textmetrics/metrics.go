@@ -0,0 +1,178 @@
+package textmetrics
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/width"
+)
+
+// Unit: 文字数を数える単位
+type Unit string
+
+const (
+	// UnitRunes: Unicodeのコードポイント数
+	UnitRunes Unit = "runes"
+	// UnitGraphemes: 書記素クラスタ数（見た目の1文字を1と数える）
+	UnitGraphemes Unit = "graphemes"
+	// UnitWidth: 東アジアの文字幅に基づく表示幅（全角を2、半角を1と数える）
+	UnitWidth Unit = "width"
+)
+
+// Valid: 定義済みの単位かどうか
+func (u Unit) Valid() bool {
+	switch u {
+	case UnitRunes, UnitGraphemes, UnitWidth:
+		return true
+	}
+	return false
+}
+
+// ellipsis: 切り詰めた際に末尾に付ける記号
+const ellipsis = "…"
+
+// Metrics: テキストの長さの計測結果
+type Metrics struct {
+	Bytes     int `json:"bytes"`
+	Runes     int `json:"runes"`
+	Graphemes int `json:"graphemes"`
+	Width     int `json:"width"`
+}
+
+// Measure: テキストのバイト数・コードポイント数・書記素クラスタ数・表示幅を計測
+func Measure(s string) Metrics {
+	m := Metrics{
+		Bytes: len(s),
+		Runes: utf8.RuneCountInString(s),
+	}
+	for _, g := range Graphemes(s) {
+		m.Graphemes++
+		m.Width += graphemeWidth(g)
+	}
+	return m
+}
+
+// Count: 指定した単位での長さ
+func (m Metrics) Count(unit Unit) int {
+	switch unit {
+	case UnitRunes:
+		return m.Runes
+	case UnitWidth:
+		return m.Width
+	default:
+		return m.Graphemes
+	}
+}
+
+// Count: 指定した単位でテキストの長さを数える
+func Count(s string, unit Unit) int {
+	if unit == UnitRunes {
+		return utf8.RuneCountInString(s)
+	}
+	return Measure(s).Count(unit)
+}
+
+// Width: テキストの表示幅
+func Width(s string) int {
+	return Measure(s).Width
+}
+
+// Truncate: 指定した単位でmax以内に収まるよう、書記素クラスタの境界で切り詰める
+//
+// 切り詰めた場合は末尾に「…」を付けます（「…」もmaxに含めます）。
+func Truncate(s string, max int, unit Unit) string {
+	if Count(s, unit) <= max {
+		return s
+	}
+
+	limit := max - Count(ellipsis, unit)
+	if limit < 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	n := 0
+	for _, g := range Graphemes(s) {
+		size := Count(g, unit)
+		if n+size > limit {
+			break
+		}
+		b.WriteString(g)
+		n += size
+	}
+	return strings.TrimRightFunc(b.String(), unicode.IsSpace) + ellipsis
+}
+
+// Limit: 長さの制限（Minが0の場合は下限なし）
+type Limit struct {
+	Unit Unit `json:"unit"`
+	Min  int  `json:"min"`
+	Max  int  `json:"max"`
+}
+
+// Check: 制限を満たしているかを検証し、違反している場合は日本語の説明を返す
+//
+// nameは説明に含める項目名です。空のテキストは必須項目の欠落として扱います。
+func (l Limit) Check(name, s string) (string, bool) {
+	n := Count(s, l.Unit)
+	switch {
+	case n == 0:
+		return name + "がありません", false
+	case l.Min > 0 && (n < l.Min || n > l.Max):
+		return fmt.Sprintf("%sは%s%d〜%d%sにしてください（現在%d%s）", name, l.label(), l.Min, l.Max, l.suffix(), n, l.suffix()), false
+	case n > l.Max:
+		return fmt.Sprintf("%sは%s%d%s以内にしてください（現在%d%s）", name, l.label(), l.Max, l.suffix(), n, l.suffix()), false
+	}
+	return "", true
+}
+
+// Describe: 制限の日本語の説明（例: 50〜100文字、20文字以内）
+func (l Limit) Describe() string {
+	if l.Min > 0 {
+		return fmt.Sprintf("%s%d〜%d%s", l.label(), l.Min, l.Max, l.suffix())
+	}
+	return fmt.Sprintf("%s%d%s以内", l.label(), l.Max, l.suffix())
+}
+
+// Exceeds: 上限を超えているかどうか
+func (l Limit) Exceeds(s string) bool {
+	return Count(s, l.Unit) > l.Max
+}
+
+func (l Limit) label() string {
+	if l.Unit == UnitWidth {
+		return "表示幅（全角2・半角1）"
+	}
+	return ""
+}
+
+func (l Limit) suffix() string {
+	if l.Unit == UnitWidth {
+		return ""
+	}
+	return "文字"
+}
+
+// graphemeWidth: 書記素クラスタの表示幅
+//
+// 曖昧幅（East Asian Ambiguous）の文字は半角として数えます。
+func graphemeWidth(g string) int {
+	r, _ := utf8.DecodeRuneInString(g)
+	switch {
+	case r == '\t':
+		return 1
+	case unicode.IsControl(r) || unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Me, r) || r == zwj:
+		return 0
+	case isRegionalIndicator(r) || strings.ContainsRune(g, emojiPresentation) || strings.ContainsRune(g, zwj):
+		// 国旗・絵文字表示の異体字セレクタ付き・ZWJで結合した絵文字は全角として表示される
+		return 2
+	}
+
+	switch width.LookupRune(r).Kind() {
+	case width.EastAsianWide, width.EastAsianFullwidth:
+		return 2
+	}
+	return 1
+}
@@ -0,0 +1,101 @@
+package textmetrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMeasure(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want Metrics
+	}{
+		{name: "空文字", text: "", want: Metrics{}},
+		{name: "半角英数字", text: "Sale 50%", want: Metrics{Bytes: 8, Runes: 8, Graphemes: 8, Width: 8}},
+		{name: "全角", text: "夏の新作", want: Metrics{Bytes: 12, Runes: 4, Graphemes: 4, Width: 8}},
+		{name: "半角カナ", text: "ｾｰﾙ", want: Metrics{Bytes: 9, Runes: 3, Graphemes: 3, Width: 3}},
+		{name: "全角英数字", text: "ＳＡＬＥ", want: Metrics{Bytes: 12, Runes: 4, Graphemes: 4, Width: 8}},
+		{name: "結合文字の濁点", text: "が", want: Metrics{Bytes: 6, Runes: 2, Graphemes: 1, Width: 2}},
+		{name: "絵文字", text: "😀", want: Metrics{Bytes: 4, Runes: 1, Graphemes: 1, Width: 2}},
+		{name: "肌の色の修飾子", text: "👍🏻", want: Metrics{Bytes: 8, Runes: 2, Graphemes: 1, Width: 2}},
+		{name: "ZWJで結合した絵文字", text: "👨‍👩‍👧", want: Metrics{Bytes: 18, Runes: 5, Graphemes: 1, Width: 2}},
+		{name: "異体字セレクタ", text: "❤️", want: Metrics{Bytes: 6, Runes: 2, Graphemes: 1, Width: 2}},
+		{name: "国旗", text: "🇯🇵🇺🇸", want: Metrics{Bytes: 16, Runes: 4, Graphemes: 2, Width: 4}},
+		{name: "ハングルの字母", text: "각", want: Metrics{Bytes: 9, Runes: 3, Graphemes: 1, Width: 2}},
+		{name: "CR LF", text: "あ\r\nい", want: Metrics{Bytes: 8, Runes: 4, Graphemes: 3, Width: 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Measure(tt.text))
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		max  int
+		unit Unit
+		want string
+	}{
+		{name: "制限内", text: "夏の新作", max: 4, unit: UnitGraphemes, want: "夏の新作"},
+		{name: "文字数で切り詰め", text: "夏の新作セール開催中", max: 5, unit: UnitGraphemes, want: "夏の新作…"},
+		{name: "表示幅で切り詰め", text: "夏のSALE開催中", max: 9, unit: UnitWidth, want: "夏のSALE…"},
+		{name: "書記素クラスタを分割しない", text: "👨‍👩‍👧👨‍👩‍👧👨‍👩‍👧", max: 2, unit: UnitGraphemes, want: "👨‍👩‍👧…"},
+		{name: "末尾の空白を除く", text: "Big Summer Sale", max: 5, unit: UnitRunes, want: "Big…"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Truncate(tt.text, tt.max, tt.unit)
+			assert.Equal(t, tt.want, got)
+			assert.LessOrEqual(t, Count(got, tt.unit), tt.max)
+		})
+	}
+}
+
+func TestLimit_Check(t *testing.T) {
+	tests := []struct {
+		name   string
+		limit  Limit
+		text   string
+		want   string
+		wantOK bool
+	}{
+		{name: "正常系", limit: Limit{Unit: UnitGraphemes, Max: 5}, text: "夏の新作", wantOK: true},
+		{name: "必須項目なし", limit: Limit{Unit: UnitGraphemes, Max: 5}, text: "", want: "titleがありません"},
+		{name: "上限超過", limit: Limit{Unit: UnitGraphemes, Max: 3}, text: "夏の新作", want: "titleは3文字以内にしてください（現在4文字）"},
+		{name: "範囲外", limit: Limit{Unit: UnitGraphemes, Min: 5, Max: 10}, text: "夏の新作", want: "titleは5〜10文字にしてください（現在4文字）"},
+		{name: "表示幅の上限超過", limit: Limit{Unit: UnitWidth, Max: 6}, text: "夏の新作", want: "titleは表示幅（全角2・半角1）6以内にしてください（現在8）"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.limit.Check("title", tt.text)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLimit_Describe(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit Limit
+		want  string
+	}{
+		{name: "上限のみ", limit: Limit{Unit: UnitGraphemes, Max: 20}, want: "20文字以内"},
+		{name: "範囲", limit: Limit{Unit: UnitGraphemes, Min: 50, Max: 100}, want: "50〜100文字"},
+		{name: "表示幅", limit: Limit{Unit: UnitWidth, Max: 40}, want: "表示幅（全角2・半角1）40以内"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.limit.Describe())
+		})
+	}
+}
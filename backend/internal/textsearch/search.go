@@ -0,0 +1,211 @@
+// Package textsearch: 日本語のテキストの検索語の正規化・トークン化・一致箇所の抽出
+package textsearch
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/textmetrics"
+)
+
+// DefaultFragmentSize: 一致箇所の前後に含める文字数の既定値
+const DefaultFragmentSize = 20
+
+// Normalize: 検索の比較に使用する形に正規化（NFKCで全角英数・半角カナを揃え、小文字にする）
+func Normalize(s string) string {
+	return strings.ToLower(norm.NFKC.String(s))
+}
+
+// Terms: 検索クエリを空白で区切った検索語（正規化済み・重複なし）
+//
+// 全角の空白もNFKCで半角の空白になるため区切りとして扱います。
+func Terms(query string) []string {
+	var terms []string
+	seen := map[string]bool{}
+	for _, term := range strings.Fields(Normalize(query)) {
+		if len(Tokens(term)) == 0 || seen[term] {
+			continue
+		}
+		seen[term] = true
+		terms = append(terms, term)
+	}
+	return terms
+}
+
+// Tokens: 転置索引に使用するトークン（正規化したテキストの1文字と隣り合う2文字）
+//
+// 日本語は単語の区切りがないため、形態素解析の代わりに文字のbigramで索引を作ります。
+// 1文字の検索語にも一致できるよう、1文字のトークン（unigram）も含めます。
+// 空白・記号では区切り、区切りをまたぐbigramは作りません。
+func Tokens(s string) []string {
+	var tokens []string
+	seen := map[string]bool{}
+	add := func(token string) {
+		if !seen[token] {
+			seen[token] = true
+			tokens = append(tokens, token)
+		}
+	}
+	for _, run := range strings.FieldsFunc(Normalize(s), isSeparator) {
+		runes := []rune(run)
+		for i, r := range runes {
+			add(string(r))
+			if i+1 < len(runes) {
+				add(string(runes[i : i+2]))
+			}
+		}
+	}
+	return tokens
+}
+
+// isSeparator: トークンの区切りとする文字
+func isSeparator(r rune) bool {
+	return unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r)
+}
+
+// Range: 一致した区間（文字（コードポイント）単位の位置で、Endは含まない）
+type Range struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// Fragment: 一致箇所を含むテキストの断片
+type Fragment struct {
+	Text    string  `json:"text"`
+	Matches []Range `json:"matches"`
+	// TruncatedStart / TruncatedEnd: 元のテキストの前・後を省略したかどうか
+	TruncatedStart bool `json:"truncatedStart,omitempty"`
+	TruncatedEnd   bool `json:"truncatedEnd,omitempty"`
+}
+
+// Contains: 正規化したテキストに検索語がすべて含まれるかどうか
+func Contains(normalized string, terms []string) bool {
+	for _, term := range terms {
+		if !strings.Contains(normalized, term) {
+			return false
+		}
+	}
+	return true
+}
+
+// Count: 正規化したテキストに検索語が含まれる回数
+func Count(normalized string, terms []string) int {
+	n := 0
+	for _, term := range terms {
+		n += strings.Count(normalized, term)
+	}
+	return n
+}
+
+// Match: テキストの中で検索語に一致した区間（元のテキストでの位置）を重なりをまとめて返す
+//
+// 正規化で文字数が変わる場合（半角カナの濁点など）も元のテキストの位置に対応付けます。
+func Match(text string, terms []string) []Range {
+	normalized, origin := normalizeWithOrigin(text)
+	var ranges []Range
+	for _, term := range terms {
+		for from := 0; from < len(normalized); {
+			i := strings.Index(normalized[from:], term)
+			if i < 0 {
+				break
+			}
+			start := from + i
+			end := start + len(term)
+			ranges = append(ranges, Range{Start: origin[start].Start, End: origin[end-1].End})
+			_, size := utf8.DecodeRuneInString(normalized[start:])
+			from = start + size
+		}
+	}
+	return mergeRanges(ranges)
+}
+
+// Highlight: 一致箇所を前後size文字とともに断片として抽出（近い一致箇所は1つの断片にまとめる）
+func Highlight(text string, terms []string, size int) []Fragment {
+	matches := Match(text, terms)
+	if len(matches) == 0 {
+		return nil
+	}
+	runes := []rune(text)
+
+	var fragments []Fragment
+	var group []Range
+	flush := func() {
+		start := max(group[0].Start-size, 0)
+		end := min(group[len(group)-1].End+size, len(runes))
+		f := Fragment{
+			Text:           string(runes[start:end]),
+			TruncatedStart: start > 0,
+			TruncatedEnd:   end < len(runes),
+		}
+		for _, m := range group {
+			f.Matches = append(f.Matches, Range{Start: m.Start - start, End: m.End - start})
+		}
+		fragments = append(fragments, f)
+	}
+	for _, m := range matches {
+		if len(group) > 0 && m.Start-group[len(group)-1].End > size*2 {
+			flush()
+			group = nil
+		}
+		group = append(group, m)
+	}
+	flush()
+	return fragments
+}
+
+// normalizeWithOrigin: 書記素クラスタごとに正規化し、正規化後の各バイトが元のテキストのどの文字の区間に由来するかを返す
+func normalizeWithOrigin(text string) (string, []Range) {
+	var b strings.Builder
+	var origin []Range
+	pos := 0
+	for _, g := range graphemes(text) {
+		n := utf8.RuneCountInString(g)
+		ng := Normalize(g)
+		b.WriteString(ng)
+		for i := 0; i < len(ng); i++ {
+			origin = append(origin, Range{Start: pos, End: pos + n})
+		}
+		pos += n
+	}
+	return b.String(), origin
+}
+
+// graphemes: 書記素クラスタに分割（半角カナの濁点・半濁点は直前の文字と合わせて正規化できるようまとめる）
+func graphemes(text string) []string {
+	var gs []string
+	for _, g := range textmetrics.Graphemes(text) {
+		if r, _ := utf8.DecodeRuneInString(g); len(gs) > 0 && (r == '\uff9e' || r == '\uff9f') {
+			gs[len(gs)-1] += g
+			continue
+		}
+		gs = append(gs, g)
+	}
+	return gs
+}
+
+// mergeRanges: 区間を位置の順に並べ、重なる・隣接する区間をまとめる
+func mergeRanges(ranges []Range) []Range {
+	if len(ranges) == 0 {
+		return nil
+	}
+	sort.Slice(ranges, func(i, j int) bool {
+		if ranges[i].Start != ranges[j].Start {
+			return ranges[i].Start < ranges[j].Start
+		}
+		return ranges[i].End < ranges[j].End
+	})
+	merged := []Range{ranges[0]}
+	for _, r := range ranges[1:] {
+		last := &merged[len(merged)-1]
+		if r.Start <= last.End {
+			last.End = max(last.End, r.End)
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
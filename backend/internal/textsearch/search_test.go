@@ -0,0 +1,175 @@
+package textsearch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTerms(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{
+			name:  "正常系_全角の空白で区切る",
+			query: "夏　セール",
+			want:  []string{"夏", "セール"},
+		},
+		{
+			name:  "正常系_全角英数と大文字を正規化",
+			query: "ＳＡＬＥ Sale",
+			want:  []string{"sale"},
+		},
+		{
+			name:  "正常系_半角カナを全角にする",
+			query: "ｾｰﾙ",
+			want:  []string{"セール"},
+		},
+		{
+			name:  "正常系_記号のみの検索語は除く",
+			query: "！ 新商品 ・",
+			want:  []string{"新商品"},
+		},
+		{
+			name:  "正常系_空のクエリ",
+			query: "   ",
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Terms(tt.query))
+		})
+	}
+}
+
+func TestTokens(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{
+			name: "正常系_1文字と2文字のトークン",
+			text: "夏セール",
+			want: []string{"夏", "夏セ", "セ", "セー", "ー", "ール", "ル"},
+		},
+		{
+			name: "正常系_記号と空白で区切る",
+			text: "新作！ 登場",
+			want: []string{"新", "新作", "作", "登", "登場", "場"},
+		},
+		{
+			name: "正常系_重複は除く",
+			text: "ママ ママ",
+			want: []string{"マ", "ママ"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Tokens(tt.text))
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		terms []string
+		want  []Range
+	}{
+		{
+			name:  "正常系_複数の一致",
+			text:  "夏のセール、夏の新作",
+			terms: []string{"夏の"},
+			want:  []Range{{Start: 0, End: 2}, {Start: 6, End: 8}},
+		},
+		{
+			name:  "正常系_重なる一致はまとめる",
+			text:  "限定セール開催",
+			terms: []string{"限定セ", "セール"},
+			want:  []Range{{Start: 0, End: 5}},
+		},
+		{
+			name:  "正常系_半角カナは元の位置に対応付ける",
+			text:  "新作ｶﾞｼﾞｪｯﾄ登場",
+			terms: []string{Normalize("ガジェット")},
+			want:  []Range{{Start: 2, End: 9}},
+		},
+		{
+			name:  "正常系_全角英字",
+			text:  "ＮＥＷアイテム",
+			terms: []string{"new"},
+			want:  []Range{{Start: 0, End: 3}},
+		},
+		{
+			name:  "正常系_一致なし",
+			text:  "夏のセール",
+			terms: []string{"冬"},
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Match(tt.text, tt.terms))
+		})
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		terms []string
+		size  int
+		want  []Fragment
+	}{
+		{
+			name:  "正常系_全体が収まる",
+			text:  "夏のセール",
+			terms: []string{"セール"},
+			size:  5,
+			want: []Fragment{
+				{Text: "夏のセール", Matches: []Range{{Start: 2, End: 5}}},
+			},
+		},
+		{
+			name:  "正常系_前後を省略",
+			text:  "毎日の暮らしを快適にする新しいセール開催中です",
+			terms: []string{"セール"},
+			size:  3,
+			want: []Fragment{
+				{Text: "新しいセール開催中", Matches: []Range{{Start: 3, End: 6}}, TruncatedStart: true, TruncatedEnd: true},
+			},
+		},
+		{
+			name:  "正常系_離れた一致は別の断片",
+			text:  "夏あいうえおかきくけこさしすせそ夏",
+			terms: []string{"夏"},
+			size:  2,
+			want: []Fragment{
+				{Text: "夏あい", Matches: []Range{{Start: 0, End: 1}}, TruncatedEnd: true},
+				{Text: "せそ夏", Matches: []Range{{Start: 2, End: 3}}, TruncatedStart: true},
+			},
+		},
+		{
+			name:  "正常系_一致なし",
+			text:  "夏のセール",
+			terms: []string{"冬"},
+			size:  5,
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Highlight(tt.text, tt.terms, tt.size))
+		})
+	}
+}
@@ -0,0 +1,318 @@
+package auth_usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/auth"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/domainerr"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+var (
+	// ErrInvalidUser: InvalidUserErrorが包むエラー（違反内容を含めてUnwrapで取得できる）
+	ErrInvalidUser = domainerr.Validation("invalid_user", "user is invalid")
+	// ErrInvalidCredentials: メールアドレスまたはパスワードが一致しない（どちらが誤っているかは返さない）
+	ErrInvalidCredentials = domainerr.Unauthenticated("invalid_credentials", "email or password is incorrect")
+	// ErrInvalidAPIKey: APIキーが存在しない、または無効にした
+	ErrInvalidAPIKey = domainerr.Unauthenticated("invalid_api_key", "api key is invalid")
+	// ErrInvalidAPIKeyName: APIキーの用途が空、または長すぎる
+	ErrInvalidAPIKeyName = domainerr.Validation("invalid_api_key_name", fmt.Sprintf("api key name must be 1-%d characters", maxNameLength))
+)
+
+// dummyHash: 存在しないメールアドレスでもパスワードを照合し、応答時間で登録の有無を推測できないようにする
+var dummyHash = sync.OnceValue(func() string {
+	// ハッシュにできる長さのパスワードのため失敗しない
+	hash, _ := auth.HashPassword("dummy password")
+	return hash
+})
+
+const (
+	// DefaultTokenTTL: ログインで発行するトークンの有効期間の既定値
+	DefaultTokenTTL = 24 * time.Hour
+	// minPasswordLength / maxPasswordBytes: パスワードの最小文字数と最大バイト数（bcryptは72バイトまで）
+	minPasswordLength = 8
+	maxPasswordBytes  = 72
+	// maxNameLength: 利用者名・APIキーの用途の最大文字数
+	maxNameLength = 100
+	// touchInterval: APIキーを使用した日時を記録し直すまでの間隔（認証のたびに書き込まないようにする）
+	touchInterval = time.Minute
+)
+
+type UseCase interface {
+	// Register: 利用者を登録する（最初の利用者を除き、認証済みの利用者のみ登録できる）
+	Register(ctx context.Context, input RegisterInput) (*entity.User, error)
+	// Login: メールアドレスとパスワードを照合し、トークンを発行する
+	Login(ctx context.Context, email, password string) (*Session, error)
+	// Me: 呼び出し元の利用者を取得
+	Me(ctx context.Context) (*entity.User, error)
+	// AuthenticateToken / AuthenticateAPIKey: トークン・APIキーから呼び出し元を特定する
+	AuthenticateToken(ctx context.Context, token string) (*entity.Identity, error)
+	AuthenticateAPIKey(ctx context.Context, key string) (*entity.Identity, error)
+	// CreateAPIKey: 呼び出し元のAPIキーを発行する（キーはこのときのみ返す）
+	CreateAPIKey(ctx context.Context, name string) (*CreatedAPIKey, error)
+	// ListAPIKeys: 呼び出し元の有効なAPIキーを取得
+	ListAPIKeys(ctx context.Context) ([]*entity.APIKey, error)
+	// RevokeAPIKey: 呼び出し元のAPIキーを無効にする
+	RevokeAPIKey(ctx context.Context, id int) error
+}
+
+type useCase struct {
+	users    repository.UserRepository
+	apiKeys  repository.APIKeyRepository
+	signer   *auth.Signer
+	tokenTTL time.Duration
+	now      func() time.Time
+}
+
+// Option: ユースケースの設定
+type Option func(*useCase)
+
+// WithTokenTTL: ログインで発行するトークンの有効期間を設定
+func WithTokenTTL(d time.Duration) Option {
+	return func(u *useCase) {
+		if d > 0 {
+			u.tokenTTL = d
+		}
+	}
+}
+
+// WithClock: トークンの発行・検証に使う現在時刻を設定（テスト用）
+func WithClock(now func() time.Time) Option {
+	return func(u *useCase) {
+		if now != nil {
+			u.now = now
+		}
+	}
+}
+
+// RegisterInput: 利用者の登録内容
+type RegisterInput struct {
+	Email    string
+	Name     string
+	Password string
+}
+
+// Session: ログインで発行したトークン
+type Session struct {
+	Token     string       `json:"token"`
+	ExpiresAt time.Time    `json:"expiresAt"`
+	User      *entity.User `json:"user"`
+}
+
+// CreatedAPIKey: 発行したAPIキー（Keyは再表示できない）
+type CreatedAPIKey struct {
+	Key    string         `json:"key"`
+	APIKey *entity.APIKey `json:"apiKey"`
+}
+
+// InvalidUserError: 利用者の登録内容が不正な場合のエラー
+type InvalidUserError struct {
+	Violations []string
+}
+
+func (e *InvalidUserError) Error() string {
+	return "invalid user: " + strings.Join(e.Violations, "; ")
+}
+
+func (e *InvalidUserError) Unwrap() error {
+	return ErrInvalidUser.WithDetail("violations", e.Violations)
+}
+
+func NewUseCase(users repository.UserRepository, apiKeys repository.APIKeyRepository, signer *auth.Signer, opts ...Option) UseCase {
+	u := &useCase{
+		users:    users,
+		apiKeys:  apiKeys,
+		signer:   signer,
+		tokenTTL: DefaultTokenTTL,
+		now:      time.Now,
+	}
+	for _, opt := range opts {
+		opt(u)
+	}
+	return u
+}
+
+// Register: 利用者を登録（メールアドレスが登録済みの場合はrepository.ErrDuplicatedを返す）
+//
+// 最初の利用者は認証せずに登録でき、以降は認証済みの利用者が他の利用者を登録します。
+// 認証せずに同時に登録した場合も、最初の利用者として登録されるのは1人だけです。
+func (u *useCase) Register(ctx context.Context, input RegisterInput) (*entity.User, error) {
+	anonymous := auth.IdentityFrom(ctx) == nil
+	// パスワードのハッシュ化の前に、2人目以降の認証されていない登録を拒否する
+	if anonymous {
+		count, err := u.users.Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			return nil, auth.ErrAuthenticationRequired
+		}
+	}
+
+	email := normalizeEmail(input.Email)
+	name := strings.TrimSpace(input.Name)
+	var violations []string
+	if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+		violations = append(violations, "emailはメールアドレスの形式にしてください")
+	}
+	if name == "" || utf8.RuneCountInString(name) > maxNameLength {
+		violations = append(violations, fmt.Sprintf("nameは1〜%d文字にしてください", maxNameLength))
+	}
+	if utf8.RuneCountInString(input.Password) < minPasswordLength || len(input.Password) > maxPasswordBytes {
+		violations = append(violations, fmt.Sprintf("passwordは%d文字以上、%dバイト以内にしてください", minPasswordLength, maxPasswordBytes))
+	}
+	if len(violations) > 0 {
+		return nil, &InvalidUserError{Violations: violations}
+	}
+
+	hash, err := auth.HashPassword(input.Password)
+	if err != nil {
+		return nil, err
+	}
+	user := &entity.User{Email: email, Name: name, PasswordHash: hash}
+	if anonymous {
+		// 確認の後に他の利用者が登録した場合も、最初の利用者の登録として拒否する
+		if err := u.users.CreateFirst(ctx, user); err != nil {
+			if errors.Is(err, repository.ErrUsersExist) {
+				return nil, auth.ErrAuthenticationRequired
+			}
+			return nil, err
+		}
+		return user, nil
+	}
+	if err := u.users.Create(ctx, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (u *useCase) Login(ctx context.Context, email, password string) (*Session, error) {
+	user, err := u.users.GetByEmail(ctx, normalizeEmail(email))
+	if errors.Is(err, repository.ErrUserNotFound) {
+		auth.CheckPassword(dummyHash(), password)
+		return nil, ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !auth.CheckPassword(user.PasswordHash, password) {
+		return nil, ErrInvalidCredentials
+	}
+
+	now := u.now()
+	expiresAt := now.Add(u.tokenTTL)
+	token, err := u.signer.Sign(auth.Claims{
+		Subject:   strconv.Itoa(user.ID),
+		Email:     user.Email,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: expiresAt.Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Session{Token: token, ExpiresAt: expiresAt, User: user}, nil
+}
+
+func (u *useCase) Me(ctx context.Context) (*entity.User, error) {
+	identity, err := identityFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return u.users.Get(ctx, identity.UserID)
+}
+
+// AuthenticateToken: トークンの署名と有効期限を検証する（データベースは参照しない）
+func (u *useCase) AuthenticateToken(ctx context.Context, token string) (*entity.Identity, error) {
+	claims, err := u.signer.Verify(token, u.now())
+	if err != nil {
+		return nil, err
+	}
+	userID, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		return nil, auth.ErrInvalidToken
+	}
+	return &entity.Identity{UserID: userID, Email: claims.Email, Method: entity.AuthMethodSession}, nil
+}
+
+// AuthenticateAPIKey: APIキーのハッシュで照合し、使用した日時を記録する
+func (u *useCase) AuthenticateAPIKey(ctx context.Context, key string) (*entity.Identity, error) {
+	apiKey, err := u.apiKeys.GetByHash(ctx, auth.HashAPIKey(key))
+	if errors.Is(err, repository.ErrAPIKeyNotFound) {
+		return nil, ErrInvalidAPIKey
+	}
+	if err != nil {
+		return nil, err
+	}
+	user, err := u.users.Get(ctx, apiKey.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := u.now()
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) >= touchInterval {
+		if err := u.apiKeys.Touch(ctx, apiKey.ID, now); err != nil {
+			return nil, err
+		}
+	}
+	return &entity.Identity{UserID: user.ID, Email: user.Email, Method: entity.AuthMethodAPIKey, APIKeyID: apiKey.ID}, nil
+}
+
+func (u *useCase) CreateAPIKey(ctx context.Context, name string) (*CreatedAPIKey, error) {
+	identity, err := identityFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxNameLength {
+		return nil, ErrInvalidAPIKeyName
+	}
+
+	key, prefix, hash, err := auth.GenerateAPIKey()
+	if err != nil {
+		return nil, err
+	}
+	apiKey := &entity.APIKey{UserID: identity.UserID, Name: name, Prefix: prefix, KeyHash: hash}
+	if err := u.apiKeys.Create(ctx, apiKey); err != nil {
+		return nil, err
+	}
+	return &CreatedAPIKey{Key: key, APIKey: apiKey}, nil
+}
+
+func (u *useCase) ListAPIKeys(ctx context.Context) ([]*entity.APIKey, error) {
+	identity, err := identityFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return u.apiKeys.ListByUser(ctx, identity.UserID)
+}
+
+func (u *useCase) RevokeAPIKey(ctx context.Context, id int) error {
+	identity, err := identityFrom(ctx)
+	if err != nil {
+		return err
+	}
+	return u.apiKeys.Delete(ctx, identity.UserID, id)
+}
+
+// identityFrom: コンテキストの呼び出し元（認証されていない場合はauth.ErrAuthenticationRequired）
+func identityFrom(ctx context.Context) (*entity.Identity, error) {
+	identity := auth.IdentityFrom(ctx)
+	if identity == nil {
+		return nil, auth.ErrAuthenticationRequired
+	}
+	return identity, nil
+}
+
+// normalizeEmail: 前後の空白を取り除き、小文字にしたメールアドレス
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
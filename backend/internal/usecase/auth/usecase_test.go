@@ -0,0 +1,457 @@
+package auth_usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/auth"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+// 利用者のリポジトリのモック
+type mockUserRepository struct {
+	mock.Mock
+}
+
+func (m *mockUserRepository) Get(ctx context.Context, id int) (*entity.User, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetByEmail(ctx context.Context, email string) (*entity.User, error) {
+	args := m.Called(ctx, email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) Create(ctx context.Context, user *entity.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+
+func (m *mockUserRepository) CreateFirst(ctx context.Context, user *entity.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+
+func (m *mockUserRepository) Count(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// APIキーのリポジトリのモック
+type mockAPIKeyRepository struct {
+	mock.Mock
+}
+
+func (m *mockAPIKeyRepository) ListByUser(ctx context.Context, userID int) ([]*entity.APIKey, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.APIKey), args.Error(1)
+}
+
+func (m *mockAPIKeyRepository) GetByHash(ctx context.Context, hash string) (*entity.APIKey, error) {
+	args := m.Called(ctx, hash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.APIKey), args.Error(1)
+}
+
+func (m *mockAPIKeyRepository) Create(ctx context.Context, key *entity.APIKey) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+func (m *mockAPIKeyRepository) Delete(ctx context.Context, userID, id int) error {
+	args := m.Called(ctx, userID, id)
+	return args.Error(0)
+}
+
+func (m *mockAPIKeyRepository) Touch(ctx context.Context, id int, usedAt time.Time) error {
+	args := m.Called(ctx, id, usedAt)
+	return args.Error(0)
+}
+
+var (
+	testNow    = time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	testSigner = auth.NewSigner([]byte("0123456789abcdef0123456789abcdef"))
+)
+
+func newTestUseCase(users repository.UserRepository, apiKeys repository.APIKeyRepository) UseCase {
+	return NewUseCase(users, apiKeys, testSigner, WithClock(func() time.Time { return testNow }))
+}
+
+// signedIn: 利用者1として認証済みのコンテキスト
+func signedIn() context.Context {
+	return auth.WithIdentity(context.Background(), &entity.Identity{UserID: 1, Email: "tanaka@example.com", Method: entity.AuthMethodSession})
+}
+
+func TestRegister(t *testing.T) {
+	valid := RegisterInput{Email: " Tanaka@Example.com ", Name: " 田中 ", Password: "password123"}
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		input     RegisterInput
+		count     int64
+		createErr error
+		wantErr   error
+	}{
+		{
+			name:  "正常系_最初の利用者は認証不要",
+			ctx:   context.Background(),
+			input: valid,
+		},
+		{
+			name:  "正常系_認証済みの利用者による登録",
+			ctx:   signedIn(),
+			input: valid,
+			count: 1,
+		},
+		{
+			name:    "異常系_2人目以降は認証が必要",
+			ctx:     context.Background(),
+			input:   valid,
+			count:   1,
+			wantErr: auth.ErrAuthenticationRequired,
+		},
+		{
+			name:      "異常系_同時に登録した他の利用者が最初の利用者になった",
+			ctx:       context.Background(),
+			input:     valid,
+			createErr: repository.ErrUsersExist,
+			wantErr:   auth.ErrAuthenticationRequired,
+		},
+		{
+			name:    "異常系_不正なメールアドレス",
+			ctx:     signedIn(),
+			input:   RegisterInput{Email: "tanaka", Name: "田中", Password: "password123"},
+			wantErr: ErrInvalidUser,
+		},
+		{
+			name:    "異常系_短いパスワード",
+			ctx:     signedIn(),
+			input:   RegisterInput{Email: "tanaka@example.com", Name: "田中", Password: "short"},
+			wantErr: ErrInvalidUser,
+		},
+		{
+			name:    "異常系_長すぎるパスワード",
+			ctx:     signedIn(),
+			input:   RegisterInput{Email: "tanaka@example.com", Name: "田中", Password: strings.Repeat("a", 73)},
+			wantErr: ErrInvalidUser,
+		},
+		{
+			name:    "異常系_名前が空",
+			ctx:     signedIn(),
+			input:   RegisterInput{Email: "tanaka@example.com", Name: " ", Password: "password123"},
+			wantErr: ErrInvalidUser,
+		},
+		{
+			name:      "異常系_登録済みのメールアドレス",
+			ctx:       signedIn(),
+			input:     valid,
+			createErr: repository.ErrDuplicated,
+			wantErr:   repository.ErrDuplicated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockUsers := new(mockUserRepository)
+			mockUsers.On("Count", mock.Anything).Return(tt.count, nil)
+			mockUsers.On("Create", mock.Anything, mock.Anything).Return(tt.createErr)
+			mockUsers.On("CreateFirst", mock.Anything, mock.Anything).Return(tt.createErr)
+
+			// テスト実行
+			got, err := newTestUseCase(mockUsers, new(mockAPIKeyRepository)).Register(tt.ctx, tt.input)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "tanaka@example.com", got.Email)
+			assert.Equal(t, "田中", got.Name)
+			assert.True(t, auth.CheckPassword(got.PasswordHash, tt.input.Password))
+			// 認証されていない場合は最初の利用者として登録する
+			if auth.IdentityFrom(tt.ctx) == nil {
+				mockUsers.AssertCalled(t, "CreateFirst", mock.Anything, got)
+				mockUsers.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+			} else {
+				mockUsers.AssertCalled(t, "Create", mock.Anything, got)
+				mockUsers.AssertNotCalled(t, "CreateFirst", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestLogin(t *testing.T) {
+	hash, err := auth.HashPassword("password123")
+	assert.NoError(t, err)
+	user := &entity.User{ID: 1, Email: "tanaka@example.com", Name: "田中", PasswordHash: hash}
+
+	tests := []struct {
+		name     string
+		email    string
+		password string
+		user     *entity.User
+		getErr   error
+		wantErr  error
+	}{
+		{
+			name:     "正常系",
+			email:    "Tanaka@example.com",
+			password: "password123",
+			user:     user,
+		},
+		{
+			name:     "異常系_パスワードが一致しない",
+			email:    "tanaka@example.com",
+			password: "wrong password",
+			user:     user,
+			wantErr:  ErrInvalidCredentials,
+		},
+		{
+			name:     "異常系_存在しない利用者",
+			email:    "suzuki@example.com",
+			password: "password123",
+			getErr:   repository.ErrUserNotFound,
+			wantErr:  ErrInvalidCredentials,
+		},
+		{
+			name:     "異常系_データベースエラー",
+			email:    "tanaka@example.com",
+			password: "password123",
+			getErr:   errors.New("database error"),
+			wantErr:  errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockUsers := new(mockUserRepository)
+			if tt.user != nil {
+				mockUsers.On("GetByEmail", mock.Anything, "tanaka@example.com").Return(tt.user, nil)
+			} else {
+				mockUsers.On("GetByEmail", mock.Anything, mock.Anything).Return(nil, tt.getErr)
+			}
+			u := newTestUseCase(mockUsers, new(mockAPIKeyRepository))
+
+			// テスト実行
+			got, err := u.Login(context.Background(), tt.email, tt.password)
+
+			// アサーション
+			if tt.wantErr != nil {
+				if errors.Is(tt.wantErr, ErrInvalidCredentials) {
+					assert.ErrorIs(t, err, tt.wantErr)
+				} else {
+					assert.EqualError(t, err, tt.wantErr.Error())
+				}
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testNow.Add(DefaultTokenTTL), got.ExpiresAt)
+			assert.Equal(t, user, got.User)
+
+			// 発行したトークンで認証できる
+			identity, err := u.AuthenticateToken(context.Background(), got.Token)
+			assert.NoError(t, err)
+			assert.Equal(t, &entity.Identity{UserID: 1, Email: "tanaka@example.com", Method: entity.AuthMethodSession}, identity)
+		})
+	}
+}
+
+func TestAuthenticateToken_Expired(t *testing.T) {
+	token, err := testSigner.Sign(auth.Claims{Subject: "1", IssuedAt: testNow.Add(-2 * time.Hour).Unix(), ExpiresAt: testNow.Add(-time.Hour).Unix()})
+	assert.NoError(t, err)
+
+	// テスト実行
+	got, err := newTestUseCase(new(mockUserRepository), new(mockAPIKeyRepository)).AuthenticateToken(context.Background(), token)
+
+	// アサーション
+	assert.ErrorIs(t, err, auth.ErrTokenExpired)
+	assert.Nil(t, got)
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	recently := testNow.Add(-30 * time.Second)
+	longAgo := testNow.Add(-time.Hour)
+
+	tests := []struct {
+		name      string
+		apiKey    *entity.APIKey
+		getErr    error
+		wantTouch bool
+		wantErr   error
+	}{
+		{
+			name:      "正常系_初めての使用",
+			apiKey:    &entity.APIKey{ID: 3, UserID: 1},
+			wantTouch: true,
+		},
+		{
+			name:      "正常系_前回の使用から間隔が空いている",
+			apiKey:    &entity.APIKey{ID: 3, UserID: 1, LastUsedAt: &longAgo},
+			wantTouch: true,
+		},
+		{
+			name:   "正常系_直前に使用した場合は記録しない",
+			apiKey: &entity.APIKey{ID: 3, UserID: 1, LastUsedAt: &recently},
+		},
+		{
+			name:    "異常系_存在しないキー",
+			getErr:  repository.ErrAPIKeyNotFound,
+			wantErr: ErrInvalidAPIKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			key := auth.APIKeyPrefix + "secret"
+			mockAPIKeys := new(mockAPIKeyRepository)
+			if tt.apiKey != nil {
+				mockAPIKeys.On("GetByHash", mock.Anything, auth.HashAPIKey(key)).Return(tt.apiKey, nil)
+			} else {
+				mockAPIKeys.On("GetByHash", mock.Anything, auth.HashAPIKey(key)).Return(nil, tt.getErr)
+			}
+			mockAPIKeys.On("Touch", mock.Anything, 3, testNow).Return(nil)
+			mockUsers := new(mockUserRepository)
+			mockUsers.On("Get", mock.Anything, 1).Return(&entity.User{ID: 1, Email: "tanaka@example.com"}, nil)
+
+			// テスト実行
+			got, err := newTestUseCase(mockUsers, mockAPIKeys).AuthenticateAPIKey(context.Background(), key)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, &entity.Identity{UserID: 1, Email: "tanaka@example.com", Method: entity.AuthMethodAPIKey, APIKeyID: 3}, got)
+			if tt.wantTouch {
+				mockAPIKeys.AssertCalled(t, "Touch", mock.Anything, 3, testNow)
+			} else {
+				mockAPIKeys.AssertNotCalled(t, "Touch", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestCreateAPIKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		keyName string
+		wantErr error
+	}{
+		{
+			name:    "正常系",
+			ctx:     signedIn(),
+			keyName: " 夜間バッチ ",
+		},
+		{
+			name:    "異常系_用途が空",
+			ctx:     signedIn(),
+			keyName: " ",
+			wantErr: ErrInvalidAPIKeyName,
+		},
+		{
+			name:    "異常系_認証されていない",
+			ctx:     context.Background(),
+			keyName: "夜間バッチ",
+			wantErr: auth.ErrAuthenticationRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockAPIKeys := new(mockAPIKeyRepository)
+			mockAPIKeys.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+			// テスト実行
+			got, err := newTestUseCase(new(mockUserRepository), mockAPIKeys).CreateAPIKey(tt.ctx, tt.keyName)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				mockAPIKeys.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, auth.IsAPIKey(got.Key))
+			assert.Equal(t, 1, got.APIKey.UserID)
+			assert.Equal(t, "夜間バッチ", got.APIKey.Name)
+			assert.True(t, strings.HasPrefix(got.Key, got.APIKey.Prefix))
+			// キーそのものは保存せず、ハッシュで照合する
+			assert.Equal(t, auth.HashAPIKey(got.Key), got.APIKey.KeyHash)
+		})
+	}
+}
+
+func TestRevokeAPIKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		repoErr error
+		wantErr error
+	}{
+		{
+			name: "正常系",
+			ctx:  signedIn(),
+		},
+		{
+			name:    "異常系_他の利用者のキー",
+			ctx:     signedIn(),
+			repoErr: repository.ErrAPIKeyNotFound,
+			wantErr: repository.ErrAPIKeyNotFound,
+		},
+		{
+			name:    "異常系_認証されていない",
+			ctx:     context.Background(),
+			wantErr: auth.ErrAuthenticationRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備（呼び出し元の利用者のキーのみ無効にする）
+			mockAPIKeys := new(mockAPIKeyRepository)
+			mockAPIKeys.On("Delete", mock.Anything, 1, 3).Return(tt.repoErr)
+
+			// テスト実行
+			err := newTestUseCase(new(mockUserRepository), mockAPIKeys).RevokeAPIKey(tt.ctx, 3)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			mockAPIKeys.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package brand_usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/domainerr"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+// ErrInvalidProfile: InvalidProfileErrorが包むエラー（違反内容を含めてUnwrapで取得できる）
+var ErrInvalidProfile = domainerr.Validation("invalid_profile", "brand profile is invalid")
+
+// maxSamples: プロンプトに記載するコピーの例の上限
+const maxSamples = 5
+
+type UseCase interface {
+	List(ctx context.Context) ([]*entity.BrandProfile, error)
+	Get(ctx context.Context, id int) (*entity.BrandProfile, error)
+	Create(ctx context.Context, input Input) (*entity.BrandProfile, error)
+	Update(ctx context.Context, id int, input Input) (*entity.BrandProfile, error)
+	// Delete: ブランドプロファイルを削除（既存のコピーはそのまま残る）
+	Delete(ctx context.Context, id int) error
+}
+
+type useCase struct {
+	repo repository.BrandProfileRepository
+}
+
+// Input: ブランドプロファイルの登録・更新の内容
+type Input struct {
+	Name           string
+	Voice          string
+	PreferredWords []string
+	BannedWords    []string
+	Samples        []string
+	Ending         entity.Ending
+}
+
+// InvalidProfileError: ブランドプロファイルの内容が不正な場合のエラー
+type InvalidProfileError struct {
+	Violations []string
+}
+
+func (e *InvalidProfileError) Error() string {
+	return "invalid brand profile: " + strings.Join(e.Violations, "; ")
+}
+
+func (e *InvalidProfileError) Unwrap() error {
+	return ErrInvalidProfile.WithDetail("violations", e.Violations)
+}
+
+func NewUseCase(repo repository.BrandProfileRepository) UseCase {
+	return &useCase{repo: repo}
+}
+
+func (u *useCase) List(ctx context.Context) ([]*entity.BrandProfile, error) {
+	return u.repo.List(ctx)
+}
+
+func (u *useCase) Get(ctx context.Context, id int) (*entity.BrandProfile, error) {
+	return u.repo.Get(ctx, id)
+}
+
+func (u *useCase) Create(ctx context.Context, input Input) (*entity.BrandProfile, error) {
+	profile := &entity.BrandProfile{}
+	if err := apply(profile, input); err != nil {
+		return nil, err
+	}
+	if err := u.repo.Create(ctx, profile); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+func (u *useCase) Update(ctx context.Context, id int, input Input) (*entity.BrandProfile, error) {
+	profile, err := u.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := apply(profile, input); err != nil {
+		return nil, err
+	}
+	if err := u.repo.Update(ctx, profile); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+func (u *useCase) Delete(ctx context.Context, id int) error {
+	if _, err := u.repo.Get(ctx, id); err != nil {
+		return err
+	}
+	return u.repo.Delete(ctx, id)
+}
+
+// apply: 入力を検証し、空白と重複を取り除いた内容をプロファイルに反映
+func apply(profile *entity.BrandProfile, input Input) error {
+	name := strings.TrimSpace(input.Name)
+	preferred := words(input.PreferredWords)
+	banned := words(input.BannedWords)
+	samples := words(input.Samples)
+
+	var violations []string
+	if name == "" {
+		violations = append(violations, "nameがありません")
+	}
+	if !input.Ending.Valid() {
+		violations = append(violations, fmt.Sprintf("endingはdesu_masu・da_dearuのいずれかにしてください（%s）", input.Ending))
+	}
+	if len(samples) > maxSamples {
+		violations = append(violations, fmt.Sprintf("samplesは%d件以内にしてください", maxSamples))
+	}
+	for _, word := range preferred {
+		if contains(banned, word) {
+			violations = append(violations, fmt.Sprintf("積極的に使う言葉と使用しない言葉の両方に含まれています（%s）", word))
+		}
+	}
+	if len(violations) > 0 {
+		return &InvalidProfileError{Violations: violations}
+	}
+
+	profile.Name = name
+	profile.Voice = strings.TrimSpace(input.Voice)
+	profile.PreferredWords = preferred
+	profile.BannedWords = banned
+	profile.Samples = samples
+	profile.Ending = input.Ending
+	return nil
+}
+
+// words: 前後の空白を取り除き、空の要素と重複（大文字・小文字を区別しない）を除いた一覧
+func words(values []string) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" && !contains(result, value) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+func contains(values []string, word string) bool {
+	for _, value := range values {
+		if strings.EqualFold(value, word) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,216 @@
+package brand_usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+// リポジトリのモック
+type mockRepository struct {
+	mock.Mock
+}
+
+func (m *mockRepository) List(ctx context.Context) ([]*entity.BrandProfile, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.BrandProfile), args.Error(1)
+}
+
+func (m *mockRepository) Get(ctx context.Context, id int) (*entity.BrandProfile, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.BrandProfile), args.Error(1)
+}
+
+func (m *mockRepository) Create(ctx context.Context, profile *entity.BrandProfile) error {
+	args := m.Called(ctx, profile)
+	return args.Error(0)
+}
+
+func (m *mockRepository) Update(ctx context.Context, profile *entity.BrandProfile) error {
+	args := m.Called(ctx, profile)
+	return args.Error(0)
+}
+
+func (m *mockRepository) Delete(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func validInput() Input {
+	return Input{
+		Name:           " テストブランド ",
+		Voice:          "親しみやすく、誠実な語り口",
+		PreferredWords: []string{"毎日", " 毎日 ", "", "Natural", "natural"},
+		BannedWords:    []string{"激安"},
+		Samples:        []string{"毎日をちょっと心地よく。"},
+		Ending:         entity.EndingPolite,
+	}
+}
+
+func TestCreate(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          func() Input
+		repoErr        error
+		wantViolations []string
+		wantErr        error
+	}{
+		{
+			name:  "正常系",
+			input: validInput,
+		},
+		{
+			name: "異常系_入力が不正",
+			input: func() Input {
+				in := validInput()
+				in.Name = " "
+				in.Ending = "gozaimasu"
+				in.BannedWords = append(in.BannedWords, "NATURAL")
+				in.Samples = []string{"1", "2", "3", "4", "5", "6"}
+				return in
+			},
+			wantViolations: []string{
+				"nameがありません",
+				"endingはdesu_masu・da_dearuのいずれかにしてください（gozaimasu）",
+				"samplesは5件以内にしてください",
+				"積極的に使う言葉と使用しない言葉の両方に含まれています（Natural）",
+			},
+		},
+		{
+			name:    "異常系_保存に失敗",
+			input:   validInput,
+			repoErr: repository.ErrDuplicated,
+			wantErr: repository.ErrDuplicated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			repo := new(mockRepository)
+			repo.On("Create", mock.Anything, mock.Anything).Return(tt.repoErr)
+
+			// テスト実行
+			u := NewUseCase(repo)
+			got, err := u.Create(context.Background(), tt.input())
+
+			// アサーション
+			if tt.wantViolations != nil {
+				var invalid *InvalidProfileError
+				assert.ErrorAs(t, err, &invalid)
+				assert.Equal(t, tt.wantViolations, invalid.Violations)
+				assert.ErrorIs(t, err, ErrInvalidProfile)
+				repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "テストブランド", got.Name)
+			assert.Equal(t, []string{"毎日", "Natural"}, got.PreferredWords)
+			assert.Equal(t, []string{"激安"}, got.BannedWords)
+			assert.Equal(t, entity.EndingPolite, got.Ending)
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		getErr  error
+		wantErr error
+	}{
+		{
+			name: "正常系",
+		},
+		{
+			name:    "異常系_存在しないプロファイル",
+			getErr:  repository.ErrBrandProfileNotFound,
+			wantErr: repository.ErrBrandProfileNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			repo := new(mockRepository)
+			if tt.getErr != nil {
+				repo.On("Get", mock.Anything, 1).Return(nil, tt.getErr)
+			} else {
+				repo.On("Get", mock.Anything, 1).Return(&entity.BrandProfile{ID: 1, Name: "旧ブランド"}, nil)
+			}
+			repo.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+			// テスト実行
+			u := NewUseCase(repo)
+			got, err := u.Update(context.Background(), 1, validInput())
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 1, got.ID)
+			assert.Equal(t, "テストブランド", got.Name)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	tests := []struct {
+		name    string
+		getErr  error
+		wantErr error
+	}{
+		{
+			name: "正常系",
+		},
+		{
+			name:    "異常系_存在しないプロファイル",
+			getErr:  repository.ErrBrandProfileNotFound,
+			wantErr: repository.ErrBrandProfileNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			repo := new(mockRepository)
+			if tt.getErr != nil {
+				repo.On("Get", mock.Anything, 1).Return(nil, tt.getErr)
+			} else {
+				repo.On("Get", mock.Anything, 1).Return(&entity.BrandProfile{ID: 1}, nil)
+			}
+			repo.On("Delete", mock.Anything, 1).Return(nil)
+
+			// テスト実行
+			u := NewUseCase(repo)
+			err := u.Delete(context.Background(), 1)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				repo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package campaign_usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/domainerr"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	copy_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/copy"
+)
+
+// ErrInvalidCampaign: InvalidCampaignErrorが包むエラー（違反内容を含めてUnwrapで取得できる）
+var ErrInvalidCampaign = domainerr.Validation("invalid_campaign_input", "campaign is invalid")
+
+type UseCase interface {
+	List(ctx context.Context) ([]*entity.Campaign, error)
+	Get(ctx context.Context, id int) (*entity.Campaign, error)
+	Create(ctx context.Context, input Input) (*entity.Campaign, error)
+	Update(ctx context.Context, id int, input Input) (*entity.Campaign, error)
+	// Delete: キャンペーンを削除（キャンペーンのコピーはそのまま残る）
+	Delete(ctx context.Context, id int) error
+	// GenerateSet: 共通の内容から配信チャネルごとに1件ずつキャンペーンのコピーを生成
+	GenerateSet(ctx context.Context, id int, brief copy_usecase.CreateCopyInput, channels []entity.Channel) (*copy_usecase.CampaignSet, error)
+	// Copies: キャンペーンのすべてのコピー（未公開を含む、新しい順）といいね数の集計
+	Copies(ctx context.Context, id int) (*CampaignCopies, error)
+}
+
+type useCase struct {
+	campaigns repository.CampaignRepository
+	copies    repository.CopyRepository
+	generator copy_usecase.UseCase
+}
+
+// Input: キャンペーンの登録・更新の内容
+type Input struct {
+	Name     string
+	Goal     string
+	Target   string
+	StartsAt time.Time
+	EndsAt   time.Time
+}
+
+// CampaignCopies: キャンペーン単位のコピーの一覧
+type CampaignCopies struct {
+	Campaign *entity.Campaign `json:"campaign"`
+	Copies   []*entity.Copy   `json:"copies"`
+	Likes    LikeSummary      `json:"likes"`
+}
+
+// LikeSummary: キャンペーンのコピーのいいね数の合計と配信チャネルごとの内訳
+type LikeSummary struct {
+	Total     int                    `json:"total"`
+	ByChannel map[entity.Channel]int `json:"byChannel"`
+}
+
+// InvalidCampaignError: キャンペーンの内容が不正な場合のエラー
+type InvalidCampaignError struct {
+	Violations []string
+}
+
+func (e *InvalidCampaignError) Error() string {
+	return "invalid campaign: " + strings.Join(e.Violations, "; ")
+}
+
+func (e *InvalidCampaignError) Unwrap() error {
+	return ErrInvalidCampaign.WithDetail("violations", e.Violations)
+}
+
+// NewUseCase: キャンペーンのユースケースを作成（コピーの生成はgeneratorに委譲する）
+func NewUseCase(campaigns repository.CampaignRepository, copies repository.CopyRepository, generator copy_usecase.UseCase) UseCase {
+	return &useCase{campaigns: campaigns, copies: copies, generator: generator}
+}
+
+func (u *useCase) List(ctx context.Context) ([]*entity.Campaign, error) {
+	return u.campaigns.List(ctx)
+}
+
+func (u *useCase) Get(ctx context.Context, id int) (*entity.Campaign, error) {
+	return u.campaigns.Get(ctx, id)
+}
+
+func (u *useCase) Create(ctx context.Context, input Input) (*entity.Campaign, error) {
+	campaign := &entity.Campaign{}
+	if err := apply(campaign, input); err != nil {
+		return nil, err
+	}
+	if err := u.campaigns.Create(ctx, campaign); err != nil {
+		return nil, err
+	}
+	return campaign, nil
+}
+
+func (u *useCase) Update(ctx context.Context, id int, input Input) (*entity.Campaign, error) {
+	campaign, err := u.campaigns.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := apply(campaign, input); err != nil {
+		return nil, err
+	}
+	if err := u.campaigns.Update(ctx, campaign); err != nil {
+		return nil, err
+	}
+	return campaign, nil
+}
+
+func (u *useCase) Delete(ctx context.Context, id int) error {
+	if _, err := u.campaigns.Get(ctx, id); err != nil {
+		return err
+	}
+	return u.campaigns.Delete(ctx, id)
+}
+
+// GenerateSet: キャンペーンが存在しない場合はrepository.ErrCampaignNotFoundを返す
+func (u *useCase) GenerateSet(ctx context.Context, id int, brief copy_usecase.CreateCopyInput, channels []entity.Channel) (*copy_usecase.CampaignSet, error) {
+	if _, err := u.campaigns.Get(ctx, id); err != nil {
+		return nil, err
+	}
+	brief.CampaignID = &id
+	return u.generator.CreateCampaignSet(ctx, brief, channels)
+}
+
+func (u *useCase) Copies(ctx context.Context, id int) (*CampaignCopies, error) {
+	campaign, err := u.campaigns.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	copies, err := u.copies.ListByCampaign(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	likes := LikeSummary{ByChannel: map[entity.Channel]int{}}
+	for _, copy := range copies {
+		likes.Total += copy.Likes
+		likes.ByChannel[copy.Channel] += copy.Likes
+	}
+	return &CampaignCopies{Campaign: campaign, Copies: copies, Likes: likes}, nil
+}
+
+// apply: 入力を検証し、前後の空白を取り除いた内容をキャンペーンに反映
+func apply(campaign *entity.Campaign, input Input) error {
+	name := strings.TrimSpace(input.Name)
+
+	var violations []string
+	if name == "" {
+		violations = append(violations, "nameがありません")
+	}
+	if input.StartsAt.IsZero() || input.EndsAt.IsZero() {
+		violations = append(violations, "startsAtとendsAtを指定してください")
+	} else if input.EndsAt.Before(input.StartsAt) {
+		violations = append(violations, "endsAtはstartsAt以降にしてください")
+	}
+	if len(violations) > 0 {
+		return &InvalidCampaignError{Violations: violations}
+	}
+
+	campaign.Name = name
+	campaign.Goal = strings.TrimSpace(input.Goal)
+	campaign.Target = strings.TrimSpace(input.Target)
+	campaign.StartsAt = input.StartsAt
+	campaign.EndsAt = input.EndsAt
+	return nil
+}
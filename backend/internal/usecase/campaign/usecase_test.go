@@ -0,0 +1,252 @@
+package campaign_usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	copy_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/copy"
+)
+
+// リポジトリのモック
+type mockCampaignRepository struct {
+	mock.Mock
+}
+
+func (m *mockCampaignRepository) List(ctx context.Context) ([]*entity.Campaign, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Campaign), args.Error(1)
+}
+
+func (m *mockCampaignRepository) Get(ctx context.Context, id int) (*entity.Campaign, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Campaign), args.Error(1)
+}
+
+func (m *mockCampaignRepository) Create(ctx context.Context, campaign *entity.Campaign) error {
+	args := m.Called(ctx, campaign)
+	return args.Error(0)
+}
+
+func (m *mockCampaignRepository) Update(ctx context.Context, campaign *entity.Campaign) error {
+	args := m.Called(ctx, campaign)
+	return args.Error(0)
+}
+
+func (m *mockCampaignRepository) Delete(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// コピーのリポジトリのモック（ListByCampaignのみ）
+type mockCopyRepository struct {
+	repository.CopyRepository
+	mock.Mock
+}
+
+func (m *mockCopyRepository) ListByCampaign(ctx context.Context, campaignID int) ([]*entity.Copy, error) {
+	args := m.Called(ctx, campaignID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Copy), args.Error(1)
+}
+
+// コピーのユースケースのモック（CreateCampaignSetのみ）
+type mockCopyUseCase struct {
+	copy_usecase.UseCase
+	mock.Mock
+}
+
+func (m *mockCopyUseCase) CreateCampaignSet(ctx context.Context, input copy_usecase.CreateCopyInput, channels []entity.Channel) (*copy_usecase.CampaignSet, error) {
+	args := m.Called(ctx, input, channels)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*copy_usecase.CampaignSet), args.Error(1)
+}
+
+func validInput() Input {
+	return Input{
+		Name:     " 夏のセール ",
+		Goal:     "新規会員の獲得",
+		Target:   "20代の会社員",
+		StartsAt: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+		EndsAt:   time.Date(2026, 7, 31, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestCreate(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          func() Input
+		wantViolations []string
+	}{
+		{
+			name:  "正常系",
+			input: validInput,
+		},
+		{
+			name: "異常系_入力が不正",
+			input: func() Input {
+				in := validInput()
+				in.Name = " "
+				in.EndsAt = in.StartsAt.Add(-time.Hour)
+				return in
+			},
+			wantViolations: []string{
+				"nameがありません",
+				"endsAtはstartsAt以降にしてください",
+			},
+		},
+		{
+			name: "異常系_期間の指定なし",
+			input: func() Input {
+				in := validInput()
+				in.EndsAt = time.Time{}
+				return in
+			},
+			wantViolations: []string{"startsAtとendsAtを指定してください"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			campaigns := new(mockCampaignRepository)
+			campaigns.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+			// テスト実行
+			u := NewUseCase(campaigns, new(mockCopyRepository), new(mockCopyUseCase))
+			got, err := u.Create(context.Background(), tt.input())
+
+			// アサーション
+			if tt.wantViolations != nil {
+				var invalid *InvalidCampaignError
+				assert.ErrorAs(t, err, &invalid)
+				assert.Equal(t, tt.wantViolations, invalid.Violations)
+				assert.ErrorIs(t, err, ErrInvalidCampaign)
+				campaigns.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "夏のセール", got.Name)
+			assert.Equal(t, "20代の会社員", got.Target)
+		})
+	}
+}
+
+func TestGenerateSet(t *testing.T) {
+	channels := []entity.Channel{entity.ChannelSNS, entity.ChannelEmail}
+
+	tests := []struct {
+		name    string
+		getErr  error
+		wantErr error
+	}{
+		{
+			name: "正常系",
+		},
+		{
+			name:    "異常系_存在しないキャンペーン",
+			getErr:  repository.ErrCampaignNotFound,
+			wantErr: repository.ErrCampaignNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			campaigns := new(mockCampaignRepository)
+			if tt.getErr != nil {
+				campaigns.On("Get", mock.Anything, 3).Return(nil, tt.getErr)
+			} else {
+				campaigns.On("Get", mock.Anything, 3).Return(&entity.Campaign{ID: 3}, nil)
+			}
+			generator := new(mockCopyUseCase)
+			generator.On("CreateCampaignSet", mock.Anything, mock.MatchedBy(func(input copy_usecase.CreateCopyInput) bool {
+				return input.CampaignID != nil && *input.CampaignID == 3 && input.ProductName == "テスト商品"
+			}), channels).Return(&copy_usecase.CampaignSet{CampaignID: 3, Copies: []*entity.Copy{{ID: 1}, {ID: 2}}}, nil)
+
+			// テスト実行
+			u := NewUseCase(campaigns, new(mockCopyRepository), generator)
+			got, err := u.GenerateSet(context.Background(), 3, copy_usecase.CreateCopyInput{ProductName: "テスト商品"}, channels)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				generator.AssertNotCalled(t, "CreateCampaignSet", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, got.Copies, 2)
+			generator.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCopies(t *testing.T) {
+	tests := []struct {
+		name      string
+		getErr    error
+		wantLikes LikeSummary
+		wantErr   error
+	}{
+		{
+			name: "正常系_いいね数を集計",
+			wantLikes: LikeSummary{
+				Total:     12,
+				ByChannel: map[entity.Channel]int{entity.ChannelSNS: 10, entity.ChannelEmail: 2},
+			},
+		},
+		{
+			name:    "異常系_存在しないキャンペーン",
+			getErr:  repository.ErrCampaignNotFound,
+			wantErr: repository.ErrCampaignNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			campaigns := new(mockCampaignRepository)
+			if tt.getErr != nil {
+				campaigns.On("Get", mock.Anything, 3).Return(nil, tt.getErr)
+			} else {
+				campaigns.On("Get", mock.Anything, 3).Return(&entity.Campaign{ID: 3, Name: "夏のセール"}, nil)
+			}
+			copies := new(mockCopyRepository)
+			copies.On("ListByCampaign", mock.Anything, 3).Return([]*entity.Copy{
+				{ID: 3, Channel: entity.ChannelSNS, Likes: 4},
+				{ID: 2, Channel: entity.ChannelEmail, Likes: 2},
+				{ID: 1, Channel: entity.ChannelSNS, Likes: 6},
+			}, nil)
+
+			// テスト実行
+			u := NewUseCase(campaigns, copies, new(mockCopyUseCase))
+			got, err := u.Copies(context.Background(), 3)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				copies.AssertNotCalled(t, "ListByCampaign", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "夏のセール", got.Campaign.Name)
+			assert.Len(t, got.Copies, 3)
+			assert.Equal(t, tt.wantLikes, got.Likes)
+		})
+	}
+}
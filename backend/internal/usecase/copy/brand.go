@@ -0,0 +1,74 @@
+package copy_usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/compliance"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/domainerr"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/prompt"
+)
+
+var ErrInvalidBrandProfile = domainerr.Validation("invalid_brand_profile", "brand profile must be one of the registered brand profiles")
+
+// WithBrandProfiles: ブランドプロファイルの取得元を設定
+//
+// 設定しない場合はブランドプロファイルを指定した生成をErrInvalidBrandProfileとします。
+func WithBrandProfiles(brands repository.BrandProfileRepository) Option {
+	return func(u *useCase) {
+		u.brands = brands
+	}
+}
+
+// brandProfile: 生成に使用するブランドプロファイルを取得（idがnilの場合はnil）
+func (u *useCase) brandProfile(ctx context.Context, id *int) (*entity.BrandProfile, error) {
+	if id == nil {
+		return nil, nil
+	}
+	if u.brands == nil {
+		return nil, ErrInvalidBrandProfile.WithDetail("brandProfileId", *id)
+	}
+
+	profile, err := u.brands.Get(ctx, *id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, ErrInvalidBrandProfile.WithDetail("brandProfileId", *id)
+	}
+	return profile, err
+}
+
+// brandOf: 保存済みのコピーの表現のチェックに使用するブランドプロファイル
+//
+// プロファイルが削除された場合はnilとし、使用しない言葉はチェックしません。
+func (u *useCase) brandOf(ctx context.Context, copy *entity.Copy) (*entity.BrandProfile, error) {
+	if copy.BrandProfileID == nil || u.brands == nil {
+		return nil, nil
+	}
+	profile, err := u.brands.Get(ctx, *copy.BrandProfileID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, nil
+	}
+	return profile, err
+}
+
+// inspect: コピーの表現規制とブランドプロファイルの使用しない言葉をチェックする
+func (u *useCase) inspect(copy *entity.Copy, brand *entity.BrandProfile) []entity.ComplianceFinding {
+	texts := compliance.CopyTexts(copy)
+	return append(u.checker.Check(texts...), compliance.BrandChecker(brand).Check(texts...)...)
+}
+
+// promptBrand: プロンプトに記載するブランドのボイス（プロファイルがnilの場合はnil）
+func promptBrand(profile *entity.BrandProfile) *prompt.Brand {
+	if profile == nil {
+		return nil
+	}
+	return &prompt.Brand{
+		Name:           profile.Name,
+		Voice:          profile.Voice,
+		Ending:         profile.Ending.Label(),
+		PreferredWords: profile.PreferredWords,
+		BannedWords:    profile.BannedWords,
+		Samples:        profile.Samples,
+	}
+}
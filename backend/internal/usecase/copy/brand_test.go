@@ -0,0 +1,130 @@
+package copy_usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/compliance"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/llm"
+)
+
+// ブランドプロファイルのリポジトリのモック（生成ではGetのみを使用する）
+type mockBrandProfileRepository struct {
+	repository.BrandProfileRepository
+	mock.Mock
+}
+
+func (m *mockBrandProfileRepository) Get(ctx context.Context, id int) (*entity.BrandProfile, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.BrandProfile), args.Error(1)
+}
+
+func testBrandProfile() *entity.BrandProfile {
+	return &entity.BrandProfile{
+		ID:             1,
+		Name:           "テストブランド",
+		Voice:          "誠実で落ち着いた語り口",
+		PreferredWords: []string{"毎日"},
+		BannedWords:    []string{"キャンペーン"},
+		Samples:        []string{"毎日をちょっと心地よく。"},
+		Ending:         entity.EndingPolite,
+	}
+}
+
+func TestCreateCopy_BrandProfile(t *testing.T) {
+	profileID := 1
+	unknownID := 999
+
+	tests := []struct {
+		name         string
+		id           *int
+		opts         func(*mockBrandProfileRepository) []Option
+		wantErr      error
+		wantFindings int
+	}{
+		{
+			name: "正常系_ボイスをプロンプトに記載し使用しない言葉を検出",
+			id:   &profileID,
+			opts: func(brands *mockBrandProfileRepository) []Option {
+				brands.On("Get", mock.Anything, 1).Return(testBrandProfile(), nil)
+				return []Option{WithBrandProfiles(brands)}
+			},
+			wantFindings: 1,
+		},
+		{
+			name: "異常系_存在しないプロファイル",
+			id:   &unknownID,
+			opts: func(brands *mockBrandProfileRepository) []Option {
+				brands.On("Get", mock.Anything, 999).Return(nil, repository.ErrBrandProfileNotFound)
+				return []Option{WithBrandProfiles(brands)}
+			},
+			wantErr: ErrInvalidBrandProfile,
+		},
+		{
+			name:    "異常系_取得元を設定していない",
+			id:      &profileID,
+			opts:    func(*mockBrandProfileRepository) []Option { return nil },
+			wantErr: ErrInvalidBrandProfile,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			brands := new(mockBrandProfileRepository)
+			mockRepo := new(mockCopyRepository)
+			mockRepo.On("CreateWithRevision", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			mockGen := new(mockGenerator)
+			mockGen.On("Generate", mock.Anything, mock.MatchedBy(func(req llm.Request) bool {
+				var prompt strings.Builder
+				for _, m := range req.Messages {
+					prompt.WriteString(m.Content)
+				}
+				return strings.Contains(prompt.String(), "ブランド『テストブランド』のボイスに合わせてください。") &&
+					strings.Contains(prompt.String(), "です・ます調") &&
+					strings.Contains(prompt.String(), "キャンペーン")
+			})).Return(&llm.Response{
+				Title:       "テストタイトル",
+				Description: testDescription,
+				Content:     testSNSContent,
+			}, nil)
+
+			// テスト実行
+			u := NewUseCase(mockRepo, mockGen, tt.opts(brands)...)
+			got, err := u.CreateCopy(context.Background(), CreateCopyInput{
+				ProductName:     "テスト商品",
+				ProductFeatures: "高品質",
+				Target:          "20代",
+				Channel:         entity.ChannelSNS,
+				Tone:            entity.ToneTrust,
+				BrandProfileID:  tt.id,
+			})
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				mockGen.AssertNotCalled(t, "Generate", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.id, got.BrandProfileID)
+			var findings []entity.ComplianceFinding
+			for _, finding := range got.Findings {
+				if finding.Category == compliance.CategoryBrand {
+					findings = append(findings, finding)
+				}
+			}
+			assert.Len(t, findings, tt.wantFindings)
+			mockGen.AssertExpectations(t)
+		})
+	}
+}
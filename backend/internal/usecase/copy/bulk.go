@@ -0,0 +1,60 @@
+package copy_usecase
+
+import (
+	"context"
+	"sync"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+)
+
+// DefaultBulkConcurrency: 一括生成で同時に生成するコピー数の既定値
+const DefaultBulkConcurrency = 4
+
+// BulkResult: 一括生成の1件分の結果（CopyかErrのどちらか一方）
+type BulkResult struct {
+	Copy *entity.Copy
+	Err  error
+}
+
+// WithBulkConcurrency: 一括生成で同時に生成するコピー数を設定
+func WithBulkConcurrency(n int) Option {
+	return func(u *useCase) {
+		if n > 0 {
+			u.bulkConcurrency = n
+		}
+	}
+}
+
+// CreateBulk: 複数の入力からそれぞれ1件ずつコピーを生成して保存
+//
+// 同時に生成する数はbulkConcurrencyまでに制限し、結果は入力と同じ順で返します。
+// 一部の入力で生成に失敗しても、残りの入力の生成は続けます。
+func (u *useCase) CreateBulk(ctx context.Context, inputs []CreateCopyInput) []BulkResult {
+	results := make([]BulkResult, len(inputs))
+	sem := make(chan struct{}, u.bulkConcurrency)
+	var wg sync.WaitGroup
+	for i, input := range inputs {
+		if err := ctx.Err(); err != nil {
+			results[i].Err = err
+			continue
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, input CreateCopyInput) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			copy, err := u.CreateCopy(ctx, input)
+			results[i] = BulkResult{Copy: copy, Err: err}
+		}(i, input)
+	}
+	wg.Wait()
+	return results
+}
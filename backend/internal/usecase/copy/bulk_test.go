@@ -0,0 +1,99 @@
+package copy_usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/llm"
+)
+
+// concurrencyGenerator: 同時に呼び出された数の最大値を記録するGenerator
+type concurrencyGenerator struct {
+	mu      sync.Mutex
+	running int
+	max     int
+}
+
+func (g *concurrencyGenerator) Generate(ctx context.Context, req llm.Request) (*llm.Response, error) {
+	g.mu.Lock()
+	g.running++
+	if g.running > g.max {
+		g.max = g.running
+	}
+	g.mu.Unlock()
+
+	time.Sleep(5 * time.Millisecond)
+
+	g.mu.Lock()
+	g.running--
+	g.mu.Unlock()
+
+	if req.Brief.ProductName == "失敗する商品" {
+		return nil, errors.New("generator error")
+	}
+	return &llm.Response{Title: "テストタイトル", Description: testDescription, Content: testSNSContent}, nil
+}
+
+func TestCreateBulk(t *testing.T) {
+	inputs := make([]CreateCopyInput, 10)
+	for i := range inputs {
+		inputs[i] = CreateCopyInput{
+			ProductName:     "テスト商品",
+			ProductFeatures: "高品質",
+			Target:          "20代",
+			Channel:         entity.ChannelSNS,
+			Tone:            entity.ToneCasual,
+		}
+	}
+	inputs[3].ProductName = "失敗する商品"
+
+	// モックの準備（保存したコピーには順にIDを振る）
+	mockRepo := new(mockCopyRepository)
+	var mu sync.Mutex
+	nextID := 0
+	mockRepo.On("CreateWithRevision", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		mu.Lock()
+		defer mu.Unlock()
+		nextID++
+		args.Get(1).(*entity.Copy).ID = nextID
+	}).Return(nil)
+	gen := &concurrencyGenerator{}
+
+	// テスト実行
+	u := NewUseCase(mockRepo, gen, WithBulkConcurrency(3))
+	results := u.CreateBulk(context.Background(), inputs)
+
+	// アサーション
+	assert.Len(t, results, len(inputs))
+	for i, result := range results {
+		if i == 3 {
+			assert.EqualError(t, result.Err, "generator error")
+			assert.Nil(t, result.Copy)
+			continue
+		}
+		assert.NoError(t, result.Err)
+		assert.NotZero(t, result.Copy.ID)
+	}
+	assert.LessOrEqual(t, gen.max, 3)
+	mockRepo.AssertNumberOfCalls(t, "CreateWithRevision", 9)
+}
+
+func TestCreateBulk_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	u := NewUseCase(new(mockCopyRepository), &concurrencyGenerator{}, WithBulkConcurrency(1))
+	results := u.CreateBulk(ctx, make([]CreateCopyInput, 3))
+
+	// キャンセル後は未着手の入力を生成しない
+	for _, result := range results {
+		assert.Error(t, result.Err)
+	}
+}
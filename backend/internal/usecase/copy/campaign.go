@@ -0,0 +1,158 @@
+package copy_usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/domainerr"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/prompt"
+)
+
+// MaxCampaignChannels: キャンペーンのセットで一度に生成できる配信チャネルの数
+const MaxCampaignChannels = 10
+
+var (
+	ErrInvalidCampaign         = domainerr.Validation("invalid_campaign", "campaign must be one of the registered campaigns")
+	ErrInvalidCampaignChannels = domainerr.Validation("invalid_campaign_channels", fmt.Sprintf("channels must be 1 to %d distinct channels", MaxCampaignChannels))
+	ErrMissingTarget           = domainerr.Validation("missing_target", "target is required when the campaign has no target audience")
+)
+
+// CampaignSet: キャンペーンの配信チャネルごとに生成したコピー（指定したチャネルの順）
+type CampaignSet struct {
+	CampaignID int            `json:"campaignId"`
+	Copies     []*entity.Copy `json:"copies"`
+}
+
+// WithCampaigns: キャンペーンの取得元を設定
+//
+// 設定しない場合はキャンペーンを指定した生成をErrInvalidCampaignとします。
+func WithCampaigns(campaigns repository.CampaignRepository) Option {
+	return func(u *useCase) {
+		u.campaigns = campaigns
+	}
+}
+
+// fillCampaign: キャンペーンを取得し、ターゲットを省略した場合はキャンペーンの対象者で補う
+func (u *useCase) fillCampaign(ctx context.Context, input *CreateCopyInput) (*entity.Campaign, error) {
+	if input.CampaignID == nil {
+		return nil, nil
+	}
+	if u.campaigns == nil {
+		return nil, ErrInvalidCampaign.WithDetail("campaignId", *input.CampaignID)
+	}
+
+	campaign, err := u.campaigns.Get(ctx, *input.CampaignID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, ErrInvalidCampaign.WithDetail("campaignId", *input.CampaignID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Target == "" {
+		input.Target = campaign.Target
+	}
+	if input.Target == "" {
+		return nil, ErrMissingTarget
+	}
+	return campaign, nil
+}
+
+// CreateCampaignSet: 共通の内容から配信チャネルごとに1件ずつコピーを生成し、まとめて保存
+//
+// すべてのチャネルの入力を検証してから並行して生成し、1件でも失敗した場合はいずれも保存しません。
+// 各チャネルのプロンプトには同時に生成する他のチャネルを記載し、訴求を揃えるよう指示します。
+func (u *useCase) CreateCampaignSet(ctx context.Context, input CreateCopyInput, channels []entity.Channel) (*CampaignSet, error) {
+	if input.CampaignID == nil {
+		return nil, ErrInvalidCampaign
+	}
+	if err := validateCampaignChannels(channels); err != nil {
+		return nil, err
+	}
+
+	n := len(channels)
+	inputs := make([]CreateCopyInput, n)
+	defs := make([]*definitions, n)
+	for i, channel := range channels {
+		in := input
+		in.Channel = channel
+		in.Variants = 0
+		d, err := u.resolve(ctx, &in)
+		if err != nil {
+			return nil, err
+		}
+		d.otherChannels = otherChannels(channels, channel)
+		inputs[i], defs[i] = in, d
+	}
+
+	copies := make([]*entity.Copy, n)
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			copies[i], errs[i] = u.generate(ctx, inputs[i], defs[i], 0)
+		}(i)
+	}
+	wg.Wait()
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	if err := u.createCopies(ctx, copies); err != nil {
+		return nil, err
+	}
+	if err := u.indexCopies(ctx, copies...); err != nil {
+		return nil, err
+	}
+
+	return &CampaignSet{CampaignID: *input.CampaignID, Copies: copies}, nil
+}
+
+func validateCampaignChannels(channels []entity.Channel) error {
+	if len(channels) == 0 || len(channels) > MaxCampaignChannels {
+		return ErrInvalidCampaignChannels
+	}
+	seen := map[entity.Channel]bool{}
+	for _, channel := range channels {
+		if seen[channel] {
+			return ErrInvalidCampaignChannels.WithDetail("channel", string(channel))
+		}
+		seen[channel] = true
+	}
+	return nil
+}
+
+// otherChannels: channelsからchannelを除いた配信チャネル
+func otherChannels(channels []entity.Channel, channel entity.Channel) []entity.Channel {
+	others := make([]entity.Channel, 0, len(channels)-1)
+	for _, c := range channels {
+		if c != channel {
+			others = append(others, c)
+		}
+	}
+	return others
+}
+
+// promptCampaign: プロンプトに記載するキャンペーンの内容（キャンペーンがnilの場合はnil）
+func promptCampaign(campaign *entity.Campaign, others []entity.Channel) *prompt.Campaign {
+	if campaign == nil {
+		return nil
+	}
+	p := &prompt.Campaign{
+		Name: campaign.Name,
+		Goal: campaign.Goal,
+	}
+	if !campaign.StartsAt.IsZero() && !campaign.EndsAt.IsZero() {
+		p.Period = campaign.StartsAt.Format("2006-01-02") + "〜" + campaign.EndsAt.Format("2006-01-02")
+	}
+	for _, c := range others {
+		p.OtherChannels = append(p.OtherChannels, string(c))
+	}
+	return p
+}
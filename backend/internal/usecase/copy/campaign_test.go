@@ -0,0 +1,227 @@
+package copy_usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/llm"
+)
+
+// キャンペーンのリポジトリのモック（生成ではGetのみを使用する）
+type mockCampaignRepository struct {
+	repository.CampaignRepository
+	mock.Mock
+}
+
+func (m *mockCampaignRepository) Get(ctx context.Context, id int) (*entity.Campaign, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Campaign), args.Error(1)
+}
+
+// promptText: リクエストのすべてのメッセージを連結したプロンプト
+func promptText(req llm.Request) string {
+	var prompt strings.Builder
+	for _, m := range req.Messages {
+		prompt.WriteString(m.Content)
+	}
+	return prompt.String()
+}
+
+func testCampaign() *entity.Campaign {
+	return &entity.Campaign{
+		ID:       3,
+		Name:     "夏のセール",
+		Goal:     "新規会員の獲得",
+		Target:   "20代の会社員",
+		StartsAt: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+		EndsAt:   time.Date(2026, 7, 31, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestCreateCopy_Campaign(t *testing.T) {
+	campaignID := 3
+	unknownID := 999
+
+	tests := []struct {
+		name       string
+		input      CreateCopyInput
+		opts       func(*mockCampaignRepository) []Option
+		wantTarget string
+		wantErr    error
+	}{
+		{
+			name:  "正常系_ターゲットをキャンペーンの対象者で補う",
+			input: CreateCopyInput{CampaignID: &campaignID},
+			opts: func(campaigns *mockCampaignRepository) []Option {
+				campaigns.On("Get", mock.Anything, 3).Return(testCampaign(), nil)
+				return []Option{WithCampaigns(campaigns)}
+			},
+			wantTarget: "20代の会社員",
+		},
+		{
+			name:  "正常系_入力したターゲットを優先",
+			input: CreateCopyInput{CampaignID: &campaignID, Target: "30代の主婦"},
+			opts: func(campaigns *mockCampaignRepository) []Option {
+				campaigns.On("Get", mock.Anything, 3).Return(testCampaign(), nil)
+				return []Option{WithCampaigns(campaigns)}
+			},
+			wantTarget: "30代の主婦",
+		},
+		{
+			name:  "異常系_ターゲットがない",
+			input: CreateCopyInput{CampaignID: &campaignID},
+			opts: func(campaigns *mockCampaignRepository) []Option {
+				campaigns.On("Get", mock.Anything, 3).Return(&entity.Campaign{ID: 3, Name: "夏のセール"}, nil)
+				return []Option{WithCampaigns(campaigns)}
+			},
+			wantErr: ErrMissingTarget,
+		},
+		{
+			name:  "異常系_存在しないキャンペーン",
+			input: CreateCopyInput{CampaignID: &unknownID},
+			opts: func(campaigns *mockCampaignRepository) []Option {
+				campaigns.On("Get", mock.Anything, 999).Return(nil, repository.ErrCampaignNotFound)
+				return []Option{WithCampaigns(campaigns)}
+			},
+			wantErr: ErrInvalidCampaign,
+		},
+		{
+			name:    "異常系_取得元を設定していない",
+			input:   CreateCopyInput{CampaignID: &campaignID},
+			opts:    func(*mockCampaignRepository) []Option { return nil },
+			wantErr: ErrInvalidCampaign,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			campaigns := new(mockCampaignRepository)
+			mockRepo := new(mockCopyRepository)
+			mockRepo.On("CreateWithRevision", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			mockGen := new(mockGenerator)
+			mockGen.On("Generate", mock.Anything, mock.MatchedBy(func(req llm.Request) bool {
+				return req.Brief.Target == tt.wantTarget &&
+					strings.Contains(promptText(req), "キャンペーン『夏のセール』") &&
+					strings.Contains(promptText(req), "2026-07-01〜2026-07-31")
+			})).Return(&llm.Response{
+				Title:       "テストタイトル",
+				Description: testDescription,
+				Content:     testSNSContent,
+			}, nil)
+
+			// テスト実行
+			input := tt.input
+			input.ProductName = "テスト商品"
+			input.ProductFeatures = "高品質、使いやすい"
+			input.Channel = entity.ChannelSNS
+			input.Tone = entity.ToneTrust
+			u := NewUseCase(mockRepo, mockGen, tt.opts(campaigns)...)
+			got, err := u.CreateCopy(context.Background(), input)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				mockGen.AssertNotCalled(t, "Generate", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, &campaignID, got.CampaignID)
+			assert.Equal(t, tt.wantTarget, got.Target)
+			mockGen.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateCampaignSet(t *testing.T) {
+	campaignID := 3
+	input := CreateCopyInput{
+		ProductName:     "テスト商品",
+		ProductFeatures: "高品質、使いやすい",
+		Tone:            entity.ToneTrust,
+		CampaignID:      &campaignID,
+	}
+
+	tests := []struct {
+		name     string
+		input    CreateCopyInput
+		channels []entity.Channel
+		wantErr  error
+	}{
+		{
+			name:     "正常系_チャネルごとに1件ずつ生成",
+			input:    input,
+			channels: []entity.Channel{entity.ChannelSNS, entity.ChannelApp},
+		},
+		{
+			name:     "異常系_チャネルの指定なし",
+			input:    input,
+			channels: nil,
+			wantErr:  ErrInvalidCampaignChannels,
+		},
+		{
+			name:     "異常系_チャネルの重複",
+			input:    input,
+			channels: []entity.Channel{entity.ChannelSNS, entity.ChannelSNS},
+			wantErr:  ErrInvalidCampaignChannels,
+		},
+		{
+			name:     "異常系_キャンペーンの指定なし",
+			input:    CreateCopyInput{ProductName: "テスト商品", Target: "20代"},
+			channels: []entity.Channel{entity.ChannelSNS},
+			wantErr:  ErrInvalidCampaign,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			campaigns := new(mockCampaignRepository)
+			campaigns.On("Get", mock.Anything, 3).Return(testCampaign(), nil)
+			mockRepo := new(mockCopyRepository)
+			mockRepo.On("CreateBatchWithRevisions", mock.Anything, mock.MatchedBy(func(copies []*entity.Copy) bool {
+				return len(copies) == 2 && copies[0].Channel == entity.ChannelSNS && copies[1].Channel == entity.ChannelApp
+			}), mock.Anything).Return(nil)
+			mockGen := new(mockGenerator)
+			mockGen.On("Generate", mock.Anything, mock.MatchedBy(func(req llm.Request) bool {
+				return req.Brief.Channel == string(entity.ChannelSNS) &&
+					strings.Contains(promptText(req), "同じキャンペーンでappにも配信します")
+			})).Return(&llm.Response{Title: "SNSのタイトル", Description: testDescription, Content: testSNSContent}, nil)
+			mockGen.On("Generate", mock.Anything, mock.MatchedBy(func(req llm.Request) bool {
+				return req.Brief.Channel == string(entity.ChannelApp) &&
+					strings.Contains(promptText(req), "同じキャンペーンでsnsにも配信します")
+			})).Return(&llm.Response{Title: "アプリのタイトル", Description: "毎日の暮らしをもっと快適にする工夫が詰まったテスト商品です。", Content: `{}`}, nil)
+
+			// テスト実行
+			u := NewUseCase(mockRepo, mockGen, WithCampaigns(campaigns))
+			got, err := u.CreateCampaignSet(context.Background(), tt.input, tt.channels)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				mockGen.AssertNotCalled(t, "Generate", mock.Anything, mock.Anything)
+				mockRepo.AssertNotCalled(t, "CreateBatchWithRevisions", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 3, got.CampaignID)
+			assert.Len(t, got.Copies, 2)
+			for _, copy := range got.Copies {
+				assert.Equal(t, &campaignID, copy.CampaignID)
+				assert.Equal(t, "20代の会社員", copy.Target)
+			}
+			mockGen.AssertExpectations(t)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package copy_usecase
+
+import (
+	"context"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/compliance"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+)
+
+// WithComplianceChecker: 表現規制のチェックに使用する辞書を設定
+func WithComplianceChecker(checker *compliance.Checker) Option {
+	return func(u *useCase) {
+		if checker != nil {
+			u.checker = checker
+		}
+	}
+}
+
+// WithPublishBlocking: severity以上の表現が検出された場合に公開を見送る
+//
+// 承認済みのコピーでも公開の操作をPublishBlockedErrorとします。
+// 空の場合は検出結果を記録するのみで公開は見送りません。
+func WithPublishBlocking(severity entity.Severity) Option {
+	return func(u *useCase) {
+		u.blockSeverity = severity
+	}
+}
+
+// review: 生成したコピーの表現とブランドプロファイルの使用しない言葉をチェックし、検出結果を記録する
+func (u *useCase) review(copy *entity.Copy, brand *entity.BrandProfile) {
+	copy.Findings = u.inspect(copy, brand)
+}
+
+// CheckCopy: 保存済みのコピーを現在の辞書で再チェックし、検出結果を更新
+func (u *useCase) CheckCopy(ctx context.Context, id int) (*entity.Copy, error) {
+	copy, err := u.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	brand, err := u.brandOf(ctx, copy)
+	if err != nil {
+		return nil, err
+	}
+	copy.Findings = u.inspect(copy, brand)
+	if err := u.repo.UpdateFindings(ctx, id, copy.Findings); err != nil {
+		return nil, err
+	}
+
+	return copy, nil
+}
+
+// CheckTexts: 任意のテキストの表現をチェック（結果は保存しない）
+func (u *useCase) CheckTexts(ctx context.Context, texts []compliance.Text) []entity.ComplianceFinding {
+	return u.checker.Check(texts...)
+}
@@ -0,0 +1,124 @@
+package copy_usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/auth"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+// WithDefinitions: 配信チャネル・トーンの定義の取得元を設定
+//
+// 設定しない場合は組み込みの定義（entity.BuiltinChannels・entity.BuiltinTones）のみを使用します。
+func WithDefinitions(channels repository.ChannelRepository, tones repository.ToneRepository) Option {
+	return func(u *useCase) {
+		u.channels = channels
+		u.tones = tones
+	}
+}
+
+// definitions: 生成に使用する配信チャネル・トーンの定義
+type definitions struct {
+	channel *entity.ChannelDefinition
+	tone    *entity.ToneDefinition
+	// brand: ブランドプロファイル（指定しない場合はnil）
+	brand *entity.BrandProfile
+	// campaign: キャンペーン（指定しない場合はnil）
+	campaign *entity.Campaign
+	// otherChannels: キャンペーンのセットで同時に生成する他の配信チャネル
+	otherChannels []entity.Channel
+}
+
+// ValidateInput: 配信チャネル・トーン・ブランドプロファイル・商品・キャンペーンが登録済みかどうかを検証
+func (u *useCase) ValidateInput(ctx context.Context, input CreateCopyInput) error {
+	_, err := u.resolve(ctx, &input)
+	return err
+}
+
+// resolve: 入力の配信チャネル・トーンの定義とブランドプロファイル・キャンペーンを取得し、
+// 商品・キャンペーンを指定した場合は商品名・特徴・ターゲットを補う（生成者はコンテキストの呼び出し元で補う）
+//
+// 登録されていない（削除済みを含む）場合はErrInvalidChannel・ErrInvalidTone・ErrInvalidBrandProfile・
+// ErrInvalidProduct・ErrInvalidCampaignを返します。
+func (u *useCase) resolve(ctx context.Context, input *CreateCopyInput) (*definitions, error) {
+	if input.CreatedBy == nil {
+		input.CreatedBy = auth.UserID(ctx)
+	}
+	if err := u.fillProduct(ctx, input); err != nil {
+		return nil, err
+	}
+	campaign, err := u.fillCampaign(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, err := u.channel(ctx, input.Channel)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, ErrInvalidChannel.WithDetail("channel", string(input.Channel))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tone, err := u.tone(ctx, input.Tone)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, ErrInvalidTone.WithDetail("tone", string(input.Tone))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	brand, err := u.brandProfile(ctx, input.BrandProfileID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &definitions{channel: channel, tone: tone, brand: brand, campaign: campaign}, nil
+}
+
+// definitionsOf: 保存済みのコピーの採点・検証に使用する定義とブランドプロファイルを取得
+//
+// 定義が削除された場合は見つからなかった定義をnilとし、既定の形式で扱います。
+func (u *useCase) definitionsOf(ctx context.Context, copy *entity.Copy) (*definitions, error) {
+	channel, err := u.channel(ctx, copy.Channel)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return nil, err
+	}
+	tone, err := u.tone(ctx, copy.Tone)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return nil, err
+	}
+	brand, err := u.brandOf(ctx, copy)
+	if err != nil {
+		return nil, err
+	}
+	return &definitions{channel: channel, tone: tone, brand: brand}, nil
+}
+
+func (u *useCase) channel(ctx context.Context, id entity.Channel) (*entity.ChannelDefinition, error) {
+	if !id.WellFormed() {
+		return nil, repository.ErrChannelNotFound
+	}
+	if u.channels == nil {
+		if d, ok := entity.BuiltinChannel(id); ok {
+			return d, nil
+		}
+		return nil, repository.ErrChannelNotFound
+	}
+	return u.channels.Get(ctx, id)
+}
+
+func (u *useCase) tone(ctx context.Context, id entity.Tone) (*entity.ToneDefinition, error) {
+	if !id.WellFormed() {
+		return nil, repository.ErrToneNotFound
+	}
+	if u.tones == nil {
+		if d, ok := entity.BuiltinTone(id); ok {
+			return d, nil
+		}
+		return nil, repository.ErrToneNotFound
+	}
+	return u.tones.Get(ctx, id)
+}
@@ -0,0 +1,169 @@
+package copy_usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/llm"
+)
+
+// 配信チャネル・トーンのリポジトリのモック（生成ではGetのみを使用する）
+type mockChannelRepository struct {
+	repository.ChannelRepository
+	mock.Mock
+}
+
+func (m *mockChannelRepository) Get(ctx context.Context, id entity.Channel) (*entity.ChannelDefinition, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ChannelDefinition), args.Error(1)
+}
+
+type mockToneRepository struct {
+	repository.ToneRepository
+	mock.Mock
+}
+
+func (m *mockToneRepository) Get(ctx context.Context, id entity.Tone) (*entity.ToneDefinition, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ToneDefinition), args.Error(1)
+}
+
+// urgentTone: 組み込み以外のトーンの定義
+func urgentTone() *entity.ToneDefinition {
+	return &entity.ToneDefinition{
+		ID:       "urgent",
+		Label:    "限定感",
+		Guidance: "数量や期間の限定を強調し、今すぐ行動する理由を伝えてください。",
+		Markers:  []string{"限定", "残りわずか"},
+	}
+}
+
+func TestCreateCopy_CustomDefinition(t *testing.T) {
+	channel := reelsChannel()
+	channel.Guidance = "縦型動画のキャプションとして、冒頭で視聴者の手を止める書き出しにしてください。"
+
+	channels := new(mockChannelRepository)
+	channels.On("Get", mock.Anything, entity.Channel("reels")).Return(channel, nil)
+	tones := new(mockToneRepository)
+	tones.On("Get", mock.Anything, entity.Tone("urgent")).Return(urgentTone(), nil)
+
+	mockRepo := new(mockCopyRepository)
+	mockRepo.On("CreateWithRevision", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockGen := new(mockGenerator)
+	mockGen.On("Generate", mock.Anything, mock.MatchedBy(func(req llm.Request) bool {
+		// 定義の指示と出力形式がプロンプトに含まれ、固有の項目がBriefで渡されること
+		var prompt strings.Builder
+		for _, m := range req.Messages {
+			prompt.WriteString(m.Content)
+		}
+		return strings.Contains(prompt.String(), channel.Guidance) &&
+			strings.Contains(prompt.String(), urgentTone().Guidance) &&
+			strings.Contains(prompt.String(), `"hook": "最初の1秒で読み上げる一言（10文字以内）"`) &&
+			assert.ObjectsAreEqual([]string{"hook"}, req.Brief.Fields)
+	})).Return(&llm.Response{
+		Title:       "数量限定の新作",
+		Description: "残りわずか。汗をかいてもさらっと快適な着心地です",
+		Content:     `{"hook": "急いで！"}`,
+	}, nil)
+
+	u := NewUseCase(mockRepo, mockGen, WithDefinitions(channels, tones))
+	got, err := u.CreateCopy(context.Background(), CreateCopyInput{
+		ProductName: "テスト商品",
+		Target:      "20代",
+		Channel:     "reels",
+		Tone:        "urgent",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &entity.CopyContent{Fields: map[string]string{"hook": "急いで！"}}, got.Content)
+	mockGen.AssertExpectations(t)
+}
+
+func TestValidateInput(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     CreateCopyInput
+		setupMock func(*mockChannelRepository, *mockToneRepository)
+		wantErr   error
+	}{
+		{
+			name:  "正常系_登録済みの定義",
+			input: CreateCopyInput{Channel: "reels", Tone: "urgent"},
+			setupMock: func(c *mockChannelRepository, tn *mockToneRepository) {
+				c.On("Get", mock.Anything, entity.Channel("reels")).Return(reelsChannel(), nil)
+				tn.On("Get", mock.Anything, entity.Tone("urgent")).Return(urgentTone(), nil)
+			},
+		},
+		{
+			name:  "異常系_未登録のチャネル",
+			input: CreateCopyInput{Channel: "tv", Tone: "urgent"},
+			setupMock: func(c *mockChannelRepository, _ *mockToneRepository) {
+				c.On("Get", mock.Anything, entity.Channel("tv")).Return(nil, repository.ErrChannelNotFound)
+			},
+			wantErr: ErrInvalidChannel,
+		},
+		{
+			name:  "異常系_未登録のトーン",
+			input: CreateCopyInput{Channel: "reels", Tone: "angry"},
+			setupMock: func(c *mockChannelRepository, tn *mockToneRepository) {
+				c.On("Get", mock.Anything, entity.Channel("reels")).Return(reelsChannel(), nil)
+				tn.On("Get", mock.Anything, entity.Tone("angry")).Return(nil, repository.ErrToneNotFound)
+			},
+			wantErr: ErrInvalidTone,
+		},
+		{
+			name:      "異常系_形式が不正なチャネルは問い合わせない",
+			input:     CreateCopyInput{Channel: "TV!", Tone: "urgent"},
+			setupMock: func(*mockChannelRepository, *mockToneRepository) {},
+			wantErr:   ErrInvalidChannel,
+		},
+		{
+			name:  "異常系_リポジトリエラー",
+			input: CreateCopyInput{Channel: "reels", Tone: "urgent"},
+			setupMock: func(c *mockChannelRepository, _ *mockToneRepository) {
+				c.On("Get", mock.Anything, entity.Channel("reels")).Return(nil, errors.New("database error"))
+			},
+			wantErr: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			channels := new(mockChannelRepository)
+			tones := new(mockToneRepository)
+			tt.setupMock(channels, tones)
+
+			u := NewUseCase(new(mockCopyRepository), new(mockGenerator), WithDefinitions(channels, tones))
+			err := u.ValidateInput(context.Background(), tt.input)
+
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			channels.AssertExpectations(t)
+			tones.AssertExpectations(t)
+		})
+	}
+}
+
+func TestValidateInput_Builtin(t *testing.T) {
+	// リポジトリを設定しない場合は組み込みの定義のみを使用する
+	u := NewUseCase(new(mockCopyRepository), new(mockGenerator))
+
+	assert.NoError(t, u.ValidateInput(context.Background(), CreateCopyInput{Channel: entity.ChannelSNS, Tone: entity.ToneCasual}))
+	assert.ErrorIs(t, u.ValidateInput(context.Background(), CreateCopyInput{Channel: "reels", Tone: entity.ToneCasual}), ErrInvalidChannel)
+}
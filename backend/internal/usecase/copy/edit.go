@@ -0,0 +1,150 @@
+package copy_usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/domainerr"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+)
+
+var (
+	ErrNoChanges       = domainerr.Validation("no_changes", "no editable fields were specified")
+	ErrCopyNotEditable = domainerr.Conflict("copy_not_editable", "copy can be edited only while it is a draft")
+	// ErrInvalidEdit / ErrPublishBlocked: InvalidEditError・PublishBlockedErrorが包むエラー（詳細を含めてUnwrapで取得できる）
+	ErrInvalidEdit    = domainerr.Validation("invalid_edit", "edit violates the channel constraints")
+	ErrPublishBlocked = domainerr.Conflict("publish_blocked", "copy contains expressions that block publishing")
+)
+
+// UpdateCopyInput: 手動で編集できる項目（nilの項目は変更しない）
+//
+// 生成時の条件（商品名・チャネル・トーンなど）やいいね数は編集できません。
+// 状態はワークフローの操作（SubmitForReviewなど）でのみ変更できます。
+type UpdateCopyInput struct {
+	Title       *string
+	Description *string
+	// Author: 編集した利用者（版に記録する）
+	Author string
+}
+
+// InvalidEditError: 編集内容がチャネルの制限を満たさない場合のエラー
+type InvalidEditError struct {
+	Violations []string
+}
+
+func (e *InvalidEditError) Error() string {
+	return "invalid edit: " + strings.Join(e.Violations, "; ")
+}
+
+func (e *InvalidEditError) Unwrap() error {
+	return ErrInvalidEdit.WithDetail("violations", e.Violations)
+}
+
+// PublishBlockedError: 公開を見送る重大度以上の表現を含むため公開できない場合のエラー
+type PublishBlockedError struct {
+	Severity entity.Severity
+	Findings []entity.ComplianceFinding
+}
+
+func (e *PublishBlockedError) Error() string {
+	return fmt.Sprintf("copy cannot be published: contains expressions with severity %s or higher", e.Severity)
+}
+
+func (e *PublishBlockedError) Unwrap() error {
+	return ErrPublishBlocked.
+		WithMessage(e.Error()).
+		WithDetail("severity", e.Severity).
+		WithDetail("findings", e.Findings)
+}
+
+// UpdateCopy: 見出し・本文を編集
+//
+// 見出し・本文はチャネルの長さの制限で検証し、構造化された出力と表現のチェック結果も更新します。
+// 編集できるのは下書きのコピーのみで、それ以外の状態の場合はErrCopyNotEditableを返します。
+func (u *useCase) UpdateCopy(ctx context.Context, id int, input UpdateCopyInput) (*entity.Copy, error) {
+	if input.Title == nil && input.Description == nil {
+		return nil, ErrNoChanges
+	}
+
+	copy, err := u.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !copy.Status.Editable() {
+		return nil, ErrCopyNotEditable.WithDetail("status", copy.Status)
+	}
+	before := *copy
+
+	defs, err := u.definitionsOf(ctx, copy)
+	if err != nil {
+		return nil, err
+	}
+	f := formatFor(defs.channel)
+	var (
+		fields     []string
+		violations []string
+	)
+	if input.Title != nil {
+		copy.Title = strings.TrimSpace(*input.Title)
+		if violation, ok := f.title.Check("title", copy.Title); !ok {
+			violations = append(violations, violation)
+		}
+		fields = append(fields, "Title")
+	}
+	if input.Description != nil {
+		copy.Description = strings.TrimSpace(*input.Description)
+		if violation, ok := f.description.Check("description", copy.Description); !ok {
+			violations = append(violations, violation)
+		}
+		fields = append(fields, "Description")
+	}
+	if len(violations) > 0 {
+		return nil, &InvalidEditError{Violations: violations}
+	}
+
+	// 編集した見出し・本文を構造化された出力に反映し、表現を再チェックする
+	if copy.Content != nil {
+		copy.Content.SetSummary(copy.Title, copy.Description)
+		fields = append(fields, "Content")
+	}
+	copy.Findings = u.inspect(copy, defs.brand)
+	fields = append(fields, "Findings")
+
+	// 見出し・本文のいずれかが変わった場合のみ、更新と同じトランザクションで版を記録する
+	var revision *entity.CopyRevision
+	if copy.Title != before.Title || copy.Description != before.Description {
+		revision = u.newRevision(copy, entity.RevisionSourceEdited, input.Author, 0)
+	}
+	if err := u.repo.UpdateWithRevision(ctx, copy, fields, revision); err != nil {
+		return nil, err
+	}
+	// 索引はコミットした内容で更新する
+	if err := u.indexCopies(ctx, copy); err != nil {
+		return nil, err
+	}
+	return copy, nil
+}
+
+// DeleteCopy: コピーを論理削除
+func (u *useCase) DeleteCopy(ctx context.Context, id int) error {
+	if err := u.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	return u.unindexCopy(ctx, id)
+}
+
+// RestoreCopy: 論理削除したコピーを元に戻す
+func (u *useCase) RestoreCopy(ctx context.Context, id int) (*entity.Copy, error) {
+	if err := u.repo.Restore(ctx, id); err != nil {
+		return nil, err
+	}
+	copy, err := u.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.indexCopies(ctx, copy); err != nil {
+		return nil, err
+	}
+	return copy, nil
+}
@@ -0,0 +1,220 @@
+package copy_usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+func stringPtr(s string) *string { return &s }
+
+func TestUpdateCopy(t *testing.T) {
+	// 編集前のコピー（構造化された出力を持つSNS向け）
+	newCopy := func(status entity.CopyStatus) *entity.Copy {
+		return &entity.Copy{
+			ID:          1,
+			Status:      status,
+			Title:       "テストタイトル",
+			Description: testDescription,
+			Channel:     entity.ChannelSNS,
+			Content:     &entity.CopyContent{SNS: &entity.SNSContent{Text: testDescription, Hashtags: []string{"テスト商品"}}},
+			Findings:    []entity.ComplianceFinding{},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		input      UpdateCopyInput
+		status     entity.CopyStatus
+		getErr     error
+		wantFields []string
+		wantErr    error
+		check      func(t *testing.T, got *entity.Copy)
+	}{
+		{
+			name:       "正常系_見出しの編集",
+			input:      UpdateCopyInput{Title: stringPtr("  業界初の新商品  ")},
+			wantFields: []string{"Title", "Content", "Findings"},
+			check: func(t *testing.T, got *entity.Copy) {
+				assert.Equal(t, "業界初の新商品", got.Title)
+				assert.Len(t, got.Findings, 1)
+				assert.Equal(t, "first-in-industry", got.Findings[0].RuleID)
+			},
+		},
+		{
+			name:       "正常系_本文の編集は構造化された出力に反映",
+			input:      UpdateCopyInput{Description: stringPtr("【新発売】" + testDescription)},
+			wantFields: []string{"Description", "Content", "Findings"},
+			check: func(t *testing.T, got *entity.Copy) {
+				assert.Equal(t, "【新発売】"+testDescription, got.Content.SNS.Text)
+				assert.Equal(t, []string{"テスト商品"}, got.Content.SNS.Hashtags)
+			},
+		},
+		{
+			name:    "異常系_変更なし",
+			input:   UpdateCopyInput{},
+			wantErr: ErrNoChanges,
+		},
+		{
+			name:    "異常系_存在しないID",
+			input:   UpdateCopyInput{Title: stringPtr("新しいタイトル")},
+			getErr:  repository.ErrNotFound,
+			wantErr: repository.ErrNotFound,
+		},
+		{
+			name:    "異常系_レビュー中は編集できない",
+			input:   UpdateCopyInput{Title: stringPtr("新しいタイトル")},
+			status:  entity.CopyStatusInReview,
+			wantErr: ErrCopyNotEditable,
+		},
+		{
+			name:    "異常系_公開済みは編集できない",
+			input:   UpdateCopyInput{Title: stringPtr("新しいタイトル")},
+			status:  entity.CopyStatusPublished,
+			wantErr: ErrCopyNotEditable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockRepo := new(mockCopyRepository)
+			if tt.getErr != nil {
+				mockRepo.On("Get", mock.Anything, 1).Return(nil, tt.getErr)
+			} else {
+				status := tt.status
+				if status == "" {
+					status = entity.CopyStatusDraft
+				}
+				mockRepo.On("Get", mock.Anything, 1).Return(newCopy(status), nil)
+			}
+			if tt.wantFields != nil {
+				mockRepo.On("UpdateWithRevision", mock.Anything, mock.Anything, tt.wantFields, (*entity.CopyRevision)(nil)).Return(nil)
+			}
+
+			// ユースケースの初期化
+			u := NewUseCase(mockRepo, new(mockGenerator))
+
+			// テスト実行
+			got, err := u.UpdateCopy(context.Background(), 1, tt.input)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				mockRepo.AssertNotCalled(t, "UpdateWithRevision", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			tt.check(t, got)
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateCopy_Length(t *testing.T) {
+	// モックの準備（検証に失敗した場合は保存しない）
+	mockRepo := new(mockCopyRepository)
+	mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{ID: 1, Title: "テストタイトル", Channel: entity.ChannelSNS, Status: entity.CopyStatusDraft}, nil)
+
+	// ユースケースの初期化
+	u := NewUseCase(mockRepo, new(mockGenerator))
+
+	// テスト実行
+	_, err := u.UpdateCopy(context.Background(), 1, UpdateCopyInput{Title: stringPtr(strings.Repeat("長", 21))})
+
+	// アサーション
+	var invalid *InvalidEditError
+	assert.ErrorAs(t, err, &invalid)
+	assert.Len(t, invalid.Violations, 1)
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDeleteCopy(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		wantErr bool
+	}{
+		{
+			name: "正常系",
+		},
+		{
+			name:    "異常系_存在しないID",
+			err:     repository.ErrNotFound,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockRepo := new(mockCopyRepository)
+			mockRepo.On("Delete", mock.Anything, 1).Return(tt.err)
+
+			// ユースケースの初期化
+			u := NewUseCase(mockRepo, new(mockGenerator))
+
+			// テスト実行
+			err := u.DeleteCopy(context.Background(), 1)
+
+			// アサーション
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRestoreCopy(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		wantErr bool
+	}{
+		{
+			name: "正常系",
+		},
+		{
+			name:    "異常系_削除されていないID",
+			err:     repository.ErrNotFound,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockRepo := new(mockCopyRepository)
+			mockRepo.On("Restore", mock.Anything, 1).Return(tt.err)
+			if !tt.wantErr {
+				mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{ID: 1, Title: "テストタイトル"}, nil)
+			}
+
+			// ユースケースの初期化
+			u := NewUseCase(mockRepo, new(mockGenerator))
+
+			// テスト実行
+			got, err := u.RestoreCopy(context.Background(), 1)
+
+			// アサーション
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.err)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 1, got.ID)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,282 @@
+package copy_usecase
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/llm"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/textmetrics"
+)
+
+const snsMaxHashtags = 5
+
+// snsPostLimit: SNSの投稿全体（本文とハッシュタグ）の上限（全角140文字相当）
+var snsPostLimit = textmetrics.Limit{Unit: textmetrics.UnitWidth, Max: 280}
+
+// channelOutput: titleとdescription以外のチャネル固有の出力項目
+type channelOutput struct {
+	// values: 文字列の出力項目（キーはOutputField.Name）
+	values   map[string]string
+	Hashtags []string
+}
+
+// fieldLimit: チャネル固有の文字列項目の制限
+type fieldLimit struct {
+	name  string
+	limit textmetrics.Limit
+}
+
+// channelFormat: チャネルごとの出力形式と長さの制限
+//
+// titleとdescriptionはすべてのチャネルで一覧表示用の見出しと本文として使用し、
+// チャネル固有の項目と合わせて構造化された出力を組み立てます。
+// 長さの制限はチャネルの定義（entity.ChannelDefinition.Fields）から組み立てます。
+type channelFormat struct {
+	title       textmetrics.Limit
+	description textmetrics.Limit
+	extras      []fieldLimit
+	// decodeHashtags: 出力のhashtags（文字列の配列）も取り出すかどうか
+	decodeHashtags bool
+	// validate: 文字列項目以外のチャネル固有の項目を検証し、違反内容を返す
+	validate func(description string, out *channelOutput) []string
+	content  func(title, description string, out *channelOutput) *entity.CopyContent
+}
+
+// defaultFormat: チャネルの定義が見つからない場合（削除済みのチャネルのコピーなど）の形式
+var defaultFormat = channelFormat{
+	title:       textmetrics.Limit{Unit: textmetrics.UnitGraphemes, Max: 20},
+	description: textmetrics.Limit{Unit: textmetrics.UnitGraphemes, Min: 50, Max: 100},
+}
+
+// builtinFormats: 組み込みのチャネルの構造化された出力とチャネル固有の検証
+var builtinFormats = map[entity.Channel]channelFormat{
+	entity.ChannelApp: {
+		content: func(title, description string, _ *channelOutput) *entity.CopyContent {
+			return &entity.CopyContent{App: &entity.AppContent{Title: title, Body: description}}
+		},
+	},
+	entity.ChannelLine: {
+		content: func(title, description string, out *channelOutput) *entity.CopyContent {
+			return &entity.CopyContent{Line: entity.NewLineContent(out.values["altText"], title, description, out.values["buttonLabel"])}
+		},
+	},
+	entity.ChannelPop: {
+		content: func(title, description string, out *channelOutput) *entity.CopyContent {
+			return &entity.CopyContent{Pop: &entity.PopContent{Headline: title, SubCopy: description, PriceCallout: out.values["priceCallout"]}}
+		},
+	},
+	entity.ChannelSNS: {
+		decodeHashtags: true,
+		validate:       validateSNS,
+		content: func(_, description string, out *channelOutput) *entity.CopyContent {
+			return &entity.CopyContent{SNS: &entity.SNSContent{Text: description, Hashtags: out.Hashtags}}
+		},
+	},
+	entity.ChannelEmail: {
+		content: func(title, description string, out *channelOutput) *entity.CopyContent {
+			return &entity.CopyContent{Email: &entity.EmailContent{Subject: title, Preheader: out.values["preheader"], Body: description}}
+		},
+	},
+}
+
+// formatFor: チャネルの定義に対応する出力形式を返す（定義がない場合はdefaultFormat）
+func formatFor(definition *entity.ChannelDefinition) channelFormat {
+	if definition == nil {
+		return defaultFormat
+	}
+
+	f, builtin := builtinFormats[definition.ID]
+	if !builtin {
+		f.content = fieldsContent
+	}
+	f.title, f.description = defaultFormat.title, defaultFormat.description
+	for _, field := range definition.Fields {
+		switch field.Name {
+		case entity.FieldTitle:
+			f.title = field.Limit
+		case entity.FieldDescription:
+			f.description = field.Limit
+		default:
+			f.extras = append(f.extras, fieldLimit{name: field.Name, limit: field.Limit})
+		}
+	}
+	return f
+}
+
+// fieldsContent: 組み込み以外のチャネルの構造化された出力（固有の項目がない場合はnil）
+func fieldsContent(_, _ string, out *channelOutput) *entity.CopyContent {
+	if len(out.values) == 0 {
+		return nil
+	}
+	return &entity.CopyContent{Fields: out.values}
+}
+
+// customFields: 組み込み以外のチャネルでtitle・description以外に出力する項目
+func customFields(definition *entity.ChannelDefinition) []string {
+	if definition == nil || definition.BuiltIn {
+		return nil
+	}
+
+	var names []string
+	for _, field := range definition.Fields {
+		if field.Name != entity.FieldTitle && field.Name != entity.FieldDescription {
+			names = append(names, field.Name)
+		}
+	}
+	return names
+}
+
+// outputSchema: 組み込み以外のチャネルの出力形式をプロンプトに記載するJSONの例として組み立てる
+//
+// 組み込みのチャネルの出力形式はテンプレートに記載しているため空文字を返します。
+func outputSchema(definition *entity.ChannelDefinition) string {
+	if definition == nil || definition.BuiltIn {
+		return ""
+	}
+
+	lines := make([]string, 0, len(definition.Fields))
+	for _, field := range definition.Fields {
+		lines = append(lines, fmt.Sprintf("  %q: %q", field.Name, field.Description+"（"+field.Limit.Describe()+"）"))
+	}
+	return "{\n" + strings.Join(lines, ",\n") + "\n}"
+}
+
+// checkedField: 長さを検証する項目
+type checkedField struct {
+	name  string
+	value *string
+	limit textmetrics.Limit
+}
+
+// validOutput: 検証を通過した出力
+type validOutput struct {
+	Title       string
+	Description string
+	Content     *entity.CopyContent
+	Metrics     *entity.CopyMetrics
+}
+
+// validateOutput: チャネルの形式に従って出力を検証し、構造化された出力を組み立てる
+//
+// policyがLengthPolicyTruncateの場合は、上限を超えた項目を切り詰めてから検証します。
+// 違反がある場合は違反内容のみを返します。
+func validateOutput(f channelFormat, resp *llm.Response, policy LengthPolicy) (*validOutput, []string) {
+	var (
+		out        channelOutput
+		violations []string
+	)
+	if f.decodeHashtags || len(f.extras) > 0 {
+		if err := decodeChannelOutput(resp.Content, f, &out); err != nil {
+			return nil, []string{"JSONの形式が正しくありません（" + err.Error() + "）"}
+		}
+	}
+
+	// レスポンスは再生成時に会話へ追加するため、値をコピーしてから切り詰める
+	title, description := resp.Title, resp.Description
+	fields := []checkedField{
+		{name: entity.FieldTitle, value: &title, limit: f.title},
+		{name: entity.FieldDescription, value: &description, limit: f.description},
+	}
+	for _, extra := range f.extras {
+		value := out.values[extra.name]
+		fields = append(fields, checkedField{name: extra.name, value: &value, limit: extra.limit})
+	}
+
+	metrics := &entity.CopyMetrics{Fields: map[string]textmetrics.Metrics{}}
+	for _, field := range fields {
+		if policy == LengthPolicyTruncate && field.limit.Exceeds(*field.value) {
+			*field.value = textmetrics.Truncate(*field.value, field.limit.Max, field.limit.Unit)
+			metrics.Truncated = append(metrics.Truncated, field.name)
+		}
+		if violation, ok := field.limit.Check(field.name, *field.value); !ok {
+			violations = append(violations, violation)
+		}
+		metrics.Fields[field.name] = textmetrics.Measure(*field.value)
+	}
+	for _, field := range fields[2:] {
+		out.values[field.name] = *field.value
+	}
+
+	if f.validate != nil {
+		violations = append(violations, f.validate(description, &out)...)
+	}
+
+	if len(violations) > 0 {
+		return nil, violations
+	}
+
+	valid := &validOutput{Title: title, Description: description, Metrics: metrics}
+	if f.content != nil {
+		valid.Content = f.content(title, description, &out)
+	}
+	return valid, nil
+}
+
+// decodeChannelOutput: モデルの出力からチャネル固有の項目を取り出す（出力にない項目は空文字）
+func decodeChannelOutput(content string, f channelFormat, out *channelOutput) error {
+	obj, err := llm.ExtractJSON(content)
+	if err != nil {
+		return err
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(obj), &raw); err != nil {
+		return err
+	}
+
+	out.values = make(map[string]string, len(f.extras))
+	for _, extra := range f.extras {
+		var value string
+		if v, ok := raw[extra.name]; ok && string(v) != "null" {
+			if err := json.Unmarshal(v, &value); err != nil {
+				return fmt.Errorf("%s: %w", extra.name, err)
+			}
+		}
+		out.values[extra.name] = value
+	}
+	if v, ok := raw["hashtags"]; ok && f.decodeHashtags {
+		if err := json.Unmarshal(v, &out.Hashtags); err != nil {
+			return fmt.Errorf("hashtags: %w", err)
+		}
+	}
+	return nil
+}
+
+// validateSNS: ハッシュタグの数と投稿全体の長さを検証する
+//
+// ハッシュタグは先頭の#を取り除いた形に正規化します。
+func validateSNS(description string, out *channelOutput) []string {
+	var violations []string
+
+	hashtags := make([]string, 0, len(out.Hashtags))
+	for _, tag := range out.Hashtags {
+		tag = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(tag), "#＃"))
+		if tag == "" {
+			continue
+		}
+		if strings.ContainsAny(tag, " 　#＃") {
+			violations = append(violations, fmt.Sprintf("hashtagsに空白や#を含めないでください（%s）", tag))
+		}
+		hashtags = append(hashtags, tag)
+	}
+	out.Hashtags = hashtags
+
+	switch n := len(hashtags); {
+	case n == 0:
+		violations = append(violations, "hashtagsがありません")
+	case n > snsMaxHashtags:
+		violations = append(violations, fmt.Sprintf("hashtagsは%d個以内にしてください（現在%d個）", snsMaxHashtags, n))
+	}
+
+	// 投稿時は本文の後に空白区切りで「#タグ」を並べる
+	post := description
+	for _, tag := range hashtags {
+		post += " #" + tag
+	}
+	if violation, ok := snsPostLimit.Check("descriptionとhashtagsの合計", post); !ok {
+		violations = append(violations, violation)
+	}
+
+	return violations
+}
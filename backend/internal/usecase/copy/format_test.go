@@ -0,0 +1,357 @@
+package copy_usecase
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/llm"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/textmetrics"
+)
+
+// builtinFormat: 組み込みのチャネルの出力形式（空の場合は定義がない場合の形式）
+func builtinFormat(channel entity.Channel) channelFormat {
+	definition, _ := entity.BuiltinChannel(channel)
+	return formatFor(definition)
+}
+
+// reelsChannel: 組み込み以外のチャネルの定義
+func reelsChannel() *entity.ChannelDefinition {
+	return &entity.ChannelDefinition{
+		ID:    "reels",
+		Label: "Instagramリール",
+		Fields: []entity.OutputField{
+			{Name: entity.FieldTitle, Description: "動画の冒頭に表示するテロップ", Limit: textmetrics.Limit{Unit: textmetrics.UnitGraphemes, Max: 15}},
+			{Name: entity.FieldDescription, Description: "キャプション", Limit: textmetrics.Limit{Unit: textmetrics.UnitGraphemes, Min: 10, Max: 60}},
+			{Name: "hook", Description: "最初の1秒で読み上げる一言", Limit: textmetrics.Limit{Unit: textmetrics.UnitGraphemes, Max: 10}},
+		},
+	}
+}
+
+func TestValidateOutput(t *testing.T) {
+	tests := []struct {
+		name    string
+		channel entity.Channel
+		resp    *llm.Response
+		want    []string
+	}{
+		{
+			name: "正常系",
+			resp: &llm.Response{Title: "夏の新作", Description: strings.Repeat("あ", 50)},
+			want: nil,
+		},
+		{
+			name: "必須項目なし",
+			resp: &llm.Response{},
+			want: []string{"titleがありません", "descriptionがありません"},
+		},
+		{
+			name: "文字数超過",
+			resp: &llm.Response{Title: strings.Repeat("あ", 21), Description: strings.Repeat("あ", 101)},
+			want: []string{
+				"titleは20文字以内にしてください（現在21文字）",
+				"descriptionは50〜100文字にしてください（現在101文字）",
+			},
+		},
+		{
+			name: "本文が短い",
+			resp: &llm.Response{Title: "夏の新作", Description: strings.Repeat("あ", 49)},
+			want: []string{"descriptionは50〜100文字にしてください（現在49文字）"},
+		},
+		{
+			name:    "SNS_ハッシュタグなし",
+			channel: entity.ChannelSNS,
+			resp: &llm.Response{
+				Title:       "夏の新作",
+				Description: strings.Repeat("あ", 50),
+				Content:     `{"title": "夏の新作", "hashtags": []}`,
+			},
+			want: []string{"hashtagsがありません"},
+		},
+		{
+			name:    "SNS_ハッシュタグが多い",
+			channel: entity.ChannelSNS,
+			resp: &llm.Response{
+				Title:       "夏の新作",
+				Description: strings.Repeat("あ", 50),
+				Content:     `{"hashtags": ["夏コーデ", "新作", "セール", "限定", "おすすめ", "人気", "話題"]}`,
+			},
+			want: []string{"hashtagsは5個以内にしてください（現在7個）"},
+		},
+		{
+			name:    "SNS_投稿全体が長い",
+			channel: entity.ChannelSNS,
+			resp: &llm.Response{
+				Title:       "夏の新作",
+				Description: strings.Repeat("あ", 100),
+				Content:     `{"hashtags": ["夏コーデ2026年版", "新作アイテム紹介", "夏のおすすめ品", "今年の流行アイテム", "夏の新作キャンペーン"]}`,
+			},
+			want: []string{"descriptionとhashtagsの合計は表示幅（全角2・半角1）280以内にしてください（現在294）"},
+		},
+		{
+			name:    "アプリ_表示幅の上限超過",
+			channel: entity.ChannelApp,
+			resp:    &llm.Response{Title: "本日限定！アプリ会員様だけの特別セール開催", Description: "アプリからの注文で送料無料。お見逃しなく！"},
+			want:    []string{"titleは表示幅（全角2・半角1）40以内にしてください（現在42）"},
+		},
+		{
+			name:    "SNS_ハッシュタグに空白を含む",
+			channel: entity.ChannelSNS,
+			resp: &llm.Response{
+				Title:       "夏の新作",
+				Description: strings.Repeat("あ", 50),
+				Content:     `{"hashtags": ["夏 コーデ"]}`,
+			},
+			want: []string{"hashtagsに空白や#を含めないでください（夏 コーデ）"},
+		},
+		{
+			name:    "LINE_固有項目なし",
+			channel: entity.ChannelLine,
+			resp: &llm.Response{
+				Title:       "夏の新作",
+				Description: strings.Repeat("あ", 50),
+				Content:     `{"buttonLabel": "` + strings.Repeat("あ", 21) + `"}`,
+			},
+			want: []string{"altTextがありません", "buttonLabelは20文字以内にしてください（現在21文字）"},
+		},
+		{
+			name:    "メール_本文が短い",
+			channel: entity.ChannelEmail,
+			resp: &llm.Response{
+				Title:       "夏の新作のご案内",
+				Description: strings.Repeat("あ", 50),
+				Content:     `{"preheader": "今だけの特典があります"}`,
+			},
+			want: []string{"descriptionは100〜400文字にしてください（現在50文字）"},
+		},
+		{
+			name:    "POP_形式が正しくない",
+			channel: entity.ChannelPop,
+			resp: &llm.Response{
+				Title:       "夏の新作",
+				Description: strings.Repeat("あ", 30),
+				Content:     `{"priceCallout": 980}`,
+			},
+			want: []string{"JSONの形式が正しくありません（priceCallout: json: cannot unmarshal number into Go value of type string）"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, got := validateOutput(builtinFormat(tt.channel), tt.resp, LengthPolicyRegenerate)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestValidateOutput_Content(t *testing.T) {
+	tests := []struct {
+		name    string
+		channel entity.Channel
+		resp    *llm.Response
+		want    *entity.CopyContent
+	}{
+		{
+			name:    "アプリ",
+			channel: entity.ChannelApp,
+			resp:    &llm.Response{Title: "本日限定セール", Description: "アプリからの注文で送料無料。お見逃しなく！"},
+			want: &entity.CopyContent{
+				App: &entity.AppContent{Title: "本日限定セール", Body: "アプリからの注文で送料無料。お見逃しなく！"},
+			},
+		},
+		{
+			name:    "SNS_ハッシュタグの#を取り除く",
+			channel: entity.ChannelSNS,
+			resp: &llm.Response{
+				Title:       "夏の新作",
+				Description: strings.Repeat("あ", 50),
+				Content:     `{"hashtags": ["#夏コーデ", "新作"]}`,
+			},
+			want: &entity.CopyContent{
+				SNS: &entity.SNSContent{Text: strings.Repeat("あ", 50), Hashtags: []string{"夏コーデ", "新作"}},
+			},
+		},
+		{
+			name:    "LINE",
+			channel: entity.ChannelLine,
+			resp: &llm.Response{
+				Title:       "夏の新作",
+				Description: strings.Repeat("あ", 50),
+				Content:     `{"altText": "夏の新作のお知らせ", "buttonLabel": "詳しく見る"}`,
+			},
+			want: &entity.CopyContent{
+				Line: entity.NewLineContent("夏の新作のお知らせ", "夏の新作", strings.Repeat("あ", 50), "詳しく見る"),
+			},
+		},
+		{
+			name:    "POP",
+			channel: entity.ChannelPop,
+			resp: &llm.Response{
+				Title:       "夏の新作",
+				Description: "汗をかいてもさらっと快適な着心地。毎日の通勤にぴったりです。",
+				Content:     `{"priceCallout": "今だけ20%OFF"}`,
+			},
+			want: &entity.CopyContent{
+				Pop: &entity.PopContent{Headline: "夏の新作", SubCopy: "汗をかいてもさらっと快適な着心地。毎日の通勤にぴったりです。", PriceCallout: "今だけ20%OFF"},
+			},
+		},
+		{
+			name:    "メール",
+			channel: entity.ChannelEmail,
+			resp: &llm.Response{
+				Title:       "夏の新作のご案内",
+				Description: strings.Repeat("あ", 100),
+				Content:     `{"preheader": "今だけの特典があります"}`,
+			},
+			want: &entity.CopyContent{
+				Email: &entity.EmailContent{Subject: "夏の新作のご案内", Preheader: "今だけの特典があります", Body: strings.Repeat("あ", 100)},
+			},
+		},
+		{
+			name: "チャネル固有の形式なし",
+			resp: &llm.Response{Title: "夏の新作", Description: strings.Repeat("あ", 50)},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, violations := validateOutput(builtinFormat(tt.channel), tt.resp, LengthPolicyRegenerate)
+			assert.Empty(t, violations)
+			assert.Equal(t, tt.want, got.Content)
+		})
+	}
+}
+
+func TestValidateOutput_CustomChannel(t *testing.T) {
+	tests := []struct {
+		name           string
+		resp           *llm.Response
+		want           *entity.CopyContent
+		wantViolations []string
+	}{
+		{
+			name: "正常系",
+			resp: &llm.Response{
+				Title:       "夏の新作",
+				Description: "汗をかいてもさらっと快適な着心地です",
+				Content:     `{"hook": "暑い夏に！"}`,
+			},
+			want: &entity.CopyContent{Fields: map[string]string{"hook": "暑い夏に！"}},
+		},
+		{
+			name: "定義の長さの制限を超過",
+			resp: &llm.Response{
+				Title:       strings.Repeat("あ", 16),
+				Description: "汗をかいてもさらっと快適な着心地です",
+				Content:     `{"hook": "` + strings.Repeat("あ", 11) + `"}`,
+			},
+			wantViolations: []string{
+				"titleは15文字以内にしてください（現在16文字）",
+				"hookは10文字以内にしてください（現在11文字）",
+			},
+		},
+		{
+			name: "固有項目なし",
+			resp: &llm.Response{
+				Title:       "夏の新作",
+				Description: "汗をかいてもさらっと快適な着心地です",
+				Content:     `{"title": "夏の新作"}`,
+			},
+			wantViolations: []string{"hookがありません"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, violations := validateOutput(formatFor(reelsChannel()), tt.resp, LengthPolicyRegenerate)
+			assert.Equal(t, tt.wantViolations, violations)
+			if tt.want != nil {
+				assert.Equal(t, tt.want, got.Content)
+			}
+		})
+	}
+}
+
+func TestOutputSchema(t *testing.T) {
+	assert.Equal(t, `{
+  "title": "動画の冒頭に表示するテロップ（15文字以内）",
+  "description": "キャプション（10〜60文字）",
+  "hook": "最初の1秒で読み上げる一言（10文字以内）"
+}`, outputSchema(reelsChannel()))
+	assert.Equal(t, []string{"hook"}, customFields(reelsChannel()))
+
+	// 組み込みのチャネルの出力形式はテンプレートに記載している
+	sns, _ := entity.BuiltinChannel(entity.ChannelSNS)
+	assert.Empty(t, outputSchema(sns))
+	assert.Empty(t, customFields(sns))
+}
+
+func TestValidateOutput_LengthPolicy(t *testing.T) {
+	resp := &llm.Response{
+		Title:       "夏の新作セール開催中！今だけの特別価格でご案内",
+		Description: strings.Repeat("あ", 120),
+		Content:     `{"altText": "夏の新作のお知らせ", "buttonLabel": "詳しく見る"}`,
+	}
+
+	t.Run("再生成_違反を返す", func(t *testing.T) {
+		got, violations := validateOutput(builtinFormat(entity.ChannelLine), resp, LengthPolicyRegenerate)
+		assert.Nil(t, got)
+		assert.Equal(t, []string{
+			"titleは20文字以内にしてください（現在23文字）",
+			"descriptionは50〜100文字にしてください（現在120文字）",
+		}, violations)
+	})
+
+	t.Run("切り詰め_上限に収めて計測結果を返す", func(t *testing.T) {
+		got, violations := validateOutput(builtinFormat(entity.ChannelLine), resp, LengthPolicyTruncate)
+		assert.Empty(t, violations)
+		assert.Equal(t, "夏の新作セール開催中！今だけの特別価格…", got.Title)
+		assert.Equal(t, strings.Repeat("あ", 99)+"…", got.Description)
+		assert.Equal(t, []string{"title", "description"}, got.Metrics.Truncated)
+		assert.Equal(t, textmetrics.Metrics{Bytes: 60, Runes: 20, Graphemes: 20, Width: 39}, got.Metrics.Fields["title"])
+		assert.Equal(t, 100, got.Metrics.Fields["description"].Graphemes)
+		assert.Equal(t, 5, got.Metrics.Fields["buttonLabel"].Graphemes)
+
+		// 構造化された出力も切り詰めた値で組み立てる
+		assert.Equal(t, got.Title, got.Content.Line.Bubble.Header.Contents[0].Text)
+
+		// 再生成時に会話へ追加するレスポンスは変更しない
+		assert.Equal(t, "夏の新作セール開催中！今だけの特別価格でご案内", resp.Title)
+	})
+
+	t.Run("切り詰め_下限未満は違反を返す", func(t *testing.T) {
+		short := *resp
+		short.Description = "短い本文"
+		got, violations := validateOutput(builtinFormat(entity.ChannelLine), &short, LengthPolicyTruncate)
+		assert.Nil(t, got)
+		assert.Equal(t, []string{"descriptionは50〜100文字にしてください（現在4文字）"}, violations)
+	})
+}
+
+func TestParseLengthPolicy(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    LengthPolicy
+		wantErr bool
+	}{
+		{input: "", want: LengthPolicyRegenerate},
+		{input: "truncate", want: LengthPolicyTruncate},
+		{input: " Reject ", want: LengthPolicyReject},
+		{input: "ignore", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseLengthPolicy(tt.input)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidLengthPolicy)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package copy_usecase
+
+import (
+	"context"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/domainerr"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+)
+
+var ErrVoterRequired = domainerr.Validation("voter_required", "voter is required")
+
+// LikeCopy: 投票者のいいねを記録（いいね済みの場合はいいね数を変えない）
+func (u *useCase) LikeCopy(ctx context.Context, id int, voter string) (*entity.Copy, error) {
+	if voter == "" {
+		return nil, ErrVoterRequired
+	}
+	if err := u.repo.Like(ctx, id, voter); err != nil {
+		return nil, err
+	}
+	return u.likedCopy(ctx, id, true)
+}
+
+// UnlikeCopy: 投票者のいいねを取り消し（いいねしていない場合はいいね数を変えない）
+func (u *useCase) UnlikeCopy(ctx context.Context, id int, voter string) (*entity.Copy, error) {
+	if voter == "" {
+		return nil, ErrVoterRequired
+	}
+	if err := u.repo.Unlike(ctx, id, voter); err != nil {
+		return nil, err
+	}
+	return u.likedCopy(ctx, id, false)
+}
+
+// likedCopy: 更新後のいいね数を含むコピーを取得し、投票者のいいねの状態を設定
+func (u *useCase) likedCopy(ctx context.Context, id int, liked bool) (*entity.Copy, error) {
+	copy, err := u.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	copy.Liked = &liked
+	return copy, nil
+}
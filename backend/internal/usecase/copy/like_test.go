@@ -0,0 +1,90 @@
+package copy_usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+func TestLikeCopy(t *testing.T) {
+	tests := []struct {
+		name      string
+		id        int
+		voter     string
+		setupMock func(*mockCopyRepository)
+		wantLikes int
+		wantErr   error
+	}{
+		{
+			name:  "正常系",
+			id:    1,
+			voter: "user:1",
+			setupMock: func(mockRepo *mockCopyRepository) {
+				mockRepo.On("Like", mock.Anything, 1, "user:1").Return(nil)
+				mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{ID: 1, Likes: 3}, nil)
+			},
+			wantLikes: 3,
+		},
+		{
+			name:    "異常系_投票者なし",
+			id:      1,
+			wantErr: ErrVoterRequired,
+		},
+		{
+			name:  "異常系_存在しないID",
+			id:    999,
+			voter: "user:1",
+			setupMock: func(mockRepo *mockCopyRepository) {
+				mockRepo.On("Like", mock.Anything, 999, "user:1").Return(repository.ErrNotFound)
+			},
+			wantErr: repository.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockRepo := new(mockCopyRepository)
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo)
+			}
+			u := NewUseCase(mockRepo, new(mockGenerator))
+
+			// テスト実行
+			got, err := u.LikeCopy(context.Background(), tt.id, tt.voter)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantLikes, got.Likes)
+			assert.True(t, *got.Liked)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUnlikeCopy(t *testing.T) {
+	// モックの準備
+	mockRepo := new(mockCopyRepository)
+	mockRepo.On("Unlike", mock.Anything, 1, "anon:abc").Return(nil)
+	mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{ID: 1, Likes: 2}, nil)
+	u := NewUseCase(mockRepo, new(mockGenerator))
+
+	// テスト実行
+	got, err := u.UnlikeCopy(context.Background(), 1, "anon:abc")
+
+	// アサーション
+	assert.NoError(t, err)
+	assert.Equal(t, 2, got.Likes)
+	assert.False(t, *got.Liked)
+	mockRepo.AssertExpectations(t)
+}
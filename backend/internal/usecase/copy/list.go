@@ -0,0 +1,105 @@
+package copy_usecase
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/domainerr"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+const (
+	// DefaultPageSize / MaxPageSize: 一覧の1ページの件数の既定値と上限
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+var (
+	ErrInvalidCursor    = domainerr.Validation("invalid_cursor", "invalid cursor")
+	ErrInvalidSort      = domainerr.Validation("invalid_sort", "sort must be one of newest, likes, trending")
+	ErrInvalidPageSize  = domainerr.Validation("invalid_page_size", fmt.Sprintf("limit must be between 1 and %d", MaxPageSize))
+	ErrInvalidDateRange = domainerr.Validation("invalid_date_range", "createdFrom must be before createdTo")
+)
+
+// ListCopiesInput: 公開済みのコピーの一覧の取得条件
+type ListCopiesInput struct {
+	Filter repository.CopyFilter
+	// Sort: 並び順（空の場合はnewest）
+	Sort repository.CopySort
+	// Cursor: 前のページのNextCursor（空の場合は最初のページ）
+	Cursor string
+	// Limit: 1ページの件数（0の場合はDefaultPageSize）
+	Limit int
+}
+
+// CopyPage: 一覧の1ページ
+type CopyPage struct {
+	Copies []*entity.Copy `json:"copies"`
+	// NextCursor: 次のページを取得するカーソル（最後のページの場合は空）
+	NextCursor string `json:"nextCursor,omitempty"`
+	HasMore    bool   `json:"hasMore"`
+}
+
+// pageCursor: カーソルの内容（並び順を含め、別の並び順のカーソルは受け付けない）
+type pageCursor struct {
+	Sort          repository.CopySort `json:"s"`
+	ID            int                 `json:"id"`
+	CreatedAt     time.Time           `json:"c,omitempty"`
+	Likes         int                 `json:"l,omitempty"`
+	TrendingScore int64               `json:"t,omitempty"`
+}
+
+// encodeCursor: ページの最後のコピーからカーソルを作成（クライアントには不透明な文字列として返す）
+func encodeCursor(sort repository.CopySort, copy *entity.Copy) string {
+	b, _ := json.Marshal(pageCursor{
+		Sort:          sort,
+		ID:            copy.ID,
+		CreatedAt:     copy.CreatedAt,
+		Likes:         copy.Likes,
+		TrendingScore: copy.TrendingScore,
+	})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(sort repository.CopySort, s string) (*repository.CopyCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c pageCursor
+	if err := json.Unmarshal(b, &c); err != nil || c.Sort != sort || c.ID <= 0 {
+		return nil, ErrInvalidCursor
+	}
+	return &repository.CopyCursor{
+		ID:            c.ID,
+		CreatedAt:     c.CreatedAt,
+		Likes:         c.Likes,
+		TrendingScore: c.TrendingScore,
+	}, nil
+}
+
+// normalize: 既定値を補い、取得条件を検証する
+func (in *ListCopiesInput) normalize() (*repository.CopyCursor, error) {
+	if in.Sort == "" {
+		in.Sort = repository.CopySortNewest
+	}
+	if !in.Sort.Valid() {
+		return nil, ErrInvalidSort
+	}
+	if in.Limit == 0 {
+		in.Limit = DefaultPageSize
+	}
+	if in.Limit < 1 || in.Limit > MaxPageSize {
+		return nil, ErrInvalidPageSize
+	}
+	if f := in.Filter; f.CreatedFrom != nil && f.CreatedTo != nil && !f.CreatedFrom.Before(*f.CreatedTo) {
+		return nil, ErrInvalidDateRange
+	}
+	if in.Cursor == "" {
+		return nil, nil
+	}
+	return decodeCursor(in.Sort, in.Cursor)
+}
@@ -0,0 +1,113 @@
+package copy_usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+func TestGetPublishedCopies_Pagination(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	copies := []*entity.Copy{
+		{ID: 3, Likes: 5, CreatedAt: now, TrendingScore: entity.TrendingScore(5, now)},
+		{ID: 2, Likes: 3, CreatedAt: now.Add(-time.Hour), TrendingScore: entity.TrendingScore(3, now.Add(-time.Hour))},
+		{ID: 1, Likes: 1, CreatedAt: now.Add(-2 * time.Hour), TrendingScore: entity.TrendingScore(1, now.Add(-2*time.Hour))},
+	}
+
+	// モックの準備（1ページ目は2件+次のページの有無の判定用の1件）
+	mockRepo := new(mockCopyRepository)
+	mockRepo.On("ListPublished", mock.Anything, mock.MatchedBy(func(q repository.CopyListQuery) bool {
+		return q.After == nil
+	})).Return(copies, nil)
+	mockRepo.On("ListPublished", mock.Anything, mock.MatchedBy(func(q repository.CopyListQuery) bool {
+		return q.After != nil
+	})).Return(copies[2:], nil)
+
+	// ユースケースの初期化
+	u := NewUseCase(mockRepo, new(mockGenerator), WithClock(func() time.Time { return now }))
+
+	// 1ページ目
+	input := ListCopiesInput{Sort: repository.CopySortLikes, Limit: 2, Filter: repository.CopyFilter{Channel: entity.ChannelSNS}}
+	page, err := u.GetPublishedCopies(context.Background(), input)
+	assert.NoError(t, err)
+	assert.Equal(t, copies[:2], page.Copies)
+	assert.True(t, page.HasMore)
+	assert.NotEmpty(t, page.NextCursor)
+
+	// 2ページ目（カーソルは1ページ目の最後のコピーのキー）
+	input.Cursor = page.NextCursor
+	page, err = u.GetPublishedCopies(context.Background(), input)
+	assert.NoError(t, err)
+	assert.Equal(t, copies[2:], page.Copies)
+	assert.False(t, page.HasMore)
+	assert.Empty(t, page.NextCursor)
+
+	mockRepo.AssertCalled(t, "ListPublished", mock.Anything, repository.CopyListQuery{
+		Filter: repository.CopyFilter{Channel: entity.ChannelSNS},
+		Sort:   repository.CopySortLikes,
+		After:  &repository.CopyCursor{ID: 2, CreatedAt: copies[1].CreatedAt, Likes: 3, TrendingScore: copies[1].TrendingScore},
+		Limit:  3,
+		Now:    now,
+	})
+}
+
+func TestGetPublishedCopies_InvalidInput(t *testing.T) {
+	from := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	newestCursor := encodeCursor(repository.CopySortNewest, &entity.Copy{ID: 1})
+
+	tests := []struct {
+		name    string
+		input   ListCopiesInput
+		wantErr error
+	}{
+		{
+			name:    "異常系_不正な並び順",
+			input:   ListCopiesInput{Sort: "oldest"},
+			wantErr: ErrInvalidSort,
+		},
+		{
+			name:    "異常系_件数の上限超過",
+			input:   ListCopiesInput{Limit: MaxPageSize + 1},
+			wantErr: ErrInvalidPageSize,
+		},
+		{
+			name:    "異常系_不正なカーソル",
+			input:   ListCopiesInput{Cursor: "not-a-cursor"},
+			wantErr: ErrInvalidCursor,
+		},
+		{
+			name:    "異常系_別の並び順のカーソル",
+			input:   ListCopiesInput{Sort: repository.CopySortTrending, Cursor: newestCursor},
+			wantErr: ErrInvalidCursor,
+		},
+		{
+			name:    "異常系_作成日時の範囲が逆",
+			input:   ListCopiesInput{Filter: repository.CopyFilter{CreatedFrom: &from, CreatedTo: &to}},
+			wantErr: ErrInvalidDateRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備（検証に失敗した場合はリポジトリを呼び出さない）
+			mockRepo := new(mockCopyRepository)
+
+			// ユースケースの初期化
+			u := NewUseCase(mockRepo, new(mockGenerator))
+
+			// テスト実行
+			_, err := u.GetPublishedCopies(context.Background(), tt.input)
+
+			// アサーション
+			assert.ErrorIs(t, err, tt.wantErr)
+			mockRepo.AssertNotCalled(t, "ListPublished", mock.Anything, mock.Anything)
+		})
+	}
+}
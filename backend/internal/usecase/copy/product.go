@@ -0,0 +1,46 @@
+package copy_usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/domainerr"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+var ErrInvalidProduct = domainerr.Validation("invalid_product", "product must be one of the registered products")
+
+// WithProducts: 商品カタログの取得元を設定
+//
+// 設定しない場合は商品を指定した生成をErrInvalidProductとします。
+func WithProducts(products repository.ProductRepository) Option {
+	return func(u *useCase) {
+		u.products = products
+	}
+}
+
+// fillProduct: 商品を指定した場合、商品名と空の特徴を商品カタログの内容で補う
+//
+// 特徴を入力した場合は入力を優先し、生成ごとに訴求点を変えられるようにします。
+func (u *useCase) fillProduct(ctx context.Context, input *CreateCopyInput) error {
+	if input.ProductID == nil {
+		return nil
+	}
+	if u.products == nil {
+		return ErrInvalidProduct.WithDetail("productId", *input.ProductID)
+	}
+
+	product, err := u.products.Get(ctx, *input.ProductID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return ErrInvalidProduct.WithDetail("productId", *input.ProductID)
+	}
+	if err != nil {
+		return err
+	}
+
+	input.ProductName = product.Name
+	if input.ProductFeatures == "" {
+		input.ProductFeatures = product.Features
+	}
+	return nil
+}
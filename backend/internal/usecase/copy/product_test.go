@@ -0,0 +1,115 @@
+package copy_usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/llm"
+)
+
+// 商品のリポジトリのモック（生成ではGetのみを使用する）
+type mockProductRepository struct {
+	repository.ProductRepository
+	mock.Mock
+}
+
+func (m *mockProductRepository) Get(ctx context.Context, id int) (*entity.Product, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Product), args.Error(1)
+}
+
+func TestCreateCopy_Product(t *testing.T) {
+	productID := 7
+	unknownID := 999
+	product := &entity.Product{ID: 7, SKU: "SKU-007", Name: "カタログ商品", Features: "軽量、防水"}
+
+	tests := []struct {
+		name         string
+		input        CreateCopyInput
+		opts         func(*mockProductRepository) []Option
+		wantName     string
+		wantFeatures string
+		wantErr      error
+	}{
+		{
+			name:  "正常系_商品名と特徴を商品の内容で補う",
+			input: CreateCopyInput{ProductID: &productID},
+			opts: func(products *mockProductRepository) []Option {
+				products.On("Get", mock.Anything, 7).Return(product, nil)
+				return []Option{WithProducts(products)}
+			},
+			wantName:     "カタログ商品",
+			wantFeatures: "軽量、防水",
+		},
+		{
+			name:  "正常系_入力した特徴を優先",
+			input: CreateCopyInput{ProductID: &productID, ProductName: "別の名前", ProductFeatures: "限定カラー"},
+			opts: func(products *mockProductRepository) []Option {
+				products.On("Get", mock.Anything, 7).Return(product, nil)
+				return []Option{WithProducts(products)}
+			},
+			wantName:     "カタログ商品",
+			wantFeatures: "限定カラー",
+		},
+		{
+			name:  "異常系_存在しない商品",
+			input: CreateCopyInput{ProductID: &unknownID},
+			opts: func(products *mockProductRepository) []Option {
+				products.On("Get", mock.Anything, 999).Return(nil, repository.ErrProductNotFound)
+				return []Option{WithProducts(products)}
+			},
+			wantErr: ErrInvalidProduct,
+		},
+		{
+			name:    "異常系_取得元を設定していない",
+			input:   CreateCopyInput{ProductID: &productID},
+			opts:    func(*mockProductRepository) []Option { return nil },
+			wantErr: ErrInvalidProduct,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			products := new(mockProductRepository)
+			mockRepo := new(mockCopyRepository)
+			mockRepo.On("CreateWithRevision", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			mockGen := new(mockGenerator)
+			mockGen.On("Generate", mock.Anything, mock.MatchedBy(func(req llm.Request) bool {
+				return req.Brief.ProductName == tt.wantName && req.Brief.ProductFeatures == tt.wantFeatures
+			})).Return(&llm.Response{
+				Title:       "テストタイトル",
+				Description: testDescription,
+				Content:     testSNSContent,
+			}, nil)
+
+			// テスト実行
+			input := tt.input
+			input.Target = "20代"
+			input.Channel = entity.ChannelSNS
+			input.Tone = entity.ToneTrust
+			u := NewUseCase(mockRepo, mockGen, tt.opts(products)...)
+			got, err := u.CreateCopy(context.Background(), input)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				mockGen.AssertNotCalled(t, "Generate", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, &productID, got.ProductID)
+			assert.Equal(t, tt.wantName, got.ProductName)
+			assert.Equal(t, tt.wantFeatures, got.ProductFeatures)
+			mockGen.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package copy_usecase
+
+import (
+	"context"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/domainerr"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/textdiff"
+)
+
+var ErrRevisionsUnavailable = domainerr.UpstreamUnavailable("revisions_unavailable", "revision history is not configured")
+
+// WithRevisionRepository: 版の保存先を設定（未設定の場合は版を記録しない）
+func WithRevisionRepository(revisions repository.CopyRevisionRepository) Option {
+	return func(u *useCase) {
+		u.revisions = revisions
+	}
+}
+
+// RevisionDiff: 2つの版の差分
+type RevisionDiff struct {
+	CopyID      int           `json:"copyId"`
+	From        int           `json:"from"`
+	To          int           `json:"to"`
+	Title       []textdiff.Op `json:"title"`
+	Description []textdiff.Op `json:"description"`
+	// Status: 状態の変更（変更がない場合はnil）
+	Status *StatusChange `json:"status,omitempty"`
+}
+
+// StatusChange: 状態の変更前後
+type StatusChange struct {
+	From entity.CopyStatus `json:"from"`
+	To   entity.CopyStatus `json:"to"`
+}
+
+// newRevision: コピーの現在の内容の版（版の保存先が未設定の場合はnil）
+func (u *useCase) newRevision(copy *entity.Copy, source entity.RevisionSource, author string, rollbackOf int) *entity.CopyRevision {
+	if u.revisions == nil {
+		return nil
+	}
+	revision := entity.NewCopyRevision(copy, source, author)
+	revision.RollbackOf = rollbackOf
+	return revision
+}
+
+// createCopy: コピーを保存し、同じトランザクションで生成時の出力を最初の版として記録
+func (u *useCase) createCopy(ctx context.Context, copy *entity.Copy) error {
+	return u.repo.CreateWithRevision(ctx, copy, u.newRevision(copy, entity.RevisionSourceGenerated, "", 0))
+}
+
+// createCopies: 複数のコピーを1トランザクションで保存し、同じトランザクションで生成時の出力をそれぞれの最初の版として記録
+func (u *useCase) createCopies(ctx context.Context, copies []*entity.Copy) error {
+	var revisions []*entity.CopyRevision
+	if u.revisions != nil {
+		revisions = make([]*entity.CopyRevision, len(copies))
+		for i, copy := range copies {
+			revisions[i] = u.newRevision(copy, entity.RevisionSourceGenerated, "", 0)
+		}
+	}
+	return u.repo.CreateBatchWithRevisions(ctx, copies, revisions)
+}
+
+// ListRevisions: コピーの版を古い順に取得（最初の版は生成時の出力）
+func (u *useCase) ListRevisions(ctx context.Context, copyID int) ([]*entity.CopyRevision, error) {
+	if u.revisions == nil {
+		return nil, ErrRevisionsUnavailable
+	}
+	if _, err := u.repo.Get(ctx, copyID); err != nil {
+		return nil, err
+	}
+	return u.revisions.List(ctx, copyID)
+}
+
+// DiffRevisions: 版fromから版toへの見出し・本文の文字単位の差分と状態の変更
+func (u *useCase) DiffRevisions(ctx context.Context, copyID, from, to int) (*RevisionDiff, error) {
+	if u.revisions == nil {
+		return nil, ErrRevisionsUnavailable
+	}
+	a, err := u.revisions.Get(ctx, copyID, from)
+	if err != nil {
+		return nil, err
+	}
+	b, err := u.revisions.Get(ctx, copyID, to)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &RevisionDiff{
+		CopyID:      copyID,
+		From:        from,
+		To:          to,
+		Title:       textdiff.Diff(a.Title, b.Title),
+		Description: textdiff.Diff(a.Description, b.Description),
+	}
+	if a.Status != b.Status {
+		diff.Status = &StatusChange{From: a.Status, To: b.Status}
+	}
+	return diff, nil
+}
+
+// RollbackCopy: コピーを指定した版の内容に戻す
+//
+// 履歴は書き換えず、指定した版と同じ見出し・本文の版を新しく作成します（状態は変更しない）。
+// 編集と同じく下書きのコピーのみ戻すことができ、戻した内容は表現を再チェックします。
+func (u *useCase) RollbackCopy(ctx context.Context, copyID, revision int, author string) (*entity.Copy, error) {
+	if u.revisions == nil {
+		return nil, ErrRevisionsUnavailable
+	}
+	copy, err := u.repo.Get(ctx, copyID)
+	if err != nil {
+		return nil, err
+	}
+	if !copy.Status.Editable() {
+		return nil, ErrCopyNotEditable.WithDetail("status", copy.Status)
+	}
+	rev, err := u.revisions.Get(ctx, copyID, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	copy.Title = rev.Title
+	copy.Description = rev.Description
+	copy.Content = rev.Content
+	brand, err := u.brandOf(ctx, copy)
+	if err != nil {
+		return nil, err
+	}
+	copy.Findings = u.inspect(copy, brand)
+
+	fields := []string{"Title", "Description", "Content", "Findings"}
+	rollback := u.newRevision(copy, entity.RevisionSourceRollback, author, revision)
+	if err := u.repo.UpdateWithRevision(ctx, copy, fields, rollback); err != nil {
+		return nil, err
+	}
+	if err := u.indexCopies(ctx, copy); err != nil {
+		return nil, err
+	}
+	return copy, nil
+}
@@ -0,0 +1,251 @@
+package copy_usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/llm"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/textdiff"
+)
+
+// 版のリポジトリのモック
+type mockRevisionRepository struct {
+	mock.Mock
+}
+
+func (m *mockRevisionRepository) Create(ctx context.Context, revision *entity.CopyRevision) error {
+	args := m.Called(ctx, revision)
+	return args.Error(0)
+}
+
+func (m *mockRevisionRepository) List(ctx context.Context, copyID int) ([]*entity.CopyRevision, error) {
+	args := m.Called(ctx, copyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.CopyRevision), args.Error(1)
+}
+
+func (m *mockRevisionRepository) Get(ctx context.Context, copyID, revision int) (*entity.CopyRevision, error) {
+	args := m.Called(ctx, copyID, revision)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.CopyRevision), args.Error(1)
+}
+
+func TestCreateCopy_Revision(t *testing.T) {
+	// モックの準備（生成時の出力を最初の版としてコピーと同じトランザクションで記録する）
+	mockRepo := new(mockCopyRepository)
+	mockGen := new(mockGenerator)
+	mockRevisions := new(mockRevisionRepository)
+	mockGen.On("Generate", mock.Anything, mock.Anything).Return(&llm.Response{
+		Title:       "テストタイトル",
+		Description: testDescription,
+		Content:     testSNSContent,
+	}, nil)
+	mockRepo.On("CreateWithRevision", mock.Anything, mock.Anything, mock.MatchedBy(func(r *entity.CopyRevision) bool {
+		return r.Source == entity.RevisionSourceGenerated && r.Title == "テストタイトル" && r.Author == ""
+	})).Return(nil)
+
+	// ユースケースの初期化
+	u := NewUseCase(mockRepo, mockGen, WithRevisionRepository(mockRevisions))
+
+	// テスト実行
+	_, err := u.CreateCopy(context.Background(), CreateCopyInput{
+		ProductName:     "テスト商品",
+		ProductFeatures: "高品質、使いやすい",
+		Target:          "20-30代女性",
+		Channel:         entity.ChannelSNS,
+		Tone:            entity.ToneCasual,
+	})
+
+	// アサーション
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockRevisions.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestUpdateCopy_Revision(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      UpdateCopyInput
+		wantRecord bool
+	}{
+		{
+			name:       "正常系_変更を版として記録",
+			input:      UpdateCopyInput{Title: stringPtr("新しいタイトル"), Author: "editor"},
+			wantRecord: true,
+		},
+		{
+			name:  "正常系_内容が同じ場合は記録しない",
+			input: UpdateCopyInput{Title: stringPtr("テストタイトル")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockRepo := new(mockCopyRepository)
+			mockRevisions := new(mockRevisionRepository)
+			mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{ID: 1, Title: "テストタイトル", Description: testDescription, Channel: entity.ChannelSNS, Status: entity.CopyStatusDraft}, nil)
+			// 版は更新と同じトランザクションで記録する
+			if tt.wantRecord {
+				mockRepo.On("UpdateWithRevision", mock.Anything, mock.Anything, mock.Anything, mock.MatchedBy(func(r *entity.CopyRevision) bool {
+					return r.CopyID == 1 && r.Source == entity.RevisionSourceEdited && r.Author == "editor" && r.Title == "新しいタイトル"
+				})).Return(nil)
+			} else {
+				mockRepo.On("UpdateWithRevision", mock.Anything, mock.Anything, mock.Anything, (*entity.CopyRevision)(nil)).Return(nil)
+			}
+
+			// ユースケースの初期化
+			u := NewUseCase(mockRepo, new(mockGenerator), WithRevisionRepository(mockRevisions))
+
+			// テスト実行
+			_, err := u.UpdateCopy(context.Background(), 1, tt.input)
+
+			// アサーション
+			assert.NoError(t, err)
+			mockRepo.AssertExpectations(t)
+			mockRevisions.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestListRevisions(t *testing.T) {
+	tests := []struct {
+		name    string
+		getErr  error
+		opts    func(*mockRevisionRepository) []Option
+		wantErr error
+	}{
+		{
+			name: "正常系",
+			opts: func(r *mockRevisionRepository) []Option { return []Option{WithRevisionRepository(r)} },
+		},
+		{
+			name:    "異常系_存在しないコピー",
+			getErr:  repository.ErrNotFound,
+			opts:    func(r *mockRevisionRepository) []Option { return []Option{WithRevisionRepository(r)} },
+			wantErr: repository.ErrNotFound,
+		},
+		{
+			name:    "異常系_版の保存先が未設定",
+			opts:    func(r *mockRevisionRepository) []Option { return nil },
+			wantErr: ErrRevisionsUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockRepo := new(mockCopyRepository)
+			mockRevisions := new(mockRevisionRepository)
+			if tt.getErr != nil {
+				mockRepo.On("Get", mock.Anything, 1).Return(nil, tt.getErr)
+			} else {
+				mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{ID: 1}, nil)
+			}
+			mockRevisions.On("List", mock.Anything, 1).Return([]*entity.CopyRevision{{CopyID: 1, Revision: 1}, {CopyID: 1, Revision: 2}}, nil)
+
+			// ユースケースの初期化
+			u := NewUseCase(mockRepo, new(mockGenerator), tt.opts(mockRevisions)...)
+
+			// テスト実行
+			got, err := u.ListRevisions(context.Background(), 1)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, got, 2)
+		})
+	}
+}
+
+func TestDiffRevisions(t *testing.T) {
+	// モックの準備
+	mockRevisions := new(mockRevisionRepository)
+	mockRevisions.On("Get", mock.Anything, 1, 1).Return(&entity.CopyRevision{CopyID: 1, Revision: 1, Title: "春の新作", Description: "本文", Status: entity.CopyStatusApproved}, nil)
+	mockRevisions.On("Get", mock.Anything, 1, 2).Return(&entity.CopyRevision{CopyID: 1, Revision: 2, Title: "夏の新作", Description: "本文", Status: entity.CopyStatusPublished}, nil)
+	mockRevisions.On("Get", mock.Anything, 1, 9).Return(nil, repository.ErrNotFound)
+
+	// ユースケースの初期化
+	u := NewUseCase(new(mockCopyRepository), new(mockGenerator), WithRevisionRepository(mockRevisions))
+
+	// テスト実行
+	got, err := u.DiffRevisions(context.Background(), 1, 1, 2)
+
+	// アサーション
+	assert.NoError(t, err)
+	assert.Equal(t, []textdiff.Op{
+		{Type: textdiff.OpDelete, Text: "春"},
+		{Type: textdiff.OpInsert, Text: "夏"},
+		{Type: textdiff.OpEqual, Text: "の新作"},
+	}, got.Title)
+	assert.Equal(t, []textdiff.Op{{Type: textdiff.OpEqual, Text: "本文"}}, got.Description)
+	assert.Equal(t, &StatusChange{From: entity.CopyStatusApproved, To: entity.CopyStatusPublished}, got.Status)
+
+	// 存在しない版
+	_, err = u.DiffRevisions(context.Background(), 1, 1, 9)
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+}
+
+func TestRollbackCopy(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  entity.CopyStatus
+		wantErr error
+	}{
+		{
+			name:   "正常系",
+			status: entity.CopyStatusDraft,
+		},
+		{
+			name:    "異常系_承認済みはロールバックできない",
+			status:  entity.CopyStatusApproved,
+			wantErr: ErrCopyNotEditable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備（履歴は書き換えず、ロールバックを新しい版として記録する）
+			mockRepo := new(mockCopyRepository)
+			mockRevisions := new(mockRevisionRepository)
+			mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{ID: 1, Title: "編集したタイトル", Description: testDescription, Channel: entity.ChannelSNS, Status: tt.status}, nil)
+			mockRevisions.On("Get", mock.Anything, 1, 1).Return(&entity.CopyRevision{CopyID: 1, Revision: 1, Title: "生成したタイトル", Description: testDescription, Status: entity.CopyStatusPublished}, nil)
+			if tt.wantErr == nil {
+				mockRepo.On("UpdateWithRevision", mock.Anything, mock.Anything, []string{"Title", "Description", "Content", "Findings"}, mock.MatchedBy(func(r *entity.CopyRevision) bool {
+					return r.Source == entity.RevisionSourceRollback && r.RollbackOf == 1 && r.Author == "editor" && r.Title == "生成したタイトル"
+				})).Return(nil)
+			}
+
+			// ユースケースの初期化
+			u := NewUseCase(mockRepo, new(mockGenerator), WithRevisionRepository(mockRevisions))
+
+			// テスト実行
+			got, err := u.RollbackCopy(context.Background(), 1, 1, "editor")
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				mockRepo.AssertNotCalled(t, "UpdateWithRevision", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "生成したタイトル", got.Title)
+			// ロールバックしても状態は変えない
+			assert.Equal(t, entity.CopyStatusDraft, got.Status)
+			mockRepo.AssertExpectations(t)
+			mockRevisions.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package copy_usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/domainerr"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+// schedulerActor: 公開日時・公開終了日時による状態の変更を版に記録する際の操作者
+const schedulerActor = "scheduler"
+
+var (
+	ErrInvalidSchedule = domainerr.Validation("invalid_schedule", "expireAt must be after publishAt and the current time")
+	ErrNotSchedulable  = domainerr.Conflict("copy_not_schedulable", "archived copy cannot be scheduled")
+	ErrCopyExpired     = domainerr.Conflict("copy_expired", "copy cannot be published after its expireAt")
+)
+
+// WithClock: 公開日時・公開終了日時の判定に使う現在時刻を設定（テスト用）
+func WithClock(now func() time.Time) Option {
+	return func(u *useCase) {
+		if now != nil {
+			u.now = now
+		}
+	}
+}
+
+// ScheduleInput: 公開日時・公開終了日時の設定内容（nilの場合は設定を解除する）
+type ScheduleInput struct {
+	PublishAt *time.Time
+	ExpireAt  *time.Time
+	// Actor: 設定した操作者（版に記録する）
+	Actor string
+}
+
+// ScheduleResult: RunScheduleで状態を変更したコピーのID
+type ScheduleResult struct {
+	// Published: 公開日時になり公開したコピー
+	Published []int
+	// Archived: 公開終了日時になりアーカイブしたコピー
+	Archived []int
+}
+
+// Schedule: コピーの公開日時・公開終了日時を設定する
+//
+// 承認済みのコピーは公開日時にRunScheduleで公開され、公開済みのコピーは公開終了日時にアーカイブされます。
+// アーカイブしたコピーには設定できません。取得してから更新するまでに状態が変わった場合はErrCopyStatusChangedを返します。
+func (u *useCase) Schedule(ctx context.Context, id int, input ScheduleInput) (*entity.Copy, error) {
+	if input.ExpireAt != nil {
+		if input.PublishAt != nil && !input.ExpireAt.After(*input.PublishAt) {
+			return nil, ErrInvalidSchedule.WithDetail("publishAt", *input.PublishAt).WithDetail("expireAt", *input.ExpireAt)
+		}
+		if !input.ExpireAt.After(u.now()) {
+			return nil, ErrInvalidSchedule.WithDetail("expireAt", *input.ExpireAt)
+		}
+	}
+
+	copy, err := u.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if copy.Status == entity.CopyStatusArchived {
+		return nil, ErrNotSchedulable.WithDetail("status", copy.Status)
+	}
+
+	// 取得した時点の状態を条件に更新し、その間にアーカイブ・公開されたコピーには設定しない
+	guard := repository.CopyStatusGuard{Status: copy.Status}
+	copy.PublishAt = input.PublishAt
+	copy.ExpireAt = input.ExpireAt
+	revision := u.newRevision(copy, entity.RevisionSourceSchedule, strings.TrimSpace(input.Actor), 0)
+	if err := u.repo.UpdateStatus(ctx, copy, guard, []string{"PublishAt", "ExpireAt"}, revision); err != nil {
+		return nil, err
+	}
+	// 公開日時・公開終了日時で検索の対象が変わるため、索引も更新する
+	if err := u.indexCopies(ctx, copy); err != nil {
+		return nil, err
+	}
+	return copy, nil
+}
+
+// RunSchedule: 公開日時になった承認済みのコピーを公開し、公開終了日時になった公開済みのコピーをアーカイブする
+//
+// 状態の変更はschedulerの版として記録します。公開する前に公開終了日時を過ぎたコピーはアーカイブします。
+// 一覧で取得した時点の状態のままで、公開日時（公開終了日時）が現在時刻以前の場合のみ変更します。
+// 条件の確認と保存は1つのUPDATE文で行うため、複数のスケジューラーが同時に実行しても同じコピーを
+// 公開・アーカイブするのは1回だけで、一覧の取得後に公開日時を変更したコピーを早く公開することもありません。
+// 他の操作・スケジューラーで既に変わっていたコピーは対象外とし、それ以外で失敗したコピーのエラーはまとめて返します。
+func (u *useCase) RunSchedule(ctx context.Context) (*ScheduleResult, error) {
+	now := u.now()
+	result := &ScheduleResult{Published: []int{}, Archived: []int{}}
+	var errs []error
+
+	run := func(ids *[]int, listed *entity.Copy, guard repository.CopyStatusGuard, next entity.CopyStatus, apply func(*entity.Copy) ([]string, error)) {
+		copy, err := u.repo.Get(ctx, listed.ID)
+		if err == nil {
+			_, err = u.transitionCopy(ctx, copy, guard, next, entity.RevisionSourceScheduler, WorkflowInput{Actor: schedulerActor}, apply)
+		}
+		switch {
+		case err == nil:
+			*ids = append(*ids, listed.ID)
+		case errors.Is(err, ErrInvalidTransition):
+			// 一覧の取得後に他の操作・スケジューラーで状態・日時が変わったコピーは対象外
+		default:
+			errs = append(errs, fmt.Errorf("copy %d: %w", listed.ID, err))
+		}
+	}
+
+	due, err := u.repo.ListDueToPublish(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	for _, copy := range due {
+		guard := repository.CopyStatusGuard{Status: copy.Status, PublishDue: &now}
+		if copy.ExpireAt != nil && !now.Before(*copy.ExpireAt) {
+			guard.ExpireDue = &now
+			run(&result.Archived, copy, guard, entity.CopyStatusArchived, nil)
+			continue
+		}
+		run(&result.Published, copy, guard, entity.CopyStatusPublished, u.checkPublishable)
+	}
+
+	expired, err := u.repo.ListDueToExpire(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	for _, copy := range expired {
+		run(&result.Archived, copy, repository.CopyStatusGuard{Status: copy.Status, ExpireDue: &now}, entity.CopyStatusArchived, nil)
+	}
+
+	return result, errors.Join(errs...)
+}
@@ -0,0 +1,272 @@
+package copy_usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+func TestSchedule(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	publishAt := now.Add(24 * time.Hour)
+	expireAt := now.Add(7 * 24 * time.Hour)
+	past := now.Add(-time.Hour)
+
+	tests := []struct {
+		name      string
+		status    entity.CopyStatus
+		input     ScheduleInput
+		getErr    error
+		updateErr error
+		wantErr   error
+	}{
+		{
+			name:   "正常系_公開日時と公開終了日時",
+			status: entity.CopyStatusApproved,
+			input:  ScheduleInput{PublishAt: &publishAt, ExpireAt: &expireAt, Actor: "tanaka"},
+		},
+		{
+			name:   "正常系_公開済みのコピーの公開終了日時のみ",
+			status: entity.CopyStatusPublished,
+			input:  ScheduleInput{ExpireAt: &expireAt},
+		},
+		{
+			name:   "正常系_設定の解除",
+			status: entity.CopyStatusDraft,
+			input:  ScheduleInput{},
+		},
+		{
+			name:    "異常系_公開終了日時が公開日時より前",
+			status:  entity.CopyStatusApproved,
+			input:   ScheduleInput{PublishAt: &expireAt, ExpireAt: &publishAt},
+			wantErr: ErrInvalidSchedule,
+		},
+		{
+			name:    "異常系_公開終了日時が過去",
+			status:  entity.CopyStatusPublished,
+			input:   ScheduleInput{ExpireAt: &past},
+			wantErr: ErrInvalidSchedule,
+		},
+		{
+			name:    "異常系_アーカイブ済み",
+			status:  entity.CopyStatusArchived,
+			input:   ScheduleInput{PublishAt: &publishAt},
+			wantErr: ErrNotSchedulable,
+		},
+		{
+			name:    "異常系_存在しないコピー",
+			input:   ScheduleInput{PublishAt: &publishAt},
+			getErr:  repository.ErrNotFound,
+			wantErr: repository.ErrNotFound,
+		},
+		{
+			name:      "異常系_取得後に他の操作で状態が変更された",
+			status:    entity.CopyStatusApproved,
+			input:     ScheduleInput{PublishAt: &publishAt},
+			updateErr: repository.ErrCopyStatusChanged,
+			wantErr:   repository.ErrCopyStatusChanged,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockRepo := new(mockCopyRepository)
+			if tt.getErr != nil {
+				mockRepo.On("Get", mock.Anything, 1).Return(nil, tt.getErr)
+			} else {
+				mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{ID: 1, Status: tt.status, PublishAt: &past}, nil)
+			}
+			// 取得時の状態のままの場合のみ更新し、設定した日時と操作者を同じトランザクションで版に記録する
+			mockRepo.On("UpdateStatus", mock.Anything, mock.Anything, repository.CopyStatusGuard{Status: tt.status}, []string{"PublishAt", "ExpireAt"}, mock.MatchedBy(func(r *entity.CopyRevision) bool {
+				return r.Source == entity.RevisionSourceSchedule && r.Author == tt.input.Actor &&
+					r.PublishAt == tt.input.PublishAt && r.ExpireAt == tt.input.ExpireAt
+			})).Return(tt.updateErr)
+
+			// ユースケースの初期化
+			u := NewUseCase(mockRepo, new(mockGenerator), WithRevisionRepository(new(mockRevisionRepository)), WithClock(func() time.Time { return now }))
+
+			// テスト実行
+			got, err := u.Schedule(context.Background(), 1, tt.input)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				if tt.updateErr == nil {
+					mockRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			// 状態は変更しない
+			assert.Equal(t, tt.status, got.Status)
+			assert.Equal(t, tt.input.PublishAt, got.PublishAt)
+			assert.Equal(t, tt.input.ExpireAt, got.ExpireAt)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRunSchedule(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	// モックの準備
+	// 1: 公開日時になった承認済みのコピー
+	// 2: 公開日時と公開終了日時の両方を過ぎた承認済みのコピー（公開せずにアーカイブする）
+	// 3: 一覧の取得後に他の操作で下書きに戻されたコピー（対象外）
+	// 4: 公開終了日時になった公開済みのコピー
+	// 5: 保存に失敗するコピー
+	mockRepo := new(mockCopyRepository)
+	mockRepo.On("ListDueToPublish", mock.Anything, now).Return([]*entity.Copy{
+		{ID: 1, Status: entity.CopyStatusApproved, PublishAt: &past, ExpireAt: &future},
+		{ID: 2, Status: entity.CopyStatusApproved, PublishAt: &past, ExpireAt: &now},
+		{ID: 3, Status: entity.CopyStatusApproved, PublishAt: &past},
+	}, nil)
+	mockRepo.On("ListDueToExpire", mock.Anything, now).Return([]*entity.Copy{
+		{ID: 4, Status: entity.CopyStatusPublished, ExpireAt: &past},
+		{ID: 5, Status: entity.CopyStatusPublished, ExpireAt: &past},
+	}, nil)
+	mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{ID: 1, Status: entity.CopyStatusApproved, PublishAt: &past, ExpireAt: &future}, nil)
+	mockRepo.On("Get", mock.Anything, 2).Return(&entity.Copy{ID: 2, Status: entity.CopyStatusApproved, PublishAt: &past, ExpireAt: &now}, nil)
+	mockRepo.On("Get", mock.Anything, 3).Return(&entity.Copy{ID: 3, Status: entity.CopyStatusDraft, PublishAt: &past}, nil)
+	mockRepo.On("Get", mock.Anything, 4).Return(&entity.Copy{ID: 4, Status: entity.CopyStatusPublished, ExpireAt: &past}, nil)
+	mockRepo.On("Get", mock.Anything, 5).Return(&entity.Copy{ID: 5, Status: entity.CopyStatusPublished, ExpireAt: &past}, nil)
+	mockRepo.On("UpdateStatus", mock.Anything, mock.MatchedBy(func(c *entity.Copy) bool { return c.ID == 5 }), mock.Anything, []string{"Status"}, mock.Anything).Return(errors.New("database error"))
+	mockRepo.On("UpdateStatus", mock.Anything, mock.Anything, mock.Anything, []string{"Status"}, mock.Anything).Return(nil)
+	mockRevisions := new(mockRevisionRepository)
+
+	// ユースケースの初期化
+	u := NewUseCase(mockRepo, new(mockGenerator), WithRevisionRepository(mockRevisions), WithClock(func() time.Time { return now }))
+
+	// テスト実行
+	got, err := u.RunSchedule(context.Background())
+
+	// アサーション
+	assert.ErrorContains(t, err, "copy 5: database error")
+	assert.Equal(t, []int{1}, got.Published)
+	assert.Equal(t, []int{2, 4}, got.Archived)
+	// 一覧の時点の状態と公開日時・公開終了日時を条件に保存し、状態の変更はschedulerの版として記録する
+	mockRepo.AssertNumberOfCalls(t, "UpdateStatus", 4)
+	wantGuards := map[int]repository.CopyStatusGuard{
+		1: {Status: entity.CopyStatusApproved, PublishDue: &now},
+		2: {Status: entity.CopyStatusApproved, PublishDue: &now, ExpireDue: &now},
+		4: {Status: entity.CopyStatusPublished, ExpireDue: &now},
+		5: {Status: entity.CopyStatusPublished, ExpireDue: &now},
+	}
+	for _, call := range mockRepo.Calls {
+		if call.Method != "UpdateStatus" {
+			continue
+		}
+		copy := call.Arguments.Get(1).(*entity.Copy)
+		assert.Equal(t, wantGuards[copy.ID], call.Arguments.Get(2))
+		revision := call.Arguments.Get(4).(*entity.CopyRevision)
+		assert.Equal(t, entity.RevisionSourceScheduler, revision.Source)
+		assert.Equal(t, "scheduler", revision.Author)
+	}
+}
+
+func TestRunSchedule_Concurrent(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+
+	// モックの準備（取得した時点では承認済みだが、保存までに他のスケジューラーが公開したコピー）
+	mockRepo := new(mockCopyRepository)
+	mockRepo.On("ListDueToPublish", mock.Anything, now).Return([]*entity.Copy{{ID: 1, Status: entity.CopyStatusApproved, PublishAt: &past}}, nil)
+	mockRepo.On("ListDueToExpire", mock.Anything, now).Return([]*entity.Copy{}, nil)
+	mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{ID: 1, Status: entity.CopyStatusApproved, PublishAt: &past}, nil)
+	mockRepo.On("UpdateStatus", mock.Anything, mock.Anything, repository.CopyStatusGuard{Status: entity.CopyStatusApproved, PublishDue: &now}, []string{"Status"}, mock.Anything).Return(repository.ErrCopyStatusChanged)
+	mockSearch := new(mockSearchIndex)
+
+	// ユースケースの初期化
+	u := NewUseCase(mockRepo, new(mockGenerator),
+		WithRevisionRepository(new(mockRevisionRepository)),
+		WithSearchIndex(mockSearch),
+		WithClock(func() time.Time { return now }),
+	)
+
+	// テスト実行
+	got, err := u.RunSchedule(context.Background())
+
+	// アサーション（エラーにせず、公開したコピーとしても数えない）
+	assert.NoError(t, err)
+	assert.Empty(t, got.Published)
+	mockSearch.AssertNotCalled(t, "Index", mock.Anything, mock.Anything)
+}
+
+func TestRunSchedule_ChangedAfterList(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	later := now.Add(24 * time.Hour)
+
+	// モックの準備
+	// 1: 一覧の取得後に公開日時を翌日に変更したコピー（保存の条件を満たさない）
+	// 2: 一覧の取得後に下書きに戻された公開済みのコピー（保存しない）
+	mockRepo := new(mockCopyRepository)
+	mockRepo.On("ListDueToPublish", mock.Anything, now).Return([]*entity.Copy{{ID: 1, Status: entity.CopyStatusApproved, PublishAt: &past}}, nil)
+	mockRepo.On("ListDueToExpire", mock.Anything, now).Return([]*entity.Copy{{ID: 2, Status: entity.CopyStatusPublished, ExpireAt: &past}}, nil)
+	mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{ID: 1, Status: entity.CopyStatusApproved, PublishAt: &later}, nil)
+	mockRepo.On("Get", mock.Anything, 2).Return(&entity.Copy{ID: 2, Status: entity.CopyStatusDraft, ExpireAt: &past}, nil)
+	mockRepo.On("UpdateStatus", mock.Anything, mock.Anything, repository.CopyStatusGuard{Status: entity.CopyStatusApproved, PublishDue: &now}, []string{"Status"}, mock.Anything).Return(repository.ErrCopyStatusChanged)
+	mockSearch := new(mockSearchIndex)
+
+	// ユースケースの初期化
+	u := NewUseCase(mockRepo, new(mockGenerator),
+		WithRevisionRepository(new(mockRevisionRepository)),
+		WithSearchIndex(mockSearch),
+		WithClock(func() time.Time { return now }),
+	)
+
+	// テスト実行
+	got, err := u.RunSchedule(context.Background())
+
+	// アサーション（どちらも公開・アーカイブせず、エラーにもしない）
+	assert.NoError(t, err)
+	assert.Empty(t, got.Published)
+	assert.Empty(t, got.Archived)
+	mockRepo.AssertNumberOfCalls(t, "UpdateStatus", 1)
+	mockSearch.AssertNotCalled(t, "Index", mock.Anything, mock.Anything)
+}
+
+func TestRunSchedule_ListError(t *testing.T) {
+	// モックの準備
+	mockRepo := new(mockCopyRepository)
+	mockRepo.On("ListDueToPublish", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
+
+	// テスト実行
+	got, err := NewUseCase(mockRepo, new(mockGenerator)).RunSchedule(context.Background())
+
+	// アサーション
+	assert.Error(t, err)
+	assert.Nil(t, got)
+	mockRepo.AssertNotCalled(t, "ListDueToExpire", mock.Anything, mock.Anything)
+}
+
+func TestPublish_Expired(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	expireAt := now.Add(-time.Minute)
+
+	// モックの準備（公開終了日時を過ぎた承認済みのコピー）
+	mockRepo := new(mockCopyRepository)
+	mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{ID: 1, Status: entity.CopyStatusApproved, ExpireAt: &expireAt}, nil)
+
+	// ユースケースの初期化
+	u := NewUseCase(mockRepo, new(mockGenerator), WithClock(func() time.Time { return now }))
+
+	// テスト実行
+	got, err := u.Publish(context.Background(), 1, WorkflowInput{Actor: "tanaka"})
+
+	// アサーション
+	assert.ErrorIs(t, err, ErrCopyExpired)
+	assert.Nil(t, got)
+	mockRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,109 @@
+package copy_usecase
+
+import (
+	"math"
+	"strings"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/textmetrics"
+)
+
+const (
+	lengthFitWeight       = 0.4
+	keywordCoverageWeight = 0.4
+	toneMatchWeight       = 0.2
+)
+
+// Score: 候補のスコア（各項目は0〜1）
+type Score struct {
+	LengthFit       float64 `json:"lengthFit"`
+	KeywordCoverage float64 `json:"keywordCoverage"`
+	ToneMatch       float64 `json:"toneMatch"`
+	Total           float64 `json:"total"`
+}
+
+// keywordSeparators: 商品特徴をキーワードに分割する区切り文字
+var keywordSeparators = []string{"、", "，", ",", "・", "/", "／", "。", " ", "　", "\n"}
+
+// scoreCopy: 文字数の適合度・商品特徴の網羅率・トーンの一致度から候補を採点
+//
+// 文字数の適合度はチャネルの形式の制限に対して、トーンの一致度はトーンの定義の表現（Markers）で計算します。
+func scoreCopy(copy *entity.Copy, defs *definitions) Score {
+	score := Score{
+		LengthFit:       lengthFit(formatFor(defs.channel), copy.Title, copy.Description),
+		KeywordCoverage: keywordCoverage(copy.ProductFeatures, copy.Title+copy.Description),
+		ToneMatch:       toneMatch(defs.tone, copy.Title+copy.Description),
+	}
+	score.Total = round(
+		score.LengthFit*lengthFitWeight +
+			score.KeywordCoverage*keywordCoverageWeight +
+			score.ToneMatch*toneMatchWeight,
+	)
+	return score
+}
+
+func lengthFit(f channelFormat, title, description string) float64 {
+	return round((rangeFit(title, f.title) + rangeFit(description, f.description)) / 2)
+}
+
+func rangeFit(value string, limit textmetrics.Limit) float64 {
+	n := textmetrics.Count(value, limit.Unit)
+	switch {
+	case n == 0:
+		return 0
+	case n < limit.Min:
+		return float64(n) / float64(limit.Min)
+	case n > limit.Max:
+		return float64(limit.Max) / float64(n)
+	}
+	return 1
+}
+
+func keywordCoverage(features, text string) float64 {
+	keywords := splitKeywords(features)
+	if len(keywords) == 0 {
+		return 1
+	}
+
+	hits := 0
+	for _, keyword := range keywords {
+		if strings.Contains(text, keyword) {
+			hits++
+		}
+	}
+	return round(float64(hits) / float64(len(keywords)))
+}
+
+func splitKeywords(features string) []string {
+	normalized := features
+	for _, sep := range keywordSeparators {
+		normalized = strings.ReplaceAll(normalized, sep, "\x00")
+	}
+
+	var keywords []string
+	for _, keyword := range strings.Split(normalized, "\x00") {
+		if keyword = strings.TrimSpace(keyword); keyword != "" {
+			keywords = append(keywords, keyword)
+		}
+	}
+	return keywords
+}
+
+func toneMatch(tone *entity.ToneDefinition, text string) float64 {
+	if tone == nil || len(tone.Markers) == 0 {
+		return 0
+	}
+
+	// 2種類以上の表現が含まれていれば満点とする
+	hits := 0
+	for _, marker := range tone.Markers {
+		if strings.Contains(text, marker) {
+			hits++
+		}
+	}
+	return round(math.Min(float64(hits)/2, 1))
+}
+
+func round(v float64) float64 {
+	return math.Round(v*1000) / 1000
+}
@@ -0,0 +1,84 @@
+package copy_usecase
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+)
+
+func TestScoreCopy(t *testing.T) {
+	// 50文字ちょうどの本文
+	validDescription := strings.Repeat("あ", 30) + "高品質で使いやすいのが魅力です。今すぐチェック！"
+
+	tests := []struct {
+		name string
+		copy *entity.Copy
+		want Score
+	}{
+		{
+			name: "すべての条件を満たす",
+			copy: &entity.Copy{
+				Title:           "ワクワクの新商品！",
+				Description:     validDescription,
+				ProductFeatures: "高品質、使いやすい",
+				Tone:            entity.TonePop,
+			},
+			want: Score{LengthFit: 1, KeywordCoverage: 1, ToneMatch: 1, Total: 1},
+		},
+		{
+			name: "タイトルが長く特徴を含まない",
+			copy: &entity.Copy{
+				Title:           strings.Repeat("長", 40),
+				Description:     validDescription,
+				ProductFeatures: "軽量・防水",
+				Tone:            entity.ToneLuxury,
+			},
+			want: Score{LengthFit: 0.75, KeywordCoverage: 0, ToneMatch: 0, Total: 0.3},
+		},
+		{
+			name: "本文が短く特徴を一部含む",
+			copy: &entity.Copy{
+				Title:           "安心の品質",
+				Description:     strings.Repeat("い", 20) + "軽量",
+				ProductFeatures: "軽量, 防水",
+				Tone:            entity.ToneTrust,
+			},
+			want: Score{LengthFit: 0.72, KeywordCoverage: 0.5, ToneMatch: 1, Total: 0.688},
+		},
+		{
+			name: "特徴が空の場合は網羅率を満点とする",
+			copy: &entity.Copy{
+				Title:       "タイトル",
+				Description: validDescription,
+				Tone:        entity.ToneValue,
+			},
+			want: Score{LengthFit: 1, KeywordCoverage: 1, ToneMatch: 0, Total: 0.8},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			channel, _ := entity.BuiltinChannel(tt.copy.Channel)
+			tone, _ := entity.BuiltinTone(tt.copy.Tone)
+			assert.Equal(t, tt.want, scoreCopy(tt.copy, &definitions{channel: channel, tone: tone}))
+		})
+	}
+}
+
+func TestToneMatch(t *testing.T) {
+	urgent := &entity.ToneDefinition{ID: "urgent", Label: "限定感", Markers: []string{"限定", "残りわずか"}}
+
+	assert.Equal(t, 1.0, toneMatch(urgent, "数量限定の特別セット、残りわずか"))
+	assert.Equal(t, 0.5, toneMatch(urgent, "数量限定の特別セット"))
+	assert.Equal(t, 0.0, toneMatch(urgent, "いつでも買える定番品"))
+	// トーンの定義がない（削除済みなど）場合は判定しない
+	assert.Equal(t, 0.0, toneMatch(nil, "数量限定の特別セット"))
+}
+
+func TestSplitKeywords(t *testing.T) {
+	assert.Equal(t, []string{"高品質", "使いやすい", "軽量", "防水"}, splitKeywords("高品質、使いやすい・軽量 / 防水"))
+	assert.Nil(t, splitKeywords(" 、 "))
+}
@@ -0,0 +1,156 @@
+package copy_usecase
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/domainerr"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/textsearch"
+)
+
+// MaxSearchQueryLength: 検索クエリの文字数の上限
+const MaxSearchQueryLength = 100
+
+var (
+	ErrSearchUnavailable = domainerr.UpstreamUnavailable("search_unavailable", "search index is not configured")
+	ErrEmptyQuery        = domainerr.Validation("empty_query", "q must contain at least one search term")
+	ErrQueryTooLong      = domainerr.Validation("query_too_long", fmt.Sprintf("q must be at most %d characters", MaxSearchQueryLength))
+	ErrInvalidOffset     = domainerr.Validation("invalid_offset", "offset must not be negative")
+)
+
+// WithSearchIndex: 全文検索の索引を設定（未設定の場合は検索できない）
+func WithSearchIndex(index repository.CopySearchIndex) Option {
+	return func(u *useCase) {
+		u.search = index
+	}
+}
+
+// SearchCopiesInput: コピーの全文検索の条件
+type SearchCopiesInput struct {
+	// Query: 空白で区切った検索語（すべてを含むコピーを対象にする）
+	Query string
+	// IncludeUnpublished: 未公開のコピーも対象にするかどうか（既定は公開済みのみ）
+	IncludeUnpublished bool
+	// Limit: 1ページの件数（0の場合はDefaultPageSize）
+	Limit  int
+	Offset int
+}
+
+// SearchHighlight: 項目ごとの検索語に一致した箇所
+type SearchHighlight struct {
+	Field     string                `json:"field"`
+	Fragments []textsearch.Fragment `json:"fragments"`
+}
+
+// SearchResult: 検索に一致したコピー
+type SearchResult struct {
+	Copy       *entity.Copy      `json:"copy"`
+	Score      float64           `json:"score"`
+	Highlights []SearchHighlight `json:"highlights"`
+}
+
+// SearchPage: 検索結果の1ページ
+type SearchPage struct {
+	Results []SearchResult `json:"results"`
+	HasMore bool           `json:"hasMore"`
+}
+
+// highlightFields: 一致した箇所を抽出する項目（JSONの項目名）
+var highlightFields = []struct {
+	name  string
+	value func(copy *entity.Copy) string
+}{
+	{name: "title", value: func(c *entity.Copy) string { return c.Title }},
+	{name: "description", value: func(c *entity.Copy) string { return c.Description }},
+	{name: "productName", value: func(c *entity.Copy) string { return c.ProductName }},
+	{name: "productFeatures", value: func(c *entity.Copy) string { return c.ProductFeatures }},
+}
+
+// SearchCopies: コピーをタイトル・説明文・商品名・商品の特徴で検索
+//
+// 1件多く取得して次のページの有無を判定し、一致した箇所を項目ごとに抽出します。
+// 索引に残っていても削除済みのコピーは結果に含めません。
+func (u *useCase) SearchCopies(ctx context.Context, input SearchCopiesInput) (*SearchPage, error) {
+	if u.search == nil {
+		return nil, ErrSearchUnavailable
+	}
+	if utf8.RuneCountInString(input.Query) > MaxSearchQueryLength {
+		return nil, ErrQueryTooLong
+	}
+	terms := textsearch.Terms(input.Query)
+	if len(terms) == 0 {
+		return nil, ErrEmptyQuery
+	}
+	if input.Limit == 0 {
+		input.Limit = DefaultPageSize
+	}
+	if input.Limit < 1 || input.Limit > MaxPageSize {
+		return nil, ErrInvalidPageSize
+	}
+	if input.Offset < 0 {
+		return nil, ErrInvalidOffset
+	}
+
+	hits, err := u.search.Search(ctx, repository.CopySearchQuery{
+		Terms:         terms,
+		PublishedOnly: !input.IncludeUnpublished,
+		Now:           u.now(),
+		Limit:         input.Limit + 1,
+		Offset:        input.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	page := &SearchPage{Results: []SearchResult{}}
+	if len(hits) > input.Limit {
+		hits = hits[:input.Limit]
+		page.HasMore = true
+	}
+
+	ids := make([]int, len(hits))
+	scores := make(map[int]float64, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.ID
+		scores[hit.ID] = hit.Score
+	}
+	copies, err := u.repo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, copy := range copies {
+		result := SearchResult{Copy: copy, Score: scores[copy.ID], Highlights: []SearchHighlight{}}
+		for _, field := range highlightFields {
+			if fragments := textsearch.Highlight(field.value(copy), terms, textsearch.DefaultFragmentSize); len(fragments) > 0 {
+				result.Highlights = append(result.Highlights, SearchHighlight{Field: field.name, Fragments: fragments})
+			}
+		}
+		page.Results = append(page.Results, result)
+	}
+	return page, nil
+}
+
+// indexCopies: コピーを検索の索引に反映（索引が未設定の場合は何もしない）
+func (u *useCase) indexCopies(ctx context.Context, copies ...*entity.Copy) error {
+	if u.search == nil {
+		return nil
+	}
+	for _, copy := range copies {
+		if err := u.search.Index(ctx, copy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unindexCopy: コピーを検索の索引から除く（索引が未設定の場合は何もしない）
+func (u *useCase) unindexCopy(ctx context.Context, id int) error {
+	if u.search == nil {
+		return nil
+	}
+	return u.search.Remove(ctx, id)
+}
@@ -0,0 +1,205 @@
+package copy_usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/textsearch"
+)
+
+// 検索の索引のモック
+type mockSearchIndex struct {
+	mock.Mock
+}
+
+func (m *mockSearchIndex) Index(ctx context.Context, copy *entity.Copy) error {
+	args := m.Called(ctx, copy)
+	return args.Error(0)
+}
+
+func (m *mockSearchIndex) Remove(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *mockSearchIndex) Search(ctx context.Context, query repository.CopySearchQuery) ([]repository.CopySearchHit, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]repository.CopySearchHit), args.Error(1)
+}
+
+func TestSearchCopies(t *testing.T) {
+	copies := []*entity.Copy{
+		{ID: 2, Title: "夏のセール開催中", Description: "涼しいアイテムが揃っています", ProductName: "ハンディファン", Status: entity.CopyStatusPublished},
+		{ID: 1, Title: "新作登場", Description: "この夏のセールで人気の一品", ProductName: "デスクファン", Status: entity.CopyStatusPublished},
+	}
+
+	// モックの準備（2件+次のページの有無の判定用の1件を返す）
+	mockRepo := new(mockCopyRepository)
+	mockSearch := new(mockSearchIndex)
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	mockSearch.On("Search", mock.Anything, repository.CopySearchQuery{
+		Terms:         []string{"夏", "セール"},
+		PublishedOnly: true,
+		Now:           now,
+		Limit:         3,
+		Offset:        2,
+	}).Return([]repository.CopySearchHit{{ID: 2, Score: 6}, {ID: 1, Score: 2}, {ID: 5, Score: 1}}, nil)
+	mockRepo.On("GetByIDs", mock.Anything, []int{2, 1}).Return(copies, nil)
+
+	u := NewUseCase(mockRepo, new(mockGenerator), WithSearchIndex(mockSearch), WithClock(func() time.Time { return now }))
+
+	// テスト実行（全角の空白で区切る）
+	page, err := u.SearchCopies(context.Background(), SearchCopiesInput{Query: "夏　セール", Limit: 2, Offset: 2})
+
+	// アサーション
+	assert.NoError(t, err)
+	assert.True(t, page.HasMore)
+	assert.Len(t, page.Results, 2)
+	assert.Equal(t, copies[0], page.Results[0].Copy)
+	assert.Equal(t, float64(6), page.Results[0].Score)
+	assert.Equal(t, []SearchHighlight{
+		{Field: "title", Fragments: []textsearch.Fragment{
+			{Text: "夏のセール開催中", Matches: []textsearch.Range{{Start: 0, End: 1}, {Start: 2, End: 5}}},
+		}},
+	}, page.Results[0].Highlights)
+	assert.Equal(t, []SearchHighlight{
+		{Field: "description", Fragments: []textsearch.Fragment{
+			{Text: "この夏のセールで人気の一品", Matches: []textsearch.Range{{Start: 2, End: 3}, {Start: 4, End: 7}}},
+		}},
+	}, page.Results[1].Highlights)
+	mockRepo.AssertExpectations(t)
+	mockSearch.AssertExpectations(t)
+}
+
+func TestSearchCopies_InvalidInput(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []Option
+		input   SearchCopiesInput
+		wantErr error
+	}{
+		{
+			name:    "異常系_索引が未設定",
+			input:   SearchCopiesInput{Query: "夏"},
+			wantErr: ErrSearchUnavailable,
+		},
+		{
+			name:    "異常系_検索語なし",
+			opts:    []Option{WithSearchIndex(new(mockSearchIndex))},
+			input:   SearchCopiesInput{Query: "　！"},
+			wantErr: ErrEmptyQuery,
+		},
+		{
+			name:    "異常系_クエリが長すぎる",
+			opts:    []Option{WithSearchIndex(new(mockSearchIndex))},
+			input:   SearchCopiesInput{Query: strings.Repeat("夏", MaxSearchQueryLength+1)},
+			wantErr: ErrQueryTooLong,
+		},
+		{
+			name:    "異常系_件数の上限超過",
+			opts:    []Option{WithSearchIndex(new(mockSearchIndex))},
+			input:   SearchCopiesInput{Query: "夏", Limit: MaxPageSize + 1},
+			wantErr: ErrInvalidPageSize,
+		},
+		{
+			name:    "異常系_負の開始位置",
+			opts:    []Option{WithSearchIndex(new(mockSearchIndex))},
+			input:   SearchCopiesInput{Query: "夏", Offset: -1},
+			wantErr: ErrInvalidOffset,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := NewUseCase(new(mockCopyRepository), new(mockGenerator), tt.opts...)
+
+			page, err := u.SearchCopies(context.Background(), tt.input)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Nil(t, page)
+		})
+	}
+}
+
+func TestSearchIndexMaintenance(t *testing.T) {
+	ctx := context.Background()
+	copy := &entity.Copy{ID: 1, Title: "夏のセール"}
+
+	t.Run("正常系_削除で索引から除く", func(t *testing.T) {
+		mockRepo := new(mockCopyRepository)
+		mockSearch := new(mockSearchIndex)
+		mockRepo.On("Delete", mock.Anything, 1).Return(nil)
+		mockSearch.On("Remove", mock.Anything, 1).Return(nil)
+
+		err := NewUseCase(mockRepo, new(mockGenerator), WithSearchIndex(mockSearch)).DeleteCopy(ctx, 1)
+
+		assert.NoError(t, err)
+		mockSearch.AssertExpectations(t)
+	})
+
+	t.Run("正常系_復元で索引に戻す", func(t *testing.T) {
+		mockRepo := new(mockCopyRepository)
+		mockSearch := new(mockSearchIndex)
+		mockRepo.On("Restore", mock.Anything, 1).Return(nil)
+		mockRepo.On("Get", mock.Anything, 1).Return(copy, nil)
+		mockSearch.On("Index", mock.Anything, copy).Return(nil)
+
+		got, err := NewUseCase(mockRepo, new(mockGenerator), WithSearchIndex(mockSearch)).RestoreCopy(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, copy, got)
+		mockSearch.AssertExpectations(t)
+	})
+
+	t.Run("正常系_公開日時の設定で索引を更新", func(t *testing.T) {
+		mockRepo := new(mockCopyRepository)
+		mockSearch := new(mockSearchIndex)
+		publishAt := time.Now().Add(24 * time.Hour)
+		mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{ID: 1, Title: "夏のセール", Status: entity.CopyStatusPublished}, nil)
+		mockRepo.On("UpdateStatus", mock.Anything, mock.Anything, repository.CopyStatusGuard{Status: entity.CopyStatusPublished}, []string{"PublishAt", "ExpireAt"}, mock.Anything).Return(nil)
+		mockSearch.On("Index", mock.Anything, mock.MatchedBy(func(c *entity.Copy) bool {
+			return c.ID == 1 && c.PublishAt != nil && c.PublishAt.Equal(publishAt)
+		})).Return(nil)
+
+		_, err := NewUseCase(mockRepo, new(mockGenerator), WithSearchIndex(mockSearch)).
+			Schedule(ctx, 1, ScheduleInput{PublishAt: &publishAt})
+
+		assert.NoError(t, err)
+		mockSearch.AssertExpectations(t)
+	})
+
+	t.Run("異常系_削除に失敗した場合は索引を変更しない", func(t *testing.T) {
+		mockRepo := new(mockCopyRepository)
+		mockSearch := new(mockSearchIndex)
+		mockRepo.On("Delete", mock.Anything, 1).Return(errors.New("database error"))
+
+		err := NewUseCase(mockRepo, new(mockGenerator), WithSearchIndex(mockSearch)).DeleteCopy(ctx, 1)
+
+		assert.Error(t, err)
+		mockSearch.AssertNotCalled(t, "Remove", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系_編集と版の記録に失敗した場合は索引を変更しない", func(t *testing.T) {
+		mockRepo := new(mockCopyRepository)
+		mockSearch := new(mockSearchIndex)
+		mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{ID: 1, Title: "夏のセール", Channel: entity.ChannelSNS, Status: entity.CopyStatusDraft}, nil)
+		mockRepo.On("UpdateWithRevision", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("database error"))
+
+		_, err := NewUseCase(mockRepo, new(mockGenerator), WithSearchIndex(mockSearch), WithRevisionRepository(new(mockRevisionRepository))).
+			UpdateCopy(ctx, 1, UpdateCopyInput{Title: stringPtr("冬のセール")})
+
+		assert.Error(t, err)
+		mockSearch.AssertNotCalled(t, "Index", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,152 @@
+package copy_usecase
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/llm"
+)
+
+// ストリーミングで通知するフィールド
+const (
+	StreamFieldTitle       = "title"
+	StreamFieldDescription = "description"
+	// StreamFieldRetry: 出力が不正だったため再生成することを通知する（Deltaは問題点）
+	StreamFieldRetry = "retry"
+)
+
+// StreamEvent: 生成途中のタイトル・本文の差分
+type StreamEvent struct {
+	Field string `json:"field"`
+	Delta string `json:"delta"`
+}
+
+// CreateCopyStream: 生成途中のタイトル・本文を逐次通知しながらコピーを生成
+//
+// ctxがキャンセルされた場合（クライアントの切断など）はLLMの呼び出しも中断され、
+// コピーは保存されません。出力が不正で再生成する場合はretryイベントを通知した上で、
+// 再生成した出力を最初から通知します。
+func (u *useCase) CreateCopyStream(ctx context.Context, input CreateCopyInput, onEvent func(StreamEvent) error) (*entity.Copy, error) {
+	defs, err := u.resolve(ctx, &input)
+	if err != nil {
+		return nil, err
+	}
+	req, p, err := u.newGenerateRequest(input, defs, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	attempt := 0
+	out, err := u.generateValid(ctx, req, formatFor(defs.channel), func(ctx context.Context, req llm.Request) (*llm.Response, error) {
+		attempt++
+		if attempt > 1 {
+			if err := onEvent(StreamEvent{Field: StreamFieldRetry, Delta: req.Messages[len(req.Messages)-1].Content}); err != nil {
+				return nil, err
+			}
+		}
+		return u.stream(ctx, req, onEvent)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	copy := newCopy(input, out, p)
+	u.review(copy, defs.brand)
+	if err := u.createCopy(ctx, copy); err != nil {
+		return nil, err
+	}
+	if err := u.indexCopies(ctx, copy); err != nil {
+		return nil, err
+	}
+
+	return copy, nil
+}
+
+// stream: 1回分の生成を行い、タイトル・本文の差分を通知する
+func (u *useCase) stream(ctx context.Context, req llm.Request, onEvent func(StreamEvent) error) (*llm.Response, error) {
+	var content strings.Builder
+	sent := map[string]int{}
+
+	return llm.GenerateStream(ctx, u.generator, req, func(delta string) error {
+		content.WriteString(delta)
+
+		// 出力途中のJSONからフィールドの値を取り出し、未通知の部分のみを通知する
+		for _, field := range []string{StreamFieldTitle, StreamFieldDescription} {
+			value := partialJSONString(content.String(), field)
+			if len(value) <= sent[field] {
+				continue
+			}
+			if err := onEvent(StreamEvent{Field: field, Delta: value[sent[field]:]}); err != nil {
+				return err
+			}
+			sent[field] = len(value)
+		}
+		return ctx.Err()
+	})
+}
+
+// partialJSONString: 出力途中のJSONから指定キーの文字列値を取り出す
+//
+// 値が閉じていない場合は、その時点までに確定した部分を返します。
+// エスケープシーケンスの途中で途切れている場合はその手前までを返します。
+func partialJSONString(content, key string) string {
+	idx := strings.Index(content, `"`+key+`"`)
+	if idx < 0 {
+		return ""
+	}
+	rest := strings.TrimLeft(content[idx+len(key)+2:], " \t\r\n")
+	if !strings.HasPrefix(rest, ":") {
+		return ""
+	}
+	rest = strings.TrimLeft(rest[1:], " \t\r\n")
+	if !strings.HasPrefix(rest, `"`) {
+		return ""
+	}
+	rest = rest[1:]
+
+	var value strings.Builder
+	for i := 0; i < len(rest); {
+		switch c := rest[i]; c {
+		case '"':
+			return value.String()
+		case '\\':
+			if i+1 >= len(rest) {
+				return value.String()
+			}
+			switch rest[i+1] {
+			case 'u':
+				if i+6 > len(rest) {
+					return value.String()
+				}
+				code, err := strconv.ParseUint(rest[i+2:i+6], 16, 32)
+				if err != nil {
+					return value.String()
+				}
+				value.WriteRune(rune(code))
+				i += 6
+				continue
+			case 'n':
+				value.WriteByte('\n')
+			case 't':
+				value.WriteByte('\t')
+			case 'r':
+				value.WriteByte('\r')
+			case 'b':
+				value.WriteByte('\b')
+			case 'f':
+				value.WriteByte('\f')
+			default:
+				value.WriteByte(rest[i+1])
+			}
+			i += 2
+		default:
+			_, size := utf8.DecodeRuneInString(rest[i:])
+			value.WriteString(rest[i : i+size])
+			i += size
+		}
+	}
+	return value.String()
+}
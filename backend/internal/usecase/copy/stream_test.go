@@ -0,0 +1,95 @@
+package copy_usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/llm"
+)
+
+func TestPartialJSONString(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		key     string
+		want    string
+	}{
+		{name: "キーが未出力", content: `{"tit`, key: "title", want: ""},
+		{name: "値の途中", content: `{"title": "夏の新`, key: "title", want: "夏の新"},
+		{name: "値が閉じている", content: `{"title": "夏の新作", "description": "涼`, key: "title", want: "夏の新作"},
+		{name: "後続のキー", content: `{"title": "夏の新作", "description": "涼`, key: "description", want: "涼"},
+		{name: "エスケープ", content: `{"title": "\"限定\"\nあ`, key: "title", want: "\"限定\"\nあ"},
+		{name: "エスケープの途中", content: `{"title": "限定\u30`, key: "title", want: "限定"},
+		{name: "バックスラッシュで終わる", content: `{"title": "限定\`, key: "title", want: "限定"},
+		{name: "コードフェンス付き", content: "```json\n{\"title\": \"夏\"", key: "title", want: "夏"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, partialJSONString(tt.content, tt.key))
+		})
+	}
+}
+
+func TestCreateCopyStream(t *testing.T) {
+	input := CreateCopyInput{
+		ProductName:     "テスト商品",
+		ProductFeatures: "高品質、使いやすい",
+		Target:          "20-30代女性",
+		Channel:         entity.ChannelSNS,
+		Tone:            entity.ToneCasual,
+	}
+
+	t.Run("正常系", func(t *testing.T) {
+		// モックの準備
+		mockRepo := new(mockCopyRepository)
+		mockRepo.On("CreateWithRevision", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		// ユースケースの初期化
+		u := NewUseCase(mockRepo, llm.NewFakeGenerator())
+
+		// テスト実行
+		var title, description strings.Builder
+		got, err := u.CreateCopyStream(context.Background(), input, func(event StreamEvent) error {
+			switch event.Field {
+			case StreamFieldTitle:
+				title.WriteString(event.Delta)
+			case StreamFieldDescription:
+				description.WriteString(event.Delta)
+			}
+			return nil
+		})
+
+		// アサーション
+		require.NoError(t, err)
+		assert.Equal(t, got.Title, title.String())
+		assert.Equal(t, got.Description, description.String())
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系_中断した場合は保存しない", func(t *testing.T) {
+		// モックの準備
+		mockRepo := new(mockCopyRepository)
+
+		// ユースケースの初期化
+		u := NewUseCase(mockRepo, llm.NewFakeGenerator())
+
+		// テスト実行
+		disconnected := errors.New("client disconnected")
+		got, err := u.CreateCopyStream(context.Background(), input, func(event StreamEvent) error {
+			return disconnected
+		})
+
+		// アサーション
+		assert.ErrorIs(t, err, disconnected)
+		assert.Nil(t, got)
+		mockRepo.AssertNotCalled(t, "CreateWithRevision", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -2,30 +2,120 @@ package copy_usecase
 
 import (
 	"context"
-	"encoding/json"
+	"crypto/rand"
 	"errors"
-	"os"
-
-	"github.com/sashabaranov/go-openai"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
 
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/compliance"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/domainerr"
 	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
 	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/llm"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/prompt"
+)
+
+const (
+	MaxVariants = 10
+
+	// DefaultMaxAttempts: 出力が不正な場合に再生成を含めて試行する回数の既定値
+	DefaultMaxAttempts = 3
+)
+
+var (
+	ErrInvalidVariants    = domainerr.Validation("invalid_variants", fmt.Sprintf("variants must be between 1 and %d", MaxVariants))
+	ErrGenerationNotFound = domainerr.NotFound("generation_not_found", "generation not found")
+	ErrVariantNotFound    = domainerr.NotFound("variant_not_found", "variant not found in generation")
+	ErrInvalidChannel     = domainerr.Validation("invalid_channel", "channel must be one of the registered channels")
+	ErrInvalidTone        = domainerr.Validation("invalid_tone", "tone must be one of the registered tones")
 )
 
 type UseCase interface {
 	CreateCopy(ctx context.Context, input CreateCopyInput) (*entity.Copy, error)
+	CreateVariants(ctx context.Context, input CreateCopyInput) (*Generation, error)
+	CreateBulk(ctx context.Context, inputs []CreateCopyInput) []BulkResult
+	CreateCopyStream(ctx context.Context, input CreateCopyInput, onEvent func(StreamEvent) error) (*entity.Copy, error)
+	CreateCampaignSet(ctx context.Context, input CreateCopyInput, channels []entity.Channel) (*CampaignSet, error)
+	GetGeneration(ctx context.Context, generationID string) (*Generation, error)
+	SelectVariant(ctx context.Context, generationID string, id int) (*entity.Copy, error)
 	GetCopy(ctx context.Context, id int) (*entity.Copy, error)
-	GetPublishedCopies(ctx context.Context) ([]*entity.Copy, error)
-	UpdateLikes(ctx context.Context, id int) (*entity.Copy, error)
+	Visible(copy *entity.Copy) bool
+	GetPublishedCopies(ctx context.Context, input ListCopiesInput) (*CopyPage, error)
+	LikeCopy(ctx context.Context, id int, voter string) (*entity.Copy, error)
+	UnlikeCopy(ctx context.Context, id int, voter string) (*entity.Copy, error)
+	UpdateCopy(ctx context.Context, id int, input UpdateCopyInput) (*entity.Copy, error)
+	DeleteCopy(ctx context.Context, id int) error
+	RestoreCopy(ctx context.Context, id int) (*entity.Copy, error)
+	CheckCopy(ctx context.Context, id int) (*entity.Copy, error)
+	CheckTexts(ctx context.Context, texts []compliance.Text) []entity.ComplianceFinding
+	ListRevisions(ctx context.Context, copyID int) ([]*entity.CopyRevision, error)
+	DiffRevisions(ctx context.Context, copyID, from, to int) (*RevisionDiff, error)
+	RollbackCopy(ctx context.Context, copyID, revision int, author string) (*entity.Copy, error)
+	SearchCopies(ctx context.Context, input SearchCopiesInput) (*SearchPage, error)
+	SubmitForReview(ctx context.Context, id int, input WorkflowInput) (*entity.Copy, error)
+	AssignReviewer(ctx context.Context, id int, input WorkflowInput) (*entity.Copy, error)
+	Approve(ctx context.Context, id int, input WorkflowInput) (*entity.Copy, error)
+	Reject(ctx context.Context, id int, input WorkflowInput) (*entity.Copy, error)
+	Publish(ctx context.Context, id int, input WorkflowInput) (*entity.Copy, error)
+	Archive(ctx context.Context, id int, input WorkflowInput) (*entity.Copy, error)
+	Reopen(ctx context.Context, id int, input WorkflowInput) (*entity.Copy, error)
+	ListInReview(ctx context.Context, reviewer string) ([]*entity.Copy, error)
+	Schedule(ctx context.Context, id int, input ScheduleInput) (*entity.Copy, error)
+	RunSchedule(ctx context.Context) (*ScheduleResult, error)
+	ValidateInput(ctx context.Context, input CreateCopyInput) error
 }
 
 type useCase struct {
 	repo         repository.CopyRepository
-	openaiClient openAIClient
+	generator    llm.Generator
+	prompts      *prompt.Store
+	maxAttempts  int
+	lengthPolicy LengthPolicy
+	checker      *compliance.Checker
+	// blockSeverity: この重大度以上の表現を含むコピーは公開しない（空の場合は公開を見送らない）
+	blockSeverity   entity.Severity
+	bulkConcurrency int
+	revisions       repository.CopyRevisionRepository
+	search          repository.CopySearchIndex
+	channels        repository.ChannelRepository
+	tones           repository.ToneRepository
+	brands          repository.BrandProfileRepository
+	products        repository.ProductRepository
+	campaigns       repository.CampaignRepository
+	// now: 公開日時・公開終了日時の判定に使う現在時刻
+	now func() time.Time
+}
+
+// Option: ユースケースの設定
+type Option func(*useCase)
+
+// WithPromptStore: プロンプトテンプレートの読み込み元を設定
+func WithPromptStore(store *prompt.Store) Option {
+	return func(u *useCase) {
+		if store != nil {
+			u.prompts = store
+		}
+	}
+}
+
+// WithMaxAttempts: 出力が不正な場合に再生成を含めて試行する回数を設定
+func WithMaxAttempts(n int) Option {
+	return func(u *useCase) {
+		if n > 0 {
+			u.maxAttempts = n
+		}
+	}
 }
 
-type openAIClient interface {
-	CreateChatCompletion(ctx context.Context, req openai.ChatCompletionRequest) (openai.ChatCompletionResponse, error)
+// WithLengthPolicy: 出力がチャネルの制限を満たさない場合の扱いを設定
+func WithLengthPolicy(policy LengthPolicy) Option {
+	return func(u *useCase) {
+		if policy != "" {
+			u.lengthPolicy = policy
+		}
+	}
 }
 
 type CreateCopyInput struct {
@@ -34,81 +124,264 @@ type CreateCopyInput struct {
 	Target          string
 	Channel         entity.Channel
 	Tone            entity.Tone
-	IsPublished     bool
+	// Variants: 生成する候補数（0の場合は1）
+	Variants int
+	// Locale: プロンプトテンプレートのロケール（空の場合はja）
+	Locale string
+	// BrandProfileID: ブランドプロファイル（nilの場合はブランドのボイスを指定しない）
+	BrandProfileID *int
+	// ProductID: 商品カタログの商品（指定した場合は商品名・特徴を商品の内容で補う）
+	ProductID *int
+	// CampaignID: コピーが属するキャンペーン（指定した場合、空のターゲットはキャンペーンの対象者で補う）
+	CampaignID *int
+	// CreatedBy: コピーを生成した利用者（nilの場合はコンテキストの呼び出し元）
+	CreatedBy *int
+	// GenerationID: 保存するコピーの生成ID（空の場合、複数候補では新しく発行し、1件では記録しない）
+	//
+	// 生成ジョブではジョブごとに固定し、再実行時に保存済みのコピーを見つけられるようにします。
+	GenerationID string
+}
+
+// Variant: スコア付きの候補
+type Variant struct {
+	Copy  *entity.Copy `json:"copy"`
+	Score Score        `json:"score"`
 }
 
-type openAIResponse struct {
-	Title       string `json:"title"`
-	Description string `json:"description"`
+// Generation: 1回のリクエストで生成された候補群（スコアの高い順）
+type Generation struct {
+	ID       string    `json:"generationId"`
+	Variants []Variant `json:"variants"`
 }
 
-func NewUseCase(repo repository.CopyRepository) UseCase {
-	return &useCase{
-		repo:         repo,
-		openaiClient: openai.NewClient(os.Getenv("OPENAI_API_KEY")),
+func NewUseCase(repo repository.CopyRepository, generator llm.Generator, opts ...Option) UseCase {
+	u := &useCase{
+		repo:            repo,
+		generator:       generator,
+		prompts:         prompt.Default(),
+		maxAttempts:     DefaultMaxAttempts,
+		lengthPolicy:    LengthPolicyRegenerate,
+		checker:         compliance.Default(),
+		bulkConcurrency: DefaultBulkConcurrency,
+		now:             time.Now,
+	}
+	for _, opt := range opts {
+		opt(u)
 	}
+	return u
 }
 
 func (u *useCase) CreateCopy(ctx context.Context, input CreateCopyInput) (*entity.Copy, error) {
-	// プロンプトの生成
-	prompt := generatePrompt(input)
-
-	// OpenAI APIの呼び出し
-	resp, err := u.openaiClient.CreateChatCompletion(
-		ctx,
-		openai.ChatCompletionRequest{
-			Model: openai.GPT3Dot5Turbo,
-			Messages: []openai.ChatCompletionMessage{
-				{
-					Role:    openai.ChatMessageRoleUser,
-					Content: prompt,
-				},
-			},
-		},
-	)
+	defs, err := u.resolve(ctx, &input)
 	if err != nil {
 		return nil, err
 	}
+	copy, err := u.generate(ctx, input, defs, 0)
+	if err != nil {
+		return nil, err
+	}
+	copy.GenerationID = input.GenerationID
 
-	if len(resp.Choices) == 0 {
-		return nil, errors.New("no response from OpenAI")
+	// リポジトリへの保存（生成時の出力を最初の版として記録）
+	if err := u.createCopy(ctx, copy); err != nil {
+		return nil, err
+	}
+	if err := u.indexCopies(ctx, copy); err != nil {
+		return nil, err
 	}
 
-	// レスポンスの解析
-	var aiResp openAIResponse
-	if err := json.Unmarshal([]byte(resp.Choices[0].Message.Content), &aiResp); err != nil {
+	return copy, nil
+}
+
+// CreateVariants: 複数の候補を生成し、採点した上で同じ生成IDで保存
+func (u *useCase) CreateVariants(ctx context.Context, input CreateCopyInput) (*Generation, error) {
+	n := input.Variants
+	if n == 0 {
+		n = 1
+	}
+	if n < 1 || n > MaxVariants {
+		return nil, ErrInvalidVariants
+	}
+	defs, err := u.resolve(ctx, &input)
+	if err != nil {
 		return nil, err
 	}
 
-	// エンティティの作成
-	copy := &entity.Copy{
-		Title:           aiResp.Title,
-		Description:     aiResp.Description,
-		ProductName:     input.ProductName,
-		ProductFeatures: input.ProductFeatures,
-		Target:          input.Target,
-		Channel:         input.Channel,
-		Tone:            input.Tone,
-		Likes:           0,
-		IsPublished:     input.IsPublished,
+	// 候補はそれぞれ独立しているため並行して生成する
+	copies := make([]*entity.Copy, n)
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			copies[i], errs[i] = u.generate(ctx, input, defs, i)
+		}(i)
+	}
+	wg.Wait()
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
 	}
 
-	// リポジトリへの保存
-	if err := u.repo.Create(ctx, copy); err != nil {
+	generationID := input.GenerationID
+	if generationID == "" {
+		id, err := newGenerationID()
+		if err != nil {
+			return nil, err
+		}
+		generationID = id
+	}
+
+	variants := make([]Variant, n)
+	for i, copy := range copies {
+		score := scoreCopy(copy, defs)
+		copy.GenerationID = generationID
+		copy.Score = score.Total
+		variants[i] = Variant{Copy: copy, Score: score}
+	}
+	sort.SliceStable(variants, func(i, j int) bool {
+		return variants[i].Score.Total > variants[j].Score.Total
+	})
+
+	sorted := make([]*entity.Copy, n)
+	for i, v := range variants {
+		sorted[i] = v.Copy
+	}
+	if err := u.createCopies(ctx, sorted); err != nil {
+		return nil, err
+	}
+	if err := u.indexCopies(ctx, sorted...); err != nil {
+		return nil, err
+	}
+
+	return &Generation{ID: generationID, Variants: variants}, nil
+}
+
+// generate: LLMで1件のコピーを生成（保存はしない）
+func (u *useCase) generate(ctx context.Context, input CreateCopyInput, defs *definitions, variant int) (*entity.Copy, error) {
+	req, p, err := u.newGenerateRequest(input, defs, variant)
+	if err != nil {
 		return nil, err
 	}
 
+	// LLMプロバイダーの呼び出し
+	out, err := u.generateValid(ctx, req, formatFor(defs.channel), u.generator.Generate)
+	if err != nil {
+		return nil, err
+	}
+
+	copy := newCopy(input, out, p)
+	u.review(copy, defs.brand)
 	return copy, nil
 }
 
-func generatePrompt(input CreateCopyInput) string {
-	return `以下の情報に基づき、ターゲット『` + input.Target + `』向けに、商品『` + input.ProductName + `』（特徴: ` + input.ProductFeatures + `）の配信チャネル『` + string(input.Channel) + `』、トーン『` + string(input.Tone) + `』に最適な販促コピーを生成してください。
+// generateValid: 出力を検証し、不正な場合は問題点を伝えて再生成する
+//
+// 解析できない出力やチャネルの形式の違反はmaxAttemptsまで再生成し、
+// それでも有効な出力が得られない場合は*InvalidOutputErrorを返します。
+// 形式の違反の扱いはlengthPolicyに従い、LengthPolicyRejectの場合は再生成しません。
+func (u *useCase) generateValid(ctx context.Context, req llm.Request, f channelFormat, call func(context.Context, llm.Request) (*llm.Response, error)) (*validOutput, error) {
+	var (
+		content    string
+		violations []string
+	)
+	for attempt := 1; attempt <= u.maxAttempts; attempt++ {
+		resp, err := call(ctx, req)
+
+		var parseErr *llm.ParseError
+		switch {
+		case errors.As(err, &parseErr):
+			content = parseErr.Content
+			violations = []string{"JSONとして解析できません（" + parseErr.Err.Error() + "）"}
+		case err != nil:
+			return nil, err
+		default:
+			var out *validOutput
+			content = resp.Content
+			out, violations = validateOutput(f, resp, u.lengthPolicy)
+			if len(violations) == 0 {
+				return out, nil
+			}
+			if u.lengthPolicy == LengthPolicyReject {
+				return nil, &InvalidOutputError{Attempts: attempt, Violations: violations, Content: content}
+			}
+		}
+
+		// 前回の出力と問題点を会話に追加して再生成を依頼する
+		req.Messages = append(req.Messages,
+			llm.Message{Role: llm.RoleAssistant, Content: content},
+			llm.Message{Role: llm.RoleUser, Content: repairPrompt(violations)},
+		)
+	}
 
-出力形式は以下のJSON形式でお願いします：
-{
-  "title": "タイトル（20文字以内）",
-  "description": "本文（50〜100文字以内）"
-}`
+	return nil, &InvalidOutputError{
+		Attempts:   u.maxAttempts,
+		Violations: violations,
+		Content:    content,
+	}
+}
+
+// newGenerateRequest: 入力に対応するテンプレートからLLMへのリクエストを組み立てる
+//
+// 配信チャネル・トーンの定義に登録された指示と、組み込み以外のチャネルの出力形式をプロンプトに含めます。
+func (u *useCase) newGenerateRequest(input CreateCopyInput, defs *definitions, variant int) (llm.Request, *prompt.Prompt, error) {
+	p, err := u.prompts.Render(prompt.Key{
+		Channel: string(input.Channel),
+		Tone:    string(input.Tone),
+		Locale:  input.Locale,
+	}, prompt.Data{
+		ProductName:     input.ProductName,
+		ProductFeatures: input.ProductFeatures,
+		Target:          input.Target,
+		Channel:         string(input.Channel),
+		Tone:            string(input.Tone),
+		ChannelGuidance: defs.channel.Guidance,
+		ToneGuidance:    defs.tone.Guidance,
+		OutputSchema:    outputSchema(defs.channel),
+		Brand:           promptBrand(defs.brand),
+		Campaign:        promptCampaign(defs.campaign, defs.otherChannels),
+		Variants:        input.Variants,
+		VariantNumber:   variant + 1,
+	})
+	if err != nil {
+		return llm.Request{}, nil, err
+	}
+
+	return llm.Request{
+		Messages: p.Messages,
+		Brief: llm.Brief{
+			ProductName:     input.ProductName,
+			ProductFeatures: input.ProductFeatures,
+			Target:          input.Target,
+			Channel:         string(input.Channel),
+			Tone:            string(input.Tone),
+			Fields:          customFields(defs.channel),
+		},
+		Variant: variant,
+	}, p, nil
+}
+
+// newCopy: 生成結果からエンティティを作成
+func newCopy(input CreateCopyInput, out *validOutput, p *prompt.Prompt) *entity.Copy {
+	return &entity.Copy{
+		Title:                 out.Title,
+		Description:           out.Description,
+		ProductName:           input.ProductName,
+		ProductFeatures:       input.ProductFeatures,
+		Target:                input.Target,
+		Channel:               input.Channel,
+		Tone:                  input.Tone,
+		Likes:                 0,
+		Status:                entity.CopyStatusDraft,
+		PromptTemplateID:      p.TemplateID,
+		PromptTemplateVersion: p.Version,
+		BrandProfileID:        input.BrandProfileID,
+		ProductID:             input.ProductID,
+		CampaignID:            input.CampaignID,
+		CreatedBy:             input.CreatedBy,
+		Content:               out.Content,
+		Metrics:               out.Metrics,
+	}
 }
 
 func (u *useCase) GetCopy(ctx context.Context, id int) (*entity.Copy, error) {
@@ -120,37 +393,111 @@ func (u *useCase) GetCopy(ctx context.Context, id int) (*entity.Copy, error) {
 	return copy, nil
 }
 
+// Visible: 現在時刻で公開済みの一覧・検索に表示されるコピーかどうか（認証なしで閲覧できるかの判定に使う）
+func (u *useCase) Visible(copy *entity.Copy) bool {
+	return copy.Visible(u.now())
+}
+
 // GetPublishedCopies: 公開済みのコピーの一覧を取得
 //
 // アーキテクチャ上の考察:
 // このメソッドは、リポジトリ層で公開済みのフィルタリングを行っています。
 // 本来、公開済みのフィルタリングはビジネスロジックとしてユースケース層に実装することも可能ですが、
 // 以下の理由からリポジトリ層で実装することを選択しています：
-// 1. is_publishedは単純なブール値フラグで、複雑なビジネスロジックを含まない
-// 2. データベースレベルでのフィルタリングにより、パフォーマンスが向上
-// 3. 将来的なデータ量の増加を考慮した場合、データベースでのフィルタリングが効率的
+//  1. 公開済みかどうかは状態（status = published）のみで決まり、承認を経たかどうかは
+//     ユースケース層の状態遷移（Publishは承認済みのコピーのみ）で担保している
+//  2. データベースレベルでのフィルタリングにより、パフォーマンスが向上
+//  3. 将来的なデータ量の増加を考慮した場合、データベースでのフィルタリングが効率的
 //
 // 将来的に「公開」の定義が複雑になる可能性がある場合は、
 // ユースケース層に移動することを検討する必要があります。
-func (u *useCase) GetPublishedCopies(ctx context.Context) ([]*entity.Copy, error) {
-	copies, err := u.repo.GetPublished(ctx)
+//
+// 1件多く取得し、次のページがある場合のみ最後のコピーからカーソルを作成します。
+func (u *useCase) GetPublishedCopies(ctx context.Context, input ListCopiesInput) (*CopyPage, error) {
+	after, err := input.normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	copies, err := u.repo.ListPublished(ctx, repository.CopyListQuery{
+		Filter: input.Filter,
+		Sort:   input.Sort,
+		After:  after,
+		Limit:  input.Limit + 1,
+		Now:    u.now(),
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return copies, nil
+	page := &CopyPage{Copies: copies}
+	if len(copies) > input.Limit {
+		page.Copies = copies[:input.Limit]
+		page.HasMore = true
+		page.NextCursor = encodeCursor(input.Sort, page.Copies[input.Limit-1])
+	}
+	return page, nil
 }
 
-func (u *useCase) UpdateLikes(ctx context.Context, id int) (*entity.Copy, error) {
-	copy, err := u.repo.Get(ctx, id)
+// GetGeneration: 生成IDに紐づく候補をスコア付きで取得
+func (u *useCase) GetGeneration(ctx context.Context, generationID string) (*Generation, error) {
+	copies, err := u.repo.GetByGenerationID(ctx, generationID)
 	if err != nil {
 		return nil, err
 	}
+	if len(copies) == 0 {
+		return nil, ErrGenerationNotFound
+	}
 
-	copy.Likes++
-	if err := u.repo.UpdateLikes(ctx, id, copy.Likes); err != nil {
+	// スコアの内訳は保存せず、コピーの内容から再計算する（候補の配信チャネル・トーンはすべて同じ）
+	defs, err := u.definitionsOf(ctx, copies[0])
+	if err != nil {
 		return nil, err
 	}
+	variants := make([]Variant, len(copies))
+	for i, copy := range copies {
+		variants[i] = Variant{Copy: copy, Score: scoreCopy(copy, defs)}
+	}
 
-	return copy, nil
+	return &Generation{ID: generationID, Variants: variants}, nil
+}
+
+// SelectVariant: 候補の中から採用するコピーを選択
+func (u *useCase) SelectVariant(ctx context.Context, generationID string, id int) (*entity.Copy, error) {
+	copies, err := u.repo.GetByGenerationID(ctx, generationID)
+	if err != nil {
+		return nil, err
+	}
+	if len(copies) == 0 {
+		return nil, ErrGenerationNotFound
+	}
+
+	var selected *entity.Copy
+	for _, copy := range copies {
+		if copy.ID == id {
+			selected = copy
+			break
+		}
+	}
+	if selected == nil {
+		return nil, ErrVariantNotFound
+	}
+
+	if err := u.repo.SelectVariant(ctx, generationID, id); err != nil {
+		return nil, err
+	}
+
+	selected.IsSelected = true
+	return selected, nil
+}
+
+// newGenerationID: UUID v4形式の生成IDを発行
+func newGenerationID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
 }
@@ -3,14 +3,18 @@ package copy_usecase
 import (
 	"context"
 	"errors"
-	"os"
+	"strings"
 	"testing"
+	"time"
 
-	"github.com/sashabaranov/go-openai"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/auth"
 	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/llm"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/textmetrics"
 )
 
 // モックリポジトリの定義
@@ -23,6 +27,21 @@ func (m *mockCopyRepository) Create(ctx context.Context, copy *entity.Copy) erro
 	return args.Error(0)
 }
 
+func (m *mockCopyRepository) CreateBatch(ctx context.Context, copies []*entity.Copy) error {
+	args := m.Called(ctx, copies)
+	return args.Error(0)
+}
+
+func (m *mockCopyRepository) CreateWithRevision(ctx context.Context, copy *entity.Copy, revision *entity.CopyRevision) error {
+	args := m.Called(ctx, copy, revision)
+	return args.Error(0)
+}
+
+func (m *mockCopyRepository) CreateBatchWithRevisions(ctx context.Context, copies []*entity.Copy, revisions []*entity.CopyRevision) error {
+	args := m.Called(ctx, copies, revisions)
+	return args.Error(0)
+}
+
 func (m *mockCopyRepository) Get(ctx context.Context, id int) (*entity.Copy, error) {
 	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
@@ -31,42 +50,140 @@ func (m *mockCopyRepository) Get(ctx context.Context, id int) (*entity.Copy, err
 	return args.Get(0).(*entity.Copy), args.Error(1)
 }
 
-func (m *mockCopyRepository) GetPublished(ctx context.Context) ([]*entity.Copy, error) {
-	args := m.Called(ctx)
+func (m *mockCopyRepository) GetByIDs(ctx context.Context, ids []int) ([]*entity.Copy, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Copy), args.Error(1)
+}
+
+func (m *mockCopyRepository) ListPublished(ctx context.Context, query repository.CopyListQuery) ([]*entity.Copy, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Copy), args.Error(1)
+}
+
+func (m *mockCopyRepository) Like(ctx context.Context, id int, voter string) error {
+	args := m.Called(ctx, id, voter)
+	return args.Error(0)
+}
+
+func (m *mockCopyRepository) Unlike(ctx context.Context, id int, voter string) error {
+	args := m.Called(ctx, id, voter)
+	return args.Error(0)
+}
+
+func (m *mockCopyRepository) GetByGenerationID(ctx context.Context, generationID string) ([]*entity.Copy, error) {
+	args := m.Called(ctx, generationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Copy), args.Error(1)
+}
+
+func (m *mockCopyRepository) ListByProduct(ctx context.Context, productID int) ([]*entity.Copy, error) {
+	args := m.Called(ctx, productID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Copy), args.Error(1)
+}
+
+func (m *mockCopyRepository) ListByCampaign(ctx context.Context, campaignID int) ([]*entity.Copy, error) {
+	args := m.Called(ctx, campaignID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]*entity.Copy), args.Error(1)
 }
 
-func (m *mockCopyRepository) UpdateLikes(ctx context.Context, id int, likes int) error {
-	args := m.Called(ctx, id, likes)
+func (m *mockCopyRepository) ListInReview(ctx context.Context, reviewer string) ([]*entity.Copy, error) {
+	args := m.Called(ctx, reviewer)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Copy), args.Error(1)
+}
+
+func (m *mockCopyRepository) ListDueToPublish(ctx context.Context, now time.Time) ([]*entity.Copy, error) {
+	args := m.Called(ctx, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Copy), args.Error(1)
+}
+
+func (m *mockCopyRepository) ListDueToExpire(ctx context.Context, now time.Time) ([]*entity.Copy, error) {
+	args := m.Called(ctx, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Copy), args.Error(1)
+}
+
+func (m *mockCopyRepository) SelectVariant(ctx context.Context, generationID string, id int) error {
+	args := m.Called(ctx, generationID, id)
+	return args.Error(0)
+}
+
+func (m *mockCopyRepository) UpdateFindings(ctx context.Context, id int, findings []entity.ComplianceFinding) error {
+	args := m.Called(ctx, id, findings)
+	return args.Error(0)
+}
+
+func (m *mockCopyRepository) Update(ctx context.Context, copy *entity.Copy, fields []string) error {
+	args := m.Called(ctx, copy, fields)
+	return args.Error(0)
+}
+
+func (m *mockCopyRepository) UpdateWithRevision(ctx context.Context, copy *entity.Copy, fields []string, revision *entity.CopyRevision) error {
+	args := m.Called(ctx, copy, fields, revision)
+	return args.Error(0)
+}
+
+func (m *mockCopyRepository) UpdateStatus(ctx context.Context, copy *entity.Copy, guard repository.CopyStatusGuard, fields []string, revision *entity.CopyRevision) error {
+	args := m.Called(ctx, copy, guard, fields, revision)
+	return args.Error(0)
+}
+
+func (m *mockCopyRepository) Delete(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *mockCopyRepository) Restore(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
 	return args.Error(0)
 }
 
-// OpenAIクライアントのモック
-type mockOpenAIClient struct {
+// プロンプトの文字数制限（50〜100文字）を満たす本文
+const testDescription = "高品質で使いやすいテスト商品です。毎日の暮らしをもっと快適にする工夫が詰まっています。今ならお得なキャンペーンも実施中です。"
+
+// SNSのチャネル固有の項目
+const testSNSContent = `{"hashtags": ["テスト商品"]}`
+
+// Generatorのモック
+type mockGenerator struct {
 	mock.Mock
 }
 
-func (m *mockOpenAIClient) CreateChatCompletion(ctx context.Context, req openai.ChatCompletionRequest) (openai.ChatCompletionResponse, error) {
+func (m *mockGenerator) Generate(ctx context.Context, req llm.Request) (*llm.Response, error) {
 	args := m.Called(ctx, req)
-	return args.Get(0).(openai.ChatCompletionResponse), args.Error(1)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*llm.Response), args.Error(1)
 }
 
 func TestCreateCopy(t *testing.T) {
-	// OpenAIのモックレスポンスを準備
-	mockResponse := openai.ChatCompletionResponse{
-		Choices: []openai.ChatCompletionChoice{
-			{
-				Message: openai.ChatCompletionMessage{
-					Content: `{
-						"title": "テストタイトル",
-						"description": "テスト説明"
-					}`,
-				},
-			},
-		},
+	// Generatorのモックレスポンスを準備
+	mockResponse := &llm.Response{
+		Title:       "テストタイトル",
+		Description: testDescription,
+		Content:     testSNSContent,
 	}
 
 	tests := []struct {
@@ -84,18 +201,30 @@ func TestCreateCopy(t *testing.T) {
 				Target:          "20-30代女性",
 				Channel:         entity.ChannelSNS,
 				Tone:            entity.ToneCasual,
-				IsPublished:     true,
 			},
 			want: &entity.Copy{
 				Title:           "テストタイトル",
-				Description:     "テスト説明",
+				Description:     testDescription,
 				ProductName:     "テスト商品",
 				ProductFeatures: "高品質、使いやすい",
 				Target:          "20-30代女性",
 				Channel:         entity.ChannelSNS,
 				Tone:            entity.ToneCasual,
 				Likes:           0,
-				IsPublished:     true,
+				Status:          entity.CopyStatusDraft,
+				// 使用したプロンプトテンプレートが記録されること
+				PromptTemplateID:      "ja/sns",
+				PromptTemplateVersion: "5",
+				Content: &entity.CopyContent{
+					SNS: &entity.SNSContent{Text: testDescription, Hashtags: []string{"テスト商品"}},
+				},
+				Findings: []entity.ComplianceFinding{},
+				Metrics: &entity.CopyMetrics{
+					Fields: map[string]textmetrics.Metrics{
+						"title":       textmetrics.Measure("テストタイトル"),
+						"description": textmetrics.Measure(testDescription),
+					},
+				},
 			},
 			wantErr: false,
 		},
@@ -107,25 +236,36 @@ func TestCreateCopy(t *testing.T) {
 				Target:          "20-30代女性",
 				Channel:         entity.ChannelSNS,
 				Tone:            entity.ToneCasual,
-				IsPublished:     true,
 			},
 			want:    nil,
 			wantErr: true,
 			errMsg:  "repository error",
 		},
 		{
-			name: "異常系_OpenAIエラー",
+			name: "異常系_Generatorエラー",
 			input: CreateCopyInput{
 				ProductName:     "テスト商品",
 				ProductFeatures: "高品質、使いやすい",
 				Target:          "20-30代女性",
 				Channel:         entity.ChannelSNS,
 				Tone:            entity.ToneCasual,
-				IsPublished:     true,
 			},
 			want:    nil,
 			wantErr: true,
-			errMsg:  "openai error",
+			errMsg:  "generator error",
+		},
+		{
+			name: "異常系_未定義のトーン",
+			input: CreateCopyInput{
+				ProductName:     "テスト商品",
+				ProductFeatures: "高品質、使いやすい",
+				Target:          "20-30代女性",
+				Channel:         entity.ChannelSNS,
+				Tone:            "formal",
+			},
+			want:    nil,
+			wantErr: true,
+			errMsg:  ErrInvalidTone.Error(),
 		},
 	}
 
@@ -133,28 +273,22 @@ func TestCreateCopy(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// モックの準備
 			mockRepo := new(mockCopyRepository)
-			mockOpenAI := new(mockOpenAIClient)
+			mockGen := new(mockGenerator)
 
 			if tt.wantErr {
 				if tt.errMsg == "repository error" {
-					mockOpenAI.On("CreateChatCompletion", mock.Anything, mock.Anything).Return(mockResponse, nil)
-					mockRepo.On("Create", mock.Anything, mock.Anything).Return(errors.New(tt.errMsg))
-				} else if tt.errMsg == "openai error" {
-					mockOpenAI.On("CreateChatCompletion", mock.Anything, mock.Anything).Return(openai.ChatCompletionResponse{}, errors.New(tt.errMsg))
+					mockGen.On("Generate", mock.Anything, mock.Anything).Return(mockResponse, nil)
+					mockRepo.On("CreateWithRevision", mock.Anything, mock.Anything, mock.Anything).Return(errors.New(tt.errMsg))
+				} else if tt.errMsg == "generator error" {
+					mockGen.On("Generate", mock.Anything, mock.Anything).Return(nil, errors.New(tt.errMsg))
 				}
 			} else {
-				mockOpenAI.On("CreateChatCompletion", mock.Anything, mock.Anything).Return(mockResponse, nil)
-				mockRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+				mockGen.On("Generate", mock.Anything, mock.Anything).Return(mockResponse, nil)
+				mockRepo.On("CreateWithRevision", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 			}
 
-			// 環境変数の設定
-			os.Setenv("OPENAI_API_KEY", "dummy-key")
-
 			// ユースケースの初期化
-			u := &useCase{
-				repo:         mockRepo,
-				openaiClient: mockOpenAI,
-			}
+			u := NewUseCase(mockRepo, mockGen)
 
 			// テスト実行
 			got, err := u.CreateCopy(context.Background(), tt.input)
@@ -171,72 +305,89 @@ func TestCreateCopy(t *testing.T) {
 			assert.Equal(t, tt.want, got)
 
 			// モックの呼び出しを検証
-			mockOpenAI.AssertExpectations(t)
+			mockGen.AssertExpectations(t)
 			mockRepo.AssertExpectations(t)
 		})
 	}
 }
 
-func TestGetCopy(t *testing.T) {
-	tests := []struct {
-		name    string
-		id      int
-		want    *entity.Copy
-		wantErr bool
-	}{
-		{
-			name: "正常系",
-			id:   1,
-			want: &entity.Copy{
-				ID:              1,
-				Title:           "テストタイトル",
-				Description:     "テスト説明",
-				ProductName:     "テスト商品",
-				ProductFeatures: "高品質、使いやすい",
-				Target:          "20-30代女性",
-				Channel:         entity.ChannelSNS,
-				Tone:            entity.ToneCasual,
-				Likes:           0,
-				IsPublished:     true,
-			},
-			wantErr: false,
-		},
-		{
-			name:    "異常系_存在しないID",
-			id:      999,
-			want:    nil,
-			wantErr: true,
-		},
+func TestCreateCopy_CreatedBy(t *testing.T) {
+	// モックの準備
+	mockRepo := new(mockCopyRepository)
+	mockRepo.On("CreateWithRevision", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockGen := new(mockGenerator)
+	mockGen.On("Generate", mock.Anything, mock.Anything).Return(&llm.Response{
+		Title:       "テストタイトル",
+		Description: testDescription,
+		Content:     testSNSContent,
+	}, nil)
+	u := NewUseCase(mockRepo, mockGen)
+
+	input := CreateCopyInput{
+		ProductName:     "テスト商品",
+		ProductFeatures: "高品質、使いやすい",
+		Target:          "20-30代女性",
+		Channel:         entity.ChannelSNS,
+		Tone:            entity.ToneCasual,
 	}
+	ctx := auth.WithIdentity(context.Background(), &entity.Identity{UserID: 1})
+
+	// テスト実行（生成者を指定しない場合はコンテキストの呼び出し元）
+	got, err := u.CreateCopy(ctx, input)
+
+	// アサーション
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *got.CreatedBy)
+
+	// 入力で指定した生成者を優先する（ジョブなど）
+	createdBy := 2
+	input.CreatedBy = &createdBy
+	got, err = u.CreateCopy(ctx, input)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, *got.CreatedBy)
+
+	// 認証されていない場合は記録しない
+	input.CreatedBy = nil
+	got, err = u.CreateCopy(context.Background(), input)
+	assert.NoError(t, err)
+	assert.Nil(t, got.CreatedBy)
+}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			// モックの準備
-			mockRepo := new(mockCopyRepository)
-			if tt.wantErr {
-				mockRepo.On("Get", mock.Anything, tt.id).Return(nil, errors.New("not found"))
-			} else {
-				mockRepo.On("Get", mock.Anything, tt.id).Return(tt.want, nil)
-			}
+func TestCreateCopy_GenerationID(t *testing.T) {
+	// モックの準備
+	mockRepo := new(mockCopyRepository)
+	mockRepo.On("CreateWithRevision", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockGen := new(mockGenerator)
+	mockGen.On("Generate", mock.Anything, mock.Anything).Return(&llm.Response{
+		Title:       "テストタイトル",
+		Description: testDescription,
+		Content:     testSNSContent,
+	}, nil)
+	u := NewUseCase(mockRepo, mockGen)
+
+	input := CreateCopyInput{
+		ProductName:     "テスト商品",
+		ProductFeatures: "高品質、使いやすい",
+		Target:          "20-30代女性",
+		Channel:         entity.ChannelSNS,
+		Tone:            entity.ToneCasual,
+	}
 
-			// ユースケースの初期化
-			u := NewUseCase(mockRepo)
+	// テスト実行（1件の生成では指定しない場合は生成IDを記録しない）
+	got, err := u.CreateCopy(context.Background(), input)
 
-			// テスト実行
-			got, err := u.GetCopy(context.Background(), tt.id)
+	// アサーション
+	assert.NoError(t, err)
+	assert.Empty(t, got.GenerationID)
 
-			// アサーション
-			if tt.wantErr {
-				assert.Error(t, err)
-				return
-			}
-			assert.NoError(t, err)
-			assert.Equal(t, tt.want, got)
-		})
-	}
+	// 指定した生成IDで保存する（ジョブの再実行で保存済みのコピーを見つけるため）
+	input.GenerationID = "job-1"
+	got, err = u.CreateCopy(context.Background(), input)
+	assert.NoError(t, err)
+	assert.Equal(t, "job-1", got.GenerationID)
 }
 
-func TestUpdateLikes(t *testing.T) {
+func TestGetCopy(t *testing.T) {
 	tests := []struct {
 		name    string
 		id      int
@@ -255,8 +406,8 @@ func TestUpdateLikes(t *testing.T) {
 				Target:          "20-30代女性",
 				Channel:         entity.ChannelSNS,
 				Tone:            entity.ToneCasual,
-				Likes:           1,
-				IsPublished:     true,
+				Likes:           0,
+				Status:          entity.CopyStatusPublished,
 			},
 			wantErr: false,
 		},
@@ -275,17 +426,14 @@ func TestUpdateLikes(t *testing.T) {
 			if tt.wantErr {
 				mockRepo.On("Get", mock.Anything, tt.id).Return(nil, errors.New("not found"))
 			} else {
-				initialCopy := *tt.want
-				initialCopy.Likes = 0
-				mockRepo.On("Get", mock.Anything, tt.id).Return(&initialCopy, nil)
-				mockRepo.On("UpdateLikes", mock.Anything, tt.id, tt.want.Likes).Return(nil)
+				mockRepo.On("Get", mock.Anything, tt.id).Return(tt.want, nil)
 			}
 
 			// ユースケースの初期化
-			u := NewUseCase(mockRepo)
+			u := NewUseCase(mockRepo, new(mockGenerator))
 
 			// テスト実行
-			got, err := u.UpdateLikes(context.Background(), tt.id)
+			got, err := u.GetCopy(context.Background(), tt.id)
 
 			// アサーション
 			if tt.wantErr {
@@ -299,6 +447,8 @@ func TestUpdateLikes(t *testing.T) {
 }
 
 func TestGetPublishedCopies(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+
 	tests := []struct {
 		name    string
 		want    []*entity.Copy
@@ -317,7 +467,7 @@ func TestGetPublishedCopies(t *testing.T) {
 					Channel:         entity.ChannelSNS,
 					Tone:            entity.ToneCasual,
 					Likes:           0,
-					IsPublished:     true,
+					Status:          entity.CopyStatusPublished,
 				},
 				{
 					ID:              2,
@@ -329,7 +479,7 @@ func TestGetPublishedCopies(t *testing.T) {
 					Channel:         entity.ChannelSNS,
 					Tone:            entity.ToneCasual,
 					Likes:           0,
-					IsPublished:     true,
+					Status:          entity.CopyStatusPublished,
 				},
 			},
 			wantErr: false,
@@ -346,16 +496,21 @@ func TestGetPublishedCopies(t *testing.T) {
 			// モックの準備
 			mockRepo := new(mockCopyRepository)
 			if tt.wantErr {
-				mockRepo.On("GetPublished", mock.Anything).Return(nil, errors.New("repository error"))
+				mockRepo.On("ListPublished", mock.Anything, mock.Anything).Return(nil, errors.New("repository error"))
 			} else {
-				mockRepo.On("GetPublished", mock.Anything).Return(tt.want, nil)
+				// 次のページの有無を判定するため1件多く取得する
+				mockRepo.On("ListPublished", mock.Anything, repository.CopyListQuery{
+					Sort:  repository.CopySortNewest,
+					Limit: DefaultPageSize + 1,
+					Now:   now,
+				}).Return(tt.want, nil)
 			}
 
 			// ユースケースの初期化
-			u := NewUseCase(mockRepo)
+			u := NewUseCase(mockRepo, new(mockGenerator), WithClock(func() time.Time { return now }))
 
 			// テスト実行
-			got, err := u.GetPublishedCopies(context.Background())
+			got, err := u.GetPublishedCopies(context.Background(), ListCopiesInput{})
 
 			// アサーション
 			if tt.wantErr {
@@ -363,7 +518,333 @@ func TestGetPublishedCopies(t *testing.T) {
 				return
 			}
 			assert.NoError(t, err)
-			assert.Equal(t, tt.want, got)
+			assert.Equal(t, &CopyPage{Copies: tt.want}, got)
+		})
+	}
+}
+
+func TestCreateVariants(t *testing.T) {
+	input := CreateCopyInput{
+		ProductName:     "テスト商品",
+		ProductFeatures: "高品質、使いやすい",
+		Target:          "20-30代女性",
+		Channel:         entity.ChannelSNS,
+		Tone:            entity.TonePop,
+		Variants:        3,
+	}
+
+	// 候補番号ごとに異なるレスポンスを返す
+	responses := []*llm.Response{
+		{Title: "候補1", Description: "高品質で使いやすい商品です。" + testDescription, Content: testSNSContent},
+		{Title: "候補2！", Description: "高品質で使いやすい商品です！今すぐチェック。" + testDescription, Content: testSNSContent},
+		{Title: "候補3", Description: "使いやすい商品です。毎日の暮らしをもっと快適にする工夫が詰まっています。今ならキャンペーンも実施中です。", Content: testSNSContent},
+	}
+
+	tests := []struct {
+		name       string
+		input      CreateCopyInput
+		setupMock  func(*mockCopyRepository, *mockGenerator)
+		wantTitles []string
+		wantID     string
+		wantErr    error
+	}{
+		{
+			name:  "正常系_スコア順に並ぶ",
+			input: input,
+			setupMock: func(mockRepo *mockCopyRepository, mockGen *mockGenerator) {
+				for i, resp := range responses {
+					i := i
+					mockGen.On("Generate", mock.Anything, mock.MatchedBy(func(req llm.Request) bool {
+						return req.Variant == i
+					})).Return(resp, nil)
+				}
+				mockRepo.On("CreateBatchWithRevisions", mock.Anything, mock.MatchedBy(func(copies []*entity.Copy) bool {
+					return len(copies) == 3 && copies[0].Title == "候補2！"
+				}), mock.Anything).Return(nil)
+			},
+			wantTitles: []string{"候補2！", "候補1", "候補3"},
+		},
+		{
+			name:  "正常系_指定した生成IDで保存",
+			input: withGenerationID(input, "job-1"),
+			setupMock: func(mockRepo *mockCopyRepository, mockGen *mockGenerator) {
+				mockGen.On("Generate", mock.Anything, mock.Anything).Return(responses[0], nil)
+				mockRepo.On("CreateBatchWithRevisions", mock.Anything, mock.MatchedBy(func(copies []*entity.Copy) bool {
+					return len(copies) == 3 && copies[0].GenerationID == "job-1"
+				}), mock.Anything).Return(nil)
+			},
+			wantTitles: []string{"候補1", "候補1", "候補1"},
+			wantID:     "job-1",
+		},
+		{
+			name: "異常系_候補数が上限超過",
+			input: CreateCopyInput{
+				ProductName: "テスト商品",
+				Variants:    MaxVariants + 1,
+			},
+			setupMock: func(mockRepo *mockCopyRepository, mockGen *mockGenerator) {},
+			wantErr:   ErrInvalidVariants,
+		},
+		{
+			name:  "異常系_Generatorエラー",
+			input: input,
+			setupMock: func(mockRepo *mockCopyRepository, mockGen *mockGenerator) {
+				mockGen.On("Generate", mock.Anything, mock.Anything).Return(nil, errors.New("generator error"))
+			},
+			wantErr: errors.New("generator error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockRepo := new(mockCopyRepository)
+			mockGen := new(mockGenerator)
+			tt.setupMock(mockRepo, mockGen)
+
+			// ユースケースの初期化
+			u := NewUseCase(mockRepo, mockGen)
+
+			// テスト実行
+			got, err := u.CreateVariants(context.Background(), tt.input)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorContains(t, err, tt.wantErr.Error())
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotEmpty(t, got.ID)
+			if tt.wantID != "" {
+				assert.Equal(t, tt.wantID, got.ID)
+			}
+
+			var titles []string
+			for i, v := range got.Variants {
+				titles = append(titles, v.Copy.Title)
+				assert.Equal(t, got.ID, v.Copy.GenerationID)
+				assert.Equal(t, v.Score.Total, v.Copy.Score)
+				if i > 0 {
+					assert.GreaterOrEqual(t, got.Variants[i-1].Score.Total, v.Score.Total)
+				}
+			}
+			assert.Equal(t, tt.wantTitles, titles)
+
+			// モックの呼び出しを検証
+			mockGen.AssertExpectations(t)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+// withGenerationID: 生成IDを指定した入力
+func withGenerationID(input CreateCopyInput, generationID string) CreateCopyInput {
+	input.GenerationID = generationID
+	return input
+}
+
+func TestGetGeneration(t *testing.T) {
+	tests := []struct {
+		name    string
+		copies  []*entity.Copy
+		wantErr error
+	}{
+		{
+			name: "正常系",
+			copies: []*entity.Copy{
+				{ID: 2, Title: "候補2", GenerationID: "gen-1", Tone: entity.TonePop},
+				{ID: 1, Title: "候補1", GenerationID: "gen-1", Tone: entity.TonePop},
+			},
+		},
+		{
+			name:    "異常系_存在しない生成ID",
+			copies:  []*entity.Copy{},
+			wantErr: ErrGenerationNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockRepo := new(mockCopyRepository)
+			mockRepo.On("GetByGenerationID", mock.Anything, "gen-1").Return(tt.copies, nil)
+
+			// ユースケースの初期化
+			u := NewUseCase(mockRepo, new(mockGenerator))
+
+			// テスト実行
+			got, err := u.GetGeneration(context.Background(), "gen-1")
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "gen-1", got.ID)
+			assert.Len(t, got.Variants, len(tt.copies))
+			assert.Equal(t, tt.copies[0], got.Variants[0].Copy)
+		})
+	}
+}
+
+func TestSelectVariant(t *testing.T) {
+	copies := func() []*entity.Copy {
+		return []*entity.Copy{
+			{ID: 1, Title: "候補1", GenerationID: "gen-1"},
+			{ID: 2, Title: "候補2", GenerationID: "gen-1"},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		id        int
+		setupMock func(*mockCopyRepository)
+		wantErr   error
+	}{
+		{
+			name: "正常系",
+			id:   2,
+			setupMock: func(mockRepo *mockCopyRepository) {
+				mockRepo.On("GetByGenerationID", mock.Anything, "gen-1").Return(copies(), nil)
+				mockRepo.On("SelectVariant", mock.Anything, "gen-1", 2).Return(nil)
+			},
+		},
+		{
+			name: "異常系_候補に含まれないID",
+			id:   3,
+			setupMock: func(mockRepo *mockCopyRepository) {
+				mockRepo.On("GetByGenerationID", mock.Anything, "gen-1").Return(copies(), nil)
+			},
+			wantErr: ErrVariantNotFound,
+		},
+		{
+			name: "異常系_存在しない生成ID",
+			id:   1,
+			setupMock: func(mockRepo *mockCopyRepository) {
+				mockRepo.On("GetByGenerationID", mock.Anything, "gen-1").Return([]*entity.Copy{}, nil)
+			},
+			wantErr: ErrGenerationNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockRepo := new(mockCopyRepository)
+			tt.setupMock(mockRepo)
+
+			// ユースケースの初期化
+			u := NewUseCase(mockRepo, new(mockGenerator))
+
+			// テスト実行
+			got, err := u.SelectVariant(context.Background(), "gen-1", tt.id)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.id, got.ID)
+			assert.True(t, got.IsSelected)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateCopy_Repair(t *testing.T) {
+	input := CreateCopyInput{
+		ProductName:     "テスト商品",
+		ProductFeatures: "高品質、使いやすい",
+		Target:          "20-30代女性",
+		Channel:         entity.ChannelSNS,
+		Tone:            entity.ToneCasual,
+	}
+	valid := &llm.Response{Title: "テストタイトル", Description: testDescription, Content: testSNSContent}
+	parseErr := &llm.ParseError{Content: "申し訳ありません", Err: llm.ErrNoJSONObject}
+	tooLong := &llm.Response{Title: strings.Repeat("長", 21), Description: testDescription, Content: testSNSContent}
+
+	tests := []struct {
+		name         string
+		opts         []Option
+		setupMock    func(*mockGenerator)
+		wantCalls    int
+		wantAttempts int
+	}{
+		{
+			name: "正常系_解析エラーから回復",
+			setupMock: func(mockGen *mockGenerator) {
+				isRepair := func(req llm.Request) bool {
+					// 前回の出力と問題点が会話の末尾に追加されていること
+					n := len(req.Messages)
+					return n >= 2 &&
+						req.Messages[n-2].Role == llm.RoleAssistant && req.Messages[n-2].Content == "申し訳ありません" &&
+						req.Messages[n-1].Role == llm.RoleUser && strings.Contains(req.Messages[n-1].Content, "JSONとして解析できません")
+				}
+				mockGen.On("Generate", mock.Anything, mock.MatchedBy(func(req llm.Request) bool {
+					return !isRepair(req)
+				})).Return(nil, parseErr).Once()
+				mockGen.On("Generate", mock.Anything, mock.MatchedBy(isRepair)).Return(valid, nil).Once()
+			},
+			wantCalls: 2,
+		},
+		{
+			name: "正常系_文字数違反から回復",
+			setupMock: func(mockGen *mockGenerator) {
+				mockGen.On("Generate", mock.Anything, mock.Anything).Return(tooLong, nil).Once()
+				mockGen.On("Generate", mock.Anything, mock.Anything).Return(valid, nil).Once()
+			},
+			wantCalls: 2,
+		},
+		{
+			name: "異常系_試行回数の上限",
+			opts: []Option{WithMaxAttempts(2)},
+			setupMock: func(mockGen *mockGenerator) {
+				mockGen.On("Generate", mock.Anything, mock.Anything).Return(tooLong, nil)
+			},
+			wantCalls:    2,
+			wantAttempts: 2,
+		},
+		{
+			name: "異常系_rejectの場合は再生成しない",
+			opts: []Option{WithLengthPolicy(LengthPolicyReject)},
+			setupMock: func(mockGen *mockGenerator) {
+				mockGen.On("Generate", mock.Anything, mock.Anything).Return(tooLong, nil)
+			},
+			wantCalls:    1,
+			wantAttempts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockRepo := new(mockCopyRepository)
+			mockRepo.On("CreateWithRevision", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			mockGen := new(mockGenerator)
+			tt.setupMock(mockGen)
+
+			// ユースケースの初期化
+			u := NewUseCase(mockRepo, mockGen, tt.opts...)
+
+			// テスト実行
+			got, err := u.CreateCopy(context.Background(), input)
+
+			// アサーション
+			mockGen.AssertNumberOfCalls(t, "Generate", tt.wantCalls)
+			if tt.wantAttempts > 0 {
+				var invalidOutput *InvalidOutputError
+				assert.ErrorAs(t, err, &invalidOutput)
+				assert.Equal(t, tt.wantAttempts, invalidOutput.Attempts)
+				assert.Equal(t, []string{"titleは20文字以内にしてください（現在21文字）"}, invalidOutput.Violations)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, valid.Title, got.Title)
 		})
 	}
 }
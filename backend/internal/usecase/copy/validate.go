@@ -0,0 +1,66 @@
+package copy_usecase
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/domainerr"
+)
+
+// LengthPolicy: 出力がチャネルの制限を満たさない場合の扱い
+type LengthPolicy string
+
+const (
+	// LengthPolicyRegenerate: 問題点を伝えて再生成する（既定）
+	LengthPolicyRegenerate LengthPolicy = "regenerate"
+	// LengthPolicyTruncate: 上限を超えた項目を切り詰める（それ以外の違反は再生成する）
+	LengthPolicyTruncate LengthPolicy = "truncate"
+	// LengthPolicyReject: 再生成せずにエラーとする
+	LengthPolicyReject LengthPolicy = "reject"
+)
+
+var (
+	ErrInvalidLengthPolicy = errors.New("length policy must be one of regenerate, truncate or reject")
+	// ErrInvalidOutput: InvalidOutputErrorが包むエラー（モデルが有効な出力を返さないため、依存先の不具合として扱う）
+	ErrInvalidOutput = domainerr.UpstreamUnavailable("invalid_llm_output", "LLM did not return a valid copy")
+)
+
+// ParseLengthPolicy: 文字列からLengthPolicyに変換（空の場合は既定値）
+func ParseLengthPolicy(s string) (LengthPolicy, error) {
+	switch p := LengthPolicy(strings.ToLower(strings.TrimSpace(s))); p {
+	case "":
+		return LengthPolicyRegenerate, nil
+	case LengthPolicyRegenerate, LengthPolicyTruncate, LengthPolicyReject:
+		return p, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrInvalidLengthPolicy, s)
+}
+
+// InvalidOutputError: 再生成を繰り返しても有効な出力が得られなかった場合のエラー
+type InvalidOutputError struct {
+	Attempts   int
+	Violations []string
+	// Content: 最後に受け取ったモデルの出力
+	Content string
+}
+
+func (e *InvalidOutputError) Error() string {
+	return fmt.Sprintf("invalid output from LLM after %d attempts: %s", e.Attempts, strings.Join(e.Violations, "; "))
+}
+
+func (e *InvalidOutputError) Unwrap() error {
+	return ErrInvalidOutput.
+		WithDetail("attempts", e.Attempts).
+		WithDetail("violations", e.Violations)
+}
+
+// repairPrompt: 検証エラーを伝えて出力の修正を依頼するプロンプト
+func repairPrompt(violations []string) string {
+	var b strings.Builder
+	b.WriteString("前回の出力には以下の問題がありました。問題を修正し、指定したJSON形式のみを出力してください（説明文やコードブロックは不要です）。\n")
+	for _, v := range violations {
+		b.WriteString("- " + v + "\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,213 @@
+package copy_usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/compliance"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/domainerr"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+var (
+	ErrInvalidTransition = domainerr.Conflict("invalid_transition", "copy cannot move to the requested status from its current status")
+	ErrNotInReview       = domainerr.Conflict("not_in_review", "reviewer can be assigned only while the copy is in review")
+	ErrNotReviewer       = domainerr.Conflict("not_assigned_reviewer", "only the assigned reviewer can approve or reject the copy")
+	ErrReviewerRequired  = domainerr.Validation("reviewer_required", "reviewer is required")
+	ErrSelfReview        = domainerr.Validation("self_review", "reviewer must be different from the user who submitted the copy")
+	ErrCommentRequired   = domainerr.Validation("comment_required", "comment is required to reject a copy")
+)
+
+// WorkflowInput: ワークフローの操作の内容
+type WorkflowInput struct {
+	// Actor: 操作した利用者（承認・差し戻しはレビューを依頼された利用者のみ、版に記録する）
+	Actor string
+	// Reviewer: レビューを依頼する利用者（レビューの依頼・担当者の変更のみ）
+	Reviewer string
+	// Comment: 承認・差し戻しのコメント（差し戻しの場合は必須）
+	Comment string
+}
+
+// SubmitForReview: 下書きのコピーのレビューをReviewerに依頼する（依頼したActorはReviewerに指定できない）
+func (u *useCase) SubmitForReview(ctx context.Context, id int, input WorkflowInput) (*entity.Copy, error) {
+	reviewer := strings.TrimSpace(input.Reviewer)
+	if reviewer == "" {
+		return nil, ErrReviewerRequired
+	}
+	submitter := strings.TrimSpace(input.Actor)
+	if err := checkSelfReview(reviewer, submitter); err != nil {
+		return nil, err
+	}
+	return u.transition(ctx, id, entity.CopyStatusInReview, entity.RevisionSourceWorkflow, input, func(copy *entity.Copy) ([]string, error) {
+		copy.Reviewer = reviewer
+		copy.SubmittedBy = submitter
+		copy.ReviewComment = ""
+		return []string{"Reviewer", "SubmittedBy", "ReviewComment"}, nil
+	})
+}
+
+// AssignReviewer: レビュー中のコピーの担当者を変更する（状態は変更しない）
+//
+// レビューを依頼した利用者は担当者に指定できません。
+// 取得してから保存するまでに他の操作でレビュー中でなくなった場合は、保存せずにErrNotInReviewを返します。
+func (u *useCase) AssignReviewer(ctx context.Context, id int, input WorkflowInput) (*entity.Copy, error) {
+	reviewer := strings.TrimSpace(input.Reviewer)
+	if reviewer == "" {
+		return nil, ErrReviewerRequired
+	}
+	copy, err := u.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if copy.Status != entity.CopyStatusInReview {
+		return nil, ErrNotInReview.WithDetail("status", copy.Status)
+	}
+	if err := checkSelfReview(reviewer, copy.SubmittedBy); err != nil {
+		return nil, err
+	}
+
+	copy.Reviewer = reviewer
+	revision := u.newRevision(copy, entity.RevisionSourceWorkflow, strings.TrimSpace(input.Actor), 0)
+	guard := repository.CopyStatusGuard{Status: entity.CopyStatusInReview}
+	if err := u.repo.UpdateStatus(ctx, copy, guard, []string{"Reviewer"}, revision); err != nil {
+		if errors.Is(err, repository.ErrCopyStatusChanged) {
+			return nil, ErrNotInReview
+		}
+		return nil, err
+	}
+	return copy, nil
+}
+
+// Approve: レビュー中のコピーを承認する（担当者のみ）
+func (u *useCase) Approve(ctx context.Context, id int, input WorkflowInput) (*entity.Copy, error) {
+	return u.transition(ctx, id, entity.CopyStatusApproved, entity.RevisionSourceWorkflow, input, func(copy *entity.Copy) ([]string, error) {
+		if err := checkReviewer(copy, input.Actor); err != nil {
+			return nil, err
+		}
+		copy.ReviewComment = strings.TrimSpace(input.Comment)
+		return []string{"ReviewComment"}, nil
+	})
+}
+
+// Reject: レビュー中のコピーをコメントを付けて下書きに差し戻す（担当者のみ）
+func (u *useCase) Reject(ctx context.Context, id int, input WorkflowInput) (*entity.Copy, error) {
+	comment := strings.TrimSpace(input.Comment)
+	if comment == "" {
+		return nil, ErrCommentRequired
+	}
+	return u.transition(ctx, id, entity.CopyStatusDraft, entity.RevisionSourceWorkflow, input, func(copy *entity.Copy) ([]string, error) {
+		if copy.Status != entity.CopyStatusInReview {
+			return nil, invalidTransition(copy.Status, entity.CopyStatusDraft)
+		}
+		if err := checkReviewer(copy, input.Actor); err != nil {
+			return nil, err
+		}
+		copy.ReviewComment = comment
+		return []string{"ReviewComment"}, nil
+	})
+}
+
+// Publish: 承認済みのコピーを公開する
+//
+// 公開を見送る重大度が設定されている場合、該当する表現を含むコピーはPublishBlockedErrorとします。
+// 公開日時より前に公開したコピーは、公開日時になるまで公開済みの一覧に表示されません。
+func (u *useCase) Publish(ctx context.Context, id int, input WorkflowInput) (*entity.Copy, error) {
+	return u.transition(ctx, id, entity.CopyStatusPublished, entity.RevisionSourceWorkflow, input, u.checkPublishable)
+}
+
+// Archive: コピーをアーカイブする（公開済みのコピーは公開の一覧から外れる）
+func (u *useCase) Archive(ctx context.Context, id int, input WorkflowInput) (*entity.Copy, error) {
+	return u.transition(ctx, id, entity.CopyStatusArchived, entity.RevisionSourceWorkflow, input, nil)
+}
+
+// Reopen: レビューの依頼を取り下げる、または承認済み・アーカイブしたコピーを下書きに戻して編集できるようにする
+func (u *useCase) Reopen(ctx context.Context, id int, input WorkflowInput) (*entity.Copy, error) {
+	return u.transition(ctx, id, entity.CopyStatusDraft, entity.RevisionSourceWorkflow, input, nil)
+}
+
+// ListInReview: レビュー中のコピーを依頼の古い順に取得（reviewerを指定した場合はその利用者の担当のみ）
+func (u *useCase) ListInReview(ctx context.Context, reviewer string) ([]*entity.Copy, error) {
+	return u.repo.ListInReview(ctx, strings.TrimSpace(reviewer))
+}
+
+// transition: コピーの状態をnextに変更して保存し、状態の変更をsourceの版として同じトランザクションで記録する
+//
+// 遷移できない状態の場合はErrInvalidTransitionを返します。
+// 取得してから保存するまでに他の操作で状態が変わった場合も、保存せずにErrInvalidTransitionを返します。
+// applyは状態以外に変更する項目を反映し、保存する項目の名前を返します。
+func (u *useCase) transition(ctx context.Context, id int, next entity.CopyStatus, source entity.RevisionSource, input WorkflowInput, apply func(*entity.Copy) ([]string, error)) (*entity.Copy, error) {
+	copy, err := u.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return u.transitionCopy(ctx, copy, repository.CopyStatusGuard{Status: copy.Status}, next, source, input, apply)
+}
+
+// transitionCopy: 取得したコピーをguardの条件を満たす場合のみnextに変更して保存する
+//
+// コピーの状態がguardの状態と異なる場合、または保存の時点でguardの条件を満たさない場合はErrInvalidTransitionを返します。
+func (u *useCase) transitionCopy(ctx context.Context, copy *entity.Copy, guard repository.CopyStatusGuard, next entity.CopyStatus, source entity.RevisionSource, input WorkflowInput, apply func(*entity.Copy) ([]string, error)) (*entity.Copy, error) {
+	from := copy.Status
+	if from != guard.Status || !from.CanTransitionTo(next) {
+		return nil, invalidTransition(from, next)
+	}
+
+	fields := []string{"Status"}
+	if apply != nil {
+		more, err := apply(copy)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, more...)
+	}
+	copy.Status = next
+
+	revision := u.newRevision(copy, source, strings.TrimSpace(input.Actor), 0)
+	if revision != nil {
+		revision.Comment = strings.TrimSpace(input.Comment)
+	}
+	if err := u.repo.UpdateStatus(ctx, copy, guard, fields, revision); err != nil {
+		if errors.Is(err, repository.ErrCopyStatusChanged) {
+			return nil, invalidTransition(from, next)
+		}
+		return nil, err
+	}
+	// 公開済みかどうかで検索の対象が変わるため、コミットした後に索引も更新する
+	if err := u.indexCopies(ctx, copy); err != nil {
+		return nil, err
+	}
+	return copy, nil
+}
+
+// checkPublishable: 公開を見送る表現を含まず、公開終了日時を過ぎていないことを検証
+func (u *useCase) checkPublishable(copy *entity.Copy) ([]string, error) {
+	if u.blockSeverity != "" && compliance.HasSeverity(copy.Findings, u.blockSeverity) {
+		return nil, &PublishBlockedError{Severity: u.blockSeverity, Findings: copy.Findings}
+	}
+	if copy.ExpireAt != nil && !u.now().Before(*copy.ExpireAt) {
+		return nil, ErrCopyExpired.WithDetail("expireAt", *copy.ExpireAt)
+	}
+	return nil, nil
+}
+
+func invalidTransition(from, to entity.CopyStatus) error {
+	return ErrInvalidTransition.WithDetail("from", from).WithDetail("to", to)
+}
+
+// checkSelfReview: レビューを依頼した利用者submitterが自分をreviewerに指定していないことを検証
+func checkSelfReview(reviewer, submitter string) error {
+	if submitter != "" && reviewer == submitter {
+		return ErrSelfReview.WithDetail("reviewer", reviewer)
+	}
+	return nil
+}
+
+// checkReviewer: actorがレビューを依頼された利用者かどうかを検証
+func checkReviewer(copy *entity.Copy, actor string) error {
+	if actor = strings.TrimSpace(actor); actor == "" || actor != copy.Reviewer {
+		return ErrNotReviewer.WithDetail("reviewer", copy.Reviewer)
+	}
+	return nil
+}
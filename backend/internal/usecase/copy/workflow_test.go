@@ -0,0 +1,309 @@
+package copy_usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+// workflowOp: ワークフローの操作を行うUseCaseのメソッド
+type workflowOp func(UseCase, context.Context, int, WorkflowInput) (*entity.Copy, error)
+
+func TestWorkflow(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     entity.CopyStatus
+		op         workflowOp
+		input      WorkflowInput
+		getErr     error
+		wantStatus entity.CopyStatus
+		wantFields []string
+		wantErr    error
+		check      func(t *testing.T, got *entity.Copy)
+	}{
+		{
+			name:       "正常系_レビューの依頼",
+			status:     entity.CopyStatusDraft,
+			op:         UseCase.SubmitForReview,
+			input:      WorkflowInput{Actor: "tanaka", Reviewer: " suzuki "},
+			wantStatus: entity.CopyStatusInReview,
+			wantFields: []string{"Status", "Reviewer", "SubmittedBy", "ReviewComment"},
+			check: func(t *testing.T, got *entity.Copy) {
+				assert.Equal(t, "suzuki", got.Reviewer)
+				assert.Equal(t, "tanaka", got.SubmittedBy)
+				assert.Empty(t, got.ReviewComment)
+			},
+		},
+		{
+			name:       "正常系_承認",
+			status:     entity.CopyStatusInReview,
+			op:         UseCase.Approve,
+			input:      WorkflowInput{Actor: "suzuki", Comment: "問題ありません"},
+			wantStatus: entity.CopyStatusApproved,
+			wantFields: []string{"Status", "ReviewComment"},
+			check: func(t *testing.T, got *entity.Copy) {
+				assert.Equal(t, "問題ありません", got.ReviewComment)
+			},
+		},
+		{
+			name:       "正常系_差し戻し",
+			status:     entity.CopyStatusInReview,
+			op:         UseCase.Reject,
+			input:      WorkflowInput{Actor: "suzuki", Comment: "価格の表記を修正してください"},
+			wantStatus: entity.CopyStatusDraft,
+			wantFields: []string{"Status", "ReviewComment"},
+			check: func(t *testing.T, got *entity.Copy) {
+				assert.Equal(t, "価格の表記を修正してください", got.ReviewComment)
+			},
+		},
+		{
+			name:       "正常系_公開",
+			status:     entity.CopyStatusApproved,
+			op:         UseCase.Publish,
+			input:      WorkflowInput{Actor: "tanaka"},
+			wantStatus: entity.CopyStatusPublished,
+			wantFields: []string{"Status"},
+		},
+		{
+			name:       "正常系_アーカイブ",
+			status:     entity.CopyStatusPublished,
+			op:         UseCase.Archive,
+			input:      WorkflowInput{Actor: "tanaka"},
+			wantStatus: entity.CopyStatusArchived,
+			wantFields: []string{"Status"},
+		},
+		{
+			name:       "正常系_アーカイブから下書きに戻す",
+			status:     entity.CopyStatusArchived,
+			op:         UseCase.Reopen,
+			input:      WorkflowInput{Actor: "tanaka"},
+			wantStatus: entity.CopyStatusDraft,
+			wantFields: []string{"Status"},
+		},
+		{
+			name:    "異常系_下書きは公開できない",
+			status:  entity.CopyStatusDraft,
+			op:      UseCase.Publish,
+			wantErr: ErrInvalidTransition,
+		},
+		{
+			name:    "異常系_公開済みは承認できない",
+			status:  entity.CopyStatusPublished,
+			op:      UseCase.Approve,
+			input:   WorkflowInput{Actor: "suzuki"},
+			wantErr: ErrInvalidTransition,
+		},
+		{
+			name:    "異常系_承認済みは差し戻しできない",
+			status:  entity.CopyStatusApproved,
+			op:      UseCase.Reject,
+			input:   WorkflowInput{Actor: "suzuki", Comment: "やはり修正してください"},
+			wantErr: ErrInvalidTransition,
+		},
+		{
+			name:    "異常系_担当者以外は承認できない",
+			status:  entity.CopyStatusInReview,
+			op:      UseCase.Approve,
+			input:   WorkflowInput{Actor: "tanaka"},
+			wantErr: ErrNotReviewer,
+		},
+		{
+			name:    "異常系_レビューの担当者なし",
+			status:  entity.CopyStatusDraft,
+			op:      UseCase.SubmitForReview,
+			input:   WorkflowInput{Actor: "tanaka", Reviewer: " "},
+			wantErr: ErrReviewerRequired,
+		},
+		{
+			name:    "異常系_自分をレビューの担当者に指定",
+			status:  entity.CopyStatusDraft,
+			op:      UseCase.SubmitForReview,
+			input:   WorkflowInput{Actor: "tanaka", Reviewer: "tanaka"},
+			wantErr: ErrSelfReview,
+		},
+		{
+			name:    "異常系_コメントなしの差し戻し",
+			status:  entity.CopyStatusInReview,
+			op:      UseCase.Reject,
+			input:   WorkflowInput{Actor: "suzuki"},
+			wantErr: ErrCommentRequired,
+		},
+		{
+			name:    "異常系_存在しないコピー",
+			op:      UseCase.Archive,
+			getErr:  repository.ErrNotFound,
+			wantErr: repository.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			mockRepo := new(mockCopyRepository)
+			mockRevisions := new(mockRevisionRepository)
+			if tt.getErr != nil {
+				mockRepo.On("Get", mock.Anything, 1).Return(nil, tt.getErr)
+			} else {
+				mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{ID: 1, Title: "テストタイトル", Status: tt.status, Reviewer: "suzuki"}, nil)
+			}
+			if tt.wantFields != nil {
+				// 取得時の状態のままの場合のみ更新し、操作した利用者とコメントを含めて同じトランザクションで版に記録する
+				mockRepo.On("UpdateStatus", mock.Anything, mock.Anything, repository.CopyStatusGuard{Status: tt.status}, tt.wantFields, mock.MatchedBy(func(r *entity.CopyRevision) bool {
+					return r.Source == entity.RevisionSourceWorkflow && r.Status == tt.wantStatus &&
+						r.Author == tt.input.Actor && r.Comment == tt.input.Comment
+				})).Return(nil)
+			}
+
+			// ユースケースの初期化
+			u := NewUseCase(mockRepo, new(mockGenerator), WithRevisionRepository(mockRevisions))
+
+			// テスト実行
+			got, err := tt.op(u, context.Background(), 1, tt.input)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				mockRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, got.Status)
+			if tt.check != nil {
+				tt.check(t, got)
+			}
+			mockRepo.AssertExpectations(t)
+			mockRevisions.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestPublish_Blocking(t *testing.T) {
+	// モックの準備（重大度highの表現を含む承認済みのコピー）
+	mockRepo := new(mockCopyRepository)
+	mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{
+		ID:       1,
+		Status:   entity.CopyStatusApproved,
+		Findings: []entity.ComplianceFinding{{RuleID: "absolute-claim", Severity: entity.SeverityHigh, Match: "必ず"}},
+	}, nil)
+
+	// ユースケースの初期化
+	u := NewUseCase(mockRepo, new(mockGenerator), WithPublishBlocking(entity.SeverityHigh))
+
+	// テスト実行
+	got, err := u.Publish(context.Background(), 1, WorkflowInput{Actor: "tanaka"})
+
+	// アサーション
+	var blocked *PublishBlockedError
+	assert.ErrorAs(t, err, &blocked)
+	assert.Equal(t, entity.SeverityHigh, blocked.Severity)
+	assert.ErrorIs(t, err, ErrPublishBlocked)
+	assert.Nil(t, got)
+	mockRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestWorkflow_ConcurrentTransition(t *testing.T) {
+	// モックの準備（取得した後に他の操作でレビュー中から変わったため、状態を条件にした更新が0件になる）
+	mockRepo := new(mockCopyRepository)
+	mockSearch := new(mockSearchIndex)
+	mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{ID: 1, Title: "テストタイトル", Status: entity.CopyStatusInReview, Reviewer: "suzuki"}, nil)
+	mockRepo.On("UpdateStatus", mock.Anything, mock.Anything, repository.CopyStatusGuard{Status: entity.CopyStatusInReview}, mock.Anything, mock.Anything).Return(repository.ErrCopyStatusChanged)
+
+	// ユースケースの初期化
+	u := NewUseCase(mockRepo, new(mockGenerator), WithRevisionRepository(new(mockRevisionRepository)), WithSearchIndex(mockSearch))
+
+	// テスト実行
+	got, err := u.Approve(context.Background(), 1, WorkflowInput{Actor: "suzuki"})
+
+	// アサーション（遷移できないエラーとし、索引は更新しない）
+	assert.ErrorIs(t, err, ErrInvalidTransition)
+	assert.Nil(t, got)
+	mockSearch.AssertNotCalled(t, "Index", mock.Anything, mock.Anything)
+}
+
+func TestAssignReviewer(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    entity.CopyStatus
+		reviewer  string
+		updateErr error
+		wantErr   error
+	}{
+		{
+			name:     "正常系",
+			status:   entity.CopyStatusInReview,
+			reviewer: "sato",
+		},
+		{
+			name:     "異常系_レビュー中でない",
+			status:   entity.CopyStatusApproved,
+			reviewer: "sato",
+			wantErr:  ErrNotInReview,
+		},
+		{
+			name:     "異常系_レビューを依頼した利用者を担当者に指定",
+			status:   entity.CopyStatusInReview,
+			reviewer: "tanaka",
+			wantErr:  ErrSelfReview,
+		},
+		{
+			name:      "異常系_取得後に他の操作でレビュー中でなくなった",
+			status:    entity.CopyStatusInReview,
+			reviewer:  "sato",
+			updateErr: repository.ErrCopyStatusChanged,
+			wantErr:   ErrNotInReview,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備（レビュー中のままの場合のみ更新し、担当者を変更した利用者を同じトランザクションで版に記録する）
+			mockRepo := new(mockCopyRepository)
+			mockRevisions := new(mockRevisionRepository)
+			mockRepo.On("Get", mock.Anything, 1).Return(&entity.Copy{ID: 1, Status: tt.status, Reviewer: "suzuki", SubmittedBy: "tanaka"}, nil)
+			mockRepo.On("UpdateStatus", mock.Anything, mock.Anything, repository.CopyStatusGuard{Status: entity.CopyStatusInReview}, []string{"Reviewer"}, mock.MatchedBy(func(r *entity.CopyRevision) bool {
+				return r.Source == entity.RevisionSourceWorkflow && r.Author == "suzuki"
+			})).Return(tt.updateErr)
+
+			// ユースケースの初期化
+			u := NewUseCase(mockRepo, new(mockGenerator), WithRevisionRepository(mockRevisions))
+
+			// テスト実行
+			got, err := u.AssignReviewer(context.Background(), 1, WorkflowInput{Actor: "suzuki", Reviewer: tt.reviewer})
+
+			// アサーション
+			mockRevisions.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				if tt.updateErr == nil {
+					mockRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "sato", got.Reviewer)
+			assert.Equal(t, entity.CopyStatusInReview, got.Status)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestListInReview(t *testing.T) {
+	// モックの準備
+	mockRepo := new(mockCopyRepository)
+	mockRepo.On("ListInReview", mock.Anything, "suzuki").Return([]*entity.Copy{{ID: 1}, {ID: 2}}, nil)
+
+	// テスト実行
+	got, err := NewUseCase(mockRepo, new(mockGenerator)).ListInReview(context.Background(), " suzuki ")
+
+	// アサーション
+	assert.NoError(t, err)
+	assert.Len(t, got, 2)
+	mockRepo.AssertExpectations(t)
+}
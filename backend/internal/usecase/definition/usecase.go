@@ -0,0 +1,310 @@
+package definition_usecase
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/domainerr"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+var (
+	// ErrInvalidDefinition: InvalidDefinitionErrorが包むエラー（違反内容を含めてUnwrapで取得できる）
+	ErrInvalidDefinition = domainerr.Validation("invalid_definition", "definition is invalid")
+	ErrBuiltinDefinition = domainerr.Conflict("builtin_definition", "built-in channels and tones cannot be deleted")
+)
+
+// fieldNamePattern: 出力項目の名前（JSONのキー）の形式
+var fieldNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]{0,49}$`)
+
+type UseCase interface {
+	ListChannels(ctx context.Context) ([]*entity.ChannelDefinition, error)
+	GetChannel(ctx context.Context, id entity.Channel) (*entity.ChannelDefinition, error)
+	CreateChannel(ctx context.Context, input ChannelInput) (*entity.ChannelDefinition, error)
+	UpdateChannel(ctx context.Context, id entity.Channel, input ChannelInput) (*entity.ChannelDefinition, error)
+	DeleteChannel(ctx context.Context, id entity.Channel) error
+	ListTones(ctx context.Context) ([]*entity.ToneDefinition, error)
+	GetTone(ctx context.Context, id entity.Tone) (*entity.ToneDefinition, error)
+	CreateTone(ctx context.Context, input ToneInput) (*entity.ToneDefinition, error)
+	UpdateTone(ctx context.Context, id entity.Tone, input ToneInput) (*entity.ToneDefinition, error)
+	DeleteTone(ctx context.Context, id entity.Tone) error
+	// Options: 画面の選択肢に表示する配信チャネル・トーン
+	Options(ctx context.Context) (*Options, error)
+}
+
+type useCase struct {
+	channels repository.ChannelRepository
+	tones    repository.ToneRepository
+}
+
+// ChannelInput: 配信チャネルの登録・更新の内容（IDは登録時のみ使用）
+type ChannelInput struct {
+	ID          entity.Channel
+	Label       string
+	Description string
+	Guidance    string
+	Fields      []entity.OutputField
+	SortOrder   int
+}
+
+// ToneInput: トーンの登録・更新の内容（IDは登録時のみ使用）
+type ToneInput struct {
+	ID          entity.Tone
+	Label       string
+	Description string
+	Guidance    string
+	Markers     []string
+	SortOrder   int
+}
+
+// Options: 画面の選択肢に表示する配信チャネル・トーン（表示順）
+type Options struct {
+	Channels []entity.Option `json:"channels"`
+	Tones    []entity.Option `json:"tones"`
+}
+
+// InvalidDefinitionError: 定義の内容が不正な場合のエラー
+type InvalidDefinitionError struct {
+	Violations []string
+}
+
+func (e *InvalidDefinitionError) Error() string {
+	return "invalid definition: " + strings.Join(e.Violations, "; ")
+}
+
+func (e *InvalidDefinitionError) Unwrap() error {
+	return ErrInvalidDefinition.WithDetail("violations", e.Violations)
+}
+
+func NewUseCase(channels repository.ChannelRepository, tones repository.ToneRepository) UseCase {
+	return &useCase{channels: channels, tones: tones}
+}
+
+func (u *useCase) ListChannels(ctx context.Context) ([]*entity.ChannelDefinition, error) {
+	return u.channels.List(ctx)
+}
+
+func (u *useCase) GetChannel(ctx context.Context, id entity.Channel) (*entity.ChannelDefinition, error) {
+	return u.channels.Get(ctx, id)
+}
+
+// CreateChannel: 配信チャネルを登録（組み込み以外のチャネルとして登録する）
+func (u *useCase) CreateChannel(ctx context.Context, input ChannelInput) (*entity.ChannelDefinition, error) {
+	var violations []string
+	if !input.ID.WellFormed() {
+		violations = append(violations, "idは英小文字で始まる英小文字・数字・_・-の50文字以内にしてください")
+	}
+	violations = append(violations, validateChannel(input)...)
+	if len(violations) > 0 {
+		return nil, &InvalidDefinitionError{Violations: violations}
+	}
+
+	definition := &entity.ChannelDefinition{ID: input.ID}
+	applyChannel(definition, input)
+	if err := u.channels.Create(ctx, definition); err != nil {
+		return nil, err
+	}
+	return definition, nil
+}
+
+// UpdateChannel: 配信チャネルの定義を更新
+//
+// 組み込みのチャネルは構造化された出力の組み立てとプロンプトテンプレートが出力項目に依存するため、
+// 表示名・説明・指示・表示順のみ変更でき、出力項目は変更できません。
+func (u *useCase) UpdateChannel(ctx context.Context, id entity.Channel, input ChannelInput) (*entity.ChannelDefinition, error) {
+	definition, err := u.channels.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if definition.BuiltIn {
+		if input.Fields == nil {
+			input.Fields = definition.Fields
+		}
+		if !sameFields(definition.Fields, input.Fields) {
+			return nil, &InvalidDefinitionError{Violations: []string{"組み込みのチャネルの出力項目は変更できません"}}
+		}
+	}
+	if violations := validateChannel(input); len(violations) > 0 {
+		return nil, &InvalidDefinitionError{Violations: violations}
+	}
+
+	applyChannel(definition, input)
+	if err := u.channels.Update(ctx, definition); err != nil {
+		return nil, err
+	}
+	return definition, nil
+}
+
+// DeleteChannel: 配信チャネルを削除（組み込みのチャネルは削除できない）
+//
+// 削除したチャネルは新しい生成に使用できなくなりますが、既存のコピーはそのまま残ります。
+func (u *useCase) DeleteChannel(ctx context.Context, id entity.Channel) error {
+	definition, err := u.channels.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if definition.BuiltIn {
+		return ErrBuiltinDefinition
+	}
+	return u.channels.Delete(ctx, id)
+}
+
+func (u *useCase) ListTones(ctx context.Context) ([]*entity.ToneDefinition, error) {
+	return u.tones.List(ctx)
+}
+
+func (u *useCase) GetTone(ctx context.Context, id entity.Tone) (*entity.ToneDefinition, error) {
+	return u.tones.Get(ctx, id)
+}
+
+// CreateTone: トーンを登録（組み込み以外のトーンとして登録する）
+func (u *useCase) CreateTone(ctx context.Context, input ToneInput) (*entity.ToneDefinition, error) {
+	var violations []string
+	if !input.ID.WellFormed() {
+		violations = append(violations, "idは英小文字で始まる英小文字・数字・_・-の50文字以内にしてください")
+	}
+	violations = append(violations, validateTone(input)...)
+	if len(violations) > 0 {
+		return nil, &InvalidDefinitionError{Violations: violations}
+	}
+
+	definition := &entity.ToneDefinition{ID: input.ID}
+	applyTone(definition, input)
+	if err := u.tones.Create(ctx, definition); err != nil {
+		return nil, err
+	}
+	return definition, nil
+}
+
+// UpdateTone: トーンの定義を更新（組み込みのトーンもすべての項目を変更できる）
+func (u *useCase) UpdateTone(ctx context.Context, id entity.Tone, input ToneInput) (*entity.ToneDefinition, error) {
+	definition, err := u.tones.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if violations := validateTone(input); len(violations) > 0 {
+		return nil, &InvalidDefinitionError{Violations: violations}
+	}
+
+	applyTone(definition, input)
+	if err := u.tones.Update(ctx, definition); err != nil {
+		return nil, err
+	}
+	return definition, nil
+}
+
+// DeleteTone: トーンを削除（組み込みのトーンは削除できない）
+func (u *useCase) DeleteTone(ctx context.Context, id entity.Tone) error {
+	definition, err := u.tones.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if definition.BuiltIn {
+		return ErrBuiltinDefinition
+	}
+	return u.tones.Delete(ctx, id)
+}
+
+func (u *useCase) Options(ctx context.Context) (*Options, error) {
+	channels, err := u.channels.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tones, err := u.tones.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &Options{
+		Channels: make([]entity.Option, len(channels)),
+		Tones:    make([]entity.Option, len(tones)),
+	}
+	for i, d := range channels {
+		options.Channels[i] = d.Option()
+	}
+	for i, d := range tones {
+		options.Tones[i] = d.Option()
+	}
+	return options, nil
+}
+
+// validateChannel: 配信チャネルの表示名と出力項目を検証し、違反内容を返す
+func validateChannel(input ChannelInput) []string {
+	var violations []string
+	if strings.TrimSpace(input.Label) == "" {
+		violations = append(violations, "labelがありません")
+	}
+
+	seen := map[string]bool{}
+	for _, field := range input.Fields {
+		switch {
+		case !fieldNamePattern.MatchString(field.Name):
+			violations = append(violations, fmt.Sprintf("出力項目の名前は英字で始まる英数字・_の50文字以内にしてください（%s）", field.Name))
+		case seen[field.Name]:
+			violations = append(violations, fmt.Sprintf("出力項目の名前が重複しています（%s）", field.Name))
+		}
+		seen[field.Name] = true
+
+		if strings.TrimSpace(field.Description) == "" {
+			violations = append(violations, fmt.Sprintf("出力項目の説明がありません（%s）", field.Name))
+		}
+		limit := field.Limit
+		if !limit.Unit.Valid() {
+			violations = append(violations, fmt.Sprintf("出力項目の単位はrunes・graphemes・widthのいずれかにしてください（%s）", field.Name))
+		}
+		if limit.Max <= 0 || limit.Min < 0 || limit.Min > limit.Max {
+			violations = append(violations, fmt.Sprintf("出力項目の長さの制限は0≦min≦max、0<maxにしてください（%s）", field.Name))
+		}
+	}
+	for _, name := range []string{entity.FieldTitle, entity.FieldDescription} {
+		if !seen[name] {
+			violations = append(violations, fmt.Sprintf("出力項目に%sがありません", name))
+		}
+	}
+	return violations
+}
+
+func validateTone(input ToneInput) []string {
+	if strings.TrimSpace(input.Label) == "" {
+		return []string{"labelがありません"}
+	}
+	return nil
+}
+
+func applyChannel(definition *entity.ChannelDefinition, input ChannelInput) {
+	definition.Label = strings.TrimSpace(input.Label)
+	definition.Description = strings.TrimSpace(input.Description)
+	definition.Guidance = strings.TrimSpace(input.Guidance)
+	definition.Fields = input.Fields
+	definition.SortOrder = input.SortOrder
+}
+
+// applyTone: 入力の内容を反映（空の表現は取り除く）
+func applyTone(definition *entity.ToneDefinition, input ToneInput) {
+	definition.Label = strings.TrimSpace(input.Label)
+	definition.Description = strings.TrimSpace(input.Description)
+	definition.Guidance = strings.TrimSpace(input.Guidance)
+	definition.Markers = make([]string, 0, len(input.Markers))
+	for _, marker := range input.Markers {
+		if marker = strings.TrimSpace(marker); marker != "" {
+			definition.Markers = append(definition.Markers, marker)
+		}
+	}
+	definition.SortOrder = input.SortOrder
+}
+
+func sameFields(a, b []entity.OutputField) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
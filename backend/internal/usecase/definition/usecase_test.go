@@ -0,0 +1,386 @@
+package definition_usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/textmetrics"
+)
+
+// リポジトリのモック
+type mockChannelRepository struct {
+	mock.Mock
+}
+
+func (m *mockChannelRepository) List(ctx context.Context) ([]*entity.ChannelDefinition, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.ChannelDefinition), args.Error(1)
+}
+
+func (m *mockChannelRepository) Get(ctx context.Context, id entity.Channel) (*entity.ChannelDefinition, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ChannelDefinition), args.Error(1)
+}
+
+func (m *mockChannelRepository) Create(ctx context.Context, definition *entity.ChannelDefinition) error {
+	args := m.Called(ctx, definition)
+	return args.Error(0)
+}
+
+func (m *mockChannelRepository) Update(ctx context.Context, definition *entity.ChannelDefinition) error {
+	args := m.Called(ctx, definition)
+	return args.Error(0)
+}
+
+func (m *mockChannelRepository) Delete(ctx context.Context, id entity.Channel) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+type mockToneRepository struct {
+	mock.Mock
+}
+
+func (m *mockToneRepository) List(ctx context.Context) ([]*entity.ToneDefinition, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.ToneDefinition), args.Error(1)
+}
+
+func (m *mockToneRepository) Get(ctx context.Context, id entity.Tone) (*entity.ToneDefinition, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ToneDefinition), args.Error(1)
+}
+
+func (m *mockToneRepository) Create(ctx context.Context, definition *entity.ToneDefinition) error {
+	args := m.Called(ctx, definition)
+	return args.Error(0)
+}
+
+func (m *mockToneRepository) Update(ctx context.Context, definition *entity.ToneDefinition) error {
+	args := m.Called(ctx, definition)
+	return args.Error(0)
+}
+
+func (m *mockToneRepository) Delete(ctx context.Context, id entity.Tone) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func limit(min, max int) textmetrics.Limit {
+	return textmetrics.Limit{Unit: textmetrics.UnitGraphemes, Min: min, Max: max}
+}
+
+// reelsInput: 組み込み以外のチャネルの登録内容
+func reelsInput() ChannelInput {
+	return ChannelInput{
+		ID:       "reels",
+		Label:    " Instagramリール ",
+		Guidance: "冒頭で視聴者の手を止める書き出しにしてください。",
+		Fields: []entity.OutputField{
+			{Name: entity.FieldTitle, Description: "テロップ", Limit: limit(0, 15)},
+			{Name: entity.FieldDescription, Description: "キャプション", Limit: limit(10, 60)},
+			{Name: "hook", Description: "最初の一言", Limit: limit(0, 10)},
+		},
+		SortOrder: 6,
+	}
+}
+
+func TestCreateChannel(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          func() ChannelInput
+		repoErr        error
+		wantViolations []string
+		wantErr        error
+	}{
+		{
+			name:  "正常系",
+			input: reelsInput,
+		},
+		{
+			name: "異常系_IDの形式が不正",
+			input: func() ChannelInput {
+				in := reelsInput()
+				in.ID = "Reels!"
+				return in
+			},
+			wantViolations: []string{"idは英小文字で始まる英小文字・数字・_・-の50文字以内にしてください"},
+		},
+		{
+			name: "異常系_必須の出力項目なし",
+			input: func() ChannelInput {
+				in := reelsInput()
+				in.Fields = in.Fields[1:]
+				return in
+			},
+			wantViolations: []string{"出力項目にtitleがありません"},
+		},
+		{
+			name: "異常系_出力項目が不正",
+			input: func() ChannelInput {
+				in := reelsInput()
+				in.Fields = append(in.Fields,
+					entity.OutputField{Name: "hook", Description: "重複", Limit: limit(0, 10)},
+					entity.OutputField{Name: "cta-label", Limit: textmetrics.Limit{Unit: "bytes", Min: 5, Max: 3}},
+				)
+				return in
+			},
+			wantViolations: []string{
+				"出力項目の名前が重複しています（hook）",
+				"出力項目の名前は英字で始まる英数字・_の50文字以内にしてください（cta-label）",
+				"出力項目の説明がありません（cta-label）",
+				"出力項目の単位はrunes・graphemes・widthのいずれかにしてください（cta-label）",
+				"出力項目の長さの制限は0≦min≦max、0<maxにしてください（cta-label）",
+			},
+		},
+		{
+			name:    "異常系_登録済みのID",
+			input:   reelsInput,
+			repoErr: repository.ErrDuplicated,
+			wantErr: repository.ErrDuplicated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			channels := new(mockChannelRepository)
+			channels.On("Create", mock.Anything, mock.Anything).Return(tt.repoErr)
+
+			// テスト実行
+			u := NewUseCase(channels, new(mockToneRepository))
+			got, err := u.CreateChannel(context.Background(), tt.input())
+
+			// アサーション
+			if tt.wantViolations != nil {
+				var invalid *InvalidDefinitionError
+				assert.ErrorAs(t, err, &invalid)
+				assert.Equal(t, tt.wantViolations, invalid.Violations)
+				assert.ErrorIs(t, err, ErrInvalidDefinition)
+				channels.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, entity.Channel("reels"), got.ID)
+			assert.Equal(t, "Instagramリール", got.Label)
+			assert.False(t, got.BuiltIn)
+			assert.Len(t, got.Fields, 3)
+		})
+	}
+}
+
+func TestUpdateChannel(t *testing.T) {
+	builtinInput := func() ChannelInput {
+		return ChannelInput{Label: "SNS", Guidance: "絵文字は1つまでにしてください。", SortOrder: 1}
+	}
+
+	tests := []struct {
+		name           string
+		id             entity.Channel
+		input          func() ChannelInput
+		current        func() *entity.ChannelDefinition
+		getErr         error
+		wantViolations []string
+		wantErr        error
+	}{
+		{
+			name:  "正常系_組み込みのチャネルの指示を変更",
+			id:    entity.ChannelSNS,
+			input: builtinInput,
+			current: func() *entity.ChannelDefinition {
+				d, _ := entity.BuiltinChannel(entity.ChannelSNS)
+				return d
+			},
+		},
+		{
+			name: "異常系_組み込みのチャネルの出力項目は変更できない",
+			id:   entity.ChannelSNS,
+			input: func() ChannelInput {
+				in := builtinInput()
+				in.Fields = reelsInput().Fields
+				return in
+			},
+			current: func() *entity.ChannelDefinition {
+				d, _ := entity.BuiltinChannel(entity.ChannelSNS)
+				return d
+			},
+			wantViolations: []string{"組み込みのチャネルの出力項目は変更できません"},
+		},
+		{
+			name:    "異常系_存在しないチャネル",
+			id:      "tv",
+			input:   reelsInput,
+			getErr:  repository.ErrChannelNotFound,
+			wantErr: repository.ErrChannelNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			channels := new(mockChannelRepository)
+			if tt.getErr != nil {
+				channels.On("Get", mock.Anything, tt.id).Return(nil, tt.getErr)
+			} else {
+				channels.On("Get", mock.Anything, tt.id).Return(tt.current(), nil)
+			}
+			channels.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+			// テスト実行
+			u := NewUseCase(channels, new(mockToneRepository))
+			got, err := u.UpdateChannel(context.Background(), tt.id, tt.input())
+
+			// アサーション
+			if tt.wantViolations != nil {
+				var invalid *InvalidDefinitionError
+				assert.ErrorAs(t, err, &invalid)
+				assert.Equal(t, tt.wantViolations, invalid.Violations)
+				channels.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+				return
+			}
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "SNS", got.Label)
+			assert.Equal(t, "絵文字は1つまでにしてください。", got.Guidance)
+			// 出力項目を省略した場合は組み込みの出力項目を維持する
+			sns, _ := entity.BuiltinChannel(entity.ChannelSNS)
+			assert.Equal(t, sns.Fields, got.Fields)
+		})
+	}
+}
+
+func TestDeleteChannel(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition *entity.ChannelDefinition
+		wantErr    error
+	}{
+		{
+			name:       "正常系",
+			definition: &entity.ChannelDefinition{ID: "reels"},
+		},
+		{
+			name:       "異常系_組み込みのチャネル",
+			definition: &entity.ChannelDefinition{ID: entity.ChannelSNS, BuiltIn: true},
+			wantErr:    ErrBuiltinDefinition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			channels := new(mockChannelRepository)
+			channels.On("Get", mock.Anything, tt.definition.ID).Return(tt.definition, nil)
+			channels.On("Delete", mock.Anything, tt.definition.ID).Return(nil)
+
+			// テスト実行
+			u := NewUseCase(channels, new(mockToneRepository))
+			err := u.DeleteChannel(context.Background(), tt.definition.ID)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				channels.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			channels.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateTone(t *testing.T) {
+	tones := new(mockToneRepository)
+	tones.On("Create", mock.Anything, mock.Anything).Return(nil)
+	u := NewUseCase(new(mockChannelRepository), tones)
+
+	t.Run("正常系_空の表現を取り除く", func(t *testing.T) {
+		got, err := u.CreateTone(context.Background(), ToneInput{
+			ID:      "urgent",
+			Label:   "限定感",
+			Markers: []string{" 限定 ", "", "残りわずか"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"限定", "残りわずか"}, got.Markers)
+	})
+
+	t.Run("異常系_表示名なし", func(t *testing.T) {
+		_, err := u.CreateTone(context.Background(), ToneInput{ID: "urgent"})
+		var invalid *InvalidDefinitionError
+		assert.ErrorAs(t, err, &invalid)
+		assert.Equal(t, []string{"labelがありません"}, invalid.Violations)
+	})
+}
+
+func TestOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		repoErr error
+		want    *Options
+	}{
+		{
+			name: "正常系",
+			want: &Options{
+				Channels: []entity.Option{entity.BuiltinChannels[0].Option(), {Value: "reels", Label: "Instagramリール"}},
+				Tones:    []entity.Option{entity.BuiltinTones[0].Option()},
+			},
+		},
+		{
+			name:    "異常系_リポジトリエラー",
+			repoErr: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			channels := new(mockChannelRepository)
+			tones := new(mockToneRepository)
+			if tt.repoErr != nil {
+				channels.On("List", mock.Anything).Return(nil, tt.repoErr)
+			} else {
+				channels.On("List", mock.Anything).Return([]*entity.ChannelDefinition{
+					entity.BuiltinChannels[0],
+					{ID: "reels", Label: "Instagramリール"},
+				}, nil)
+				tones.On("List", mock.Anything).Return([]*entity.ToneDefinition{entity.BuiltinTones[0]}, nil)
+			}
+
+			// テスト実行
+			got, err := NewUseCase(channels, tones).Options(context.Background())
+
+			// アサーション
+			if tt.repoErr != nil {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,267 @@
+package job_usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/auth"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	copy_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/copy"
+)
+
+const (
+	// DefaultMaxAttempts: ジョブを実行する回数の既定値
+	DefaultMaxAttempts = 5
+	// DefaultBackoff / DefaultMaxBackoff: 再試行までの待機時間の初期値と上限
+	DefaultBackoff    = 10 * time.Second
+	DefaultMaxBackoff = 10 * time.Minute
+	// DefaultLease: 実行中のジョブを停止したワーカーのものとみなすまでの時間
+	DefaultLease = 10 * time.Minute
+	// DefaultTimeout: 1回の実行にかける時間の上限
+	DefaultTimeout = 5 * time.Minute
+)
+
+type UseCase interface {
+	Enqueue(ctx context.Context, input copy_usecase.CreateCopyInput) (*entity.GenerationJob, error)
+	GetJob(ctx context.Context, id int) (*entity.GenerationJob, error)
+	// ProcessNext: 実行可能なジョブを1件実行する（実行するジョブがない場合はfalse）
+	ProcessNext(ctx context.Context, workerID string) (bool, error)
+}
+
+type useCase struct {
+	repo        repository.GenerationJobRepository
+	copies      copy_usecase.UseCase
+	maxAttempts int
+	backoff     time.Duration
+	maxBackoff  time.Duration
+	lease       time.Duration
+	timeout     time.Duration
+	now         func() time.Time
+}
+
+// Option: ユースケースの設定
+type Option func(*useCase)
+
+// WithMaxAttempts: ジョブを実行する回数の上限を設定
+func WithMaxAttempts(n int) Option {
+	return func(u *useCase) {
+		if n > 0 {
+			u.maxAttempts = n
+		}
+	}
+}
+
+// WithBackoff: 再試行までの待機時間を設定（失敗するたびに倍にし、maxで打ち切る）
+func WithBackoff(base, max time.Duration) Option {
+	return func(u *useCase) {
+		if base > 0 {
+			u.backoff = base
+		}
+		if max > 0 {
+			u.maxBackoff = max
+		}
+	}
+}
+
+// WithLease: 実行中のジョブを再取得するまでの時間を設定
+func WithLease(d time.Duration) Option {
+	return func(u *useCase) {
+		if d > 0 {
+			u.lease = d
+		}
+	}
+}
+
+// WithTimeout: 1回の実行にかける時間の上限を設定（ロックの期限より短くする必要がある）
+func WithTimeout(d time.Duration) Option {
+	return func(u *useCase) {
+		if d > 0 {
+			u.timeout = d
+		}
+	}
+}
+
+// NewUseCase: 生成ジョブのユースケースを作成
+//
+// 1回の実行にかける時間がロックの期限以上の場合、実行中のジョブを他のワーカーが再取得してしまうためエラーを返します。
+func NewUseCase(repo repository.GenerationJobRepository, copies copy_usecase.UseCase, opts ...Option) (UseCase, error) {
+	u := &useCase{
+		repo:        repo,
+		copies:      copies,
+		maxAttempts: DefaultMaxAttempts,
+		backoff:     DefaultBackoff,
+		maxBackoff:  DefaultMaxBackoff,
+		lease:       DefaultLease,
+		timeout:     DefaultTimeout,
+		now:         time.Now,
+	}
+	for _, opt := range opts {
+		opt(u)
+	}
+	if u.timeout >= u.lease {
+		return nil, fmt.Errorf("job timeout %s must be shorter than lease %s", u.timeout, u.lease)
+	}
+	return u, nil
+}
+
+// Enqueue: 生成ジョブを登録する（生成はワーカーが非同期で実行）
+func (u *useCase) Enqueue(ctx context.Context, input copy_usecase.CreateCopyInput) (*entity.GenerationJob, error) {
+	if input.Variants < 0 || input.Variants > copy_usecase.MaxVariants {
+		return nil, copy_usecase.ErrInvalidVariants
+	}
+	if err := u.copies.ValidateInput(ctx, input); err != nil {
+		return nil, err
+	}
+
+	job := &entity.GenerationJob{
+		Input: entity.GenerationJobInput{
+			ProductName:     input.ProductName,
+			ProductFeatures: input.ProductFeatures,
+			Target:          input.Target,
+			Channel:         input.Channel,
+			Tone:            input.Tone,
+			Variants:        input.Variants,
+			Locale:          input.Locale,
+			BrandProfileID:  input.BrandProfileID,
+			ProductID:       input.ProductID,
+			CampaignID:      input.CampaignID,
+			CreatedBy:       createdBy(ctx, input),
+		},
+		MaxAttempts: u.maxAttempts,
+		RunAt:       u.now(),
+		CopyIDs:     []int{},
+	}
+	if err := u.repo.Create(ctx, job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+func (u *useCase) GetJob(ctx context.Context, id int) (*entity.GenerationJob, error) {
+	return u.repo.Get(ctx, id)
+}
+
+// ProcessNext: ジョブを1件取得して実行し、結果に応じて完了・再試行・失敗にする
+//
+// 生成に失敗した場合も、ジョブの状態を更新できればジョブの失敗内容をエラーとして返します。
+func (u *useCase) ProcessNext(ctx context.Context, workerID string) (bool, error) {
+	now := u.now()
+	job, err := u.repo.Claim(ctx, workerID, now, now.Add(-u.lease))
+	if err != nil {
+		return false, err
+	}
+	if job == nil {
+		return false, nil
+	}
+
+	// 実行中にワーカーが停止し、再取得した時点で上限を超えている場合
+	if job.Attempts > job.MaxAttempts {
+		err := fmt.Errorf("generation job %d exceeded %d attempts", job.ID, job.MaxAttempts)
+		return true, errors.Join(err, u.repo.Fail(ctx, job.ID, workerID, err.Error(), u.now()))
+	}
+
+	copyIDs, generationID, runErr := u.run(ctx, job)
+	if runErr == nil {
+		return true, u.repo.Complete(ctx, job.ID, workerID, copyIDs, generationID, u.now())
+	}
+
+	runErr = fmt.Errorf("generation job %d attempt %d/%d: %w", job.ID, job.Attempts, job.MaxAttempts, runErr)
+	if job.Attempts >= job.MaxAttempts {
+		return true, errors.Join(runErr, u.repo.Fail(ctx, job.ID, workerID, runErr.Error(), u.now()))
+	}
+	runAt := u.now().Add(u.backoffFor(job.Attempts))
+	return true, errors.Join(runErr, u.repo.Retry(ctx, job.ID, workerID, runAt, runErr.Error()))
+}
+
+// run: ジョブの入力でコピーを生成し、生成したコピーのIDを返す
+//
+// 生成IDをジョブごとに固定し、同じジョブの再実行ではコピーを重複して保存しません。
+func (u *useCase) run(ctx context.Context, job *entity.GenerationJob) ([]int, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	input := copy_usecase.CreateCopyInput{
+		ProductName:     job.Input.ProductName,
+		ProductFeatures: job.Input.ProductFeatures,
+		Target:          job.Input.Target,
+		Channel:         job.Input.Channel,
+		Tone:            job.Input.Tone,
+		Variants:        job.Input.Variants,
+		Locale:          job.Input.Locale,
+		BrandProfileID:  job.Input.BrandProfileID,
+		ProductID:       job.Input.ProductID,
+		CampaignID:      job.Input.CampaignID,
+		CreatedBy:       job.Input.CreatedBy,
+		GenerationID:    generationIDFor(job),
+	}
+
+	// リースの期限切れ後も前回の実行が保存まで完了していた場合は、生成し直さずに保存済みのコピーを使用する
+	generation, err := u.copies.GetGeneration(ctx, input.GenerationID)
+	if err == nil {
+		return copyIDsOf(generation), resultGenerationID(input, generation), nil
+	}
+	if !errors.Is(err, copy_usecase.ErrGenerationNotFound) {
+		return nil, "", err
+	}
+
+	if input.Variants > 1 {
+		generation, err := u.copies.CreateVariants(ctx, input)
+		if err != nil {
+			return nil, "", err
+		}
+		return copyIDsOf(generation), generation.ID, nil
+	}
+
+	copy, err := u.copies.CreateCopy(ctx, input)
+	if err != nil {
+		return nil, "", err
+	}
+	return []int{copy.ID}, "", nil
+}
+
+// generationIDFor: ジョブで生成するコピーの生成ID（ジョブごとに固定）
+func generationIDFor(job *entity.GenerationJob) string {
+	return fmt.Sprintf("job-%d", job.ID)
+}
+
+// copyIDsOf: 候補のコピーのID（スコアの高い順）
+func copyIDsOf(generation *copy_usecase.Generation) []int {
+	ids := make([]int, len(generation.Variants))
+	for i, v := range generation.Variants {
+		ids[i] = v.Copy.ID
+	}
+	return ids
+}
+
+// resultGenerationID: ジョブに記録する生成ID（1件のみ生成するジョブでは記録しない）
+func resultGenerationID(input copy_usecase.CreateCopyInput, generation *copy_usecase.Generation) string {
+	if input.Variants > 1 {
+		return generation.ID
+	}
+	return ""
+}
+
+// backoffFor: attempt回目の失敗後に待機する時間（指数的に増やし、上限で打ち切る）
+func (u *useCase) backoffFor(attempt int) time.Duration {
+	d := u.backoff
+	for i := 1; i < attempt; i++ {
+		d *= 2
+		if d >= u.maxBackoff {
+			return u.maxBackoff
+		}
+	}
+	return d
+}
+
+// createdBy: ジョブを登録した利用者（入力で指定しない場合はコンテキストの呼び出し元）
+//
+// ワーカーのコンテキストには呼び出し元がないため、登録時に記録しておきます。
+func createdBy(ctx context.Context, input copy_usecase.CreateCopyInput) *int {
+	if input.CreatedBy != nil {
+		return input.CreatedBy
+	}
+	return auth.UserID(ctx)
+}
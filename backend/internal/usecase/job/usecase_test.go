@@ -0,0 +1,374 @@
+package job_usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/auth"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	copy_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/copy"
+)
+
+// リポジトリのモック
+type mockJobRepository struct {
+	mock.Mock
+}
+
+func (m *mockJobRepository) Create(ctx context.Context, job *entity.GenerationJob) error {
+	args := m.Called(ctx, job)
+	if args.Error(0) == nil {
+		job.ID = 1
+		job.Status = entity.JobStatusQueued
+	}
+	return args.Error(0)
+}
+
+func (m *mockJobRepository) Get(ctx context.Context, id int) (*entity.GenerationJob, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.GenerationJob), args.Error(1)
+}
+
+func (m *mockJobRepository) Claim(ctx context.Context, workerID string, now, staleBefore time.Time) (*entity.GenerationJob, error) {
+	args := m.Called(ctx, workerID, now, staleBefore)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.GenerationJob), args.Error(1)
+}
+
+func (m *mockJobRepository) Complete(ctx context.Context, id int, workerID string, copyIDs []int, generationID string, completedAt time.Time) error {
+	args := m.Called(ctx, id, workerID, copyIDs, generationID, completedAt)
+	return args.Error(0)
+}
+
+func (m *mockJobRepository) Retry(ctx context.Context, id int, workerID string, runAt time.Time, lastError string) error {
+	args := m.Called(ctx, id, workerID, runAt, lastError)
+	return args.Error(0)
+}
+
+func (m *mockJobRepository) Fail(ctx context.Context, id int, workerID string, lastError string, completedAt time.Time) error {
+	args := m.Called(ctx, id, workerID, lastError, completedAt)
+	return args.Error(0)
+}
+
+// コピー生成のユースケースのモック（ジョブで使用するメソッドのみ）
+type mockCopyUseCase struct {
+	copy_usecase.UseCase
+	mock.Mock
+}
+
+func (m *mockCopyUseCase) CreateCopy(ctx context.Context, input copy_usecase.CreateCopyInput) (*entity.Copy, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Copy), args.Error(1)
+}
+
+func (m *mockCopyUseCase) CreateVariants(ctx context.Context, input copy_usecase.CreateCopyInput) (*copy_usecase.Generation, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*copy_usecase.Generation), args.Error(1)
+}
+
+func (m *mockCopyUseCase) GetGeneration(ctx context.Context, generationID string) (*copy_usecase.Generation, error) {
+	args := m.Called(ctx, generationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*copy_usecase.Generation), args.Error(1)
+}
+
+func (m *mockCopyUseCase) ValidateInput(ctx context.Context, input copy_usecase.CreateCopyInput) error {
+	args := m.Called(ctx, input)
+	return args.Error(0)
+}
+
+var testNow = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestUseCase(repo *mockJobRepository, copies *mockCopyUseCase, opts ...Option) *useCase {
+	uc, err := NewUseCase(repo, copies, opts...)
+	if err != nil {
+		panic(err)
+	}
+	u := uc.(*useCase)
+	u.now = func() time.Time { return testNow }
+	return u
+}
+
+func TestEnqueue(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       copy_usecase.CreateCopyInput
+		validateErr error
+		repoErr     error
+		wantErr     error
+	}{
+		{
+			name: "正常系",
+			input: copy_usecase.CreateCopyInput{
+				ProductName: "テスト商品",
+				Channel:     entity.ChannelSNS,
+				Tone:        entity.ToneCasual,
+				Variants:    3,
+			},
+		},
+		{
+			name:    "異常系_候補数が上限を超える",
+			input:   copy_usecase.CreateCopyInput{Variants: copy_usecase.MaxVariants + 1},
+			wantErr: copy_usecase.ErrInvalidVariants,
+		},
+		{
+			name:        "異常系_未登録のチャネル",
+			input:       copy_usecase.CreateCopyInput{ProductName: "テスト商品", Channel: "tv", Tone: entity.ToneCasual},
+			validateErr: copy_usecase.ErrInvalidChannel,
+			wantErr:     copy_usecase.ErrInvalidChannel,
+		},
+		{
+			name:    "異常系_リポジトリエラー",
+			input:   copy_usecase.CreateCopyInput{ProductName: "テスト商品", Channel: entity.ChannelSNS, Tone: entity.ToneCasual},
+			repoErr: errors.New("repository error"),
+			wantErr: errors.New("repository error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			repo := new(mockJobRepository)
+			repo.On("Create", mock.Anything, mock.Anything).Return(tt.repoErr)
+			copies := new(mockCopyUseCase)
+			copies.On("ValidateInput", mock.Anything, tt.input).Return(tt.validateErr)
+
+			// テスト実行
+			u := newTestUseCase(repo, copies, WithMaxAttempts(3))
+			ctx := auth.WithIdentity(context.Background(), &entity.Identity{UserID: 1})
+			got, err := u.Enqueue(ctx, tt.input)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, entity.JobStatusQueued, got.Status)
+			assert.Equal(t, 3, got.MaxAttempts)
+			assert.Equal(t, testNow, got.RunAt)
+			assert.Equal(t, tt.input.ProductName, got.Input.ProductName)
+			assert.Equal(t, tt.input.Variants, got.Input.Variants)
+			// 登録した利用者をコピーの生成者として記録する（ワーカーのコンテキストには呼び出し元がない）
+			assert.Equal(t, 1, *got.Input.CreatedBy)
+		})
+	}
+}
+
+func TestGetJob(t *testing.T) {
+	repo := new(mockJobRepository)
+	repo.On("Get", mock.Anything, 1).Return(&entity.GenerationJob{ID: 1, Status: entity.JobStatusRunning}, nil)
+	repo.On("Get", mock.Anything, 999).Return(nil, repository.ErrJobNotFound)
+	u := newTestUseCase(repo, new(mockCopyUseCase))
+
+	got, err := u.GetJob(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.JobStatusRunning, got.Status)
+
+	_, err = u.GetJob(context.Background(), 999)
+	assert.ErrorIs(t, err, repository.ErrJobNotFound)
+}
+
+func TestNewUseCase(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantErr bool
+	}{
+		{name: "正常系_既定値"},
+		{name: "正常系_ロックの期限より短い", opts: []Option{WithTimeout(time.Minute), WithLease(2 * time.Minute)}},
+		{name: "異常系_ロックの期限と同じ", opts: []Option{WithTimeout(time.Minute), WithLease(time.Minute)}, wantErr: true},
+		{name: "異常系_ロックの期限より長い", opts: []Option{WithTimeout(DefaultLease + time.Minute)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// テスト実行
+			got, err := NewUseCase(new(mockJobRepository), new(mockCopyUseCase), tt.opts...)
+
+			// アサーション
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotNil(t, got)
+		})
+	}
+}
+
+func TestProcessNext(t *testing.T) {
+	staleBefore := testNow.Add(-DefaultLease)
+
+	tests := []struct {
+		name          string
+		job           *entity.GenerationJob
+		setupMock     func(*mockJobRepository, *mockCopyUseCase)
+		wantProcessed bool
+		wantErr       bool
+	}{
+		{
+			name:          "正常系_実行するジョブがない",
+			job:           nil,
+			setupMock:     func(*mockJobRepository, *mockCopyUseCase) {},
+			wantProcessed: false,
+		},
+		{
+			name: "正常系_1件生成して完了",
+			job:  &entity.GenerationJob{ID: 1, Attempts: 1, MaxAttempts: 3, Input: entity.GenerationJobInput{ProductName: "テスト商品"}},
+			setupMock: func(repo *mockJobRepository, copies *mockCopyUseCase) {
+				copies.On("GetGeneration", mock.Anything, "job-1").Return(nil, copy_usecase.ErrGenerationNotFound)
+				copies.On("CreateCopy", mock.Anything, copy_usecase.CreateCopyInput{ProductName: "テスト商品", GenerationID: "job-1"}).Return(&entity.Copy{ID: 10}, nil)
+				repo.On("Complete", mock.Anything, 1, "worker-1", []int{10}, "", testNow).Return(nil)
+			},
+			wantProcessed: true,
+		},
+		{
+			name: "正常系_複数候補を生成して完了",
+			job:  &entity.GenerationJob{ID: 1, Attempts: 1, MaxAttempts: 3, Input: entity.GenerationJobInput{Variants: 2}},
+			setupMock: func(repo *mockJobRepository, copies *mockCopyUseCase) {
+				copies.On("GetGeneration", mock.Anything, "job-1").Return(nil, copy_usecase.ErrGenerationNotFound)
+				copies.On("CreateVariants", mock.Anything, copy_usecase.CreateCopyInput{Variants: 2, GenerationID: "job-1"}).Return(&copy_usecase.Generation{
+					ID:       "job-1",
+					Variants: []copy_usecase.Variant{{Copy: &entity.Copy{ID: 11}}, {Copy: &entity.Copy{ID: 12}}},
+				}, nil)
+				repo.On("Complete", mock.Anything, 1, "worker-1", []int{11, 12}, "job-1", testNow).Return(nil)
+			},
+			wantProcessed: true,
+		},
+		{
+			name: "正常系_前回の実行で保存済みの候補は生成し直さない",
+			job:  &entity.GenerationJob{ID: 1, Attempts: 2, MaxAttempts: 3, Input: entity.GenerationJobInput{Variants: 2}},
+			setupMock: func(repo *mockJobRepository, copies *mockCopyUseCase) {
+				copies.On("GetGeneration", mock.Anything, "job-1").Return(&copy_usecase.Generation{
+					ID:       "job-1",
+					Variants: []copy_usecase.Variant{{Copy: &entity.Copy{ID: 11}}, {Copy: &entity.Copy{ID: 12}}},
+				}, nil)
+				repo.On("Complete", mock.Anything, 1, "worker-1", []int{11, 12}, "job-1", testNow).Return(nil)
+			},
+			wantProcessed: true,
+		},
+		{
+			name: "正常系_前回の実行で保存済みのコピーは生成し直さない",
+			job:  &entity.GenerationJob{ID: 1, Attempts: 2, MaxAttempts: 3, Input: entity.GenerationJobInput{ProductName: "テスト商品"}},
+			setupMock: func(repo *mockJobRepository, copies *mockCopyUseCase) {
+				copies.On("GetGeneration", mock.Anything, "job-1").Return(&copy_usecase.Generation{
+					ID:       "job-1",
+					Variants: []copy_usecase.Variant{{Copy: &entity.Copy{ID: 10}}},
+				}, nil)
+				repo.On("Complete", mock.Anything, 1, "worker-1", []int{10}, "", testNow).Return(nil)
+			},
+			wantProcessed: true,
+		},
+		{
+			name: "異常系_保存済みのコピーの確認に失敗したため再試行",
+			job:  &entity.GenerationJob{ID: 1, Attempts: 1, MaxAttempts: 3},
+			setupMock: func(repo *mockJobRepository, copies *mockCopyUseCase) {
+				copies.On("GetGeneration", mock.Anything, "job-1").Return(nil, errors.New("db error"))
+				repo.On("Retry", mock.Anything, 1, "worker-1", testNow.Add(DefaultBackoff), "generation job 1 attempt 1/3: db error").Return(nil)
+			},
+			wantProcessed: true,
+			wantErr:       true,
+		},
+		{
+			name: "異常系_他のワーカーが再取得済みのため完了を記録しない",
+			job:  &entity.GenerationJob{ID: 1, Attempts: 1, MaxAttempts: 3, Input: entity.GenerationJobInput{ProductName: "テスト商品"}},
+			setupMock: func(repo *mockJobRepository, copies *mockCopyUseCase) {
+				copies.On("GetGeneration", mock.Anything, "job-1").Return(nil, copy_usecase.ErrGenerationNotFound)
+				copies.On("CreateCopy", mock.Anything, mock.Anything).Return(&entity.Copy{ID: 10}, nil)
+				repo.On("Complete", mock.Anything, 1, "worker-1", []int{10}, "", testNow).Return(repository.ErrJobLeaseLost)
+			},
+			wantProcessed: true,
+			wantErr:       true,
+		},
+		{
+			name: "異常系_生成エラーで再試行",
+			job:  &entity.GenerationJob{ID: 1, Attempts: 2, MaxAttempts: 3},
+			setupMock: func(repo *mockJobRepository, copies *mockCopyUseCase) {
+				copies.On("GetGeneration", mock.Anything, "job-1").Return(nil, copy_usecase.ErrGenerationNotFound)
+				copies.On("CreateCopy", mock.Anything, mock.Anything).Return(nil, errors.New("llm error"))
+				// 2回目の失敗後は初期値の2倍待機する
+				repo.On("Retry", mock.Anything, 1, "worker-1", testNow.Add(2*DefaultBackoff), "generation job 1 attempt 2/3: llm error").Return(nil)
+			},
+			wantProcessed: true,
+			wantErr:       true,
+		},
+		{
+			name: "異常系_上限に達したため失敗",
+			job:  &entity.GenerationJob{ID: 1, Attempts: 3, MaxAttempts: 3},
+			setupMock: func(repo *mockJobRepository, copies *mockCopyUseCase) {
+				copies.On("GetGeneration", mock.Anything, "job-1").Return(nil, copy_usecase.ErrGenerationNotFound)
+				copies.On("CreateCopy", mock.Anything, mock.Anything).Return(nil, errors.New("llm error"))
+				repo.On("Fail", mock.Anything, 1, "worker-1", "generation job 1 attempt 3/3: llm error", testNow).Return(nil)
+			},
+			wantProcessed: true,
+			wantErr:       true,
+		},
+		{
+			name: "異常系_停止したワーカーから再取得した時点で上限を超えている",
+			job:  &entity.GenerationJob{ID: 1, Attempts: 4, MaxAttempts: 3},
+			setupMock: func(repo *mockJobRepository, copies *mockCopyUseCase) {
+				repo.On("Fail", mock.Anything, 1, "worker-1", "generation job 1 exceeded 3 attempts", testNow).Return(nil)
+			},
+			wantProcessed: true,
+			wantErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			repo := new(mockJobRepository)
+			copies := new(mockCopyUseCase)
+			if tt.job != nil {
+				repo.On("Claim", mock.Anything, "worker-1", testNow, staleBefore).Return(tt.job, nil)
+			} else {
+				repo.On("Claim", mock.Anything, "worker-1", testNow, staleBefore).Return(nil, nil)
+			}
+			tt.setupMock(repo, copies)
+
+			// テスト実行
+			u := newTestUseCase(repo, copies)
+			processed, err := u.ProcessNext(context.Background(), "worker-1")
+
+			// アサーション
+			assert.Equal(t, tt.wantProcessed, processed)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			repo.AssertExpectations(t)
+			copies.AssertExpectations(t)
+		})
+	}
+}
+
+func TestBackoffFor(t *testing.T) {
+	u := newTestUseCase(new(mockJobRepository), new(mockCopyUseCase), WithBackoff(time.Second, 5*time.Second))
+
+	assert.Equal(t, time.Second, u.backoffFor(1))
+	assert.Equal(t, 2*time.Second, u.backoffFor(2))
+	assert.Equal(t, 4*time.Second, u.backoffFor(3))
+	assert.Equal(t, 5*time.Second, u.backoffFor(4))
+	assert.Equal(t, 5*time.Second, u.backoffFor(10))
+}
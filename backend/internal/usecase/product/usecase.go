@@ -0,0 +1,153 @@
+package product_usecase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/domainerr"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+// ErrInvalidProduct: InvalidProductErrorが包むエラー（違反内容を含めてUnwrapで取得できる）
+var ErrInvalidProduct = domainerr.Validation("invalid_product_input", "product is invalid")
+
+// maxSKULength: SKUの最大文字数
+const maxSKULength = 64
+
+type UseCase interface {
+	List(ctx context.Context) ([]*entity.Product, error)
+	Get(ctx context.Context, id int) (*entity.Product, error)
+	Create(ctx context.Context, input Input) (*entity.Product, error)
+	Update(ctx context.Context, id int, input Input) (*entity.Product, error)
+	// Delete: 商品を削除（商品を指定して生成した既存のコピーはそのまま残る）
+	Delete(ctx context.Context, id int) error
+	// ListCopies: 商品を指定して生成したすべてのコピーを新しい順に取得（未公開のコピーを含む）
+	ListCopies(ctx context.Context, id int) ([]*entity.Copy, error)
+}
+
+type useCase struct {
+	products repository.ProductRepository
+	copies   repository.CopyRepository
+}
+
+// Input: 商品の登録・更新の内容
+type Input struct {
+	SKU      string
+	Name     string
+	Features string
+	Price    *int
+	Category string
+	Images   []entity.ProductImage
+}
+
+// InvalidProductError: 商品の内容が不正な場合のエラー
+type InvalidProductError struct {
+	Violations []string
+}
+
+func (e *InvalidProductError) Error() string {
+	return "invalid product: " + strings.Join(e.Violations, "; ")
+}
+
+func (e *InvalidProductError) Unwrap() error {
+	return ErrInvalidProduct.WithDetail("violations", e.Violations)
+}
+
+func NewUseCase(products repository.ProductRepository, copies repository.CopyRepository) UseCase {
+	return &useCase{products: products, copies: copies}
+}
+
+func (u *useCase) List(ctx context.Context) ([]*entity.Product, error) {
+	return u.products.List(ctx)
+}
+
+func (u *useCase) Get(ctx context.Context, id int) (*entity.Product, error) {
+	return u.products.Get(ctx, id)
+}
+
+// Create: 商品を登録（SKUが登録済みの場合はrepository.ErrDuplicatedを返す）
+func (u *useCase) Create(ctx context.Context, input Input) (*entity.Product, error) {
+	product := &entity.Product{}
+	if err := apply(product, input); err != nil {
+		return nil, err
+	}
+	if err := u.products.Create(ctx, product); err != nil {
+		return nil, err
+	}
+	return product, nil
+}
+
+func (u *useCase) Update(ctx context.Context, id int, input Input) (*entity.Product, error) {
+	product, err := u.products.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := apply(product, input); err != nil {
+		return nil, err
+	}
+	if err := u.products.Update(ctx, product); err != nil {
+		return nil, err
+	}
+	return product, nil
+}
+
+func (u *useCase) Delete(ctx context.Context, id int) error {
+	if _, err := u.products.Get(ctx, id); err != nil {
+		return err
+	}
+	return u.products.Delete(ctx, id)
+}
+
+func (u *useCase) ListCopies(ctx context.Context, id int) ([]*entity.Copy, error) {
+	if _, err := u.products.Get(ctx, id); err != nil {
+		return nil, err
+	}
+	return u.copies.ListByProduct(ctx, id)
+}
+
+// apply: 入力を検証し、前後の空白を取り除いた内容を商品に反映
+func apply(product *entity.Product, input Input) error {
+	sku := strings.TrimSpace(input.SKU)
+	name := strings.TrimSpace(input.Name)
+	features := strings.TrimSpace(input.Features)
+
+	var violations []string
+	if sku == "" || utf8.RuneCountInString(sku) > maxSKULength {
+		violations = append(violations, fmt.Sprintf("skuは1〜%d文字にしてください", maxSKULength))
+	}
+	if name == "" {
+		violations = append(violations, "nameがありません")
+	}
+	if features == "" {
+		violations = append(violations, "featuresがありません")
+	}
+	if input.Price != nil && *input.Price < 0 {
+		violations = append(violations, "priceは0以上にしてください")
+	}
+	for i, image := range input.Images {
+		if parsed, err := url.Parse(image.URL); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			violations = append(violations, fmt.Sprintf("images[%d]のurlはhttpまたはhttpsのURLにしてください", i))
+		}
+		if image.Width < 0 || image.Height < 0 {
+			violations = append(violations, fmt.Sprintf("images[%d]の幅・高さは0以上にしてください", i))
+		}
+	}
+	if len(violations) > 0 {
+		return &InvalidProductError{Violations: violations}
+	}
+
+	product.SKU = sku
+	product.Name = name
+	product.Features = features
+	product.Price = input.Price
+	product.Category = strings.TrimSpace(input.Category)
+	product.Images = input.Images
+	if product.Images == nil {
+		product.Images = []entity.ProductImage{}
+	}
+	return nil
+}
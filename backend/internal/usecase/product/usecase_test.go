@@ -0,0 +1,235 @@
+package product_usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+)
+
+// リポジトリのモック
+type mockProductRepository struct {
+	mock.Mock
+}
+
+func (m *mockProductRepository) List(ctx context.Context) ([]*entity.Product, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Product), args.Error(1)
+}
+
+func (m *mockProductRepository) Get(ctx context.Context, id int) (*entity.Product, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Product), args.Error(1)
+}
+
+func (m *mockProductRepository) Create(ctx context.Context, product *entity.Product) error {
+	args := m.Called(ctx, product)
+	return args.Error(0)
+}
+
+func (m *mockProductRepository) Update(ctx context.Context, product *entity.Product) error {
+	args := m.Called(ctx, product)
+	return args.Error(0)
+}
+
+func (m *mockProductRepository) Delete(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// コピーのリポジトリのモック（ListByProductのみ）
+type mockCopyRepository struct {
+	repository.CopyRepository
+	mock.Mock
+}
+
+func (m *mockCopyRepository) ListByProduct(ctx context.Context, productID int) ([]*entity.Copy, error) {
+	args := m.Called(ctx, productID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Copy), args.Error(1)
+}
+
+func validInput() Input {
+	price := 3980
+	return Input{
+		SKU:      " SKU-001 ",
+		Name:     "テスト商品",
+		Features: "軽量、防水",
+		Price:    &price,
+		Category: "アウトドア",
+		Images:   []entity.ProductImage{{URL: "https://example.com/1.jpg", Alt: "正面", Width: 800, Height: 600}},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          func() Input
+		repoErr        error
+		wantViolations []string
+		wantErr        error
+	}{
+		{
+			name:  "正常系",
+			input: validInput,
+		},
+		{
+			name: "異常系_入力が不正",
+			input: func() Input {
+				in := validInput()
+				in.SKU = " "
+				in.Features = ""
+				price := -1
+				in.Price = &price
+				in.Images = append(in.Images, entity.ProductImage{URL: "javascript:alert(1)", Width: -1})
+				return in
+			},
+			wantViolations: []string{
+				"skuは1〜64文字にしてください",
+				"featuresがありません",
+				"priceは0以上にしてください",
+				"images[1]のurlはhttpまたはhttpsのURLにしてください",
+				"images[1]の幅・高さは0以上にしてください",
+			},
+		},
+		{
+			name:    "異常系_登録済みのSKU",
+			input:   validInput,
+			repoErr: repository.ErrDuplicated,
+			wantErr: repository.ErrDuplicated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			products := new(mockProductRepository)
+			products.On("Create", mock.Anything, mock.Anything).Return(tt.repoErr)
+
+			// テスト実行
+			u := NewUseCase(products, new(mockCopyRepository))
+			got, err := u.Create(context.Background(), tt.input())
+
+			// アサーション
+			if tt.wantViolations != nil {
+				var invalid *InvalidProductError
+				assert.ErrorAs(t, err, &invalid)
+				assert.Equal(t, tt.wantViolations, invalid.Violations)
+				assert.ErrorIs(t, err, ErrInvalidProduct)
+				products.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "SKU-001", got.SKU)
+			assert.Equal(t, 3980, *got.Price)
+			assert.Len(t, got.Images, 1)
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		getErr  error
+		wantErr error
+	}{
+		{
+			name: "正常系",
+		},
+		{
+			name:    "異常系_存在しない商品",
+			getErr:  repository.ErrProductNotFound,
+			wantErr: repository.ErrProductNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			products := new(mockProductRepository)
+			if tt.getErr != nil {
+				products.On("Get", mock.Anything, 1).Return(nil, tt.getErr)
+			} else {
+				products.On("Get", mock.Anything, 1).Return(&entity.Product{ID: 1, SKU: "SKU-001", Name: "旧商品名"}, nil)
+			}
+			products.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+			// テスト実行
+			u := NewUseCase(products, new(mockCopyRepository))
+			got, err := u.Update(context.Background(), 1, validInput())
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				products.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 1, got.ID)
+			assert.Equal(t, "テスト商品", got.Name)
+			products.AssertExpectations(t)
+		})
+	}
+}
+
+func TestListCopies(t *testing.T) {
+	tests := []struct {
+		name    string
+		getErr  error
+		wantLen int
+		wantErr error
+	}{
+		{
+			name:    "正常系",
+			wantLen: 2,
+		},
+		{
+			name:    "異常系_存在しない商品",
+			getErr:  repository.ErrProductNotFound,
+			wantErr: repository.ErrProductNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モックの準備
+			products := new(mockProductRepository)
+			if tt.getErr != nil {
+				products.On("Get", mock.Anything, 1).Return(nil, tt.getErr)
+			} else {
+				products.On("Get", mock.Anything, 1).Return(&entity.Product{ID: 1}, nil)
+			}
+			copies := new(mockCopyRepository)
+			copies.On("ListByProduct", mock.Anything, 1).Return([]*entity.Copy{{ID: 2}, {ID: 1}}, nil)
+
+			// テスト実行
+			u := NewUseCase(products, copies)
+			got, err := u.ListCopies(context.Background(), 1)
+
+			// アサーション
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				copies.AssertNotCalled(t, "ListByProduct", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, got, tt.wantLen)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+// Package validation: リクエストのバインドで使用するカスタムバリデーター
+package validation
+
+import (
+	"sync"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+)
+
+const (
+	// TagChannel / TagTone: 配信チャネル・トーンのIDの形式を検証するタグ
+	//
+	// 配信チャネル・トーンはデータベースで管理するため、登録済みかどうかはユースケースで検証します。
+	TagChannel = "channel"
+	TagTone    = "tone"
+)
+
+var once sync.Once
+
+// Register: ginのバリデーターにchannel・toneのタグを登録（複数回呼び出しても登録は1度だけ）
+//
+//	Channel entity.Channel `json:"channel" binding:"required,channel"`
+//
+// 空の値は検証しないため、必須の場合はrequiredと組み合わせます。
+func Register() {
+	once.Do(func() {
+		v, ok := binding.Validator.Engine().(*validator.Validate)
+		if !ok {
+			return
+		}
+		// 登録できないのはタグ名が不正な場合のみのため、エラーは発生しない
+		_ = v.RegisterValidation(TagChannel, func(fl validator.FieldLevel) bool {
+			c, ok := fl.Field().Interface().(entity.Channel)
+			return ok && (c == "" || c.WellFormed())
+		})
+		_ = v.RegisterValidation(TagTone, func(fl validator.FieldLevel) bool {
+			t, ok := fl.Field().Interface().(entity.Tone)
+			return ok && (t == "" || t.WellFormed())
+		})
+	})
+}
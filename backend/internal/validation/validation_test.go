@@ -0,0 +1,67 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+)
+
+type request struct {
+	Channel entity.Channel `binding:"required,channel"`
+	Tone    entity.Tone    `binding:"tone"`
+}
+
+func TestRegister(t *testing.T) {
+	// 複数回呼び出しても登録は1度だけ
+	Register()
+	Register()
+
+	tests := []struct {
+		name    string
+		req     request
+		wantErr bool
+	}{
+		{
+			name: "正常系",
+			req:  request{Channel: entity.ChannelSNS, Tone: entity.ToneCasual},
+		},
+		{
+			name: "正常系_任意項目は空でもよい",
+			req:  request{Channel: entity.ChannelSNS},
+		},
+		{
+			// 登録済みかどうかはユースケースで検証する
+			name: "正常系_形式を満たす未登録のチャネル",
+			req:  request{Channel: entity.Channel("reels"), Tone: entity.ToneCasual},
+		},
+		{
+			name:    "異常系_形式が不正なチャネル",
+			req:     request{Channel: entity.Channel("Instagram Reels"), Tone: entity.ToneCasual},
+			wantErr: true,
+		},
+		{
+			name:    "異常系_形式が不正なトーン",
+			req:     request{Channel: entity.ChannelSNS, Tone: entity.Tone("限定感")},
+			wantErr: true,
+		},
+		{
+			name:    "異常系_必須項目なし",
+			req:     request{Tone: entity.ToneCasual},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := binding.Validator.ValidateStruct(tt.req)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
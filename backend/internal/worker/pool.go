@@ -0,0 +1,74 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// DefaultPollInterval: 実行するジョブがない場合に次の取得まで待機する時間の既定値
+const DefaultPollInterval = time.Second
+
+// Processor: ジョブを1件実行する処理（実行するジョブがない場合はfalse）
+type Processor interface {
+	ProcessNext(ctx context.Context, workerID string) (bool, error)
+}
+
+// Pool: 一定数のワーカーでジョブを並行して実行する
+type Pool struct {
+	processor    Processor
+	size         int
+	pollInterval time.Duration
+	name         string
+}
+
+// NewPool: size個のワーカーで実行するPoolを生成
+func NewPool(processor Processor, size int, pollInterval time.Duration) *Pool {
+	if pollInterval <= 0 {
+		pollInterval = DefaultPollInterval
+	}
+	hostname, _ := os.Hostname()
+	return &Pool{
+		processor:    processor,
+		size:         size,
+		pollInterval: pollInterval,
+		name:         fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+	}
+}
+
+// Run: ctxがキャンセルされるまでジョブを実行する
+//
+// キャンセル後は新しいジョブを取得せず、実行中のジョブが終わるのを待ってから戻ります。
+func (p *Pool) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i := 0; i < p.size; i++ {
+		wg.Add(1)
+		go func(workerID string) {
+			defer wg.Done()
+			p.work(ctx, workerID)
+		}(fmt.Sprintf("%s-%d", p.name, i))
+	}
+	wg.Wait()
+}
+
+func (p *Pool) work(ctx context.Context, workerID string) {
+	// 実行中のジョブは停止時にも中断せず、ジョブごとのタイムアウトに任せる
+	jobCtx := context.WithoutCancel(ctx)
+	for ctx.Err() == nil {
+		processed, err := p.processor.ProcessNext(jobCtx, workerID)
+		if err != nil {
+			log.Printf("worker %s: %v", workerID, err)
+		}
+		if processed {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(p.pollInterval):
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeProcessor: 指定した件数のジョブを実行し、その後は実行するジョブがない状態を返す
+type fakeProcessor struct {
+	mu        sync.Mutex
+	remaining int
+	processed int
+	workerIDs map[string]bool
+	ctxErrs   []error
+}
+
+func (p *fakeProcessor) ProcessNext(ctx context.Context, workerID string) (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.workerIDs[workerID] = true
+	p.ctxErrs = append(p.ctxErrs, ctx.Err())
+	if p.remaining == 0 {
+		return false, nil
+	}
+	p.remaining--
+	p.processed++
+	if p.processed%2 == 0 {
+		return true, errors.New("job error")
+	}
+	return true, nil
+}
+
+func TestPool_Run(t *testing.T) {
+	p := &fakeProcessor{remaining: 10, workerIDs: map[string]bool{}}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan struct{})
+	go func() {
+		NewPool(p, 3, 10*time.Millisecond).Run(ctx)
+		close(done)
+	}()
+
+	// すべてのジョブが実行されるまで待機
+	assert.Eventually(t, func() bool {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		return p.processed == 10
+	}, time.Second, 5*time.Millisecond)
+
+	// キャンセル後はワーカーが停止する
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("pool did not stop after cancel")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	assert.LessOrEqual(t, len(p.workerIDs), 3)
+	// 実行中のジョブには停止のキャンセルを伝えない
+	for _, err := range p.ctxErrs {
+		assert.NoError(t, err)
+	}
+}
@@ -0,0 +1,44 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// DefaultScheduleInterval: 定期的な処理を実行する間隔の既定値
+const DefaultScheduleInterval = time.Minute
+
+// Scheduler: 一定の間隔で処理を実行する
+type Scheduler struct {
+	name     string
+	interval time.Duration
+	task     func(ctx context.Context) error
+}
+
+// NewScheduler: intervalごとにtaskを実行するSchedulerを生成（nameはログに記録する名前）
+func NewScheduler(name string, interval time.Duration, task func(ctx context.Context) error) *Scheduler {
+	if interval <= 0 {
+		interval = DefaultScheduleInterval
+	}
+	return &Scheduler{name: name, interval: interval, task: task}
+}
+
+// Run: ctxがキャンセルされるまで、起動時とintervalごとにtaskを実行する
+//
+// taskのエラーはログに記録し、次の実行を続けます。
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		if err := s.task(ctx); err != nil {
+			log.Printf("scheduler %s: %v", s.name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
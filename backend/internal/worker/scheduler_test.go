@@ -0,0 +1,45 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduler_Run(t *testing.T) {
+	var mu sync.Mutex
+	runs := 0
+	task := func(ctx context.Context) error {
+		mu.Lock()
+		defer mu.Unlock()
+		runs++
+		// エラーを返しても次の実行は続ける
+		return errors.New("task error")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan struct{})
+	go func() {
+		NewScheduler("test", 10*time.Millisecond, task).Run(ctx)
+		close(done)
+	}()
+
+	// 起動時と間隔ごとに実行される
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return runs >= 3
+	}, time.Second, 5*time.Millisecond)
+
+	// キャンセル後は停止する
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("scheduler did not stop after cancel")
+	}
+}
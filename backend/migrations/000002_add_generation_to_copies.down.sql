@@ -0,0 +1,5 @@
+ALTER TABLE copies
+    DROP INDEX idx_copies_generation_id,
+    DROP COLUMN is_selected,
+    DROP COLUMN score,
+    DROP COLUMN generation_id;
@@ -0,0 +1,5 @@
+ALTER TABLE copies
+    ADD COLUMN generation_id VARCHAR(36) NOT NULL DEFAULT '',
+    ADD COLUMN score DOUBLE NOT NULL DEFAULT 0,
+    ADD COLUMN is_selected BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD INDEX idx_copies_generation_id (generation_id);
@@ -0,0 +1,3 @@
+ALTER TABLE copies
+    DROP COLUMN prompt_template_version,
+    DROP COLUMN prompt_template_id;
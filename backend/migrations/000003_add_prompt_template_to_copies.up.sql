@@ -0,0 +1,3 @@
+ALTER TABLE copies
+    ADD COLUMN prompt_template_id VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN prompt_template_version VARCHAR(50) NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+ALTER TABLE copies
+    ADD COLUMN content JSON NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE copies
+    ADD COLUMN findings JSON NULL;
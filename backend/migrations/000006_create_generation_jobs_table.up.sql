@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS generation_jobs (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    status VARCHAR(20) NOT NULL,
+    input JSON NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    max_attempts INT NOT NULL,
+    run_at DATETIME(3) NOT NULL,
+    locked_by VARCHAR(255) NOT NULL DEFAULT '',
+    locked_at DATETIME(3) NULL,
+    last_error TEXT NULL,
+    copy_ids JSON NULL,
+    generation_id VARCHAR(36) NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    completed_at DATETIME(3) NULL,
+    INDEX idx_generation_jobs_status_run_at (status, run_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
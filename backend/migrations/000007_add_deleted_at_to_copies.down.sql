@@ -0,0 +1,3 @@
+ALTER TABLE copies
+    DROP INDEX idx_copies_deleted_at,
+    DROP COLUMN deleted_at;
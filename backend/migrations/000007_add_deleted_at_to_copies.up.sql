@@ -0,0 +1,3 @@
+ALTER TABLE copies
+    ADD COLUMN deleted_at DATETIME(3) NULL,
+    ADD INDEX idx_copies_deleted_at (deleted_at);
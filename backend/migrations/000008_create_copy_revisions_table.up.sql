@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS copy_revisions (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    copy_id INT NOT NULL,
+    revision INT NOT NULL,
+    source VARCHAR(20) NOT NULL,
+    author VARCHAR(255) NOT NULL DEFAULT '',
+    title VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL,
+    is_published BOOLEAN NOT NULL DEFAULT FALSE,
+    content JSON NULL,
+    rollback_of INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE INDEX idx_copy_revisions_copy_id_revision (copy_id, revision),
+    CONSTRAINT fk_copy_revisions_copy_id FOREIGN KEY (copy_id) REFERENCES copies (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,7 @@
+ALTER TABLE copies
+    DROP INDEX idx_copies_published_tone_created_at,
+    DROP INDEX idx_copies_published_channel_created_at,
+    DROP INDEX idx_copies_published_trending_score,
+    DROP INDEX idx_copies_published_likes,
+    DROP INDEX idx_copies_published_created_at,
+    DROP COLUMN trending_score;
@@ -0,0 +1,13 @@
+ALTER TABLE copies
+    ADD COLUMN trending_score BIGINT NOT NULL DEFAULT 0;
+
+-- 話題順のスコア: 作成日時のUNIX秒 + いいね数 × 7200秒（1いいね = 2時間分の新しさ）
+UPDATE copies SET trending_score = UNIX_TIMESTAMP(created_at) + likes * 7200;
+
+-- 公開済みの一覧のカーソルによるページング（並び順のキーとidの降順）
+ALTER TABLE copies
+    ADD INDEX idx_copies_published_created_at (is_published, created_at, id),
+    ADD INDEX idx_copies_published_likes (is_published, likes, id),
+    ADD INDEX idx_copies_published_trending_score (is_published, trending_score, id),
+    ADD INDEX idx_copies_published_channel_created_at (is_published, channel, created_at, id),
+    ADD INDEX idx_copies_published_tone_created_at (is_published, tone, created_at, id);
@@ -0,0 +1,2 @@
+ALTER TABLE copies
+    DROP INDEX ft_copies_search;
@@ -0,0 +1,4 @@
+-- 全文検索: タイトル・説明文・商品名・商品の特徴を対象にしたngramパーサーのFULLTEXTインデックス
+-- （日本語は単語の区切りがないため、ngram_token_size（既定値2）の文字単位で索引を作る）
+ALTER TABLE copies
+    ADD FULLTEXT INDEX ft_copies_search (title, description, product_name, product_features) WITH PARSER ngram;
@@ -0,0 +1,8 @@
+-- 投票者ごとのいいね（既存のいいね数は投票者が不明なため、このテーブルには移行しない）
+CREATE TABLE IF NOT EXISTS likes (
+    copy_id INT NOT NULL,
+    voter VARCHAR(191) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (copy_id, voter),
+    CONSTRAINT fk_likes_copy_id FOREIGN KEY (copy_id) REFERENCES copies (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,5 @@
+ALTER TABLE copies
+    DROP FOREIGN KEY fk_copies_tone,
+    DROP FOREIGN KEY fk_copies_channel;
+DROP TABLE IF EXISTS tones;
+DROP TABLE IF EXISTS channels;
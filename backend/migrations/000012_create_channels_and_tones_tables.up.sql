@@ -0,0 +1,64 @@
+-- 配信チャネル・トーンの定義（管理者が追加・編集できる。idはcopies.channel・copies.toneに保存する値）
+CREATE TABLE IF NOT EXISTS channels (
+    id VARCHAR(50) NOT NULL PRIMARY KEY,
+    label VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL,
+    guidance TEXT NOT NULL,
+    -- 出力形式の項目と長さの制限: [{"name", "description", "limit": {"unit", "min", "max"}}]
+    fields JSON NOT NULL,
+    built_in BOOLEAN NOT NULL DEFAULT FALSE,
+    sort_order INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at DATETIME NULL,
+    INDEX idx_channels_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS tones (
+    id VARCHAR(50) NOT NULL PRIMARY KEY,
+    label VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL,
+    guidance TEXT NOT NULL,
+    -- 候補の採点でトーンらしさを判定する表現
+    markers JSON NOT NULL,
+    built_in BOOLEAN NOT NULL DEFAULT FALSE,
+    sort_order INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at DATETIME NULL,
+    INDEX idx_tones_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 組み込みのチャネル・トーン（entity.BuiltinChannels・entity.BuiltinTonesと同じ内容）
+INSERT INTO channels (id, label, description, guidance, fields, built_in, sort_order) VALUES
+    ('app', 'アプリ通知', 'スマートフォンのプッシュ通知。短いタイトルと本文で来店や購入を促します', '',
+        '[{"name":"title","description":"プッシュ通知のタイトル","limit":{"unit":"width","min":0,"max":40}},{"name":"description","description":"プッシュ通知の本文","limit":{"unit":"width","min":40,"max":120}}]', TRUE, 1),
+    ('line', 'LINE広告', 'LINEのFlex Message。見出し・本文・ボタンで構成します', '',
+        '[{"name":"title","description":"メッセージの見出し","limit":{"unit":"graphemes","min":0,"max":20}},{"name":"description","description":"メッセージの本文","limit":{"unit":"graphemes","min":50,"max":100}},{"name":"altText","description":"トーク一覧や通知に表示される代替テキスト","limit":{"unit":"graphemes","min":0,"max":400}},{"name":"buttonLabel","description":"ボタンの文言","limit":{"unit":"graphemes","min":0,"max":20}}]', TRUE, 2),
+    ('pop', '店舗POP', '店頭に掲示するPOP。キャッチコピーとサブコピー、価格の訴求で構成します', '',
+        '[{"name":"title","description":"POPのキャッチコピー","limit":{"unit":"graphemes","min":0,"max":20}},{"name":"description","description":"サブコピー","limit":{"unit":"graphemes","min":20,"max":50}},{"name":"priceCallout","description":"価格やお得感を伝える一言","limit":{"unit":"graphemes","min":0,"max":15}}]', TRUE, 3),
+    ('sns', 'SNS投稿', 'SNSへの投稿文。ハッシュタグを含めて全角140文字以内に収めます', '',
+        '[{"name":"title","description":"投稿の見出し","limit":{"unit":"graphemes","min":0,"max":20}},{"name":"description","description":"投稿本文（ハッシュタグは含めない）","limit":{"unit":"graphemes","min":50,"max":100}}]', TRUE, 4),
+    ('email', 'メールマガジン', 'メールマガジンの件名・プリヘッダー・本文。開封したくなる件名を重視します', '',
+        '[{"name":"title","description":"件名","limit":{"unit":"graphemes","min":0,"max":30}},{"name":"description","description":"本文","limit":{"unit":"graphemes","min":100,"max":400}},{"name":"preheader","description":"受信一覧で件名の後に表示されるプレヘッダー","limit":{"unit":"graphemes","min":0,"max":50}}]', TRUE, 5);
+
+INSERT INTO tones (id, label, description, guidance, markers, built_in, sort_order) VALUES
+    ('pop', 'ポップ', '明るく親しみやすい、弾むような言葉づかい', '', '["！","!","♪","ワクワク","今すぐ","見逃し"]', TRUE, 1),
+    ('trust', '信頼感', '落ち着いた丁寧な言葉づかいで、品質や実績を伝えます', '', '["安心","品質","信頼","確か","実績","自信"]', TRUE, 2),
+    ('value', 'お得感', '価格や特典を前面に出し、今買う理由を伝えます', '', '["お得","円","%","％","割","コスパ","セール","お買い得"]', TRUE, 3),
+    ('luxury', '高級感', '上品で洗練された言葉づかいで、特別感を演出します', '', '["上質","特別","贅沢","極上","ワンランク","洗練"]', TRUE, 4),
+    ('casual', 'カジュアル', '友人に話しかけるような、気軽な言葉づかい', '', '["ね","よ","気軽","〜","ちょっと","はじめ"]', TRUE, 5);
+
+-- 検証を導入する前に保存された未定義の値は、削除済みの定義として登録して外部キーを満たす
+INSERT INTO channels (id, label, description, guidance, fields, built_in, sort_order, deleted_at)
+    SELECT DISTINCT channel, channel, '', '',
+        '[{"name":"title","description":"タイトル","limit":{"unit":"graphemes","min":0,"max":20}},{"name":"description","description":"本文","limit":{"unit":"graphemes","min":50,"max":100}}]',
+        FALSE, 0, CURRENT_TIMESTAMP
+    FROM copies WHERE channel NOT IN (SELECT id FROM channels);
+INSERT INTO tones (id, label, description, guidance, markers, built_in, sort_order, deleted_at)
+    SELECT DISTINCT tone, tone, '', '', '[]', FALSE, 0, CURRENT_TIMESTAMP
+    FROM copies WHERE tone NOT IN (SELECT id FROM tones);
+
+ALTER TABLE copies
+    ADD CONSTRAINT fk_copies_channel FOREIGN KEY (channel) REFERENCES channels (id),
+    ADD CONSTRAINT fk_copies_tone FOREIGN KEY (tone) REFERENCES tones (id);
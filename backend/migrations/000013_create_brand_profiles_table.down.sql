@@ -0,0 +1,5 @@
+ALTER TABLE copies
+    DROP FOREIGN KEY fk_copies_brand_profile,
+    DROP INDEX idx_copies_brand_profile_id,
+    DROP COLUMN brand_profile_id;
+DROP TABLE IF EXISTS brand_profiles;
@@ -0,0 +1,21 @@
+-- ブランドのボイスの定義（生成時にIDで指定する）
+CREATE TABLE IF NOT EXISTS brand_profiles (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    voice TEXT NOT NULL,
+    -- 積極的に使う言葉・使用しない言葉・承認済みのコピーの例: 文字列の配列
+    preferred_words JSON NOT NULL,
+    banned_words JSON NOT NULL,
+    samples JSON NOT NULL,
+    -- 文末の表現: '' / desu_masu / da_dearu
+    ending VARCHAR(20) NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at DATETIME NULL,
+    INDEX idx_brand_profiles_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+ALTER TABLE copies
+    ADD COLUMN brand_profile_id INT NULL,
+    ADD INDEX idx_copies_brand_profile_id (brand_profile_id),
+    ADD CONSTRAINT fk_copies_brand_profile FOREIGN KEY (brand_profile_id) REFERENCES brand_profiles (id);
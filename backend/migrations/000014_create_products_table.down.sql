@@ -0,0 +1,5 @@
+ALTER TABLE copies
+    DROP FOREIGN KEY fk_copies_product,
+    DROP INDEX idx_copies_product_id,
+    DROP COLUMN product_id;
+DROP TABLE IF EXISTS products;
@@ -0,0 +1,22 @@
+-- 商品カタログ（生成時にIDで指定し、商品ごとにコピーをまとめる）
+CREATE TABLE IF NOT EXISTS products (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    sku VARCHAR(64) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    features TEXT NOT NULL,
+    -- 価格（円）: 未設定の場合はNULL
+    price INT NULL,
+    category VARCHAR(255) NOT NULL DEFAULT '',
+    -- 商品画像の情報: {url, alt, width, height}の配列
+    images JSON NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at DATETIME NULL,
+    UNIQUE INDEX idx_products_sku (sku),
+    INDEX idx_products_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+ALTER TABLE copies
+    ADD COLUMN product_id INT NULL,
+    ADD INDEX idx_copies_product_id (product_id),
+    ADD CONSTRAINT fk_copies_product FOREIGN KEY (product_id) REFERENCES products (id);
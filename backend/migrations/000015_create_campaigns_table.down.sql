@@ -0,0 +1,5 @@
+ALTER TABLE copies
+    DROP FOREIGN KEY fk_copies_campaign,
+    DROP INDEX idx_copies_campaign_id,
+    DROP COLUMN campaign_id;
+DROP TABLE IF EXISTS campaigns;
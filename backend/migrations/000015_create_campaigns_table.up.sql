@@ -0,0 +1,19 @@
+-- 複数の配信チャネルにまたがる販促キャンペーン（コピーをキャンペーンごとにまとめる）
+CREATE TABLE IF NOT EXISTS campaigns (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    goal TEXT NOT NULL,
+    target VARCHAR(255) NOT NULL DEFAULT '',
+    starts_at DATETIME NOT NULL,
+    ends_at DATETIME NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at DATETIME NULL,
+    INDEX idx_campaigns_starts_at (starts_at),
+    INDEX idx_campaigns_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+ALTER TABLE copies
+    ADD COLUMN campaign_id INT NULL,
+    ADD INDEX idx_copies_campaign_id (campaign_id),
+    ADD CONSTRAINT fk_copies_campaign FOREIGN KEY (campaign_id) REFERENCES campaigns (id);
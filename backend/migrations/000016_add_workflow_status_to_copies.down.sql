@@ -0,0 +1,28 @@
+ALTER TABLE copy_revisions
+    ADD COLUMN is_published BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE copy_revisions SET is_published = TRUE WHERE status = 'published';
+
+ALTER TABLE copy_revisions
+    DROP COLUMN comment,
+    DROP COLUMN status;
+
+ALTER TABLE copies
+    ADD COLUMN is_published BOOLEAN DEFAULT FALSE;
+
+UPDATE copies SET is_published = TRUE WHERE status = 'published';
+
+ALTER TABLE copies
+    DROP INDEX idx_copies_status_tone_created_at,
+    DROP INDEX idx_copies_status_channel_created_at,
+    DROP INDEX idx_copies_status_trending_score,
+    DROP INDEX idx_copies_status_likes,
+    DROP INDEX idx_copies_status_created_at,
+    DROP COLUMN review_comment,
+    DROP COLUMN reviewer,
+    DROP COLUMN status,
+    ADD INDEX idx_copies_published_created_at (is_published, created_at, id),
+    ADD INDEX idx_copies_published_likes (is_published, likes, id),
+    ADD INDEX idx_copies_published_trending_score (is_published, trending_score, id),
+    ADD INDEX idx_copies_published_channel_created_at (is_published, channel, created_at, id),
+    ADD INDEX idx_copies_published_tone_created_at (is_published, tone, created_at, id);
@@ -0,0 +1,32 @@
+-- 編集ワークフローの状態（draft / in_review / approved / published / archived）
+ALTER TABLE copies
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'draft',
+    ADD COLUMN reviewer VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN review_comment TEXT NULL;
+
+-- 公開済みのコピーは承認済みとみなしてそのまま公開する
+UPDATE copies SET status = 'published' WHERE is_published = TRUE;
+
+-- 公開済みの一覧のカーソルによるページング（並び順のキーとidの降順）を状態で絞り込む
+ALTER TABLE copies
+    DROP INDEX idx_copies_published_tone_created_at,
+    DROP INDEX idx_copies_published_channel_created_at,
+    DROP INDEX idx_copies_published_trending_score,
+    DROP INDEX idx_copies_published_likes,
+    DROP INDEX idx_copies_published_created_at,
+    DROP COLUMN is_published,
+    ADD INDEX idx_copies_status_created_at (status, created_at, id),
+    ADD INDEX idx_copies_status_likes (status, likes, id),
+    ADD INDEX idx_copies_status_trending_score (status, trending_score, id),
+    ADD INDEX idx_copies_status_channel_created_at (status, channel, created_at, id),
+    ADD INDEX idx_copies_status_tone_created_at (status, tone, created_at, id);
+
+-- 版には状態の変更と承認・差し戻しのコメントを記録する
+ALTER TABLE copy_revisions
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'draft',
+    ADD COLUMN comment TEXT NULL;
+
+UPDATE copy_revisions SET status = 'published' WHERE is_published = TRUE;
+
+ALTER TABLE copy_revisions
+    DROP COLUMN is_published;
@@ -0,0 +1,5 @@
+ALTER TABLE copies
+    DROP INDEX idx_copies_status_expire_at,
+    DROP INDEX idx_copies_status_publish_at,
+    DROP COLUMN expire_at,
+    DROP COLUMN publish_at;
@@ -0,0 +1,7 @@
+-- 公開日時・公開終了日時（NULLの場合は日時を指定しない）
+ALTER TABLE copies
+    ADD COLUMN publish_at DATETIME(3) NULL,
+    ADD COLUMN expire_at DATETIME(3) NULL,
+    -- スケジューラーが公開・アーカイブするコピーを状態と日時で取得する
+    ADD INDEX idx_copies_status_publish_at (status, publish_at),
+    ADD INDEX idx_copies_status_expire_at (status, expire_at);
@@ -0,0 +1,7 @@
+ALTER TABLE copies
+    DROP FOREIGN KEY fk_copies_created_by,
+    DROP INDEX idx_copies_created_by,
+    DROP COLUMN created_by;
+
+DROP TABLE IF EXISTS api_keys;
+DROP TABLE IF EXISTS users;
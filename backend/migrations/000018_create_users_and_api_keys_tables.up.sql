@@ -0,0 +1,32 @@
+-- APIを利用するアカウント（パスワードはbcryptのハッシュのみ保存する）
+CREATE TABLE IF NOT EXISTS users (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    email VARCHAR(191) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    password_hash VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE INDEX idx_users_email (email)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- スクリプトなどから利用するAPIキー（キーはSHA-256のハッシュのみ保存する）
+CREATE TABLE IF NOT EXISTS api_keys (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    prefix VARCHAR(32) NOT NULL,
+    key_hash CHAR(64) NOT NULL,
+    last_used_at DATETIME(3) NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at DATETIME NULL,
+    UNIQUE INDEX idx_api_keys_key_hash (key_hash),
+    INDEX idx_api_keys_user_id (user_id),
+    INDEX idx_api_keys_deleted_at (deleted_at),
+    CONSTRAINT fk_api_keys_user FOREIGN KEY (user_id) REFERENCES users (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- コピーを生成した利用者（既存のコピーはNULL）
+ALTER TABLE copies
+    ADD COLUMN created_by INT NULL,
+    ADD INDEX idx_copies_created_by (created_by),
+    ADD CONSTRAINT fk_copies_created_by FOREIGN KEY (created_by) REFERENCES users (id);
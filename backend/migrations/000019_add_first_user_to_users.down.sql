@@ -0,0 +1,3 @@
+ALTER TABLE users
+    DROP INDEX idx_users_first_user,
+    DROP COLUMN first_user;
@@ -0,0 +1,7 @@
+-- 認証せずに登録した最初の利用者（一意制約で同時に登録しても1人に限る、以降の利用者はNULL）
+ALTER TABLE users
+    ADD COLUMN first_user TINYINT(1) NULL,
+    ADD UNIQUE INDEX idx_users_first_user (first_user);
+
+-- 既存の最初の利用者
+UPDATE users SET first_user = 1 ORDER BY id LIMIT 1;
@@ -0,0 +1,3 @@
+ALTER TABLE copy_revisions
+    DROP COLUMN publish_at,
+    DROP COLUMN expire_at;
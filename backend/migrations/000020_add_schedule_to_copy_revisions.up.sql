@@ -0,0 +1,4 @@
+-- 版の時点の公開日時・公開終了日時（公開日時の設定も版として記録する）
+ALTER TABLE copy_revisions
+    ADD COLUMN publish_at DATETIME(3) NULL,
+    ADD COLUMN expire_at DATETIME(3) NULL;
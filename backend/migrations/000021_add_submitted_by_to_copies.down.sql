@@ -0,0 +1,2 @@
+ALTER TABLE copies
+    DROP COLUMN submitted_by;
@@ -0,0 +1,3 @@
+-- レビューを依頼した利用者（依頼した利用者は自分のコピーのレビューを担当できない）
+ALTER TABLE copies
+    ADD COLUMN submitted_by VARCHAR(255) NOT NULL DEFAULT '';
@@ -0,0 +1,150 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/auth"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	auth_handler "github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/auth"
+	copy_handler "github.com/takanoakira/ai-sales-copy-generator/backend/internal/handler/copy"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/llm"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/middleware"
+	copy_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/copy"
+	user_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/user"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/routes"
+	auth_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/auth"
+)
+
+func TestAuthIntegration(t *testing.T) {
+	// テスト用のデータベースをセットアップ
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&entity.User{}, &entity.APIKey{}))
+	generator, err := llm.New(llm.Config{Provider: llm.ProviderFake})
+	require.NoError(t, err)
+
+	// cmd/apiと同じ順序でミドルウェアを設定したルーター
+	authUsecase := auth_usecase.NewUseCase(
+		user_repository.NewRepository(db),
+		user_repository.NewAPIKeyRepository(db),
+		auth.NewSigner([]byte("0123456789abcdef0123456789abcdef")),
+	)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(middleware.ErrorHandler(), middleware.Authenticate(authUsecase))
+	r.Use(middleware.RequireAuth(middleware.SafeMethod, middleware.Paths("/api/v1/auth/login", "/api/v1/users")))
+	routes.SetupAuthRoutes(r, auth_handler.NewHandler(authUsecase))
+	routes.SetupCopyRoutes(r, copy_handler.NewHandler(copy_repository.NewRepository(db), generator))
+
+	// do: リクエストを実行し、レスポンスのボディをoutに読み込む
+	do := func(method, path string, body any, headers map[string]string, out any) int {
+		var buf bytes.Buffer
+		if body != nil {
+			require.NoError(t, json.NewEncoder(&buf).Encode(body))
+		}
+		req := httptest.NewRequest(method, path, &buf)
+		req.Header.Set("Content-Type", "application/json")
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		if out != nil && rec.Body.Len() > 0 {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), out))
+		}
+		return rec.Code
+	}
+	copyRequest := gin.H{
+		"productName":     "テスト商品",
+		"productFeatures": "高性能、使いやすい",
+		"target":          "20代女性",
+		"channel":         entity.ChannelSNS,
+		"tone":            entity.ToneCasual,
+	}
+
+	var user entity.User
+	var session auth_usecase.Session
+	var created auth_usecase.CreatedAPIKey
+
+	t.Run("最初の利用者は認証せずに登録できる", func(t *testing.T) {
+		status := do(http.MethodPost, "/api/v1/users", gin.H{"email": "Tanaka@example.com", "name": "田中", "password": "password123"}, nil, &user)
+		assert.Equal(t, http.StatusCreated, status)
+		assert.Equal(t, "tanaka@example.com", user.Email)
+
+		// 2人目以降は認証済みの利用者のみ登録できる
+		var problem gin.H
+		status = do(http.MethodPost, "/api/v1/users", gin.H{"email": "suzuki@example.com", "name": "鈴木", "password": "password123"}, nil, &problem)
+		assert.Equal(t, http.StatusUnauthorized, status)
+		assert.Equal(t, "authentication_required", problem["code"])
+
+		// 同時に登録した場合も、最初の利用者は一意制約で1人に限る
+		first := true
+		assert.Error(t, db.Create(&entity.User{Email: "sato@example.com", Name: "佐藤", PasswordHash: "hash", FirstUser: &first}).Error)
+	})
+
+	t.Run("ログイン", func(t *testing.T) {
+		var problem gin.H
+		status := do(http.MethodPost, "/api/v1/auth/login", gin.H{"email": "tanaka@example.com", "password": "wrong password"}, nil, &problem)
+		assert.Equal(t, http.StatusUnauthorized, status)
+		assert.Equal(t, "invalid_credentials", problem["code"])
+
+		status = do(http.MethodPost, "/api/v1/auth/login", gin.H{"email": "tanaka@example.com", "password": "password123"}, nil, &session)
+		require.Equal(t, http.StatusOK, status)
+		assert.NotEmpty(t, session.Token)
+
+		var me entity.User
+		status = do(http.MethodGet, "/api/v1/users/me", nil, map[string]string{"Authorization": "Bearer " + session.Token}, &me)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, user.ID, me.ID)
+	})
+
+	t.Run("コピーの生成者を記録する", func(t *testing.T) {
+		var problem gin.H
+		status := do(http.MethodPost, "/api/v1/copies", copyRequest, nil, &problem)
+		assert.Equal(t, http.StatusUnauthorized, status)
+		assert.Equal(t, "authentication_required", problem["code"])
+
+		var copy entity.Copy
+		status = do(http.MethodPost, "/api/v1/copies", copyRequest, map[string]string{"Authorization": "Bearer " + session.Token}, &copy)
+		require.Equal(t, http.StatusCreated, status)
+		require.NotNil(t, copy.CreatedBy)
+		assert.Equal(t, user.ID, *copy.CreatedBy)
+	})
+
+	t.Run("APIキーの発行・使用・無効化", func(t *testing.T) {
+		status := do(http.MethodPost, "/api/v1/users/me/api-keys", gin.H{"name": "夜間バッチ"}, map[string]string{"Authorization": "Bearer " + session.Token}, &created)
+		require.Equal(t, http.StatusCreated, status)
+		assert.True(t, auth.IsAPIKey(created.Key))
+
+		// APIキーで生成したコピーもキーの利用者を生成者として記録する
+		var copy entity.Copy
+		status = do(http.MethodPost, "/api/v1/copies", copyRequest, map[string]string{middleware.APIKeyHeader: created.Key}, &copy)
+		require.Equal(t, http.StatusCreated, status)
+		assert.Equal(t, user.ID, *copy.CreatedBy)
+
+		// 一覧には使用した日時を含め、キーそのものは含めない
+		var keys []entity.APIKey
+		status = do(http.MethodGet, "/api/v1/users/me/api-keys", nil, map[string]string{middleware.APIKeyHeader: created.Key}, &keys)
+		require.Equal(t, http.StatusOK, status)
+		require.Len(t, keys, 1)
+		assert.NotNil(t, keys[0].LastUsedAt)
+		assert.Empty(t, keys[0].KeyHash)
+
+		status = do(http.MethodDelete, "/api/v1/users/me/api-keys/"+strconv.Itoa(created.APIKey.ID), nil, map[string]string{"Authorization": "Bearer " + session.Token}, nil)
+		assert.Equal(t, http.StatusNoContent, status)
+
+		// 無効にしたキーでは認証できない
+		var problem gin.H
+		status = do(http.MethodGet, "/api/v1/users/me", nil, map[string]string{middleware.APIKeyHeader: created.Key}, &problem)
+		assert.Equal(t, http.StatusUnauthorized, status)
+		assert.Equal(t, "invalid_api_key", problem["code"])
+	})
+}
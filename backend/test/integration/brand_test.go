@@ -0,0 +1,84 @@
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/compliance"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/llm"
+	brand_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/brand"
+	copy_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/copy"
+	definition_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/definition"
+	brand_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/brand"
+	copy_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/copy"
+)
+
+func TestBrandProfileIntegration(t *testing.T) {
+	// テスト用のデータベースをセットアップ
+	db := setupTestDB(t)
+	seedDefinitions(t, db)
+	require.NoError(t, db.AutoMigrate(&entity.BrandProfile{}))
+	brandRepository := brand_repository.NewRepository(db)
+	brands := brand_usecase.NewUseCase(brandRepository)
+
+	generator, err := llm.New(llm.Config{Provider: llm.ProviderFake})
+	require.NoError(t, err)
+	copies := copy_usecase.NewUseCase(copy_repository.NewRepository(db), generator,
+		copy_usecase.WithDefinitions(definition_repository.NewChannelRepository(db), definition_repository.NewToneRepository(db)),
+		copy_usecase.WithBrandProfiles(brandRepository),
+	)
+
+	ctx := context.Background()
+
+	// 商品名を使用しない言葉に登録し、生成後に検出されることを確認する
+	profile, err := brands.Create(ctx, brand_usecase.Input{
+		Name:        "テストブランド",
+		Voice:       "誠実で落ち着いた語り口",
+		BannedWords: []string{"ブランドテスト商品", " "},
+		Ending:      entity.EndingPolite,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ブランドテスト商品"}, profile.BannedWords)
+
+	input := copy_usecase.CreateCopyInput{
+		ProductName:     "ブランドテスト商品",
+		ProductFeatures: "軽量、防水",
+		Target:          "アウトドア好き",
+		Channel:         entity.ChannelSNS,
+		Tone:            entity.ToneTrust,
+		BrandProfileID:  &profile.ID,
+	}
+	created, err := copies.CreateCopy(ctx, input)
+	require.NoError(t, err)
+	require.NotNil(t, created.BrandProfileID)
+	assert.Equal(t, profile.ID, *created.BrandProfileID)
+
+	var brandFindings []entity.ComplianceFinding
+	for _, finding := range created.Findings {
+		if finding.Category == compliance.CategoryBrand {
+			brandFindings = append(brandFindings, finding)
+		}
+	}
+	require.NotEmpty(t, brandFindings)
+	assert.Equal(t, compliance.RuleBrandBannedWord, brandFindings[0].RuleID)
+
+	// 存在しないプロファイルは指定できない
+	unknown := 999
+	input.BrandProfileID = &unknown
+	_, err = copies.CreateCopy(ctx, input)
+	assert.ErrorIs(t, err, copy_usecase.ErrInvalidBrandProfile)
+
+	// 削除したプロファイルは新しい生成に使用できないが、既存のコピーは取得・チェックできる
+	require.NoError(t, brands.Delete(ctx, profile.ID))
+	input.BrandProfileID = &profile.ID
+	_, err = copies.CreateCopy(ctx, input)
+	assert.ErrorIs(t, err, copy_usecase.ErrInvalidBrandProfile)
+
+	got, err := copies.GetCopy(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, profile.ID, *got.BrandProfileID)
+}
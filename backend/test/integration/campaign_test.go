@@ -0,0 +1,102 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/llm"
+	campaign_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/campaign"
+	copy_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/copy"
+	campaign_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/campaign"
+	copy_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/copy"
+)
+
+func TestCampaignIntegration(t *testing.T) {
+	// テスト用のデータベースをセットアップ
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&entity.Campaign{}))
+	campaignRepository := campaign_repository.NewRepository(db)
+	copyRepository := copy_repository.NewRepository(db)
+
+	generator, err := llm.New(llm.Config{Provider: llm.ProviderFake})
+	require.NoError(t, err)
+	copies := copy_usecase.NewUseCase(copyRepository, generator, copy_usecase.WithCampaigns(campaignRepository))
+	campaigns := campaign_usecase.NewUseCase(campaignRepository, copyRepository, copies)
+
+	ctx := context.Background()
+
+	// キャンペーンの登録
+	campaign, err := campaigns.Create(ctx, campaign_usecase.Input{
+		Name:     "夏のセール",
+		Goal:     "新規会員の獲得",
+		Target:   "20代の会社員",
+		StartsAt: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+		EndsAt:   time.Date(2026, 7, 31, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	// 共通の内容から配信チャネルごとにコピーを生成する
+	channels := []entity.Channel{entity.ChannelSNS, entity.ChannelEmail, entity.ChannelApp}
+	set, err := campaigns.GenerateSet(ctx, campaign.ID, copy_usecase.CreateCopyInput{
+		ProductName:     "テスト商品",
+		ProductFeatures: "軽量、防水",
+		Tone:            entity.ToneCasual,
+	}, channels)
+	require.NoError(t, err)
+	require.Len(t, set.Copies, len(channels))
+	for i, copy := range set.Copies {
+		assert.Equal(t, channels[i], copy.Channel)
+		assert.Equal(t, campaign.ID, *copy.CampaignID)
+		assert.Equal(t, "20代の会社員", copy.Target)
+	}
+
+	// キャンペーンに属さないコピーは含まれない
+	_, err = copies.CreateCopy(ctx, copy_usecase.CreateCopyInput{
+		ProductName:     "別の商品",
+		ProductFeatures: "高品質",
+		Target:          "20代",
+		Channel:         entity.ChannelSNS,
+		Tone:            entity.ToneCasual,
+	})
+	require.NoError(t, err)
+
+	// いいね数をキャンペーン単位で集計する
+	require.NoError(t, copyRepository.Like(ctx, set.Copies[0].ID, "user:1"))
+	require.NoError(t, copyRepository.Like(ctx, set.Copies[0].ID, "user:2"))
+	require.NoError(t, copyRepository.Like(ctx, set.Copies[1].ID, "user:1"))
+
+	view, err := campaigns.Copies(ctx, campaign.ID)
+	require.NoError(t, err)
+	assert.Len(t, view.Copies, len(channels))
+	assert.Equal(t, 3, view.Likes.Total)
+	assert.Equal(t, 2, view.Likes.ByChannel[entity.ChannelSNS])
+	assert.Equal(t, 1, view.Likes.ByChannel[entity.ChannelEmail])
+
+	// 削除したキャンペーンには生成できない
+	require.NoError(t, campaigns.Delete(ctx, campaign.ID))
+	_, err = campaigns.GenerateSet(ctx, campaign.ID, copy_usecase.CreateCopyInput{ProductName: "テスト商品"}, channels)
+	assert.ErrorIs(t, err, repository.ErrCampaignNotFound)
+	_, err = copies.CreateCopy(ctx, copy_usecase.CreateCopyInput{
+		ProductName: "テスト商品",
+		Channel:     entity.ChannelSNS,
+		Tone:        entity.ToneCasual,
+		CampaignID:  &campaign.ID,
+	})
+	assert.ErrorIs(t, err, copy_usecase.ErrInvalidCampaign)
+
+	// 削除しても生成済みのコピーとキャンペーンの紐付けは残る
+	linked, err := copyRepository.ListByCampaign(ctx, campaign.ID)
+	require.NoError(t, err)
+	require.Len(t, linked, len(channels))
+	linkedChannels := make([]entity.Channel, len(linked))
+	for i, copy := range linked {
+		linkedChannels[i] = copy.Channel
+	}
+	assert.ElementsMatch(t, channels, linkedChannels)
+}
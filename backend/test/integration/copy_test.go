@@ -2,7 +2,6 @@ package integration
 
 import (
 	"context"
-	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -11,7 +10,11 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/llm"
 	copy_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/copy"
+	revision_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/revision"
+	search_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/search"
 	copy_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/copy"
 )
 
@@ -19,7 +22,7 @@ func setupTestDB(t *testing.T) *gorm.DB {
 	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
 	require.NoError(t, err)
 
-	err = db.AutoMigrate(&entity.Copy{})
+	err = db.AutoMigrate(&entity.Copy{}, &entity.CopyRevision{}, &entity.Like{}, &entity.GenerationJob{})
 	require.NoError(t, err)
 
 	return db
@@ -29,10 +32,17 @@ func TestCopyIntegration(t *testing.T) {
 	// テスト用のデータベースをセットアップ
 	db := setupTestDB(t)
 	repo := copy_repository.NewRepository(db)
-	usecase := copy_usecase.NewUseCase(repo)
 
-	// OpenAI APIキーを設定
-	os.Setenv("OPENAI_API_KEY", "test-key")
+	// ネットワークを使用しないfakeプロバイダーで生成する
+	generator, err := llm.New(llm.Config{Provider: llm.ProviderFake})
+	require.NoError(t, err)
+	// SQLiteにはFULLTEXTインデックスがないため、メモリ上のbigramの索引で検索する
+	searchIndex, err := search_repository.NewBigramIndex(context.Background(), db)
+	require.NoError(t, err)
+	usecase := copy_usecase.NewUseCase(repo, generator,
+		copy_usecase.WithRevisionRepository(revision_repository.NewRepository(db)),
+		copy_usecase.WithSearchIndex(searchIndex),
+	)
 
 	t.Run("コピーの作成と取得", func(t *testing.T) {
 		ctx := context.Background()
@@ -44,7 +54,6 @@ func TestCopyIntegration(t *testing.T) {
 			Target:          "20代女性",
 			Channel:         entity.ChannelApp,
 			Tone:            entity.TonePop,
-			IsPublished:     true,
 		}
 
 		// コピーの作成
@@ -56,8 +65,11 @@ func TestCopyIntegration(t *testing.T) {
 		assert.Equal(t, input.Target, copy.Target)
 		assert.Equal(t, input.Channel, copy.Channel)
 		assert.Equal(t, input.Tone, copy.Tone)
-		assert.Equal(t, input.IsPublished, copy.IsPublished)
+		// 生成したコピーは下書きから始まる
+		assert.Equal(t, entity.CopyStatusDraft, copy.Status)
 		assert.Equal(t, 0, copy.Likes)
+		assert.NotEmpty(t, copy.Title)
+		assert.NotEmpty(t, copy.Description)
 
 		// 作成したコピーの取得
 		retrievedCopy, err := usecase.GetCopy(ctx, copy.ID)
@@ -65,6 +77,12 @@ func TestCopyIntegration(t *testing.T) {
 		assert.Equal(t, copy.ID, retrievedCopy.ID)
 		assert.Equal(t, copy.Title, retrievedCopy.Title)
 		assert.Equal(t, copy.Description, retrievedCopy.Description)
+
+		// チャネルごとの構造化された出力が保存されること
+		require.NotNil(t, retrievedCopy.Content)
+		require.NotNil(t, retrievedCopy.Content.App)
+		assert.Equal(t, copy.Title, retrievedCopy.Content.App.Title)
+		assert.Equal(t, copy.Description, retrievedCopy.Content.App.Body)
 	})
 
 	t.Run("公開済みコピーの取得", func(t *testing.T) {
@@ -77,18 +95,18 @@ func TestCopyIntegration(t *testing.T) {
 			Target:          "30代男性",
 			Channel:         entity.ChannelEmail,
 			Tone:            entity.ToneTrust,
-			IsPublished:     true,
 		}
 
-		_, err := usecase.CreateCopy(ctx, input)
+		copy, err := usecase.CreateCopy(ctx, input)
 		require.NoError(t, err)
+		publish(t, usecase, copy.ID)
 
 		// 公開済みコピーの取得
-		copies, err := usecase.GetPublishedCopies(ctx)
+		page, err := usecase.GetPublishedCopies(ctx, copy_usecase.ListCopiesInput{})
 		require.NoError(t, err)
-		assert.Greater(t, len(copies), 0)
-		for _, copy := range copies {
-			assert.True(t, copy.IsPublished)
+		assert.Greater(t, len(page.Copies), 0)
+		for _, copy := range page.Copies {
+			assert.Equal(t, entity.CopyStatusPublished, copy.Status)
 		}
 	})
 
@@ -102,20 +120,385 @@ func TestCopyIntegration(t *testing.T) {
 			Target:          "全世代",
 			Channel:         entity.ChannelSNS,
 			Tone:            entity.ToneCasual,
-			IsPublished:     true,
 		}
 
 		copy, err := usecase.CreateCopy(ctx, input)
 		require.NoError(t, err)
 
 		// いいねの更新
-		updatedCopy, err := usecase.UpdateLikes(ctx, copy.ID)
+		updatedCopy, err := usecase.LikeCopy(ctx, copy.ID, "user:1")
 		require.NoError(t, err)
 		assert.Equal(t, 1, updatedCopy.Likes)
 
-		// 再度いいねを更新
-		updatedCopy, err = usecase.UpdateLikes(ctx, copy.ID)
+		// 同じ投票者の2回目は数えない
+		updatedCopy, err = usecase.LikeCopy(ctx, copy.ID, "user:1")
+		require.NoError(t, err)
+		assert.Equal(t, 1, updatedCopy.Likes)
+
+		// 別の投票者
+		updatedCopy, err = usecase.LikeCopy(ctx, copy.ID, "user:2")
 		require.NoError(t, err)
 		assert.Equal(t, 2, updatedCopy.Likes)
+		assert.Equal(t, entity.TrendingScore(2, updatedCopy.CreatedAt), updatedCopy.TrendingScore)
+
+		// 取り消し（いいねしていない投票者の取り消しは数えない）
+		updatedCopy, err = usecase.UnlikeCopy(ctx, copy.ID, "user:1")
+		require.NoError(t, err)
+		assert.Equal(t, 1, updatedCopy.Likes)
+		updatedCopy, err = usecase.UnlikeCopy(ctx, copy.ID, "user:3")
+		require.NoError(t, err)
+		assert.Equal(t, 1, updatedCopy.Likes)
+
+		// 存在しないコピー
+		_, err = usecase.LikeCopy(ctx, 99999, "user:1")
+		assert.ErrorIs(t, err, repository.ErrNotFound)
 	})
+
+	t.Run("コピーの編集と削除・復元", func(t *testing.T) {
+		ctx := context.Background()
+
+		copy, err := usecase.CreateCopy(ctx, copy_usecase.CreateCopyInput{
+			ProductName:     "編集テスト商品",
+			ProductFeatures: "軽量",
+			Target:          "30代女性",
+			Channel:         entity.ChannelApp,
+			Tone:            entity.ToneTrust,
+		})
+		require.NoError(t, err)
+
+		// 見出しの編集（構造化された出力にも反映される）
+		title := "編集した見出し"
+		edited, err := usecase.UpdateCopy(ctx, copy.ID, copy_usecase.UpdateCopyInput{Title: &title})
+		require.NoError(t, err)
+		assert.Equal(t, title, edited.Title)
+		publish(t, usecase, copy.ID)
+
+		retrievedCopy, err := usecase.GetCopy(ctx, copy.ID)
+		require.NoError(t, err)
+		assert.Equal(t, title, retrievedCopy.Title)
+		assert.Equal(t, title, retrievedCopy.Content.App.Title)
+		assert.Equal(t, entity.CopyStatusPublished, retrievedCopy.Status)
+		assert.Equal(t, copy.Description, retrievedCopy.Description)
+
+		// 論理削除したコピーは取得・一覧の対象外
+		require.NoError(t, usecase.DeleteCopy(ctx, copy.ID))
+		_, err = usecase.GetCopy(ctx, copy.ID)
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+		page, err := usecase.GetPublishedCopies(ctx, copy_usecase.ListCopiesInput{Limit: copy_usecase.MaxPageSize})
+		require.NoError(t, err)
+		for _, c := range page.Copies {
+			assert.NotEqual(t, copy.ID, c.ID)
+		}
+		assert.ErrorIs(t, usecase.DeleteCopy(ctx, copy.ID), repository.ErrNotFound)
+
+		// 復元
+		restored, err := usecase.RestoreCopy(ctx, copy.ID)
+		require.NoError(t, err)
+		assert.Equal(t, title, restored.Title)
+		_, err = usecase.RestoreCopy(ctx, copy.ID)
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+	})
+
+	t.Run("版の記録と差分・ロールバック", func(t *testing.T) {
+		ctx := context.Background()
+
+		copy, err := usecase.CreateCopy(ctx, copy_usecase.CreateCopyInput{
+			ProductName:     "版テスト商品",
+			ProductFeatures: "軽量",
+			Target:          "30代女性",
+			Channel:         entity.ChannelApp,
+			Tone:            entity.ToneTrust,
+		})
+		require.NoError(t, err)
+		generated := copy.Title
+
+		// 編集（版2）
+		title := "編集した見出し"
+		_, err = usecase.UpdateCopy(ctx, copy.ID, copy_usecase.UpdateCopyInput{Title: &title, Author: "editor"})
+		require.NoError(t, err)
+
+		// 差分
+		diff, err := usecase.DiffRevisions(ctx, copy.ID, 1, 2)
+		require.NoError(t, err)
+		assert.NotEmpty(t, diff.Title)
+		assert.Nil(t, diff.Status)
+
+		// 生成時の版へのロールバック（版3として記録）
+		rolledBack, err := usecase.RollbackCopy(ctx, copy.ID, 1, "editor")
+		require.NoError(t, err)
+		assert.Equal(t, generated, rolledBack.Title)
+
+		revisions, err := usecase.ListRevisions(ctx, copy.ID)
+		require.NoError(t, err)
+		require.Len(t, revisions, 3)
+		assert.Equal(t, entity.RevisionSourceGenerated, revisions[0].Source)
+		assert.Equal(t, entity.RevisionSourceEdited, revisions[1].Source)
+		assert.Equal(t, "editor", revisions[1].Author)
+		assert.Equal(t, title, revisions[1].Title)
+		assert.Equal(t, entity.RevisionSourceRollback, revisions[2].Source)
+		assert.Equal(t, 1, revisions[2].RollbackOf)
+		assert.Equal(t, generated, revisions[2].Title)
+
+		retrievedCopy, err := usecase.GetCopy(ctx, copy.ID)
+		require.NoError(t, err)
+		assert.Equal(t, generated, retrievedCopy.Content.App.Title)
+	})
+
+	t.Run("編集ワークフロー", func(t *testing.T) {
+		ctx := context.Background()
+
+		copy, err := usecase.CreateCopy(ctx, copy_usecase.CreateCopyInput{
+			ProductName:     "ワークフロー商品",
+			ProductFeatures: "軽量",
+			Target:          "30代女性",
+			Channel:         entity.ChannelApp,
+			Tone:            entity.ToneTrust,
+		})
+		require.NoError(t, err)
+		inReview := func() []int {
+			copies, err := usecase.ListInReview(ctx, "reviewer")
+			require.NoError(t, err)
+			var ids []int
+			for _, c := range copies {
+				ids = append(ids, c.ID)
+			}
+			return ids
+		}
+
+		// 下書きからは公開できない
+		_, err = usecase.Publish(ctx, copy.ID, copy_usecase.WorkflowInput{Actor: "writer"})
+		assert.ErrorIs(t, err, copy_usecase.ErrInvalidTransition)
+
+		// レビューの依頼と差し戻し（レビュー中は編集できない）
+		_, err = usecase.SubmitForReview(ctx, copy.ID, copy_usecase.WorkflowInput{Actor: "writer", Reviewer: "reviewer"})
+		require.NoError(t, err)
+		assert.Contains(t, inReview(), copy.ID)
+		title := "編集した見出し"
+		_, err = usecase.UpdateCopy(ctx, copy.ID, copy_usecase.UpdateCopyInput{Title: &title})
+		assert.ErrorIs(t, err, copy_usecase.ErrCopyNotEditable)
+		_, err = usecase.Approve(ctx, copy.ID, copy_usecase.WorkflowInput{Actor: "writer"})
+		assert.ErrorIs(t, err, copy_usecase.ErrNotReviewer)
+		// 依頼した利用者は自分を担当者に変更して承認することはできない
+		_, err = usecase.AssignReviewer(ctx, copy.ID, copy_usecase.WorkflowInput{Actor: "writer", Reviewer: "writer"})
+		assert.ErrorIs(t, err, copy_usecase.ErrSelfReview)
+		rejected, err := usecase.Reject(ctx, copy.ID, copy_usecase.WorkflowInput{Actor: "reviewer", Comment: "見出しを短くしてください"})
+		require.NoError(t, err)
+		assert.Equal(t, entity.CopyStatusDraft, rejected.Status)
+		assert.NotContains(t, inReview(), copy.ID)
+
+		// 修正して再度レビューを受け、承認後に公開する
+		_, err = usecase.UpdateCopy(ctx, copy.ID, copy_usecase.UpdateCopyInput{Title: &title, Author: "writer"})
+		require.NoError(t, err)
+		published := publish(t, usecase, copy.ID)
+		assert.Equal(t, title, published.Title)
+
+		// 公開済みの一覧に含まれ、アーカイブすると外れる
+		listed := func() bool {
+			page, err := usecase.GetPublishedCopies(ctx, copy_usecase.ListCopiesInput{Filter: repository.CopyFilter{ProductName: "ワークフロー商品"}})
+			require.NoError(t, err)
+			return len(page.Copies) == 1
+		}
+		assert.True(t, listed())
+		_, err = usecase.Archive(ctx, copy.ID, copy_usecase.WorkflowInput{Actor: "writer"})
+		require.NoError(t, err)
+		assert.False(t, listed())
+
+		// 状態の変更は操作した利用者・コメントとともに版に記録される
+		revisions, err := usecase.ListRevisions(ctx, copy.ID)
+		require.NoError(t, err)
+		var statuses []entity.CopyStatus
+		for _, r := range revisions {
+			if r.Source == entity.RevisionSourceWorkflow {
+				statuses = append(statuses, r.Status)
+			}
+		}
+		assert.Equal(t, []entity.CopyStatus{
+			entity.CopyStatusInReview, entity.CopyStatusDraft,
+			entity.CopyStatusInReview, entity.CopyStatusApproved, entity.CopyStatusPublished,
+			entity.CopyStatusArchived,
+		}, statuses)
+		assert.Equal(t, "reviewer", revisions[2].Author)
+		assert.Equal(t, "見出しを短くしてください", revisions[2].Comment)
+
+		// アーカイブする前に取得した公開済みの状態で変更しようとしても、更新も版の記録も行わない
+		stale := *published
+		stale.Status = entity.CopyStatusArchived
+		err = repo.UpdateStatus(ctx, &stale, repository.CopyStatusGuard{Status: entity.CopyStatusPublished}, []string{"Status"}, entity.NewCopyRevision(&stale, entity.RevisionSourceScheduler, "scheduler"))
+		assert.ErrorIs(t, err, repository.ErrCopyStatusChanged)
+		after, err := usecase.ListRevisions(ctx, copy.ID)
+		require.NoError(t, err)
+		assert.Len(t, after, len(revisions))
+	})
+
+	t.Run("一覧のページングと絞り込み・並び替え", func(t *testing.T) {
+		ctx := context.Background()
+
+		// 専用の商品名で5件作成し、2件目と4件目にいいねする
+		var ids []int
+		for i := 0; i < 5; i++ {
+			copy, err := usecase.CreateCopy(ctx, copy_usecase.CreateCopyInput{
+				ProductName:     "ページング商品",
+				ProductFeatures: "軽量",
+				Target:          "ページング対象",
+				Channel:         entity.ChannelPop,
+				Tone:            entity.ToneValue,
+			})
+			require.NoError(t, err)
+			publish(t, usecase, copy.ID)
+			ids = append(ids, copy.ID)
+		}
+		_, err := usecase.LikeCopy(ctx, ids[1], "user:1")
+		require.NoError(t, err)
+		for _, voter := range []string{"user:1", "user:2"} {
+			_, err = usecase.LikeCopy(ctx, ids[3], voter)
+			require.NoError(t, err)
+		}
+		filter := repository.CopyFilter{Channel: entity.ChannelPop, Target: "ページング対象", ProductName: "ページング"}
+
+		// 2件ずつ取得して、すべてのページを辿る
+		var got []int
+		input := copy_usecase.ListCopiesInput{Filter: filter, Limit: 2}
+		for {
+			page, err := usecase.GetPublishedCopies(ctx, input)
+			require.NoError(t, err)
+			for _, c := range page.Copies {
+				got = append(got, c.ID)
+			}
+			if !page.HasMore {
+				break
+			}
+			input.Cursor = page.NextCursor
+		}
+		// 新しい順（既定）
+		assert.Equal(t, []int{ids[4], ids[3], ids[2], ids[1], ids[0]}, got)
+
+		// いいね数の多い順
+		page, err := usecase.GetPublishedCopies(ctx, copy_usecase.ListCopiesInput{Filter: filter, Sort: repository.CopySortLikes, Limit: 2})
+		require.NoError(t, err)
+		require.Len(t, page.Copies, 2)
+		assert.Equal(t, ids[3], page.Copies[0].ID)
+		assert.Equal(t, ids[1], page.Copies[1].ID)
+
+		// 話題順（作成日時がほぼ同じため、いいね数の順になる）
+		page, err = usecase.GetPublishedCopies(ctx, copy_usecase.ListCopiesInput{Filter: filter, Sort: repository.CopySortTrending, Limit: 1})
+		require.NoError(t, err)
+		assert.Equal(t, ids[3], page.Copies[0].ID)
+
+		// 該当しない絞り込み
+		page, err = usecase.GetPublishedCopies(ctx, copy_usecase.ListCopiesInput{Filter: repository.CopyFilter{ProductName: "存在しない%"}})
+		require.NoError(t, err)
+		assert.Empty(t, page.Copies)
+		assert.False(t, page.HasMore)
+	})
+
+	t.Run("全文検索と一致箇所の抽出", func(t *testing.T) {
+		ctx := context.Background()
+
+		// 商品名は半角カナで保存し、全角カナで検索する
+		published, err := usecase.CreateCopy(ctx, copy_usecase.CreateCopyInput{
+			ProductName:     "ｽﾏｰﾄｳｫｯﾁ",
+			ProductFeatures: "防水、心拍計測",
+			Target:          "ランナー",
+			Channel:         entity.ChannelApp,
+			Tone:            entity.ToneTrust,
+		})
+		require.NoError(t, err)
+		publish(t, usecase, published.ID)
+		_, err = usecase.CreateCopy(ctx, copy_usecase.CreateCopyInput{
+			ProductName:     "ｽﾏｰﾄｳｫｯﾁ",
+			ProductFeatures: "心拍計測",
+			Target:          "ランナー",
+			Channel:         entity.ChannelApp,
+			Tone:            entity.ToneTrust,
+		})
+		require.NoError(t, err)
+
+		// 公開済みのコピーのみ、すべての検索語を含むものが対象
+		page, err := usecase.SearchCopies(ctx, copy_usecase.SearchCopiesInput{Query: "スマートウォッチ　心拍"})
+		require.NoError(t, err)
+		require.Len(t, page.Results, 1)
+		assert.Equal(t, published.ID, page.Results[0].Copy.ID)
+		assert.False(t, page.HasMore)
+
+		// 未公開を含める場合は両方
+		page, err = usecase.SearchCopies(ctx, copy_usecase.SearchCopiesInput{Query: "スマートウォッチ　心拍", IncludeUnpublished: true})
+		require.NoError(t, err)
+		assert.Len(t, page.Results, 2)
+		page, err = usecase.SearchCopies(ctx, copy_usecase.SearchCopiesInput{Query: "スマートウォッチ　心拍"})
+		require.NoError(t, err)
+
+		highlights := map[string]copy_usecase.SearchHighlight{}
+		for _, h := range page.Results[0].Highlights {
+			highlights[h.Field] = h
+		}
+		require.Contains(t, highlights, "productName")
+		assert.Equal(t, "ｽﾏｰﾄｳｫｯﾁ", highlights["productName"].Fragments[0].Text)
+		assert.Equal(t, 0, highlights["productName"].Fragments[0].Matches[0].Start)
+		assert.Equal(t, 8, highlights["productName"].Fragments[0].Matches[0].End)
+		require.Contains(t, highlights, "productFeatures")
+
+		// 削除したコピーは検索されず、復元すると再び検索される
+		require.NoError(t, usecase.DeleteCopy(ctx, published.ID))
+		page, err = usecase.SearchCopies(ctx, copy_usecase.SearchCopiesInput{Query: "スマートウォッチ"})
+		require.NoError(t, err)
+		assert.Empty(t, page.Results)
+
+		_, err = usecase.RestoreCopy(ctx, published.ID)
+		require.NoError(t, err)
+		page, err = usecase.SearchCopies(ctx, copy_usecase.SearchCopiesInput{Query: "スマートウォッチ"})
+		require.NoError(t, err)
+		assert.Len(t, page.Results, 1)
+	})
+
+	t.Run("複数候補の生成と採用", func(t *testing.T) {
+		ctx := context.Background()
+
+		input := copy_usecase.CreateCopyInput{
+			ProductName:     "候補テスト商品",
+			ProductFeatures: "軽量、防水",
+			Target:          "アウトドア好き",
+			Channel:         entity.ChannelLine,
+			Tone:            entity.ToneValue,
+			Variants:        4,
+		}
+
+		generation, err := usecase.CreateVariants(ctx, input)
+		require.NoError(t, err)
+		require.Len(t, generation.Variants, 4)
+
+		// 保存した候補を取得
+		stored, err := usecase.GetGeneration(ctx, generation.ID)
+		require.NoError(t, err)
+		require.Len(t, stored.Variants, 4)
+		for i := 1; i < len(stored.Variants); i++ {
+			assert.GreaterOrEqual(t, stored.Variants[i-1].Copy.Score, stored.Variants[i].Copy.Score)
+		}
+
+		// 最下位の候補を採用
+		winner := stored.Variants[len(stored.Variants)-1].Copy
+		selected, err := usecase.SelectVariant(ctx, generation.ID, winner.ID)
+		require.NoError(t, err)
+		assert.True(t, selected.IsSelected)
+
+		stored, err = usecase.GetGeneration(ctx, generation.ID)
+		require.NoError(t, err)
+		for _, v := range stored.Variants {
+			assert.Equal(t, v.Copy.ID == winner.ID, v.Copy.IsSelected)
+			require.NotNil(t, v.Copy.Content)
+			require.NotNil(t, v.Copy.Content.Line)
+			assert.Equal(t, "bubble", v.Copy.Content.Line.Bubble.Type)
+		}
+	})
+}
+
+// publish: レビューの依頼・承認を経てコピーを公開する
+func publish(t *testing.T, usecase copy_usecase.UseCase, id int) *entity.Copy {
+	ctx := context.Background()
+	_, err := usecase.SubmitForReview(ctx, id, copy_usecase.WorkflowInput{Actor: "writer", Reviewer: "reviewer"})
+	require.NoError(t, err)
+	_, err = usecase.Approve(ctx, id, copy_usecase.WorkflowInput{Actor: "reviewer"})
+	require.NoError(t, err)
+	copy, err := usecase.Publish(ctx, id, copy_usecase.WorkflowInput{Actor: "writer"})
+	require.NoError(t, err)
+	return copy
 }
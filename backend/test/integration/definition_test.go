@@ -0,0 +1,109 @@
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/llm"
+	copy_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/copy"
+	definition_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/definition"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/textmetrics"
+	copy_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/copy"
+	definition_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/definition"
+)
+
+// seedDefinitions: 配信チャネル・トーンのテーブルを作成し、マイグレーションと同じく組み込みの定義を登録
+func seedDefinitions(t *testing.T, db *gorm.DB) {
+	require.NoError(t, db.AutoMigrate(&entity.ChannelDefinition{}, &entity.ToneDefinition{}))
+	for _, d := range entity.BuiltinChannels {
+		channel, _ := entity.BuiltinChannel(d.ID)
+		require.NoError(t, db.Create(channel).Error)
+	}
+	for _, d := range entity.BuiltinTones {
+		tone, _ := entity.BuiltinTone(d.ID)
+		require.NoError(t, db.Create(tone).Error)
+	}
+}
+
+func TestDefinitionIntegration(t *testing.T) {
+	// テスト用のデータベースをセットアップ
+	db := setupTestDB(t)
+	seedDefinitions(t, db)
+	channels := definition_repository.NewChannelRepository(db)
+	tones := definition_repository.NewToneRepository(db)
+	definitions := definition_usecase.NewUseCase(channels, tones)
+
+	generator, err := llm.New(llm.Config{Provider: llm.ProviderFake})
+	require.NoError(t, err)
+	copies := copy_usecase.NewUseCase(copy_repository.NewRepository(db), generator,
+		copy_usecase.WithDefinitions(channels, tones),
+	)
+
+	ctx := context.Background()
+	graphemes := func(min, max int) textmetrics.Limit {
+		return textmetrics.Limit{Unit: textmetrics.UnitGraphemes, Min: min, Max: max}
+	}
+
+	// 組み込み以外のチャネル・トーンの登録
+	_, err = definitions.CreateChannel(ctx, definition_usecase.ChannelInput{
+		ID:       "reels",
+		Label:    "Instagramリール",
+		Guidance: "冒頭で視聴者の手を止める書き出しにしてください。",
+		Fields: []entity.OutputField{
+			{Name: entity.FieldTitle, Description: "テロップ", Limit: graphemes(0, 20)},
+			{Name: entity.FieldDescription, Description: "キャプション", Limit: graphemes(50, 100)},
+			{Name: "hook", Description: "最初の一言", Limit: graphemes(0, 10)},
+		},
+		SortOrder: 6,
+	})
+	require.NoError(t, err)
+	_, err = definitions.CreateTone(ctx, definition_usecase.ToneInput{
+		ID:        "urgent",
+		Label:     "限定感",
+		Markers:   []string{"限定", "残りわずか"},
+		SortOrder: 6,
+	})
+	require.NoError(t, err)
+
+	// 選択肢には組み込みの定義の後に表示される
+	options, err := definitions.Options(ctx)
+	require.NoError(t, err)
+	require.Len(t, options.Channels, 6)
+	assert.Equal(t, "sns", options.Channels[3].Value)
+	assert.Equal(t, "reels", options.Channels[5].Value)
+	assert.Equal(t, "urgent", options.Tones[5].Value)
+
+	// 登録したチャネル・トーンで生成し、固有の項目を保存する
+	input := copy_usecase.CreateCopyInput{
+		ProductName:     "定義テスト商品",
+		ProductFeatures: "軽量、防水",
+		Target:          "アウトドア好き",
+		Channel:         "reels",
+		Tone:            "urgent",
+	}
+	created, err := copies.CreateCopy(ctx, input)
+	require.NoError(t, err)
+	require.NotNil(t, created.Content)
+	assert.NotEmpty(t, created.Content.Fields["hook"])
+
+	got, err := copies.GetCopy(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, created.Content.Fields, got.Content.Fields)
+
+	// 組み込みのチャネルは削除できない
+	err = definitions.DeleteChannel(ctx, entity.ChannelSNS)
+	assert.ErrorIs(t, err, definition_usecase.ErrBuiltinDefinition)
+
+	// 削除したチャネルは新しい生成に使用できないが、既存のコピーは取得できる
+	require.NoError(t, definitions.DeleteChannel(ctx, "reels"))
+	_, err = copies.CreateCopy(ctx, input)
+	assert.ErrorIs(t, err, copy_usecase.ErrInvalidChannel)
+
+	_, err = copies.GetCopy(ctx, created.ID)
+	assert.NoError(t, err)
+}
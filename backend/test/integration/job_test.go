@@ -0,0 +1,112 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/llm"
+	copy_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/copy"
+	job_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/job"
+	copy_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/copy"
+	job_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/job"
+)
+
+func TestGenerationJobIntegration(t *testing.T) {
+	// テスト用のデータベースをセットアップ
+	db := setupTestDB(t)
+	generator, err := llm.New(llm.Config{Provider: llm.ProviderFake})
+	require.NoError(t, err)
+	copies := copy_usecase.NewUseCase(copy_repository.NewRepository(db), generator)
+	usecase, err := job_usecase.NewUseCase(job_repository.NewRepository(db), copies)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	// ジョブの登録（1件生成と複数候補の生成）
+	single, err := usecase.Enqueue(ctx, copy_usecase.CreateCopyInput{
+		ProductName:     "ジョブテスト商品",
+		ProductFeatures: "軽量、防水",
+		Target:          "アウトドア好き",
+		Channel:         entity.ChannelPop,
+		Tone:            entity.TonePop,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, entity.JobStatusQueued, single.Status)
+
+	variants, err := usecase.Enqueue(ctx, copy_usecase.CreateCopyInput{
+		ProductName:     "ジョブテスト商品",
+		ProductFeatures: "軽量、防水",
+		Target:          "アウトドア好き",
+		Channel:         entity.ChannelSNS,
+		Tone:            entity.ToneCasual,
+		Variants:        3,
+	})
+	require.NoError(t, err)
+
+	// 登録順に実行され、実行するジョブがなくなる
+	for i := 0; i < 2; i++ {
+		processed, err := usecase.ProcessNext(ctx, "worker-1")
+		require.NoError(t, err)
+		assert.True(t, processed)
+	}
+	processed, err := usecase.ProcessNext(ctx, "worker-1")
+	require.NoError(t, err)
+	assert.False(t, processed)
+
+	// 1件生成のジョブ
+	got, err := usecase.GetJob(ctx, single.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.JobStatusSucceeded, got.Status)
+	assert.Equal(t, 1, got.Attempts)
+	require.Len(t, got.CopyIDs, 1)
+	assert.NotNil(t, got.CompletedAt)
+
+	copy, err := copies.GetCopy(ctx, got.CopyIDs[0])
+	require.NoError(t, err)
+	assert.Equal(t, "ジョブテスト商品", copy.ProductName)
+
+	// 複数候補のジョブ
+	got, err = usecase.GetJob(ctx, variants.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.JobStatusSucceeded, got.Status)
+	assert.Len(t, got.CopyIDs, 3)
+
+	generation, err := copies.GetGeneration(ctx, got.GenerationID)
+	require.NoError(t, err)
+	assert.Len(t, generation.Variants, 3)
+
+	// リースの期限切れで再実行しても、保存済みのコピーを使用して重複して生成しない
+	var before int64
+	require.NoError(t, db.Model(&entity.Copy{}).Count(&before).Error)
+	staleAt := time.Now().Add(-2 * job_usecase.DefaultLease)
+	require.NoError(t, db.Model(&entity.GenerationJob{}).Where("id = ?", variants.ID).Updates(map[string]interface{}{
+		"status":    entity.JobStatusRunning,
+		"locked_by": "worker-1",
+		"locked_at": staleAt,
+	}).Error)
+
+	processed, err = usecase.ProcessNext(ctx, "worker-2")
+	require.NoError(t, err)
+	assert.True(t, processed)
+
+	rerun, err := usecase.GetJob(ctx, variants.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.JobStatusSucceeded, rerun.Status)
+	assert.Equal(t, 2, rerun.Attempts)
+	assert.Equal(t, got.CopyIDs, rerun.CopyIDs)
+	assert.Equal(t, got.GenerationID, rerun.GenerationID)
+
+	var after int64
+	require.NoError(t, db.Model(&entity.Copy{}).Count(&after).Error)
+	assert.Equal(t, before, after)
+
+	// 存在しないジョブ
+	_, err = usecase.GetJob(ctx, 999)
+	assert.ErrorIs(t, err, repository.ErrJobNotFound)
+}
@@ -0,0 +1,94 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	copy_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/copy"
+)
+
+// setupFileDB: 複数の接続から同時に更新するためのファイルのデータベース
+//
+// :memory:は接続ごとに別のデータベースになるため、一時ファイルを使用します。
+// 書き込みのトランザクションは開始時にロックを取得し（_txlock=immediate）、ロックの解放を待ちます。
+func setupFileDB(t *testing.T) *gorm.DB {
+	dsn := filepath.Join(t.TempDir(), "likes.db") + "?_busy_timeout=10000&_journal_mode=WAL&_txlock=immediate"
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+
+	err = db.AutoMigrate(&entity.Copy{}, &entity.Like{})
+	require.NoError(t, err)
+
+	return db
+}
+
+func TestLikeConcurrency(t *testing.T) {
+	const (
+		voters = 50
+		// repeats: 同じ投票者が同時にいいねする回数（2回目以降は数えない）
+		repeats = 3
+		// unlikes: いいねを取り消す投票者の数
+		unlikes = 20
+	)
+
+	ctx := context.Background()
+	db := setupFileDB(t)
+	repo := copy_repository.NewRepository(db)
+
+	copy := &entity.Copy{Title: "同時いいねテスト", Status: entity.CopyStatusPublished}
+	require.NoError(t, repo.Create(ctx, copy))
+
+	// 並行して実行し、すべてのエラーを集める
+	parallel := func(n int, fn func(i int) error) {
+		var wg sync.WaitGroup
+		errs := make(chan error, n)
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				errs <- fn(i)
+			}(i)
+		}
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			require.NoError(t, err)
+		}
+	}
+
+	// 投票者ごとにrepeats回ずつ同時にいいねする
+	parallel(voters*repeats, func(i int) error {
+		return repo.Like(ctx, copy.ID, fmt.Sprintf("user:%d", i%voters))
+	})
+
+	got, err := repo.Get(ctx, copy.ID)
+	require.NoError(t, err)
+	assert.Equal(t, voters, got.Likes)
+	assert.Equal(t, entity.TrendingScore(voters, got.CreatedAt), got.TrendingScore)
+
+	var count int64
+	require.NoError(t, db.Model(&entity.Like{}).Where("copy_id = ?", copy.ID).Count(&count).Error)
+	assert.Equal(t, int64(voters), count)
+
+	// 一部の投票者が同時に2回ずつ取り消す
+	parallel(unlikes*2, func(i int) error {
+		return repo.Unlike(ctx, copy.ID, fmt.Sprintf("user:%d", i%unlikes))
+	})
+
+	got, err = repo.Get(ctx, copy.ID)
+	require.NoError(t, err)
+	assert.Equal(t, voters-unlikes, got.Likes)
+	assert.Equal(t, entity.TrendingScore(voters-unlikes, got.CreatedAt), got.TrendingScore)
+}
@@ -0,0 +1,95 @@
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/llm"
+	copy_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/copy"
+	product_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/product"
+	copy_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/copy"
+	product_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/product"
+)
+
+func TestProductIntegration(t *testing.T) {
+	// テスト用のデータベースをセットアップ
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&entity.Product{}))
+	productRepository := product_repository.NewRepository(db)
+	copyRepository := copy_repository.NewRepository(db)
+	products := product_usecase.NewUseCase(productRepository, copyRepository)
+
+	generator, err := llm.New(llm.Config{Provider: llm.ProviderFake})
+	require.NoError(t, err)
+	copies := copy_usecase.NewUseCase(copyRepository, generator, copy_usecase.WithProducts(productRepository))
+
+	ctx := context.Background()
+
+	// 商品の登録
+	price := 3980
+	product, err := products.Create(ctx, product_usecase.Input{
+		SKU:      "SKU-001",
+		Name:     "カタログ商品",
+		Features: "軽量、防水",
+		Price:    &price,
+		Category: "アウトドア",
+		Images:   []entity.ProductImage{{URL: "https://example.com/1.jpg", Alt: "正面"}},
+	})
+	require.NoError(t, err)
+
+	got, err := products.Get(ctx, product.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 3980, *got.Price)
+	assert.Equal(t, product.Images, got.Images)
+
+	// 商品IDのみを指定してコピーを生成し、1件目のみ公開する
+	for i := 0; i < 2; i++ {
+		created, err := copies.CreateCopy(ctx, copy_usecase.CreateCopyInput{
+			ProductID: &product.ID,
+			Target:    "アウトドア好き",
+			Channel:   entity.ChannelSNS,
+			Tone:      entity.ToneCasual,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "カタログ商品", created.ProductName)
+		assert.Equal(t, "軽量、防水", created.ProductFeatures)
+		if i == 0 {
+			publish(t, copies, created.ID)
+		}
+	}
+
+	// 商品を指定しないコピーは含まれない
+	_, err = copies.CreateCopy(ctx, copy_usecase.CreateCopyInput{
+		ProductName:     "別の商品",
+		ProductFeatures: "高品質",
+		Target:          "20代",
+		Channel:         entity.ChannelSNS,
+		Tone:            entity.ToneCasual,
+	})
+	require.NoError(t, err)
+
+	list, err := products.ListCopies(ctx, product.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	for _, copy := range list {
+		assert.Equal(t, product.ID, *copy.ProductID)
+	}
+
+	// 削除した商品は新しい生成に使用できない
+	require.NoError(t, products.Delete(ctx, product.ID))
+	_, err = copies.CreateCopy(ctx, copy_usecase.CreateCopyInput{
+		ProductID: &product.ID,
+		Target:    "アウトドア好き",
+		Channel:   entity.ChannelSNS,
+		Tone:      entity.ToneCasual,
+	})
+	assert.ErrorIs(t, err, copy_usecase.ErrInvalidProduct)
+
+	_, err = products.ListCopies(ctx, product.ID)
+	assert.ErrorIs(t, err, repository.ErrProductNotFound)
+}
@@ -0,0 +1,178 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/entity"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/domain/repository"
+	"github.com/takanoakira/ai-sales-copy-generator/backend/internal/llm"
+	copy_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/copy"
+	revision_repository "github.com/takanoakira/ai-sales-copy-generator/backend/internal/repository/revision"
+	copy_usecase "github.com/takanoakira/ai-sales-copy-generator/backend/internal/usecase/copy"
+)
+
+func TestScheduleIntegration(t *testing.T) {
+	// テスト用のデータベースをセットアップ
+	db := setupTestDB(t)
+	generator, err := llm.New(llm.Config{Provider: llm.ProviderFake})
+	require.NoError(t, err)
+
+	// 現在時刻はテストの中で進める
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	repo := copy_repository.NewRepository(db)
+	usecase := copy_usecase.NewUseCase(repo, generator,
+		copy_usecase.WithRevisionRepository(revision_repository.NewRepository(db)),
+		copy_usecase.WithClock(func() time.Time { return now }),
+	)
+
+	ctx := context.Background()
+	create := func(name string) *entity.Copy {
+		copy, err := usecase.CreateCopy(ctx, copy_usecase.CreateCopyInput{
+			ProductName:     name,
+			ProductFeatures: "高性能、使いやすい",
+			Target:          "20代女性",
+			Channel:         entity.ChannelSNS,
+			Tone:            entity.ToneCasual,
+		})
+		require.NoError(t, err)
+		return copy
+	}
+	published := func() []int {
+		page, err := usecase.GetPublishedCopies(ctx, copy_usecase.ListCopiesInput{})
+		require.NoError(t, err)
+		ids := []int{}
+		for _, copy := range page.Copies {
+			ids = append(ids, copy.ID)
+		}
+		return ids
+	}
+
+	publishAt := now.Add(time.Hour)
+	expireAt := now.Add(2 * time.Hour)
+
+	// scheduled: 承認済みで公開日時・公開終了日時を設定したコピー（スケジューラーが公開する）
+	scheduled := create("予約公開の商品")
+	_, err = usecase.SubmitForReview(ctx, scheduled.ID, copy_usecase.WorkflowInput{Actor: "writer", Reviewer: "reviewer"})
+	require.NoError(t, err)
+	_, err = usecase.Approve(ctx, scheduled.ID, copy_usecase.WorkflowInput{Actor: "reviewer"})
+	require.NoError(t, err)
+	_, err = usecase.Schedule(ctx, scheduled.ID, copy_usecase.ScheduleInput{PublishAt: &publishAt, ExpireAt: &expireAt, Actor: "writer"})
+	require.NoError(t, err)
+	// 公開日時・公開終了日時の設定も版に記録する
+	revisions, err := usecase.ListRevisions(ctx, scheduled.ID)
+	require.NoError(t, err)
+	last := revisions[len(revisions)-1]
+	assert.Equal(t, entity.RevisionSourceSchedule, last.Source)
+	assert.Equal(t, "writer", last.Author)
+	assert.True(t, publishAt.Equal(*last.PublishAt))
+	assert.True(t, expireAt.Equal(*last.ExpireAt))
+
+	// early: 公開日時より前に公開したコピー（公開日時まで一覧に表示しない）
+	early := create("先行公開の商品")
+	_, err = usecase.Schedule(ctx, early.ID, copy_usecase.ScheduleInput{PublishAt: &publishAt})
+	require.NoError(t, err)
+	publish(t, usecase, early.ID)
+
+	t.Run("公開日時前", func(t *testing.T) {
+		result, err := usecase.RunSchedule(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, result.Published)
+		assert.Empty(t, result.Archived)
+		assert.Empty(t, published())
+	})
+
+	t.Run("公開日時になると公開する", func(t *testing.T) {
+		now = publishAt
+
+		// スケジューラーの実行前でも、公開済みのコピーは公開日時から一覧に表示する
+		assert.Equal(t, []int{early.ID}, published())
+
+		result, err := usecase.RunSchedule(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []int{scheduled.ID}, result.Published)
+		assert.ElementsMatch(t, []int{scheduled.ID, early.ID}, published())
+
+		got, err := usecase.GetCopy(ctx, scheduled.ID)
+		require.NoError(t, err)
+		assert.Equal(t, entity.CopyStatusPublished, got.Status)
+	})
+
+	t.Run("公開終了日時になるとアーカイブする", func(t *testing.T) {
+		now = expireAt
+
+		// スケジューラーの実行前でも、公開終了日時を過ぎたコピーは一覧から外す
+		assert.Equal(t, []int{early.ID}, published())
+
+		result, err := usecase.RunSchedule(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []int{scheduled.ID}, result.Archived)
+
+		got, err := usecase.GetCopy(ctx, scheduled.ID)
+		require.NoError(t, err)
+		assert.Equal(t, entity.CopyStatusArchived, got.Status)
+
+		// 公開・アーカイブはschedulerの版として記録する
+		revisions, err := usecase.ListRevisions(ctx, scheduled.ID)
+		require.NoError(t, err)
+		var statuses []entity.CopyStatus
+		for _, r := range revisions {
+			if r.Source == entity.RevisionSourceScheduler {
+				assert.Equal(t, "scheduler", r.Author)
+				statuses = append(statuses, r.Status)
+			}
+		}
+		assert.ElementsMatch(t, []entity.CopyStatus{entity.CopyStatusPublished, entity.CopyStatusArchived}, statuses)
+
+		// 公開終了日時を過ぎたコピーは公開できない
+		_, err = usecase.Reopen(ctx, scheduled.ID, copy_usecase.WorkflowInput{Actor: "writer"})
+		require.NoError(t, err)
+		_, err = usecase.SubmitForReview(ctx, scheduled.ID, copy_usecase.WorkflowInput{Actor: "writer", Reviewer: "reviewer"})
+		require.NoError(t, err)
+		_, err = usecase.Approve(ctx, scheduled.ID, copy_usecase.WorkflowInput{Actor: "reviewer"})
+		require.NoError(t, err)
+		_, err = usecase.Publish(ctx, scheduled.ID, copy_usecase.WorkflowInput{Actor: "writer"})
+		assert.ErrorIs(t, err, copy_usecase.ErrCopyExpired)
+	})
+
+	t.Run("一覧の取得後に公開日時を変更したコピーは公開しない", func(t *testing.T) {
+		rescheduled := create("予約変更の商品")
+		_, err := usecase.SubmitForReview(ctx, rescheduled.ID, copy_usecase.WorkflowInput{Actor: "writer", Reviewer: "reviewer"})
+		require.NoError(t, err)
+		_, err = usecase.Approve(ctx, rescheduled.ID, copy_usecase.WorkflowInput{Actor: "reviewer"})
+		require.NoError(t, err)
+		dueAt := now
+		_, err = usecase.Schedule(ctx, rescheduled.ID, copy_usecase.ScheduleInput{PublishAt: &dueAt})
+		require.NoError(t, err)
+
+		// スケジューラーが一覧を取得した後に、公開日時を翌日に変更する
+		due, err := repo.ListDueToPublish(ctx, now)
+		require.NoError(t, err)
+		var listed entity.Copy
+		for _, copy := range due {
+			if copy.ID == rescheduled.ID {
+				listed = *copy
+			}
+		}
+		require.Equal(t, rescheduled.ID, listed.ID)
+		later := now.Add(24 * time.Hour)
+		_, err = usecase.Schedule(ctx, rescheduled.ID, copy_usecase.ScheduleInput{PublishAt: &later})
+		require.NoError(t, err)
+
+		// 一覧の時点の条件で保存しようとしても、公開日時の条件を満たさないため公開しない
+		listed.Status = entity.CopyStatusPublished
+		err = repo.UpdateStatus(ctx, &listed, repository.CopyStatusGuard{Status: entity.CopyStatusApproved, PublishDue: &now}, []string{"Status"}, nil)
+		assert.ErrorIs(t, err, repository.ErrCopyStatusChanged)
+
+		got, err := usecase.GetCopy(ctx, rescheduled.ID)
+		require.NoError(t, err)
+		assert.Equal(t, entity.CopyStatusApproved, got.Status)
+		result, err := usecase.RunSchedule(ctx)
+		require.NoError(t, err)
+		assert.NotContains(t, result.Published, rescheduled.ID)
+	})
+}
@@ -3,29 +3,16 @@
 import { Button } from '@/components/Button';
 import { useState, useEffect } from 'react';
 import { use } from 'react';
-import { getCopy, updateLikes } from '@/lib/api/copy';
+import { getCopy, likeCopy, unlikeCopy } from '@/lib/api/copy';
+import { labelOf, useOptions } from '@/lib/api/meta';
 import { GetCopyResponse } from '@/lib/api/types';
 
-const CHANNEL_LABELS: Record<string, string> = {
-  app: 'アプリ通知',
-  line: 'LINE広告',
-  pop: '店舗POP',
-  sns: 'SNS投稿',
-  email: 'メールマガジン',
-};
-
-const TONE_LABELS: Record<string, string> = {
-  pop: 'ポップ',
-  trust: '信頼感',
-  value: 'お得感',
-  luxury: '高級感',
-  casual: 'カジュアル',
-};
-
 export default function CopyDetailPage({ params }: { params: Promise<{ id: string }> }) {
   const resolvedParams = use(params);
+  const { channels, tones } = useOptions();
   const [copy, setCopy] = useState<GetCopyResponse | null>(null);
   const [likes, setLikes] = useState(0);
+  const [liked, setLiked] = useState(false);
   const [isLoading, setIsLoading] = useState(true);
   const [error, setError] = useState<string | null>(null);
   const [isLiking, setIsLiking] = useState(false);
@@ -74,8 +61,9 @@ export default function CopyDetailPage({ params }: { params: Promise<{ id: strin
     
     try {
       setIsLiking(true);
-      const updatedCopy = await updateLikes(copy.id);
+      const updatedCopy = liked ? await unlikeCopy(copy.id) : await likeCopy(copy.id);
       setLikes(updatedCopy.likes);
+      setLiked(updatedCopy.liked ?? !liked);
     } catch (err) {
       console.error('いいねの更新に失敗しました:', err);
     } finally {
@@ -128,7 +116,7 @@ export default function CopyDetailPage({ params }: { params: Promise<{ id: strin
                   isLiking ? 'opacity-50 cursor-not-allowed' : ''
                 }`}
               >
-                <span className="text-2xl">♥</span>
+                <span className="text-2xl">{liked ? '♥' : '♡'}</span>
                 <span className="text-lg">{likes}</span>
               </button>
             </div>
@@ -140,11 +128,11 @@ export default function CopyDetailPage({ params }: { params: Promise<{ id: strin
             <div className="grid grid-cols-2 gap-4 text-sm text-secondary">
               <div>
                 <span className="font-medium">配信チャネル:</span>
-                <span className="ml-2">{CHANNEL_LABELS[copy.channel]}</span>
+                <span className="ml-2">{labelOf(channels, copy.channel)}</span>
               </div>
               <div>
                 <span className="font-medium">トーン:</span>
-                <span className="ml-2">{TONE_LABELS[copy.tone]}</span>
+                <span className="ml-2">{labelOf(tones, copy.tone)}</span>
               </div>
               <div>
                 <span className="font-medium">ターゲット:</span>
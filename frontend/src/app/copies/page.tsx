@@ -5,44 +5,50 @@ import { Button } from '@/components/Button';
 import { Select } from '@/components/Select';
 import { Input } from '@/components/Input';
 import Link from 'next/link';
-import { getCopies, updateLikes } from '@/lib/api/copy';
-import { GetCopyResponse } from '@/lib/api/types';
-
-const CHANNEL_OPTIONS = [
-  { value: '', label: 'すべて' },
-  { value: 'app', label: 'アプリ通知' },
-  { value: 'line', label: 'LINE広告' },
-  { value: 'pop', label: '店舗POP' },
-  { value: 'sns', label: 'SNS投稿' },
-  { value: 'email', label: 'メールマガジン' },
-];
+import { getCopies, likeCopy, unlikeCopy } from '@/lib/api/copy';
+import { labelOf, useOptions } from '@/lib/api/meta';
+import { CopySort, GetCopyResponse } from '@/lib/api/types';
+
+const ALL_OPTION = { value: '', label: 'すべて' };
 
-const TONE_OPTIONS = [
-  { value: '', label: 'すべて' },
-  { value: 'pop', label: 'ポップ' },
-  { value: 'trust', label: '信頼感' },
-  { value: 'value', label: 'お得感' },
-  { value: 'luxury', label: '高級感' },
-  { value: 'casual', label: 'カジュアル' },
+const SORT_OPTIONS = [
+  { value: 'newest', label: '新しい順' },
+  { value: 'likes', label: 'いいねの多い順' },
+  { value: 'trending', label: '話題順' },
 ];
 
+const PAGE_SIZE = 24;
+
 export default function CopiesPage() {
+  const { channels, tones } = useOptions();
   const [copies, setCopies] = useState<GetCopyResponse[]>([]);
   const [isLoading, setIsLoading] = useState(true);
   const [error, setError] = useState<string | null>(null);
   const [selectedChannel, setSelectedChannel] = useState('');
   const [selectedTone, setSelectedTone] = useState('');
+  const [selectedSort, setSelectedSort] = useState<CopySort>('newest');
   const [searchQuery, setSearchQuery] = useState('');
   const [likingCopies, setLikingCopies] = useState<Set<string>>(new Set());
+  const [nextCursor, setNextCursor] = useState<string | undefined>(undefined);
+  const [isLoadingMore, setIsLoadingMore] = useState(false);
+
+  // チャネル・トーン・並び順はサーバー側で絞り込み、カーソルでページを辿る
+  const fetchPage = (cursor?: string) =>
+    getCopies({
+      channel: selectedChannel || undefined,
+      tone: selectedTone || undefined,
+      sort: selectedSort,
+      cursor,
+      limit: PAGE_SIZE,
+    });
 
   useEffect(() => {
     const fetchCopies = async () => {
+      setIsLoading(true);
       try {
-        const response = await getCopies();
-        const sortedCopies = (response || []).sort((a, b) => 
-          new Date(b.createdAt).getTime() - new Date(a.createdAt).getTime()
-        );
-        setCopies(sortedCopies);
+        const response = await fetchPage();
+        setCopies(response.copies || []);
+        setNextCursor(response.hasMore ? response.nextCursor : undefined);
       } catch (err) {
         setError('コピーの取得に失敗しました');
         console.error(err);
@@ -52,14 +58,30 @@ export default function CopiesPage() {
     };
 
     fetchCopies();
-  }, []);
+    // eslint-disable-next-line react-hooks/exhaustive-deps
+  }, [selectedChannel, selectedTone, selectedSort]);
+
+  const handleLoadMore = async () => {
+    if (!nextCursor || isLoadingMore) return;
+
+    try {
+      setIsLoadingMore(true);
+      const response = await fetchPage(nextCursor);
+      setCopies(prev => [...prev, ...(response.copies || [])]);
+      setNextCursor(response.hasMore ? response.nextCursor : undefined);
+    } catch (err) {
+      console.error('コピーの取得に失敗しました:', err);
+    } finally {
+      setIsLoadingMore(false);
+    }
+  };
 
-  const handleLike = async (copyId: string) => {
+  const handleLike = async (copyId: string, liked?: boolean) => {
     if (likingCopies.has(copyId)) return;
 
     try {
       setLikingCopies(prev => new Set(prev).add(copyId));
-      const updatedCopy = await updateLikes(copyId);
+      const updatedCopy = liked ? await unlikeCopy(copyId) : await likeCopy(copyId);
       setCopies(prev => prev.map(copy => 
         copy.id === copyId ? updatedCopy : copy
       ));
@@ -74,14 +96,11 @@ export default function CopiesPage() {
     }
   };
 
+  // キーワードは取得済みのコピーの中から絞り込む
   const filteredCopies = copies.filter((copy) => {
-    const matchesChannel = !selectedChannel || copy.channel === selectedChannel;
-    const matchesTone = !selectedTone || copy.tone === selectedTone;
-    const matchesSearch = !searchQuery ||
+    return !searchQuery ||
       copy.title.toLowerCase().includes(searchQuery.toLowerCase()) ||
       copy.description.toLowerCase().includes(searchQuery.toLowerCase());
-
-    return matchesChannel && matchesTone && matchesSearch;
   });
 
   if (isLoading) {
@@ -132,19 +151,25 @@ export default function CopiesPage() {
 
         {/* フィルターバー */}
         <div className="bg-white p-4 rounded-lg shadow mb-8">
-          <div className="grid grid-cols-1 md:grid-cols-3 gap-4">
+          <div className="grid grid-cols-1 md:grid-cols-4 gap-4">
             <Select
               label="配信チャネル"
-              options={CHANNEL_OPTIONS}
+              options={[ALL_OPTION, ...channels]}
               value={selectedChannel}
               onChange={(e) => setSelectedChannel(e.target.value)}
             />
             <Select
               label="トーン"
-              options={TONE_OPTIONS}
+              options={[ALL_OPTION, ...tones]}
               value={selectedTone}
               onChange={(e) => setSelectedTone(e.target.value)}
             />
+            <Select
+              label="並び順"
+              options={SORT_OPTIONS}
+              value={selectedSort}
+              onChange={(e) => setSelectedSort((e.target.value || 'newest') as CopySort)}
+            />
             <Input
               label="キーワード検索"
               placeholder="タイトルや本文で検索"
@@ -166,8 +191,8 @@ export default function CopiesPage() {
                 <h2 className="text-xl font-semibold mb-2 text-primary line-clamp-2">{copy.title}</h2>
                 <p className="text-secondary mb-4 line-clamp-3">{copy.description}</p>
                 <div className="flex items-center justify-between text-sm text-muted mb-4">
-                  <span>{CHANNEL_OPTIONS.find(opt => opt.value === copy.channel)?.label}</span>
-                  <span>{TONE_OPTIONS.find(opt => opt.value === copy.tone)?.label}</span>
+                  <span>{labelOf(channels, copy.channel)}</span>
+                  <span>{labelOf(tones, copy.tone)}</span>
                 </div>
               </div>
               <div className="flex items-center justify-between mt-auto">
@@ -177,17 +202,25 @@ export default function CopiesPage() {
                   }`}
                   onClick={(e) => {
                     e.preventDefault();
-                    handleLike(copy.id);
+                    handleLike(copy.id, copy.liked);
                   }}
                   disabled={likingCopies.has(copy.id)}
                 >
-                  ♥ {copy.likes}
+                  {copy.liked ? '♥' : '♡'} {copy.likes}
                 </button>
                 <span className="text-sm text-muted">作成日: {new Date(copy.createdAt).toLocaleDateString('ja-JP')}</span>
               </div>
             </Link>
           ))}
         </div>
+
+        {nextCursor && (
+          <div className="mt-8 text-center">
+            <Button variant="secondary" onClick={handleLoadMore} disabled={isLoadingMore}>
+              {isLoadingMore ? '読み込み中...' : 'もっと見る'}
+            </Button>
+          </div>
+        )}
       </div>
     </div>
   );
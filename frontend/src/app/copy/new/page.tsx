@@ -6,31 +6,15 @@ import { Button } from '@/components/Button';
 import { Input } from '@/components/Input';
 import { Textarea } from '@/components/Textarea';
 import { Select } from '@/components/Select';
-import { Toggle } from '@/components/Toggle';
 import { Toast } from '@/components/Toast';
 import { createCopy } from '@/lib/api/copy';
+import { useOptions } from '@/lib/api/meta';
 import { CreateCopyRequest } from '@/lib/api/types';
 
-const CHANNEL_OPTIONS = [
-  { value: 'app', label: 'アプリ通知' },
-  { value: 'line', label: 'LINE広告' },
-  { value: 'pop', label: '店舗POP' },
-  { value: 'sns', label: 'SNS投稿' },
-  { value: 'email', label: 'メールマガジン' },
-];
-
-const TONE_OPTIONS = [
-  { value: 'pop', label: 'ポップ' },
-  { value: 'trust', label: '信頼感' },
-  { value: 'value', label: 'お得感' },
-  { value: 'luxury', label: '高級感' },
-  { value: 'casual', label: 'カジュアル' },
-];
-
 export default function NewCopyPage() {
   const router = useRouter();
+  const { channels, tones } = useOptions();
   const [isLoading, setIsLoading] = useState(false);
-  const [isPublished, setIsPublished] = useState(false);
   const [toast, setToast] = useState<{ message: string; type: 'success' | 'error' } | null>(null);
 
   const handleSubmit = async (e: React.FormEvent<HTMLFormElement>) => {
@@ -44,7 +28,6 @@ export default function NewCopyPage() {
       target: formData.get('targetAudience') as string,
       channel: formData.get('channel') as CreateCopyRequest['channel'],
       tone: formData.get('tone') as CreateCopyRequest['tone'],
-      isPublished,
     };
 
     try {
@@ -94,24 +77,18 @@ export default function NewCopyPage() {
 
           <Select
             label="配信チャネル"
-            options={CHANNEL_OPTIONS}
+            options={channels}
             required
             name="channel"
           />
 
           <Select
             label="トーン"
-            options={TONE_OPTIONS}
+            options={tones}
             required
             name="tone"
           />
 
-          <Toggle
-            label="公開設定"
-            checked={isPublished}
-            onChange={(checked) => setIsPublished(checked)}
-          />
-
           <div className="flex justify-center">
             <Button
               type="submit"
@@ -0,0 +1,40 @@
+import client, { setToken } from './client';
+import { APIKey, CreatedAPIKey, LoginRequest, RegisterRequest, Session, User } from './types';
+
+// メールアドレスまたはパスワードが一致しない場合はinvalid_credentials（401）
+// 発行したトークンは以降のリクエストのAuthorizationヘッダーに付与される
+export const login = async (data: LoginRequest): Promise<Session> => {
+  const response = await client.post<Session>('/auth/login', data);
+  setToken(response.data.token);
+  return response.data;
+};
+
+export const logout = () => {
+  setToken(null);
+};
+
+// 最初の利用者以外はログイン中の利用者のみ登録できる（メールアドレスが登録済みの場合はduplicated）
+export const register = async (data: RegisterRequest): Promise<User> => {
+  const response = await client.post<User>('/users', data);
+  return response.data;
+};
+
+export const getMe = async (): Promise<User> => {
+  const response = await client.get<User>('/users/me');
+  return response.data;
+};
+
+export const getAPIKeys = async (): Promise<APIKey[]> => {
+  const response = await client.get<APIKey[]>('/users/me/api-keys');
+  return response.data;
+};
+
+// キーそのものはこのレスポンスでのみ返される（再表示できない）
+export const createAPIKey = async (name: string): Promise<CreatedAPIKey> => {
+  const response = await client.post<CreatedAPIKey>('/users/me/api-keys', { name });
+  return response.data;
+};
+
+export const revokeAPIKey = async (id: number): Promise<void> => {
+  await client.delete(`/users/me/api-keys/${id}`);
+};
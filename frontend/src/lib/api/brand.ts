@@ -0,0 +1,22 @@
+import client from './client';
+import { BrandProfile, BrandProfileRequest } from './types';
+
+export const getBrandProfiles = async (): Promise<BrandProfile[]> => {
+  const response = await client.get<BrandProfile[]>('/brand-profiles');
+  return response.data;
+};
+
+export const createBrandProfile = async (data: BrandProfileRequest): Promise<BrandProfile> => {
+  const response = await client.post<BrandProfile>('/brand-profiles', data);
+  return response.data;
+};
+
+export const updateBrandProfile = async (id: number, data: BrandProfileRequest): Promise<BrandProfile> => {
+  const response = await client.put<BrandProfile>(`/brand-profiles/${id}`, data);
+  return response.data;
+};
+
+// 削除したプロファイルを指定して生成した既存のコピーは残る
+export const deleteBrandProfile = async (id: number): Promise<void> => {
+  await client.delete(`/brand-profiles/${id}`);
+};
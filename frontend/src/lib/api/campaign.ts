@@ -0,0 +1,44 @@
+import client from './client';
+import {
+  Campaign,
+  CampaignCopiesResponse,
+  CampaignRequest,
+  CampaignSetResponse,
+  GenerateCampaignSetRequest,
+} from './types';
+
+export const getCampaigns = async (): Promise<Campaign[]> => {
+  const response = await client.get<Campaign[]>('/campaigns');
+  return response.data;
+};
+
+export const getCampaign = async (id: number): Promise<Campaign> => {
+  const response = await client.get<Campaign>(`/campaigns/${id}`);
+  return response.data;
+};
+
+export const createCampaign = async (data: CampaignRequest): Promise<Campaign> => {
+  const response = await client.post<Campaign>('/campaigns', data);
+  return response.data;
+};
+
+export const updateCampaign = async (id: number, data: CampaignRequest): Promise<Campaign> => {
+  const response = await client.put<Campaign>(`/campaigns/${id}`, data);
+  return response.data;
+};
+
+export const deleteCampaign = async (id: number): Promise<void> => {
+  await client.delete(`/campaigns/${id}`);
+};
+
+// 共通の内容から配信チャネルごとに1件ずつ生成（1件でも失敗した場合はいずれも保存されない）
+export const generateCampaignSet = async (id: number, data: GenerateCampaignSetRequest): Promise<CampaignSetResponse> => {
+  const response = await client.post<CampaignSetResponse>(`/campaigns/${id}/copies`, data);
+  return response.data;
+};
+
+// キャンペーンのすべてのコピー（未公開を含む、新しい順）といいね数の集計
+export const getCampaignCopies = async (id: number): Promise<CampaignCopiesResponse> => {
+  const response = await client.get<CampaignCopiesResponse>(`/campaigns/${id}/copies`);
+  return response.data;
+};
@@ -1,4 +1,5 @@
-import axios from 'axios';
+import axios, { isAxiosError } from 'axios';
+import { ProblemDetails } from './types';
 
 const getBaseURL = () => {
   const environment = process.env.NEXT_PUBLIC_ENVIRONMENT;
@@ -21,15 +22,54 @@ const client = axios.create({
   },
 });
 
+// 匿名の利用者を識別するクライアントIDを発行して保持（いいねの重複を防ぐため）
+const CLIENT_ID_KEY = 'clientId';
+
+const getClientId = (): string | null => {
+  if (typeof window === 'undefined') return null;
+  let clientId = window.localStorage.getItem(CLIENT_ID_KEY);
+  if (!clientId) {
+    clientId = window.crypto.randomUUID();
+    window.localStorage.setItem(CLIENT_ID_KEY, clientId);
+  }
+  return clientId;
+};
+
+// ログインで発行したトークンを保持（有効期限が切れた場合は401 token_expired）
+const TOKEN_KEY = 'authToken';
+
+export const getToken = (): string | null => {
+  if (typeof window === 'undefined') return null;
+  return window.localStorage.getItem(TOKEN_KEY);
+};
+
+export const setToken = (token: string | null) => {
+  if (typeof window === 'undefined') return;
+  if (token) {
+    window.localStorage.setItem(TOKEN_KEY, token);
+  } else {
+    window.localStorage.removeItem(TOKEN_KEY);
+  }
+};
+
 // リクエストインターセプターを追加
 client.interceptors.request.use((config) => {
   // パスに/api/v1を追加（先頭に追加）
   config.url = `/api/v1${config.url}`;
+
+  const clientId = getClientId();
+  if (clientId) {
+    config.headers['X-Client-ID'] = clientId;
+  }
+  const token = getToken();
+  if (token) {
+    config.headers['Authorization'] = `Bearer ${token}`;
+  }
   
   console.log('API Request:', {
     url: config.url,
     method: config.method,
-    headers: config.headers,
+    headers: { ...config.headers, Authorization: token ? '***' : undefined },
     data: config.data,
     baseURL: config.baseURL,
     apiBaseUrl: process.env.NEXT_PUBLIC_API_BASE_URL,
@@ -59,4 +99,14 @@ client.interceptors.response.use(
   }
 );
 
+// エラーレスポンスのRFC 7807の内容（APIのエラーレスポンスでない場合はundefined）
+export const getProblem = (error: unknown): ProblemDetails | undefined => {
+  if (!isAxiosError(error)) return undefined;
+  const data = error.response?.data;
+  if (data && typeof data === 'object' && 'code' in data) {
+    return data as ProblemDetails;
+  }
+  return undefined;
+};
+
 export default client; 
\ No newline at end of file
@@ -1,5 +1,13 @@
 import client from './client';
-import { CreateCopyRequest, CreateCopyResponse, GetCopyResponse, GetCopiesResponse } from './types';
+import {
+  CreateCopyRequest,
+  CreateCopyResponse,
+  GetCopyResponse,
+  GetCopiesParams,
+  GetCopiesResponse,
+  ScheduleRequest,
+  WorkflowRequest,
+} from './types';
 
 export const createCopy = async (data: CreateCopyRequest): Promise<CreateCopyResponse> => {
   console.log('Request data:', data);
@@ -12,12 +20,49 @@ export const getCopy = async (id: string): Promise<GetCopyResponse> => {
   return response.data;
 };
 
-export const getCopies = async (): Promise<GetCopiesResponse> => {
-  const response = await client.get<GetCopiesResponse>('/copies');
+export const getCopies = async (params: GetCopiesParams = {}): Promise<GetCopiesResponse> => {
+  const response = await client.get<GetCopiesResponse>('/copies', { params });
   return response.data;
 };
 
-export const updateLikes = async (id: string): Promise<GetCopyResponse> => {
+// いいねは投票者ごとに1回のみ数えられる（2回目以降はいいね数が変わらない、ログインが必要）
+export const likeCopy = async (id: string): Promise<GetCopyResponse> => {
   const response = await client.put<GetCopyResponse>(`/copies/${id}/likes`);
   return response.data;
-}; 
\ No newline at end of file
+};
+
+export const unlikeCopy = async (id: string): Promise<GetCopyResponse> => {
+  const response = await client.delete<GetCopyResponse>(`/copies/${id}/likes`);
+  return response.data;
+}; 
+
+export type WorkflowAction = 'review' | 'approve' | 'reject' | 'publish' | 'archive' | 'reopen';
+
+// 編集ワークフローの操作（現在の状態から遷移できない場合は409 invalid_transition）
+// 承認・差し戻しはレビューの担当者のみが行える
+export const transitionCopy = async (
+  id: string,
+  action: WorkflowAction,
+  data: WorkflowRequest = {},
+): Promise<GetCopyResponse> => {
+  const response = await client.post<GetCopyResponse>(`/copies/${id}/${action}`, data);
+  return response.data;
+};
+
+// レビュー中のコピーの担当者を変更する
+export const assignReviewer = async (id: string, reviewer: string): Promise<GetCopyResponse> => {
+  const response = await client.put<GetCopyResponse>(`/copies/${id}/reviewer`, { reviewer });
+  return response.data;
+};
+
+// レビュー中のコピー（依頼の古い順、reviewerを指定した場合はその担当のみ）
+export const getCopiesInReview = async (reviewer?: string): Promise<GetCopiesResponse['copies']> => {
+  const response = await client.get<Pick<GetCopiesResponse, 'copies'>>('/copies/in-review', { params: { reviewer } });
+  return response.data.copies;
+};
+
+// 公開日時・公開終了日時を設定する（省略した項目は解除、承認済みのコピーは公開日時に自動で公開される）
+export const scheduleCopy = async (id: string, data: ScheduleRequest): Promise<GetCopyResponse> => {
+  const response = await client.put<GetCopyResponse>(`/copies/${id}/schedule`, data);
+  return response.data;
+};
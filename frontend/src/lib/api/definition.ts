@@ -0,0 +1,41 @@
+import client from './client';
+import { ChannelDefinition, ChannelDefinitionRequest, ToneDefinition, ToneDefinitionRequest } from './types';
+
+export const getChannels = async (): Promise<ChannelDefinition[]> => {
+  const response = await client.get<ChannelDefinition[]>('/channels');
+  return response.data;
+};
+
+export const createChannel = async (data: ChannelDefinitionRequest): Promise<ChannelDefinition> => {
+  const response = await client.post<ChannelDefinition>('/channels', data);
+  return response.data;
+};
+
+// 組み込みのチャネルは出力項目（fields）を変更できない
+export const updateChannel = async (id: string, data: ChannelDefinitionRequest): Promise<ChannelDefinition> => {
+  const response = await client.put<ChannelDefinition>(`/channels/${id}`, data);
+  return response.data;
+};
+
+export const deleteChannel = async (id: string): Promise<void> => {
+  await client.delete(`/channels/${id}`);
+};
+
+export const getTones = async (): Promise<ToneDefinition[]> => {
+  const response = await client.get<ToneDefinition[]>('/tones');
+  return response.data;
+};
+
+export const createTone = async (data: ToneDefinitionRequest): Promise<ToneDefinition> => {
+  const response = await client.post<ToneDefinition>('/tones', data);
+  return response.data;
+};
+
+export const updateTone = async (id: string, data: ToneDefinitionRequest): Promise<ToneDefinition> => {
+  const response = await client.put<ToneDefinition>(`/tones/${id}`, data);
+  return response.data;
+};
+
+export const deleteTone = async (id: string): Promise<void> => {
+  await client.delete(`/tones/${id}`);
+};
@@ -0,0 +1,25 @@
+import { useEffect, useState } from 'react';
+import client from './client';
+import { Option, OptionsResponse } from './types';
+
+export const getOptions = async (): Promise<OptionsResponse> => {
+  const response = await client.get<OptionsResponse>('/meta/options');
+  return response.data;
+};
+
+// useOptions: 配信チャネル・トーンの選択肢を取得する（取得するまでは空の配列）
+export const useOptions = (): OptionsResponse => {
+  const [options, setOptions] = useState<OptionsResponse>({ channels: [], tones: [] });
+
+  useEffect(() => {
+    getOptions()
+      .then(setOptions)
+      .catch((err) => console.error('選択肢の取得に失敗しました:', err));
+  }, []);
+
+  return options;
+};
+
+// labelOf: 値に対応する表示名（見つからない場合は値をそのまま返す）
+export const labelOf = (options: Option[], value: string): string =>
+  options.find((option) => option.value === value)?.label ?? value;
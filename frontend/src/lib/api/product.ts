@@ -0,0 +1,33 @@
+import client from './client';
+import { GetCopyResponse, Product, ProductRequest } from './types';
+
+export const getProducts = async (): Promise<Product[]> => {
+  const response = await client.get<Product[]>('/products');
+  return response.data;
+};
+
+export const getProduct = async (id: number): Promise<Product> => {
+  const response = await client.get<Product>(`/products/${id}`);
+  return response.data;
+};
+
+// SKUが登録済みの場合はduplicated（409）
+export const createProduct = async (data: ProductRequest): Promise<Product> => {
+  const response = await client.post<Product>('/products', data);
+  return response.data;
+};
+
+export const updateProduct = async (id: number, data: ProductRequest): Promise<Product> => {
+  const response = await client.put<Product>(`/products/${id}`, data);
+  return response.data;
+};
+
+export const deleteProduct = async (id: number): Promise<void> => {
+  await client.delete(`/products/${id}`);
+};
+
+// 商品を指定して生成したすべてのコピー（未公開を含む、新しい順）
+export const getProductCopies = async (id: number): Promise<GetCopyResponse[]> => {
+  const response = await client.get<GetCopyResponse[]>(`/products/${id}/copies`);
+  return response.data;
+};
@@ -1,13 +1,146 @@
-export type Channel = 'app' | 'line' | 'pop' | 'sns' | 'email';
-export type Tone = 'pop' | 'trust' | 'value' | 'luxury' | 'casual';
+// 配信チャネル・トーンの値と表示名は GET /meta/options で取得する（getOptions）
+export type Channel = string;
+export type Tone = string;
 
-export interface CreateCopyRequest {
-  productName: string;
-  productFeatures: string;
+export interface Option {
+  value: string;
+  label: string;
+  description: string;
+}
+
+export interface OptionsResponse {
+  channels: Option[];
+  tones: Option[];
+}
+
+// 出力項目の長さの制限（unitは文字の数え方）
+export interface Limit {
+  unit: 'runes' | 'graphemes' | 'width';
+  min?: number;
+  max: number;
+}
+
+export interface OutputField {
+  name: string;
+  description: string;
+  limit: Limit;
+}
+
+// 配信チャネル・トーンの定義（builtInの定義は削除できない）
+export interface ChannelDefinition {
+  id: Channel;
+  label: string;
+  description: string;
+  guidance: string;
+  fields: OutputField[];
+  builtIn: boolean;
+  sortOrder: number;
+  createdAt: string;
+  updatedAt: string;
+}
+
+export interface ToneDefinition {
+  id: Tone;
+  label: string;
+  description: string;
+  guidance: string;
+  markers: string[];
+  builtIn: boolean;
+  sortOrder: number;
+  createdAt: string;
+  updatedAt: string;
+}
+
+export type ChannelDefinitionRequest = Pick<ChannelDefinition, 'id' | 'label' | 'description' | 'guidance' | 'fields' | 'sortOrder'>;
+export type ToneDefinitionRequest = Pick<ToneDefinition, 'id' | 'label' | 'description' | 'guidance' | 'markers' | 'sortOrder'>;
+
+// Ending: 文末の表現（空文字は指定なし）
+export type Ending = '' | 'desu_masu' | 'da_dearu';
+
+export interface BrandProfile {
+  id: number;
+  name: string;
+  voice: string;
+  preferredWords: string[];
+  bannedWords: string[];
+  samples: string[];
+  ending: Ending;
+  createdAt: string;
+  updatedAt: string;
+}
+
+export type BrandProfileRequest = Pick<BrandProfile, 'name' | 'voice' | 'preferredWords' | 'bannedWords' | 'samples' | 'ending'>;
+
+export interface ProductImage {
+  url: string;
+  alt: string;
+  width?: number;
+  height?: number;
+}
+
+export interface Product {
+  id: number;
+  sku: string;
+  name: string;
+  features: string;
+  // price: 価格（円、未設定の場合はnull）
+  price: number | null;
+  category: string;
+  images: ProductImage[];
+  createdAt: string;
+  updatedAt: string;
+}
+
+export type ProductRequest = Pick<Product, 'sku' | 'name' | 'features' | 'category' | 'images'> & { price?: number | null };
+
+export interface Campaign {
+  id: number;
+  name: string;
+  goal: string;
+  // target: キャンペーンの対象者（生成時にtargetを省略した場合に使用される）
   target: string;
+  startsAt: string;
+  endsAt: string;
+  createdAt: string;
+  updatedAt: string;
+}
+
+export type CampaignRequest = Pick<Campaign, 'name' | 'goal' | 'target' | 'startsAt' | 'endsAt'>;
+
+// productIdを指定した場合、productName・productFeaturesは省略でき商品の内容で補われる
+// campaignIdを指定した場合、targetは省略できキャンペーンの対象者で補われる
+// 生成したコピーは下書きとして保存され、レビューで承認された後に公開できる
+export interface CreateCopyRequest {
+  productName?: string;
+  productFeatures?: string;
+  target?: string;
   channel: Channel;
   tone: Tone;
-  isPublished: boolean;
+  // brandProfileId: 適用するブランドプロファイル（省略時は指定なし）
+  brandProfileId?: number;
+  productId?: number;
+  campaignId?: number;
+}
+
+// キャンペーンのセットの生成（channelsの配信チャネルごとに1件ずつ生成）
+export type GenerateCampaignSetRequest = Omit<CreateCopyRequest, 'channel' | 'campaignId'> & { channels: Channel[] };
+
+// 編集ワークフローの状態（draft → in_review → approved → published → archived）
+export type CopyStatus = 'draft' | 'in_review' | 'approved' | 'published' | 'archived';
+
+// ワークフローの操作（操作した利用者はログイン中の利用者）
+export interface WorkflowRequest {
+  // reviewer: レビューを担当する利用者のID（レビューの依頼・担当者の変更で必須）
+  reviewer?: string;
+  // comment: 承認・差し戻しのコメント（差し戻しで必須）
+  comment?: string;
+}
+
+export interface ScheduleRequest {
+  // publishAt: 承認済みのコピーを公開する日時（ISO 8601）
+  publishAt?: string;
+  // expireAt: 公開済みのコピーをアーカイブする日時（publishAtより後）
+  expireAt?: string;
 }
 
 interface BaseCopyResponse {
@@ -22,9 +155,166 @@ export interface GetCopyResponse extends BaseCopyResponse {
   title: string;
   description: string;
   likes: number;
+  // liked: いいね・取り消しのレスポンスのみ、この利用者がいいね済みかどうか
+  liked?: boolean;
   channel: Channel;
   tone: Tone;
   target: string;
+  status: CopyStatus;
+  // reviewer / reviewComment: レビューの担当者と、承認・差し戻しのコメント
+  reviewer: string;
+  reviewComment: string;
+  // submittedBy: レビューを依頼した利用者のID（担当者には指定できない）
+  submittedBy: string;
+  // publishAt / expireAt: 公開日時・公開終了日時（ISO 8601、この期間のみ公開済みの一覧に表示される）
+  publishAt?: string;
+  expireAt?: string;
+  // createdBy: コピーを生成した利用者のID（認証せずに生成した場合は省略）
+  createdBy?: number;
+}
+
+export interface CampaignSetResponse {
+  campaignId: number;
+  copies: GetCopyResponse[];
+}
+
+export interface CampaignCopiesResponse {
+  campaign: Campaign;
+  copies: GetCopyResponse[];
+  likes: {
+    total: number;
+    byChannel: Partial<Record<Channel, number>>;
+  };
+}
+
+export type CopySort = 'newest' | 'likes' | 'trending';
+
+export interface GetCopiesParams {
+  channel?: Channel;
+  tone?: Tone;
+  target?: string;
+  productName?: string;
+  createdFrom?: string;
+  createdTo?: string;
+  sort?: CopySort;
+  cursor?: string;
+  limit?: number;
+}
+
+export interface GetCopiesResponse {
+  copies: GetCopyResponse[];
+  nextCursor?: string;
+  hasMore: boolean;
 }
 
-export type GetCopiesResponse = GetCopyResponse[]; 
\ No newline at end of file
+export interface User {
+  id: number;
+  email: string;
+  name: string;
+  createdAt: string;
+  updatedAt: string;
+}
+
+export interface RegisterRequest {
+  email: string;
+  name: string;
+  // password: 8文字以上、72バイト以内
+  password: string;
+}
+
+export interface LoginRequest {
+  email: string;
+  password: string;
+}
+
+// ログインで発行したトークン（Authorization: Bearerで指定する）
+export interface Session {
+  token: string;
+  expiresAt: string;
+  user: User;
+}
+
+// スクリプトなどから利用するAPIキー（キーそのものは発行時のみ返される）
+export interface APIKey {
+  id: number;
+  userId: number;
+  name: string;
+  // prefix: キーの先頭の文字列（一覧でキーを見分けるために表示する）
+  prefix: string;
+  lastUsedAt?: string;
+  createdAt: string;
+}
+
+export interface CreatedAPIKey {
+  key: string;
+  apiKey: APIKey;
+}
+
+// エラーレスポンスのエラーコード（codeで判定し、detailは表示用）
+export type ErrorCode =
+  | 'invalid_request'
+  | 'invalid_channel'
+  | 'invalid_tone'
+  | 'invalid_parameter'
+  | 'copy_not_found'
+  | 'revision_not_found'
+  | 'generation_not_found'
+  | 'variant_not_found'
+  | 'job_not_found'
+  | 'channel_not_found'
+  | 'tone_not_found'
+  | 'invalid_definition'
+  | 'builtin_definition'
+  | 'brand_profile_not_found'
+  | 'invalid_brand_profile'
+  | 'invalid_profile'
+  | 'product_not_found'
+  | 'invalid_product'
+  | 'invalid_product_input'
+  | 'duplicated'
+  | 'campaign_not_found'
+  | 'invalid_campaign'
+  | 'invalid_campaign_input'
+  | 'invalid_campaign_channels'
+  | 'missing_target'
+  | 'no_changes'
+  | 'invalid_edit'
+  | 'publish_blocked'
+  | 'copy_not_editable'
+  | 'invalid_transition'
+  | 'not_in_review'
+  | 'not_assigned_reviewer'
+  | 'reviewer_required'
+  | 'self_review'
+  | 'comment_required'
+  | 'invalid_schedule'
+  | 'copy_not_schedulable'
+  | 'copy_expired'
+  | 'invalid_user'
+  | 'invalid_credentials'
+  | 'invalid_api_key'
+  | 'invalid_api_key_name'
+  | 'invalid_token'
+  | 'token_expired'
+  | 'authentication_required'
+  | 'user_not_found'
+  | 'api_key_not_found'
+  | 'invalid_llm_output'
+  | 'llm_rate_limited'
+  | 'llm_unavailable'
+  | 'search_unavailable'
+  | 'internal_error'
+  | (string & {});
+
+// RFC 7807のエラーレスポンス（application/problem+json）
+export interface ProblemDetails {
+  type: string;
+  title: string;
+  status: number;
+  code: ErrorCode;
+  detail?: string;
+  instance?: string;
+  // invalid_edit / invalid_llm_output / invalid_definition / invalid_profile / invalid_product_input / invalid_campaign_input / invalid_user の場合の違反内容
+  violations?: string[];
+  [extension: string]: unknown;
+}